@@ -0,0 +1,36 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aggregation provides helpers to merge attestations into aggregates,
+// and to build sync committee contributions from sync committee messages.
+//
+// Signatures are aggregated by the bls.SignatureAggregator, and created by
+// the bls.Signer, supplied by the caller.
+package aggregation
+
+import (
+	"errors"
+)
+
+var (
+	// ErrDataMismatch is returned when attestations to be merged have different data.
+	ErrDataMismatch = errors.New("attestation data does not match")
+	// ErrBitsMismatch is returned when attestations to be merged have incompatible bits.
+	ErrBitsMismatch = errors.New("attestation bits do not match")
+	// ErrOverlap is returned when attestations to be merged have aggregation bits in common.
+	ErrOverlap = errors.New("attestation aggregation bits overlap")
+	// ErrMessageMismatch is returned when sync committee messages to be aggregated are for different blocks.
+	ErrMessageMismatch = errors.New("sync committee message data does not match")
+	// ErrNotInSubcommittee is returned when a sync committee message is from a validator outside of the subcommittee.
+	ErrNotInSubcommittee = errors.New("validator not in sync subcommittee")
+)
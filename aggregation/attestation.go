@@ -0,0 +1,311 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// MergeAttestations merges pre-Electra attestations with the same data and
+// non-overlapping aggregation bits into a single aggregate attestation.
+func MergeAttestations(aggregator bls.SignatureAggregator,
+	attestations ...*phase0.Attestation,
+) (
+	*phase0.Attestation,
+	error,
+) {
+	if err := checkAggregator(aggregator, len(attestations)); err != nil {
+		return nil, err
+	}
+
+	bitlists := make([]bitfield.Bitlist, len(attestations))
+	signatures := make([]phase0.BLSSignature, len(attestations))
+	for i, attestation := range attestations {
+		if attestation == nil || attestation.Data == nil {
+			return nil, fmt.Errorf("attestation %d missing", i)
+		}
+		if !equalData(attestations[0].Data, attestation.Data) {
+			return nil, errors.Join(fmt.Errorf("attestation %d", i), ErrDataMismatch)
+		}
+		bitlists[i] = attestation.AggregationBits
+		signatures[i] = attestation.Signature
+	}
+
+	aggregationBits, err := mergeBits(bitlists)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := aggregator.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to aggregate signatures"), err)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            attestations[0].Data,
+		Signature:       signature,
+	}, nil
+}
+
+// MergeElectraAttestations merges Electra attestations with the same data,
+// the same committee bits and non-overlapping aggregation bits into a single
+// aggregate attestation.
+func MergeElectraAttestations(aggregator bls.SignatureAggregator,
+	attestations ...*electra.Attestation,
+) (
+	*electra.Attestation,
+	error,
+) {
+	if err := checkAggregator(aggregator, len(attestations)); err != nil {
+		return nil, err
+	}
+
+	bitlists := make([]bitfield.Bitlist, len(attestations))
+	signatures := make([]phase0.BLSSignature, len(attestations))
+	for i, attestation := range attestations {
+		if attestation == nil || attestation.Data == nil {
+			return nil, fmt.Errorf("attestation %d missing", i)
+		}
+		if !equalData(attestations[0].Data, attestation.Data) {
+			return nil, errors.Join(fmt.Errorf("attestation %d", i), ErrDataMismatch)
+		}
+		if !bytes.Equal(attestations[0].CommitteeBits, attestation.CommitteeBits) {
+			return nil, errors.Join(fmt.Errorf("attestation %d committee bits", i), ErrBitsMismatch)
+		}
+		bitlists[i] = attestation.AggregationBits
+		signatures[i] = attestation.Signature
+	}
+
+	aggregationBits, err := mergeBits(bitlists)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := aggregator.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to aggregate signatures"), err)
+	}
+
+	return &electra.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            attestations[0].Data,
+		Signature:       signature,
+		CommitteeBits:   bitfield.Bitvector64(append([]byte{}, attestations[0].CommitteeBits...)),
+	}, nil
+}
+
+// CombineCommitteeAttestations combines Electra attestations with the same
+// data, each for a single different committee, into a single attestation
+// covering all of the committees, as included in blocks.  The aggregation
+// bits of the result are those of the individual attestations concatenated
+// in committee index order.
+func CombineCommitteeAttestations(aggregator bls.SignatureAggregator,
+	attestations ...*electra.Attestation,
+) (
+	*electra.Attestation,
+	error,
+) {
+	if err := checkAggregator(aggregator, len(attestations)); err != nil {
+		return nil, err
+	}
+
+	type committeeAttestation struct {
+		index       uint64
+		attestation *electra.Attestation
+	}
+	committeeAttestations := make([]*committeeAttestation, len(attestations))
+	committeeBits := bitfield.NewBitvector64()
+	for i, attestation := range attestations {
+		if attestation == nil || attestation.Data == nil {
+			return nil, fmt.Errorf("attestation %d missing", i)
+		}
+		if !equalData(attestations[0].Data, attestation.Data) {
+			return nil, errors.Join(fmt.Errorf("attestation %d", i), ErrDataMismatch)
+		}
+		if len(attestation.CommitteeBits) != len(committeeBits) || attestation.CommitteeBits.Count() != 1 {
+			return nil, errors.Join(fmt.Errorf("attestation %d must be for a single committee", i), ErrBitsMismatch)
+		}
+		index := attestation.CommitteeBits.BitIndices()[0]
+		if committeeBits.BitAt(uint64(index)) {
+			return nil, errors.Join(fmt.Errorf("attestation %d duplicates committee %d", i, index), ErrBitsMismatch)
+		}
+		committeeBits.SetBitAt(uint64(index), true)
+		committeeAttestations[i] = &committeeAttestation{
+			index:       uint64(index),
+			attestation: attestation,
+		}
+	}
+	sort.Slice(committeeAttestations, func(i, j int) bool {
+		return committeeAttestations[i].index < committeeAttestations[j].index
+	})
+
+	total := uint64(0)
+	for _, committeeAttestation := range committeeAttestations {
+		total += committeeAttestation.attestation.AggregationBits.Len()
+	}
+	aggregationBits := bitfield.NewBitlist(total)
+	signatures := make([]phase0.BLSSignature, 0, len(committeeAttestations))
+	offset := uint64(0)
+	for _, committeeAttestation := range committeeAttestations {
+		bits := committeeAttestation.attestation.AggregationBits
+		for i := uint64(0); i < bits.Len(); i++ {
+			if bits.BitAt(i) {
+				aggregationBits.SetBitAt(offset+i, true)
+			}
+		}
+		offset += bits.Len()
+		signatures = append(signatures, committeeAttestation.attestation.Signature)
+	}
+
+	signature, err := aggregator.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to aggregate signatures"), err)
+	}
+
+	return &electra.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            attestations[0].Data,
+		Signature:       signature,
+		CommitteeBits:   committeeBits,
+	}, nil
+}
+
+// MergeVersionedAttestations merges versioned attestations of the same
+// version, using MergeAttestations or MergeElectraAttestations as
+// appropriate.
+func MergeVersionedAttestations(aggregator bls.SignatureAggregator,
+	attestations ...*spec.VersionedAttestation,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	if len(attestations) == 0 {
+		return nil, errors.New("no attestations supplied")
+	}
+	for i, attestation := range attestations {
+		if attestation == nil || attestation.IsEmpty() {
+			return nil, fmt.Errorf("attestation %d missing", i)
+		}
+		if attestation.Version != attestations[0].Version {
+			return nil, fmt.Errorf("attestation %d has version %v, expected %v", i, attestation.Version, attestations[0].Version)
+		}
+	}
+
+	version := attestations[0].Version
+	res := &spec.VersionedAttestation{
+		Version: version,
+	}
+
+	if version == spec.DataVersionElectra {
+		electraAttestations := make([]*electra.Attestation, len(attestations))
+		for i := range attestations {
+			electraAttestations[i] = attestations[i].Electra
+		}
+		attestation, err := MergeElectraAttestations(aggregator, electraAttestations...)
+		if err != nil {
+			return nil, err
+		}
+		res.Electra = attestation
+
+		return res, nil
+	}
+
+	phase0Attestations := make([]*phase0.Attestation, len(attestations))
+	for i := range attestations {
+		var attestation *phase0.Attestation
+		switch version {
+		case spec.DataVersionPhase0:
+			attestation = attestations[i].Phase0
+		case spec.DataVersionAltair:
+			attestation = attestations[i].Altair
+		case spec.DataVersionBellatrix:
+			attestation = attestations[i].Bellatrix
+		case spec.DataVersionCapella:
+			attestation = attestations[i].Capella
+		case spec.DataVersionDeneb:
+			attestation = attestations[i].Deneb
+		default:
+			return nil, fmt.Errorf("unsupported version %v", version)
+		}
+		phase0Attestations[i] = attestation
+	}
+	attestation, err := MergeAttestations(aggregator, phase0Attestations...)
+	if err != nil {
+		return nil, err
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		res.Phase0 = attestation
+	case spec.DataVersionAltair:
+		res.Altair = attestation
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = attestation
+	case spec.DataVersionCapella:
+		res.Capella = attestation
+	case spec.DataVersionDeneb:
+		res.Deneb = attestation
+	}
+
+	return res, nil
+}
+
+func checkAggregator(aggregator bls.SignatureAggregator, attestations int) error {
+	if aggregator == nil {
+		return errors.New("no signature aggregator specified")
+	}
+	if attestations == 0 {
+		return errors.New("no attestations supplied")
+	}
+
+	return nil
+}
+
+// mergeBits merges aggregation bitlists of the same length that do not overlap.
+func mergeBits(bitlists []bitfield.Bitlist) (bitfield.Bitlist, error) {
+	res := bitfield.Bitlist(append([]byte{}, bitlists[0]...))
+	for i := 1; i < len(bitlists); i++ {
+		if bitlists[i].Len() != res.Len() {
+			return nil, errors.Join(fmt.Errorf("attestation %d aggregation bits length %d, expected %d", i, bitlists[i].Len(), res.Len()), ErrBitsMismatch)
+		}
+		overlaps, err := res.Overlaps(bitlists[i])
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("attestation %d", i), err)
+		}
+		if overlaps {
+			return nil, errors.Join(fmt.Errorf("attestation %d", i), ErrOverlap)
+		}
+		res, err = res.Or(bitlists[i])
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("attestation %d", i), err)
+		}
+	}
+
+	return res, nil
+}
+
+func equalData(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	if data1.Source == nil || data1.Target == nil || data2.Source == nil || data2.Target == nil {
+		return false
+	}
+
+	return data1.Equal(data2)
+}
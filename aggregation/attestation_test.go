@@ -0,0 +1,304 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/aggregation"
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// xorAggregator is a signature aggregator that XORs signatures together.
+type xorAggregator struct{}
+
+func (xorAggregator) AggregateSignatures(signatures []phase0.BLSSignature) (phase0.BLSSignature, error) {
+	var res phase0.BLSSignature
+	for _, signature := range signatures {
+		for i := range signature {
+			res[i] ^= signature[i]
+		}
+	}
+
+	return res, nil
+}
+
+type erroringAggregator struct{}
+
+func (erroringAggregator) AggregateSignatures([]phase0.BLSSignature) (phase0.BLSSignature, error) {
+	return phase0.BLSSignature{}, errors.New("bad signature")
+}
+
+func testData(slot phase0.Slot) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            slot,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x02}},
+		Target:          &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{0x03}},
+	}
+}
+
+func testBits(length uint64, indices ...uint64) bitfield.Bitlist {
+	bits := bitfield.NewBitlist(length)
+	for _, index := range indices {
+		bits.SetBitAt(index, true)
+	}
+
+	return bits
+}
+
+func testCommitteeBits(indices ...uint64) bitfield.Bitvector64 {
+	bits := bitfield.NewBitvector64()
+	for _, index := range indices {
+		bits.SetBitAt(index, true)
+	}
+
+	return bits
+}
+
+func TestMergeAttestations(t *testing.T) {
+	tests := []struct {
+		name         string
+		aggregator   bls.SignatureAggregator
+		attestations []*phase0.Attestation
+		expected     *phase0.Attestation
+		err          string
+	}{
+		{
+			name: "AggregatorMissing",
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1)},
+			},
+			err: "no signature aggregator specified",
+		},
+		{
+			name:       "AttestationsMissing",
+			aggregator: xorAggregator{},
+			err:        "no attestations supplied",
+		},
+		{
+			name:       "AttestationNil",
+			aggregator: xorAggregator{},
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1)},
+				nil,
+			},
+			err: "attestation 1 missing",
+		},
+		{
+			name:       "DataMismatch",
+			aggregator: xorAggregator{},
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1)},
+				{AggregationBits: testBits(8, 1), Data: testData(2)},
+			},
+			err: "attestation 1\nattestation data does not match",
+		},
+		{
+			name:       "LengthMismatch",
+			aggregator: xorAggregator{},
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1)},
+				{AggregationBits: testBits(9, 1), Data: testData(1)},
+			},
+			err: "attestation 1 aggregation bits length 9, expected 8\nattestation bits do not match",
+		},
+		{
+			name:       "Overlap",
+			aggregator: xorAggregator{},
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0, 1), Data: testData(1)},
+				{AggregationBits: testBits(8, 1, 2), Data: testData(1)},
+			},
+			err: "attestation 1\nattestation aggregation bits overlap",
+		},
+		{
+			name:       "AggregatorError",
+			aggregator: erroringAggregator{},
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1)},
+			},
+			err: "failed to aggregate signatures\nbad signature",
+		},
+		{
+			name:       "Good",
+			aggregator: xorAggregator{},
+			attestations: []*phase0.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1), Signature: phase0.BLSSignature{0x01}},
+				{AggregationBits: testBits(8, 3, 4), Data: testData(1), Signature: phase0.BLSSignature{0x02}},
+				{AggregationBits: testBits(8, 7), Data: testData(1), Signature: phase0.BLSSignature{0x04}},
+			},
+			expected: &phase0.Attestation{
+				AggregationBits: testBits(8, 0, 3, 4, 7),
+				Data:            testData(1),
+				Signature:       phase0.BLSSignature{0x07},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := aggregation.MergeAttestations(test.aggregator, test.attestations...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestMergeElectraAttestations(t *testing.T) {
+	tests := []struct {
+		name         string
+		attestations []*electra.Attestation
+		expected     *electra.Attestation
+		err          string
+	}{
+		{
+			name: "CommitteeBitsMismatch",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1), CommitteeBits: testCommitteeBits(1)},
+				{AggregationBits: testBits(8, 1), Data: testData(1), CommitteeBits: testCommitteeBits(2)},
+			},
+			err: "attestation 1 committee bits\nattestation bits do not match",
+		},
+		{
+			name: "Overlap",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1), CommitteeBits: testCommitteeBits(1)},
+				{AggregationBits: testBits(8, 0), Data: testData(1), CommitteeBits: testCommitteeBits(1)},
+			},
+			err: "attestation 1\nattestation aggregation bits overlap",
+		},
+		{
+			name: "Good",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(8, 0), Data: testData(1), CommitteeBits: testCommitteeBits(1), Signature: phase0.BLSSignature{0x01}},
+				{AggregationBits: testBits(8, 5), Data: testData(1), CommitteeBits: testCommitteeBits(1), Signature: phase0.BLSSignature{0x02}},
+			},
+			expected: &electra.Attestation{
+				AggregationBits: testBits(8, 0, 5),
+				Data:            testData(1),
+				CommitteeBits:   testCommitteeBits(1),
+				Signature:       phase0.BLSSignature{0x03},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := aggregation.MergeElectraAttestations(xorAggregator{}, test.attestations...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestCombineCommitteeAttestations(t *testing.T) {
+	tests := []struct {
+		name         string
+		attestations []*electra.Attestation
+		expected     *electra.Attestation
+		err          string
+	}{
+		{
+			name: "MultipleCommittees",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(4, 0), Data: testData(1), CommitteeBits: testCommitteeBits(1, 2)},
+			},
+			err: "attestation 0 must be for a single committee\nattestation bits do not match",
+		},
+		{
+			name: "DuplicateCommittee",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(4, 0), Data: testData(1), CommitteeBits: testCommitteeBits(2)},
+				{AggregationBits: testBits(4, 1), Data: testData(1), CommitteeBits: testCommitteeBits(2)},
+			},
+			err: "attestation 1 duplicates committee 2\nattestation bits do not match",
+		},
+		{
+			name: "DataMismatch",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(4, 0), Data: testData(1), CommitteeBits: testCommitteeBits(2)},
+				{AggregationBits: testBits(4, 1), Data: testData(2), CommitteeBits: testCommitteeBits(3)},
+			},
+			err: "attestation 1\nattestation data does not match",
+		},
+		{
+			name: "Good",
+			attestations: []*electra.Attestation{
+				{AggregationBits: testBits(3, 1), Data: testData(1), CommitteeBits: testCommitteeBits(5), Signature: phase0.BLSSignature{0x01}},
+				{AggregationBits: testBits(4, 0, 3), Data: testData(1), CommitteeBits: testCommitteeBits(2), Signature: phase0.BLSSignature{0x02}},
+			},
+			expected: &electra.Attestation{
+				AggregationBits: testBits(7, 0, 3, 5),
+				Data:            testData(1),
+				CommitteeBits:   testCommitteeBits(2, 5),
+				Signature:       phase0.BLSSignature{0x03},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := aggregation.CombineCommitteeAttestations(xorAggregator{}, test.attestations...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestMergeVersionedAttestations(t *testing.T) {
+	_, err := aggregation.MergeVersionedAttestations(xorAggregator{})
+	require.EqualError(t, err, "no attestations supplied")
+
+	_, err = aggregation.MergeVersionedAttestations(xorAggregator{},
+		&spec.VersionedAttestation{Version: spec.DataVersionDeneb, Deneb: &phase0.Attestation{AggregationBits: testBits(8, 0), Data: testData(1)}},
+		&spec.VersionedAttestation{Version: spec.DataVersionElectra, Electra: &electra.Attestation{AggregationBits: testBits(8, 1), Data: testData(1)}},
+	)
+	require.EqualError(t, err, "attestation 1 has version electra, expected deneb")
+
+	res, err := aggregation.MergeVersionedAttestations(xorAggregator{},
+		&spec.VersionedAttestation{Version: spec.DataVersionDeneb, Deneb: &phase0.Attestation{AggregationBits: testBits(8, 0), Data: testData(1)}},
+		&spec.VersionedAttestation{Version: spec.DataVersionDeneb, Deneb: &phase0.Attestation{AggregationBits: testBits(8, 1), Data: testData(1)}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionDeneb, res.Version)
+	require.Equal(t, testBits(8, 0, 1), res.Deneb.AggregationBits)
+
+	res, err = aggregation.MergeVersionedAttestations(xorAggregator{},
+		&spec.VersionedAttestation{Version: spec.DataVersionElectra, Electra: &electra.Attestation{AggregationBits: testBits(8, 0), Data: testData(1), CommitteeBits: testCommitteeBits(3)}},
+		&spec.VersionedAttestation{Version: spec.DataVersionElectra, Electra: &electra.Attestation{AggregationBits: testBits(8, 1), Data: testData(1), CommitteeBits: testCommitteeBits(3)}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, res.Version)
+	require.Equal(t, testBits(8, 0, 1), res.Electra.AggregationBits)
+	require.Equal(t, testCommitteeBits(3), res.Electra.CommitteeBits)
+}
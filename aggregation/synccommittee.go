@@ -0,0 +1,217 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+const (
+	// SyncCommitteeSubnetCount is the number of sync committee subnets.
+	SyncCommitteeSubnetCount = 4
+	// TargetAggregatorsPerSyncSubcommittee is the target number of aggregators
+	// for each sync subcommittee.
+	TargetAggregatorsPerSyncSubcommittee = 16
+)
+
+var (
+	// DomainSyncCommitteeSelectionProof is the domain type for sync committee selection proofs.
+	DomainSyncCommitteeSelectionProof = phase0.DomainType{0x08, 0x00, 0x00, 0x00}
+	// DomainContributionAndProof is the domain type for sync committee contributions and proofs.
+	DomainContributionAndProof = phase0.DomainType{0x09, 0x00, 0x00, 0x00}
+)
+
+// SyncCommitteeSelectionProof creates the selection proof for a sync
+// committee member for the given slot and subcommittee.  The domain should
+// be that of DomainSyncCommitteeSelectionProof at the epoch of the slot.
+func SyncCommitteeSelectionProof(signer bls.Signer,
+	slot phase0.Slot,
+	subcommitteeIndex uint64,
+	domain phase0.Domain,
+) (
+	phase0.BLSSignature,
+	error,
+) {
+	if signer == nil {
+		return phase0.BLSSignature{}, errors.New("no signer specified")
+	}
+
+	selectionData := &altair.SyncAggregatorSelectionData{
+		Slot:              slot,
+		SubcommitteeIndex: subcommitteeIndex,
+	}
+	root, err := signingRoot(selectionData, domain)
+	if err != nil {
+		return phase0.BLSSignature{}, err
+	}
+	signature, err := signer.Sign(root)
+	if err != nil {
+		return phase0.BLSSignature{}, errors.Join(errors.New("failed to sign selection data"), err)
+	}
+
+	return signature, nil
+}
+
+// IsSyncCommitteeAggregator returns true if the selection proof selects its
+// signer as an aggregator for a sync committee of the given size.
+func IsSyncCommitteeAggregator(selectionProof phase0.BLSSignature, syncCommitteeSize uint64) bool {
+	modulo := syncCommitteeSize / SyncCommitteeSubnetCount / TargetAggregatorsPerSyncSubcommittee
+	if modulo < 1 {
+		modulo = 1
+	}
+	hash := sha256.Sum256(selectionProof[:])
+
+	return binary.LittleEndian.Uint64(hash[:8])%modulo == 0
+}
+
+// BuildSyncCommitteeContribution builds the contribution for a subcommittee
+// from the messages of its members.  The committee is the full sync
+// committee for the period, in order.  A validator that holds more than one
+// position in the subcommittee has a bit set, and its signature included in
+// the aggregate, for each position.
+func BuildSyncCommitteeContribution(aggregator bls.SignatureAggregator,
+	committee []phase0.ValidatorIndex,
+	subcommitteeIndex uint64,
+	messages ...*altair.SyncCommitteeMessage,
+) (
+	*altair.SyncCommitteeContribution,
+	error,
+) {
+	if aggregator == nil {
+		return nil, errors.New("no signature aggregator specified")
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("no messages supplied")
+	}
+	if len(committee) == 0 || len(committee)%SyncCommitteeSubnetCount != 0 {
+		return nil, fmt.Errorf("invalid sync committee size %d", len(committee))
+	}
+	if subcommitteeIndex >= SyncCommitteeSubnetCount {
+		return nil, fmt.Errorf("invalid subcommittee index %d", subcommitteeIndex)
+	}
+
+	subcommitteeSize := uint64(len(committee) / SyncCommitteeSubnetCount)
+	subcommittee := committee[subcommitteeIndex*subcommitteeSize : (subcommitteeIndex+1)*subcommitteeSize]
+
+	// Bits are set directly rather than through the bitvector's methods, as
+	// the methods only operate on mainnet-sized subcommittees.
+	aggregationBits := bitfield.Bitvector128(make([]byte, (subcommitteeSize+7)/8))
+	signatures := make([]phase0.BLSSignature, 0, len(messages))
+	for i, message := range messages {
+		if message == nil {
+			return nil, fmt.Errorf("message %d missing", i)
+		}
+		if message.Slot != messages[0].Slot || message.BeaconBlockRoot != messages[0].BeaconBlockRoot {
+			return nil, errors.Join(fmt.Errorf("message %d", i), ErrMessageMismatch)
+		}
+		found := false
+		for position, index := range subcommittee {
+			if index != message.ValidatorIndex {
+				continue
+			}
+			found = true
+			bit := byte(1 << (position % 8))
+			if aggregationBits[position/8]&bit != 0 {
+				return nil, errors.Join(fmt.Errorf("message %d duplicates validator %d", i, index), ErrOverlap)
+			}
+			aggregationBits[position/8] |= bit
+			signatures = append(signatures, message.Signature)
+		}
+		if !found {
+			return nil, errors.Join(fmt.Errorf("message %d from validator %d", i, message.ValidatorIndex), ErrNotInSubcommittee)
+		}
+	}
+
+	signature, err := aggregator.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to aggregate signatures"), err)
+	}
+
+	return &altair.SyncCommitteeContribution{
+		Slot:              messages[0].Slot,
+		BeaconBlockRoot:   messages[0].BeaconBlockRoot,
+		SubcommitteeIndex: subcommitteeIndex,
+		AggregationBits:   aggregationBits,
+		Signature:         signature,
+	}, nil
+}
+
+// BuildSignedContributionAndProof wraps a contribution with the aggregator's
+// selection proof and signs it.  The domain should be that of
+// DomainContributionAndProof at the epoch of the contribution's slot.
+func BuildSignedContributionAndProof(signer bls.Signer,
+	aggregatorIndex phase0.ValidatorIndex,
+	contribution *altair.SyncCommitteeContribution,
+	selectionProof phase0.BLSSignature,
+	domain phase0.Domain,
+) (
+	*altair.SignedContributionAndProof,
+	error,
+) {
+	if signer == nil {
+		return nil, errors.New("no signer specified")
+	}
+	if contribution == nil {
+		return nil, errors.New("no contribution supplied")
+	}
+
+	contributionAndProof := &altair.ContributionAndProof{
+		AggregatorIndex: aggregatorIndex,
+		Contribution:    contribution,
+		SelectionProof:  selectionProof,
+	}
+	root, err := signingRoot(contributionAndProof, domain)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(root)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to sign contribution and proof"), err)
+	}
+
+	return &altair.SignedContributionAndProof{
+		Message:   contributionAndProof,
+		Signature: signature,
+	}, nil
+}
+
+type hashTreeRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+func signingRoot(item hashTreeRooter, domain phase0.Domain) (phase0.Root, error) {
+	objectRoot, err := item.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate object root"), err)
+	}
+
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate signing root"), err)
+	}
+
+	return root, nil
+}
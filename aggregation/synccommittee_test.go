@@ -0,0 +1,250 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/aggregation"
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// rootSigner is a signer that returns the signed root as the signature.
+type rootSigner struct{}
+
+func (rootSigner) Sign(root phase0.Root) (phase0.BLSSignature, error) {
+	var signature phase0.BLSSignature
+	copy(signature[:], root[:])
+
+	return signature, nil
+}
+
+func testMessage(index phase0.ValidatorIndex, root phase0.Root) *altair.SyncCommitteeMessage {
+	return &altair.SyncCommitteeMessage{
+		Slot:            10,
+		BeaconBlockRoot: root,
+		ValidatorIndex:  index,
+		Signature:       phase0.BLSSignature{byte(index)},
+	}
+}
+
+func testSyncBits(length uint64, indices ...uint64) bitfield.Bitvector128 {
+	bits := bitfield.Bitvector128(make([]byte, (length+7)/8))
+	for _, index := range indices {
+		bits[index/8] |= 1 << (index % 8)
+	}
+
+	return bits
+}
+
+func TestBuildSyncCommitteeContribution(t *testing.T) {
+	// Subcommittee 1 is validators 104-107, with validator 106 in two positions.
+	committee := []phase0.ValidatorIndex{
+		100, 101, 102, 103,
+		104, 105, 106, 106,
+		108, 109, 110, 111,
+		112, 113, 114, 115,
+	}
+	root := phase0.Root{0x01}
+
+	tests := []struct {
+		name       string
+		aggregator bls.SignatureAggregator
+		committee  []phase0.ValidatorIndex
+		index      uint64
+		messages   []*altair.SyncCommitteeMessage
+		expected   *altair.SyncCommitteeContribution
+		err        string
+		errIs      error
+	}{
+		{
+			name:      "AggregatorMissing",
+			committee: committee,
+			index:     1,
+			messages:  []*altair.SyncCommitteeMessage{testMessage(104, root)},
+			err:       "no signature aggregator specified",
+		},
+		{
+			name:       "MessagesMissing",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			err:        "no messages supplied",
+		},
+		{
+			name:       "CommitteeInvalid",
+			aggregator: xorAggregator{},
+			committee:  committee[:5],
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root)},
+			err:        "invalid sync committee size 5",
+		},
+		{
+			name:       "SubcommitteeIndexInvalid",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      4,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root)},
+			err:        "invalid subcommittee index 4",
+		},
+		{
+			name:       "MessageNil",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root), nil},
+			err:        "message 1 missing",
+		},
+		{
+			name:       "RootMismatch",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root), testMessage(105, phase0.Root{0x02})},
+			errIs:      aggregation.ErrMessageMismatch,
+		},
+		{
+			name:       "NotInSubcommittee",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root), testMessage(100, root)},
+			errIs:      aggregation.ErrNotInSubcommittee,
+		},
+		{
+			name:       "DuplicateMessage",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root), testMessage(104, root)},
+			errIs:      aggregation.ErrOverlap,
+		},
+		{
+			name:       "AggregationFails",
+			aggregator: erroringAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root)},
+			err:        "failed to aggregate signatures\nbad signature",
+		},
+		{
+			name:       "Good",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(105, root), testMessage(104, root)},
+			expected: &altair.SyncCommitteeContribution{
+				Slot:              10,
+				BeaconBlockRoot:   root,
+				SubcommitteeIndex: 1,
+				AggregationBits:   testSyncBits(4, 0, 1),
+				Signature:         phase0.BLSSignature{104 ^ 105},
+			},
+		},
+		{
+			name:       "MultiplePositions",
+			aggregator: xorAggregator{},
+			committee:  committee,
+			index:      1,
+			messages:   []*altair.SyncCommitteeMessage{testMessage(104, root), testMessage(106, root)},
+			expected: &altair.SyncCommitteeContribution{
+				Slot:              10,
+				BeaconBlockRoot:   root,
+				SubcommitteeIndex: 1,
+				AggregationBits:   testSyncBits(4, 0, 2, 3),
+				// The signature of validator 106 is included twice, so cancels out.
+				Signature: phase0.BLSSignature{104},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := aggregation.BuildSyncCommitteeContribution(test.aggregator, test.committee, test.index, test.messages...)
+			switch {
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			case test.errIs != nil:
+				require.True(t, errors.Is(err, test.errIs))
+			default:
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestIsSyncCommitteeAggregator(t *testing.T) {
+	// With a small committee every member is an aggregator.
+	require.True(t, aggregation.IsSyncCommitteeAggregator(phase0.BLSSignature{0x01}, 32))
+
+	// With a mainnet committee one in eight members is an aggregator.
+	for i := 0; i < 16; i++ {
+		selectionProof := phase0.BLSSignature{byte(i)}
+		hash := sha256.Sum256(selectionProof[:])
+		expected := binary.LittleEndian.Uint64(hash[:8])%8 == 0
+		require.Equal(t, expected, aggregation.IsSyncCommitteeAggregator(selectionProof, 512))
+	}
+}
+
+func TestSyncCommitteeSelectionProof(t *testing.T) {
+	domain := phase0.Domain{0x08}
+
+	_, err := aggregation.SyncCommitteeSelectionProof(nil, 10, 1, domain)
+	require.EqualError(t, err, "no signer specified")
+
+	selectionData := &altair.SyncAggregatorSelectionData{Slot: 10, SubcommitteeIndex: 1}
+	objectRoot, err := selectionData.HashTreeRoot()
+	require.NoError(t, err)
+	expected, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+
+	selectionProof, err := aggregation.SyncCommitteeSelectionProof(rootSigner{}, 10, 1, domain)
+	require.NoError(t, err)
+	require.Equal(t, expected[:], selectionProof[:32])
+}
+
+func TestBuildSignedContributionAndProof(t *testing.T) {
+	domain := phase0.Domain{0x09}
+	contribution := &altair.SyncCommitteeContribution{
+		Slot:              10,
+		BeaconBlockRoot:   phase0.Root{0x01},
+		SubcommitteeIndex: 1,
+		AggregationBits:   bitfield.NewBitvector128(),
+	}
+
+	_, err := aggregation.BuildSignedContributionAndProof(nil, 5, contribution, phase0.BLSSignature{0x02}, domain)
+	require.EqualError(t, err, "no signer specified")
+	_, err = aggregation.BuildSignedContributionAndProof(rootSigner{}, 5, nil, phase0.BLSSignature{0x02}, domain)
+	require.EqualError(t, err, "no contribution supplied")
+
+	res, err := aggregation.BuildSignedContributionAndProof(rootSigner{}, 5, contribution, phase0.BLSSignature{0x02}, domain)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(5), res.Message.AggregatorIndex)
+	require.Equal(t, contribution, res.Message.Contribution)
+	require.Equal(t, phase0.BLSSignature{0x02}, res.Message.SelectionProof)
+
+	objectRoot, err := res.Message.HashTreeRoot()
+	require.NoError(t, err)
+	expected, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected[:], res.Signature[:32])
+}
@@ -14,6 +14,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -25,4 +27,9 @@ type AttestationDataOpts struct {
 	Slot phase0.Slot
 	// CommitteeIndex is the committee index for which the data is obtained.
 	CommitteeIndex phase0.CommitteeIndex
+	// HedgeAfter is the time after which a second request is made if no
+	// valid response has been received, with the first valid response of
+	// either request used.
+	// If 0 then a single request is made.
+	HedgeAfter time.Duration
 }
@@ -0,0 +1,26 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// BeaconBlockRootsOpts are the options for obtaining multiple beacon block roots.
+type BeaconBlockRootsOpts struct {
+	Common CommonOpts
+
+	// Blocks are the IDs of the blocks for which roots are obtained.
+	Blocks []string
+
+	// Concurrency is the maximum number of requests made at the same time.
+	// If 0 then a default of 16 is used.
+	Concurrency int
+}
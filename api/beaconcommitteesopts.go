@@ -25,4 +25,10 @@ type BeaconCommitteesOpts struct {
 	// Epoch is the epoch for which the data is obtained.
 	// This is optional; if not supplied it will obtain the data at the epoch relating to the state.
 	Epoch *phase0.Epoch
+	// Slot is the slot for which the data is obtained.
+	// This is optional; if not supplied it will obtain the committees for all slots in the epoch.
+	Slot *phase0.Slot
+	// Index is the committee index for which the data is obtained.
+	// This is optional; if not supplied it will obtain the committees for all indices.
+	Index *phase0.CommitteeIndex
 }
@@ -20,4 +20,8 @@ type BeaconStateOpts struct {
 	// State is the state at which the data is obtained.
 	// It can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 	State string
+
+	// VerifyRoot, if true and State is a state root, confirms that the root
+	// of the returned state matches the requested root.
+	VerifyRoot bool
 }
@@ -0,0 +1,26 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// BlindedBlockOpts are the options for obtaining signed blinded beacon blocks.
+type BlindedBlockOpts struct {
+	Common CommonOpts
+
+	// Block is the ID of the block which the data is obtained.
+	Block string
+
+	// VerifyRoot, if true and Block is a block root, confirms that the root
+	// of the returned block matches the requested root.
+	VerifyRoot bool
+}
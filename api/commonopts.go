@@ -13,11 +13,43 @@
 
 package api
 
-import "time"
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
 
 // CommonOpts are options common for all calls.
 type CommonOpts struct {
 	// Timeout is a specific timeout for this call.
 	// If 0 then the default timeout is used.
 	Timeout time.Duration
+
+	// RequestID is the ID for this call, sent to the server to allow
+	// correlation of client and server logs.
+	// If empty then an ID is generated.
+	RequestID string
+
+	// ConsensusVersion forces versioned data in the response to be decoded
+	// as the given version, regardless of the version reported by the
+	// server.  Any disagreement with the reported version is logged as a
+	// warning rather than failing the call.
+	// If unknown then the version reported by the server is used.
+	ConsensusVersion spec.DataVersion
+
+	// MaxResponseSize is the maximum size in bytes of the response body for
+	// this call, after any decompression.
+	// If 0 then the default maximum response size is used.
+	MaxResponseSize int64
+
+	// Address overrides the address of the beacon node for this call, for
+	// example to send heavy debug or state requests to an archive node
+	// whilst other calls go to a local node.
+	// If empty then the address of the client is used.
+	Address string
+
+	// Host overrides the Host header sent with this call, for deployments
+	// that route requests to beacon nodes by host.
+	// If empty then the host of the address is used.
+	Host string
 }
@@ -0,0 +1,92 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/api/metadata"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DependentRoot returns the block root on which the data in the response is
+// based, if supplied by the beacon node.
+func (r *Response[T]) DependentRoot() (phase0.Root, bool) {
+	if r == nil || r.Metadata == nil {
+		return phase0.Root{}, false
+	}
+
+	root, isRoot := r.Metadata[metadata.DependentRoot].(phase0.Root)
+
+	return root, isRoot
+}
+
+// DependsOnRoot returns true if the data in the response is based on the
+// given block root.
+func (r *Response[T]) DependsOnRoot(root phase0.Root) bool {
+	dependentRoot, exists := r.DependentRoot()
+
+	return exists && dependentRoot == root
+}
+
+// DutiesStale returns true if the proposer or attester duties in the
+// response, which are for the given epoch, must be refetched following the
+// head event.
+//
+// Proposer duties for an epoch depend on the last block of the prior epoch,
+// and attester duties on the last block of the epoch before that.  The head
+// event supplies these roots relative to its own epoch, so duties for epochs
+// whose dependent block is not covered by the event, or events that do not
+// supply dependent roots, are always considered stale.
+func (r *Response[T]) DutiesStale(event *apiv1.HeadEvent, dutiesEpoch phase0.Epoch, slotsPerEpoch uint64) (bool, error) {
+	if event == nil {
+		return false, errors.New("no head event supplied")
+	}
+	if slotsPerEpoch == 0 {
+		return false, errors.New("slots per epoch cannot be 0")
+	}
+	dependentRoot, exists := r.DependentRoot()
+	if !exists {
+		return false, errors.New("response does not contain dependent root")
+	}
+
+	headEpoch := phase0.Epoch(uint64(event.Slot) / slotsPerEpoch)
+
+	var eventRoot phase0.Root
+	switch any(r.Data).(type) {
+	case []*apiv1.ProposerDuty:
+		if dutiesEpoch != headEpoch {
+			return true, nil
+		}
+		eventRoot = event.CurrentDutyDependentRoot
+	case []*apiv1.AttesterDuty:
+		switch dutiesEpoch {
+		case headEpoch:
+			eventRoot = event.PreviousDutyDependentRoot
+		case headEpoch + 1:
+			eventRoot = event.CurrentDutyDependentRoot
+		default:
+			return true, nil
+		}
+	default:
+		return false, errors.New("response does not contain proposer or attester duties")
+	}
+
+	if eventRoot.IsZero() {
+		return true, nil
+	}
+
+	return eventRoot != dependentRoot, nil
+}
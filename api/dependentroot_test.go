@@ -0,0 +1,167 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/api/metadata"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependentRoot(t *testing.T) {
+	root := phase0.Root{0x01}
+
+	var nilResponse *api.Response[[]*apiv1.ProposerDuty]
+	_, exists := nilResponse.DependentRoot()
+	require.False(t, exists)
+
+	response := &api.Response[[]*apiv1.ProposerDuty]{}
+	_, exists = response.DependentRoot()
+	require.False(t, exists)
+	require.False(t, response.DependsOnRoot(root))
+
+	response.Metadata = map[string]any{metadata.DependentRoot: root}
+	dependentRoot, exists := response.DependentRoot()
+	require.True(t, exists)
+	require.Equal(t, root, dependentRoot)
+	require.True(t, response.DependsOnRoot(root))
+	require.False(t, response.DependsOnRoot(phase0.Root{0x02}))
+}
+
+func TestDutiesStale(t *testing.T) {
+	previousRoot := phase0.Root{0x01}
+	currentRoot := phase0.Root{0x02}
+	// Head at epoch 3 with 32 slots per epoch.
+	event := &apiv1.HeadEvent{
+		Slot:                      100,
+		PreviousDutyDependentRoot: previousRoot,
+		CurrentDutyDependentRoot:  currentRoot,
+	}
+
+	proposerDuties := func(root phase0.Root) *api.Response[[]*apiv1.ProposerDuty] {
+		return &api.Response[[]*apiv1.ProposerDuty]{
+			Data:     []*apiv1.ProposerDuty{},
+			Metadata: map[string]any{metadata.DependentRoot: root},
+		}
+	}
+	attesterDuties := func(root phase0.Root) *api.Response[[]*apiv1.AttesterDuty] {
+		return &api.Response[[]*apiv1.AttesterDuty]{
+			Data:     []*apiv1.AttesterDuty{},
+			Metadata: map[string]any{metadata.DependentRoot: root},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		stale    func() (bool, error)
+		expected bool
+		err      string
+	}{
+		{
+			name: "EventMissing",
+			stale: func() (bool, error) {
+				return proposerDuties(currentRoot).DutiesStale(nil, 3, 32)
+			},
+			err: "no head event supplied",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			stale: func() (bool, error) {
+				return proposerDuties(currentRoot).DutiesStale(event, 3, 0)
+			},
+			err: "slots per epoch cannot be 0",
+		},
+		{
+			name: "DependentRootMissing",
+			stale: func() (bool, error) {
+				return (&api.Response[[]*apiv1.ProposerDuty]{}).DutiesStale(event, 3, 32)
+			},
+			err: "response does not contain dependent root",
+		},
+		{
+			name: "NotDuties",
+			stale: func() (bool, error) {
+				return (&api.Response[string]{
+					Metadata: map[string]any{metadata.DependentRoot: currentRoot},
+				}).DutiesStale(event, 3, 32)
+			},
+			err: "response does not contain proposer or attester duties",
+		},
+		{
+			name: "ProposerCurrent",
+			stale: func() (bool, error) {
+				return proposerDuties(currentRoot).DutiesStale(event, 3, 32)
+			},
+			expected: false,
+		},
+		{
+			name: "ProposerReorged",
+			stale: func() (bool, error) {
+				return proposerDuties(previousRoot).DutiesStale(event, 3, 32)
+			},
+			expected: true,
+		},
+		{
+			name: "ProposerNextEpoch",
+			stale: func() (bool, error) {
+				return proposerDuties(currentRoot).DutiesStale(event, 4, 32)
+			},
+			expected: true,
+		},
+		{
+			name: "AttesterCurrentEpoch",
+			stale: func() (bool, error) {
+				return attesterDuties(previousRoot).DutiesStale(event, 3, 32)
+			},
+			expected: false,
+		},
+		{
+			name: "AttesterNextEpoch",
+			stale: func() (bool, error) {
+				return attesterDuties(currentRoot).DutiesStale(event, 4, 32)
+			},
+			expected: false,
+		},
+		{
+			name: "AttesterNextEpochReorged",
+			stale: func() (bool, error) {
+				return attesterDuties(previousRoot).DutiesStale(event, 4, 32)
+			},
+			expected: true,
+		},
+		{
+			name: "AttesterEventRootMissing",
+			stale: func() (bool, error) {
+				return attesterDuties(previousRoot).DutiesStale(&apiv1.HeadEvent{Slot: 100}, 3, 32)
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stale, err := test.stale()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, stale)
+			}
+		})
+	}
+}
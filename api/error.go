@@ -23,6 +23,8 @@ type Error struct {
 	Endpoint   string
 	StatusCode int
 	Data       []byte
+	// RequestID is the ID of the request that resulted in the error.
+	RequestID string
 }
 
 func (e Error) Error() string {
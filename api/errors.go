@@ -13,7 +13,10 @@
 
 package api
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrUnsupportedVersion is returned when the data requested is not present for the
 // version of the struct.
@@ -22,3 +25,12 @@ var ErrUnsupportedVersion = errors.New("unsupported version")
 // ErrDataMissing is returned when the data requested is missing from the versioned
 // struct.
 var ErrDataMissing = errors.New("data missing")
+
+// ErrBlindedDataMissing is returned when the data requested is only present in
+// the full variant of a versioned struct, and the struct is blinded.  It wraps
+// ErrDataMissing.
+var ErrBlindedDataMissing = fmt.Errorf("%w: not present in blinded data", ErrDataMissing)
+
+// ErrLimitExceeded is returned when data exceeds a limit of its fork, and so
+// would be rejected by a beacon node.
+var ErrLimitExceeded = errors.New("limit exceeded")
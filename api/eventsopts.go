@@ -15,6 +15,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -35,6 +36,12 @@ type EventsOpts struct {
 	// In general, it is better to use event-specific handlers as they avoid casting, and also provide a context.
 	Handler EventHandlerFunc
 
+	// RawHandler is a handler to which all events are sent without decoding, as the event name and data.
+	// If this is supplied then topics are not checked against those supported, allowing events with
+	// client-specific or newly introduced topics to be received.  Events with supported topics are also
+	// sent to the generic or event-specific handlers if they are supplied.
+	RawHandler RawEventHandlerFunc
+
 	// AttestationHandler is a handler for the attestation event.
 	AttestationHandler AttestationEventHandlerFunc
 	// AttesterSlashingHandler is a handler for the attester_slashing event.
@@ -63,11 +70,54 @@ type EventsOpts struct {
 	SingleAttestationHandler SingleAttestationEventHandlerFunc
 	// VoluntaryExitHandler is a handler for the voluntary_exit event.
 	VoluntaryExitHandler VoluntaryExitEventHandlerFunc
+
+	// Workers is the number of goroutines that send events to handlers.
+	// If 0 then events are sent to handlers directly from the loop reading
+	// the event stream, so a slow handler delays the reading of further
+	// events and can result in the stream being disconnected.
+	// If more than 1 then events may be sent to handlers out of order.
+	Workers int
+	// BufferSize is the number of events that can be queued awaiting a
+	// worker.  Only used if Workers is greater than 0.
+	// If 0 then a default of 256 is used.
+	BufferSize int
+	// OverflowPolicy is the action taken when an event is received and the
+	// buffer is full.  Only used if Workers is greater than 0.
+	OverflowPolicy EventOverflowPolicy
+	// OverflowHandler is called with each event that is dropped due to the
+	// buffer being full.  Required if OverflowPolicy is EventOverflowError.
+	OverflowHandler EventOverflowHandlerFunc
+	// HandlerTimeout is the maximum time that a handler has to process an
+	// event, after which the context passed to the handler is canceled.
+	// If 0 then the context is only canceled when the events stream stops.
+	HandlerTimeout time.Duration
 }
 
+// EventOverflowPolicy is the policy for events that arrive when the event
+// buffer is full.
+type EventOverflowPolicy int
+
+const (
+	// EventOverflowBlock waits for space in the buffer, pausing the reading of
+	// the event stream until a worker is free.
+	EventOverflowBlock EventOverflowPolicy = iota
+	// EventOverflowDropOldest drops the oldest event in the buffer to make
+	// space for the new event.
+	EventOverflowDropOldest
+	// EventOverflowError drops the new event and passes it to the overflow
+	// handler.
+	EventOverflowError
+)
+
+// EventOverflowHandlerFunc is the handler for events dropped due to overflow.
+type EventOverflowHandlerFunc func(ctx context.Context, topic string, data []byte)
+
 // EventHandlerFunc is the handler for generic events.
 type EventHandlerFunc func(*apiv1.Event)
 
+// RawEventHandlerFunc is the handler for undecoded events.
+type RawEventHandlerFunc func(ctx context.Context, topic string, data []byte)
+
 // AttestationEventHandlerFunc is the handler for attestation events.
 type AttestationEventHandlerFunc func(context.Context, *spec.VersionedAttestation)
 
@@ -0,0 +1,48 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// ProposalFallbackPolicy is the policy for choosing between a builder and a
+// locally-produced execution payload when obtaining a proposal.
+type ProposalFallbackPolicy uint8
+
+const (
+	// ProposalFallbackPolicyNone leaves the choice of payload to the beacon
+	// node, as influenced by the builder boost factor.
+	ProposalFallbackPolicyNone ProposalFallbackPolicy = iota
+	// ProposalFallbackPolicyBuilderOnly requires a builder payload.
+	ProposalFallbackPolicyBuilderOnly
+	// ProposalFallbackPolicyLocalOnly requires a locally-produced payload.
+	ProposalFallbackPolicyLocalOnly
+	// ProposalFallbackPolicyPreferBuilderAboveValue uses a builder payload if
+	// its value is at least the minimum builder value, otherwise a
+	// locally-produced payload.
+	ProposalFallbackPolicyPreferBuilderAboveValue
+)
+
+var proposalFallbackPolicyStrings = [...]string{
+	"none",
+	"builder_only",
+	"local_only",
+	"prefer_builder_above_value",
+}
+
+// String returns a string representation of the policy.
+func (p ProposalFallbackPolicy) String() string {
+	if uint64(p) < uint64(len(proposalFallbackPolicyStrings)) {
+		return proposalFallbackPolicyStrings[p]
+	}
+
+	return "unknown"
+}
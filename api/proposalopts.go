@@ -13,7 +13,12 @@
 
 package api
 
-import "github.com/attestantio/go-eth2-client/spec/phase0"
+import (
+	"math/big"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
 
 // ProposalOpts are the options for obtaining proposals.
 type ProposalOpts struct {
@@ -32,4 +37,16 @@ type ProposalOpts struct {
 	// payload, as per https://ethereum.github.io/beacon-APIs/#/Validator/produceBlockV3
 	// This is optional; if not supplied it will use the default value of 100.
 	BuilderBoostFactor *uint64
+	// FallbackPolicy is the policy for choosing between a builder and a locally-produced payload.
+	// This is optional; if supplied then BuilderBoostFactor must not be set, as the policy
+	// is implemented using the builder boost factor.
+	FallbackPolicy ProposalFallbackPolicy
+	// MinBuilderValue is the minimum value, in wei, of a builder payload for it to be used
+	// with ProposalFallbackPolicyPreferBuilderAboveValue.
+	MinBuilderValue *big.Int
+	// HedgeAfter is the time after which a second request is made if no
+	// valid response has been received, with the first valid response of
+	// either request used.
+	// If 0 then a single request is made.
+	HedgeAfter time.Duration
 }
@@ -17,4 +17,29 @@ package api
 type Response[T any] struct {
 	Data     T
 	Metadata map[string]any
+
+	release []func()
+}
+
+// OnRelease adds a function to be called when the response is released.
+// It is used by clients that decode responses in to pooled objects.
+func (r *Response[T]) OnRelease(fn func()) {
+	r.release = append(r.release, fn)
+}
+
+// Release returns any pooled objects held by the response to their pools.
+// After calling Release neither the response data nor anything obtained from
+// it may be used, so callers must copy any values they wish to retain first.
+// Calling Release is optional: responses that are not released are garbage
+// collected as usual.  Release may be called more than once, and on a nil
+// response.
+func (r *Response[T]) Release() {
+	if r == nil {
+		return
+	}
+	release := r.release
+	r.release = nil
+	for _, fn := range release {
+		fn()
+	}
 }
@@ -0,0 +1,39 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseRelease(t *testing.T) {
+	released := 0
+	response := &api.Response[int]{Data: 1}
+	response.OnRelease(func() { released++ })
+	response.OnRelease(func() { released++ })
+
+	response.Release()
+	require.Equal(t, 2, released)
+
+	// Releasing again has no effect.
+	response.Release()
+	require.Equal(t, 2, released)
+
+	// Releasing a nil response has no effect.
+	var nilResponse *api.Response[int]
+	nilResponse.Release()
+}
@@ -0,0 +1,39 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+)
+
+// ResponseTooLargeError is returned when the body of a response from a node
+// exceeds the maximum permitted size.
+type ResponseTooLargeError struct {
+	// Endpoint is the endpoint that returned the response.
+	Endpoint string
+	// Limit is the maximum permitted size of the response body, in bytes.
+	Limit int64
+	// Compressed is true if the limit was exceeded when decompressing the
+	// response body.
+	Compressed bool
+}
+
+// Error implements the error interface.
+func (e *ResponseTooLargeError) Error() string {
+	if e.Compressed {
+		return fmt.Sprintf("decompressed response from %s exceeds maximum size of %d bytes", e.Endpoint, e.Limit)
+	}
+
+	return fmt.Sprintf("response from %s exceeds maximum size of %d bytes", e.Endpoint, e.Limit)
+}
@@ -0,0 +1,34 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// RootMismatchError is returned when the root of data returned by a node
+// does not match the root that was requested.
+type RootMismatchError struct {
+	// Requested is the root that was requested.
+	Requested phase0.Root
+	// Returned is the root of the data that was returned.
+	Returned phase0.Root
+}
+
+// Error implements the error interface.
+func (e *RootMismatchError) Error() string {
+	return fmt.Sprintf("returned data has root %#x; requested %#x", e.Returned, e.Requested)
+}
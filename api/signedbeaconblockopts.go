@@ -19,4 +19,8 @@ type SignedBeaconBlockOpts struct {
 
 	// Block is the ID of the block which the data is obtained.
 	Block string
+
+	// VerifyRoot, if true and Block is a block root, confirms that the root
+	// of the returned block matches the requested root.
+	VerifyRoot bool
 }
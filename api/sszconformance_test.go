@@ -0,0 +1,189 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// populate fills in missing containers and fixed-size byte fields so that
+// an empty object is valid SSZ.
+func populate(val reflect.Value, sszSize string) {
+	switch val.Kind() {
+	case reflect.Pointer:
+		if val.IsNil() {
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+		populate(val.Elem(), sszSize)
+	case reflect.Struct:
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Type().Field(i)
+			if !field.IsExported() || field.Name == "Version" {
+				continue
+			}
+			populate(val.Field(i), field.Tag.Get("ssz-size"))
+		}
+	case reflect.Slice:
+		if val.Type() == reflect.TypeOf(bitfield.Bitlist{}) {
+			val.Set(reflect.ValueOf(bitfield.NewBitlist(0)))
+
+			return
+		}
+		size, err := strconv.Atoi(strings.Split(sszSize, ",")[0])
+		if err == nil && val.Type().Elem().Kind() == reflect.Uint8 {
+			val.Set(reflect.MakeSlice(val.Type(), size, size))
+		}
+	}
+}
+
+// sszObject is the full set of fastssz interfaces that API types with SSZ
+// representations must implement.
+type sszObject interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// TestSSZConformance ensures that API types with SSZ representations
+// implement the full set of fastssz interfaces, and that the root of their
+// tree matches their hash tree root.
+func TestSSZConformance(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  func() sszObject
+	}{
+		{
+			name: "ValidatorRegistration",
+			obj:  func() sszObject { return &apiv1.ValidatorRegistration{} },
+		},
+		{
+			name: "SignedValidatorRegistration",
+			obj: func() sszObject {
+				return &apiv1.SignedValidatorRegistration{Message: &apiv1.ValidatorRegistration{}}
+			},
+		},
+		{
+			name: "BellatrixBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1bellatrix.BlindedBeaconBlock{} },
+		},
+		{
+			name: "BellatrixBlindedBeaconBlockBody",
+			obj:  func() sszObject { return &apiv1bellatrix.BlindedBeaconBlockBody{} },
+		},
+		{
+			name: "BellatrixSignedBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1bellatrix.SignedBlindedBeaconBlock{} },
+		},
+		{
+			name: "CapellaBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1capella.BlindedBeaconBlock{} },
+		},
+		{
+			name: "CapellaBlindedBeaconBlockBody",
+			obj:  func() sszObject { return &apiv1capella.BlindedBeaconBlockBody{} },
+		},
+		{
+			name: "CapellaSignedBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1capella.SignedBlindedBeaconBlock{} },
+		},
+		{
+			name: "DenebBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1deneb.BlindedBeaconBlock{} },
+		},
+		{
+			name: "DenebBlindedBeaconBlockBody",
+			obj:  func() sszObject { return &apiv1deneb.BlindedBeaconBlockBody{} },
+		},
+		{
+			name: "DenebSignedBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1deneb.SignedBlindedBeaconBlock{} },
+		},
+		{
+			name: "DenebBlockContents",
+			obj:  func() sszObject { return &apiv1deneb.BlockContents{} },
+		},
+		{
+			name: "DenebSignedBlockContents",
+			obj:  func() sszObject { return &apiv1deneb.SignedBlockContents{} },
+		},
+		{
+			name: "ElectraBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1electra.BlindedBeaconBlock{} },
+		},
+		{
+			name: "ElectraBlindedBeaconBlockBody",
+			obj:  func() sszObject { return &apiv1electra.BlindedBeaconBlockBody{} },
+		},
+		{
+			name: "ElectraSignedBlindedBeaconBlock",
+			obj:  func() sszObject { return &apiv1electra.SignedBlindedBeaconBlock{} },
+		},
+		{
+			name: "ElectraBlockContents",
+			obj:  func() sszObject { return &apiv1electra.BlockContents{} },
+		},
+		{
+			name: "ElectraSignedBlockContents",
+			obj:  func() sszObject { return &apiv1electra.SignedBlockContents{} },
+		},
+		{
+			name: "VersionedBlindedBeaconBlock",
+			obj:  func() sszObject { return &api.VersionedBlindedBeaconBlock{} },
+		},
+		{
+			name: "VersionedSignedBlindedBeaconBlock",
+			obj:  func() sszObject { return &api.VersionedSignedBlindedBeaconBlock{} },
+		},
+		{
+			name: "VersionedSignedValidatorRegistration",
+			obj:  func() sszObject { return &api.VersionedSignedValidatorRegistration{} },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			obj := test.obj()
+			populate(reflect.ValueOf(obj), "")
+
+			data, err := obj.MarshalSSZ()
+			require.NoError(t, err)
+			require.Len(t, data, obj.SizeSSZ())
+
+			root, err := obj.HashTreeRoot()
+			require.NoError(t, err)
+			tree, err := obj.GetTree()
+			require.NoError(t, err)
+			require.Equal(t, root[:], tree.Hash())
+
+			rt := test.obj()
+			require.NoError(t, rt.UnmarshalSSZ(data))
+			rtRoot, err := rt.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, root, rtRoot)
+		})
+	}
+}
@@ -23,4 +23,8 @@ type SyncCommitteeDutiesOpts struct {
 	Epoch phase0.Epoch
 	// Indices is a list of validators for which to obtain the duties.
 	Indices []phase0.ValidatorIndex
+	// ToEpoch is the last epoch for which duties are required.  If this is in
+	// the sync committee period after that containing Epoch then the duties for
+	// both periods are returned.
+	ToEpoch phase0.Epoch
 }
@@ -0,0 +1,109 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestBeaconHeadJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.beaconHeadJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":false}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"slot":true,"root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":false}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field beaconHeadJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":false}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "RootMissing",
+			input: []byte(`{"slot":"1","execution_optimistic":false}`),
+			err:   "root missing",
+		},
+		{
+			name:  "RootInvalid",
+			input: []byte(`{"slot":"1","root":"invalid","execution_optimistic":false}`),
+			err:   "invalid value for root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "RootShort",
+			input: []byte(`{"slot":"1","root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":false}`),
+			err:   "incorrect length 31 for root",
+		},
+		{
+			name:  "ExecutionOptimisticWrongType",
+			input: []byte(`{"slot":"1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":"true"}`),
+			err:   "invalid JSON: json: cannot unmarshal string into Go struct field beaconHeadJSON.execution_optimistic of type bool",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":false}`),
+		},
+		{
+			name:  "GoodOptimistic",
+			input: []byte(`{"slot":"1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BeaconHead
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestBeaconHeadStrictJSON(t *testing.T) {
+	input := []byte(`{"slot":"1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","execution_optimistic":false,"extra":true}`)
+
+	var res api.BeaconHead
+	require.NoError(t, json.Unmarshal(input, &res))
+	require.EqualError(t, codecs.UnmarshalJSON(input, &res, codecs.WithStrictJSON(true)), "unknown field extra")
+}
@@ -0,0 +1,188 @@
+// Code generated by copygen. DO NOT EDIT.
+
+package bellatrix
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Copy returns a deep copy of the blinded beacon block.
+func (b *BlindedBeaconBlock) Copy() *BlindedBeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := &BlindedBeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+	}
+	res.Body = b.Body.Copy()
+
+	return res
+}
+
+// Equal returns true if the blinded beacon block is equal to other.
+func (b *BlindedBeaconBlock) Equal(other *BlindedBeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the blinded beacon block body.
+func (b *BlindedBeaconBlockBody) Copy() *BlindedBeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := &BlindedBeaconBlockBody{
+		RANDAOReveal: b.RANDAOReveal,
+		Graffiti:     b.Graffiti,
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = make([]*phase0.ProposerSlashing, len(b.ProposerSlashings))
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = make([]*phase0.AttesterSlashing, len(b.AttesterSlashings))
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = make([]*phase0.Attestation, len(b.Attestations))
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = make([]*phase0.Deposit, len(b.Deposits))
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = make([]*phase0.SignedVoluntaryExit, len(b.VoluntaryExits))
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+	res.ExecutionPayloadHeader = b.ExecutionPayloadHeader.Copy()
+
+	return res
+}
+
+// Equal returns true if the blinded beacon block body is equal to other.
+func (b *BlindedBeaconBlockBody) Equal(other *BlindedBeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.RANDAOReveal != other.RANDAOReveal {
+		return false
+	}
+	if !b.ETH1Data.Equal(other.ETH1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for i := range b.ProposerSlashings {
+		if !b.ProposerSlashings[i].Equal(other.ProposerSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for i := range b.AttesterSlashings {
+		if !b.AttesterSlashings[i].Equal(other.AttesterSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for i := range b.Attestations {
+		if !b.Attestations[i].Equal(other.Attestations[i]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for i := range b.Deposits {
+		if !b.Deposits[i].Equal(other.Deposits[i]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for i := range b.VoluntaryExits {
+		if !b.VoluntaryExits[i].Equal(other.VoluntaryExits[i]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayloadHeader.Equal(other.ExecutionPayloadHeader) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the signed blinded beacon block.
+func (s *SignedBlindedBeaconBlock) Copy() *SignedBlindedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := &SignedBlindedBeaconBlock{
+		Signature: s.Signature,
+	}
+	res.Message = s.Message.Copy()
+
+	return res
+}
+
+// Equal returns true if the signed blinded beacon block is equal to other.
+func (s *SignedBlindedBeaconBlock) Equal(other *SignedBlindedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
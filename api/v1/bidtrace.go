@@ -0,0 +1,167 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// BidTrace is the trace of a builder's bid for a slot, as used by the
+// builder and relay APIs.
+type BidTrace struct {
+	Slot                 phase0.Slot
+	ParentHash           phase0.Hash32
+	BlockHash            phase0.Hash32
+	BuilderPubkey        phase0.BLSPubKey
+	ProposerPubkey       phase0.BLSPubKey
+	ProposerFeeRecipient bellatrix.ExecutionAddress
+	GasLimit             uint64
+	GasUsed              uint64
+	Value                *uint256.Int
+}
+
+// bidTraceJSON is the spec representation of the struct.
+type bidTraceJSON struct {
+	Slot                 string `json:"slot"`
+	ParentHash           string `json:"parent_hash"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerPubkey       string `json:"proposer_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	GasLimit             string `json:"gas_limit"`
+	GasUsed              string `json:"gas_used"`
+	Value                string `json:"value"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BidTrace) MarshalJSON() ([]byte, error) {
+	value := "0"
+	if b.Value != nil {
+		value = b.Value.Dec()
+	}
+
+	return json.Marshal(&bidTraceJSON{
+		Slot:                 fmt.Sprintf("%d", b.Slot),
+		ParentHash:           fmt.Sprintf("%#x", b.ParentHash),
+		BlockHash:            fmt.Sprintf("%#x", b.BlockHash),
+		BuilderPubkey:        fmt.Sprintf("%#x", b.BuilderPubkey),
+		ProposerPubkey:       fmt.Sprintf("%#x", b.ProposerPubkey),
+		ProposerFeeRecipient: b.ProposerFeeRecipient.String(),
+		GasLimit:             strconv.FormatUint(b.GasLimit, 10),
+		GasUsed:              strconv.FormatUint(b.GasUsed, 10),
+		Value:                value,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BidTrace) UnmarshalJSON(input []byte) error {
+	var data bidTraceJSON
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return b.unpack(&data)
+}
+
+func (b *BidTrace) unpack(data *bidTraceJSON) error {
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+
+	if data.ParentHash == "" {
+		return errors.New("parent hash missing")
+	}
+	if err := b.ParentHash.UnmarshalText([]byte(data.ParentHash)); err != nil {
+		return errors.Wrap(err, "invalid value for parent hash")
+	}
+
+	if data.BlockHash == "" {
+		return errors.New("block hash missing")
+	}
+	if err := b.BlockHash.UnmarshalText([]byte(data.BlockHash)); err != nil {
+		return errors.Wrap(err, "invalid value for block hash")
+	}
+
+	if data.BuilderPubkey == "" {
+		return errors.New("builder public key missing")
+	}
+	if err := b.BuilderPubkey.UnmarshalText([]byte(data.BuilderPubkey)); err != nil {
+		return errors.Wrap(err, "invalid value for builder public key")
+	}
+
+	if data.ProposerPubkey == "" {
+		return errors.New("proposer public key missing")
+	}
+	if err := b.ProposerPubkey.UnmarshalText([]byte(data.ProposerPubkey)); err != nil {
+		return errors.Wrap(err, "invalid value for proposer public key")
+	}
+
+	if data.ProposerFeeRecipient == "" {
+		return errors.New("proposer fee recipient missing")
+	}
+	feeRecipient, err := codecs.DecodeHexFixed(data.ProposerFeeRecipient, bellatrix.ExecutionAddressLength)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for proposer fee recipient")
+	}
+	copy(b.ProposerFeeRecipient[:], feeRecipient)
+
+	if data.GasLimit == "" {
+		return errors.New("gas limit missing")
+	}
+	b.GasLimit, err = strconv.ParseUint(data.GasLimit, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for gas limit")
+	}
+
+	if data.GasUsed == "" {
+		return errors.New("gas used missing")
+	}
+	b.GasUsed, err = strconv.ParseUint(data.GasUsed, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for gas used")
+	}
+
+	if data.Value == "" {
+		return errors.New("value missing")
+	}
+	b.Value, err = uint256.FromDecimal(data.Value)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for value")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BidTrace) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBidTraceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.bidTraceJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x0505050505050505050505050505050505050505","gas_limit":"30000000","gas_used":"12345678","value":"1000000000000000000"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "ParentHashShort",
+			input: []byte(`{"slot":"1","parent_hash":"0x01010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x0505050505050505050505050505050505050505","gas_limit":"30000000","gas_used":"12345678","value":"1000000000000000000"}`),
+			err:   "invalid value for parent hash: incorrect length",
+		},
+		{
+			name:  "ProposerFeeRecipientInvalid",
+			input: []byte(`{"slot":"1","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"invalid","gas_limit":"30000000","gas_used":"12345678","value":"1000000000000000000"}`),
+			err:   "invalid value for proposer fee recipient: incorrect length",
+		},
+		{
+			name:  "ValueInvalid",
+			input: []byte(`{"slot":"1","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x0505050505050505050505050505050505050505","gas_limit":"30000000","gas_used":"12345678","value":"-1"}`),
+			err:   "invalid value for value: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x0505050505050505050505050505050505050505","gas_limit":"30000000","gas_used":"12345678","value":"1000000000000000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BidTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
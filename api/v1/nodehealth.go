@@ -0,0 +1,62 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"net/http"
+)
+
+// NodeHealth is the health of a node, as reported by its health endpoint.
+type NodeHealth int
+
+const (
+	// NodeHealthUnknown means the health of the node is unknown.
+	NodeHealthUnknown NodeHealth = iota
+	// NodeHealthReady means the node is ready.
+	NodeHealthReady
+	// NodeHealthSyncing means the node is syncing but can serve incomplete data.
+	NodeHealthSyncing
+	// NodeHealthNotInitialized means the node is not initialized or has issues.
+	NodeHealthNotInitialized
+)
+
+var nodeHealthStrings = [...]string{
+	"unknown",
+	"ready",
+	"syncing",
+	"not_initialized",
+}
+
+// NodeHealthFromStatusCode returns the node health given the status code
+// returned by the health endpoint.
+func NodeHealthFromStatusCode(statusCode int) NodeHealth {
+	switch statusCode {
+	case http.StatusOK:
+		return NodeHealthReady
+	case http.StatusPartialContent:
+		return NodeHealthSyncing
+	case http.StatusServiceUnavailable:
+		return NodeHealthNotInitialized
+	default:
+		return NodeHealthUnknown
+	}
+}
+
+func (h NodeHealth) String() string {
+	if h < 0 || int(h) >= len(nodeHealthStrings) {
+		return nodeHealthStrings[0]
+	}
+
+	return nodeHealthStrings[h]
+}
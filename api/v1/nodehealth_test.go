@@ -0,0 +1,45 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeHealthFromStatusCode(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   api.NodeHealth
+		str        string
+	}{
+		{statusCode: http.StatusOK, expected: api.NodeHealthReady, str: "ready"},
+		{statusCode: http.StatusPartialContent, expected: api.NodeHealthSyncing, str: "syncing"},
+		{statusCode: http.StatusServiceUnavailable, expected: api.NodeHealthNotInitialized, str: "not_initialized"},
+		{statusCode: http.StatusBadRequest, expected: api.NodeHealthUnknown, str: "unknown"},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			health := api.NodeHealthFromStatusCode(test.statusCode)
+			require.Equal(t, test.expected, health)
+			require.Equal(t, test.str, health.String())
+		})
+	}
+
+	require.Equal(t, "unknown", api.NodeHealth(-1).String())
+}
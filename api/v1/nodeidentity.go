@@ -0,0 +1,186 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// NodeIdentity contains the network identity of a node.
+type NodeIdentity struct {
+	// PeerID is the libp2p peer ID of the node.
+	PeerID string
+	// ENR is the Ethereum node record of the node.
+	ENR string
+	// P2PAddresses are the multiaddrs on which the node listens for libp2p connections.
+	P2PAddresses []string
+	// DiscoveryAddresses are the multiaddrs on which the node listens for discovery.
+	DiscoveryAddresses []string
+	// Metadata is the node's p2p metadata.
+	Metadata *NodeMetadata
+}
+
+// nodeIdentityJSON is the spec representation of the struct.
+type nodeIdentityJSON struct {
+	PeerID             string        `json:"peer_id"`
+	ENR                string        `json:"enr"`
+	P2PAddresses       []string      `json:"p2p_addresses"`
+	DiscoveryAddresses []string      `json:"discovery_addresses"`
+	Metadata           *NodeMetadata `json:"metadata"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeIdentity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&nodeIdentityJSON{
+		PeerID:             n.PeerID,
+		ENR:                n.ENR,
+		P2PAddresses:       n.P2PAddresses,
+		DiscoveryAddresses: n.DiscoveryAddresses,
+		Metadata:           n.Metadata,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeIdentity) UnmarshalJSON(input []byte) error {
+	var data nodeIdentityJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.PeerID == "" {
+		return errors.New("peer ID missing")
+	}
+	n.PeerID = data.PeerID
+	if data.ENR == "" {
+		return errors.New("ENR missing")
+	}
+	n.ENR = data.ENR
+	if data.P2PAddresses == nil {
+		return errors.New("p2p addresses missing")
+	}
+	n.P2PAddresses = data.P2PAddresses
+	if data.DiscoveryAddresses == nil {
+		return errors.New("discovery addresses missing")
+	}
+	n.DiscoveryAddresses = data.DiscoveryAddresses
+	if data.Metadata == nil {
+		return errors.New("metadata missing")
+	}
+	n.Metadata = data.Metadata
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeIdentity) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// NodeMetadata contains the p2p metadata of a node.
+type NodeMetadata struct {
+	// SeqNumber is incremented each time the metadata changes.
+	SeqNumber uint64
+	// Attnets are the attestation subnets to which the node is subscribed.
+	Attnets bitfield.Bitvector64
+	// Syncnets are the sync committee subnets to which the node is subscribed.
+	// This is nil for nodes that do not provide it.
+	Syncnets bitfield.Bitvector4
+}
+
+// nodeMetadataJSON is the spec representation of the struct.
+type nodeMetadataJSON struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeMetadata) MarshalJSON() ([]byte, error) {
+	data := &nodeMetadataJSON{
+		SeqNumber: strconv.FormatUint(n.SeqNumber, 10),
+		Attnets:   fmt.Sprintf("%#x", []byte(n.Attnets)),
+	}
+	if n.Syncnets != nil {
+		data.Syncnets = fmt.Sprintf("%#x", []byte(n.Syncnets))
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeMetadata) UnmarshalJSON(input []byte) error {
+	var data nodeMetadataJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.SeqNumber == "" {
+		return errors.New("seq number missing")
+	}
+	seqNumber, err := strconv.ParseUint(data.SeqNumber, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for seq number")
+	}
+	n.SeqNumber = seqNumber
+
+	if data.Attnets == "" {
+		return errors.New("attnets missing")
+	}
+	attnets, err := hex.DecodeString(strings.TrimPrefix(data.Attnets, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for attnets")
+	}
+	if len(attnets) != 8 {
+		return errors.New("incorrect length for attnets")
+	}
+	n.Attnets = attnets
+
+	if data.Syncnets != "" {
+		syncnets, err := hex.DecodeString(strings.TrimPrefix(data.Syncnets, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for syncnets")
+		}
+		if len(syncnets) != 1 {
+			return errors.New("incorrect length for syncnets")
+		}
+		if syncnets[0] > 0x0f {
+			return errors.New("invalid value for syncnets")
+		}
+		n.Syncnets = syncnets
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeMetadata) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
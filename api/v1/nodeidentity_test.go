@@ -0,0 +1,125 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestNodeIdentityJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.nodeIdentityJSON",
+		},
+		{
+			name:  "PeerIDMissing",
+			input: []byte(`{"enr":"enr:-IS4Q","p2p_addresses":[],"discovery_addresses":[],"metadata":{"seq_number":"1","attnets":"0x0000000000000000"}}`),
+			err:   "peer ID missing",
+		},
+		{
+			name:  "ENRMissing",
+			input: []byte(`{"peer_id":"16Uiu2","p2p_addresses":[],"discovery_addresses":[],"metadata":{"seq_number":"1","attnets":"0x0000000000000000"}}`),
+			err:   "ENR missing",
+		},
+		{
+			name:  "P2PAddressesMissing",
+			input: []byte(`{"peer_id":"16Uiu2","enr":"enr:-IS4Q","discovery_addresses":[],"metadata":{"seq_number":"1","attnets":"0x0000000000000000"}}`),
+			err:   "p2p addresses missing",
+		},
+		{
+			name:  "DiscoveryAddressesMissing",
+			input: []byte(`{"peer_id":"16Uiu2","enr":"enr:-IS4Q","p2p_addresses":[],"metadata":{"seq_number":"1","attnets":"0x0000000000000000"}}`),
+			err:   "discovery addresses missing",
+		},
+		{
+			name:  "MetadataMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"]}`),
+			err:   "metadata missing",
+		},
+		{
+			name:  "SeqNumberMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"attnets":"0x0000000000000000","syncnets":"0x0f"}}`),
+			err:   "invalid JSON: seq number missing",
+		},
+		{
+			name:  "SeqNumberInvalid",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"-1","attnets":"0x0000000000000000","syncnets":"0x0f"}}`),
+			err:   "invalid JSON: invalid value for seq number: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AttnetsMissing",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"1","syncnets":"0x0f"}}`),
+			err:   "invalid JSON: attnets missing",
+		},
+		{
+			name:  "AttnetsInvalid",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"1","attnets":"invalid","syncnets":"0x0f"}}`),
+			err:   "invalid JSON: invalid value for attnets: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "AttnetsShort",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"1","attnets":"0x00000000000000","syncnets":"0x0f"}}`),
+			err:   "invalid JSON: incorrect length for attnets",
+		},
+		{
+			name:  "SyncnetsInvalid",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"0x1f"}}`),
+			err:   "invalid JSON: invalid value for syncnets",
+		},
+		{
+			name:  "SyncnetsLong",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"1","attnets":"0x0000000000000000","syncnets":"0x0f00"}}`),
+			err:   "invalid JSON: incorrect length for syncnets",
+		},
+		{
+			name:  "GoodNoSyncnets",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"1","attnets":"0x0000000000000000"}}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"peer_id":"16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96","enr":"enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8","p2p_addresses":["/ip4/7.7.7.7/tcp/4242/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"discovery_addresses":["/ip4/7.7.7.7/udp/30303/p2p/16Uiu2HAm7ukVy4XugqVShYbLih4H2jBJjYevevznBZaHsmd1FM96"],"metadata":{"seq_number":"12345","attnets":"0x0102030405060708","syncnets":"0x0f"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeIdentity
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
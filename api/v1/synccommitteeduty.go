@@ -32,6 +32,12 @@ type SyncCommitteeDuty struct {
 	ValidatorIndex phase0.ValidatorIndex
 	// ValidatorSyncCommitteeIndices is the index of the validator in the list of validators in the committee.
 	ValidatorSyncCommitteeIndices []phase0.CommitteeIndex
+	// FromEpoch is the first epoch of the sync committee period to which the duty applies.
+	// This is not part of the API response, and is populated by the client if known.
+	FromEpoch phase0.Epoch
+	// UntilEpoch is the first epoch after the sync committee period to which the duty applies.
+	// This is not part of the API response, and is populated by the client if known.
+	UntilEpoch phase0.Epoch
 }
 
 // syncCommitteeDutyJSON is the spec representation of the struct.
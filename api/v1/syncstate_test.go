@@ -69,7 +69,11 @@ func TestSyncStateJSON(t *testing.T) {
 		},
 		{
 			name:  "Good",
-			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true}`),
+			input: []byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true,"el_offline":false}`),
+		},
+		{
+			name:  "ELOffline",
+			input: []byte(`{"head_slot":"1","sync_distance":"0","is_optimistic":true,"is_syncing":false,"el_offline":true}`),
 		},
 	}
 
@@ -89,3 +93,9 @@ func TestSyncStateJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncStateELOfflineMissing(t *testing.T) {
+	var res api.SyncState
+	require.NoError(t, json.Unmarshal([]byte(`{"head_slot":"1","sync_distance":"2","is_optimistic":false,"is_syncing":true}`), &res))
+	require.False(t, res.ELOffline)
+}
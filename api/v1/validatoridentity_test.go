@@ -0,0 +1,101 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestValidatorIdentityJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.validatorIdentityJSON",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","activation_epoch":"2"}`),
+			err:   "index missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"index":true,"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","activation_epoch":"2"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorIdentityJSON.index of type string",
+		},
+		{
+			name:  "IndexInvalid",
+			input: []byte(`{"index":"-1","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","activation_epoch":"2"}`),
+			err:   "invalid value for index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "PublicKeyMissing",
+			input: []byte(`{"index":"1","activation_epoch":"2"}`),
+			err:   "public key missing",
+		},
+		{
+			name:  "PublicKeyInvalid",
+			input: []byte(`{"index":"1","pubkey":"invalid","activation_epoch":"2"}`),
+			err:   "invalid value for public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PublicKeyShort",
+			input: []byte(`{"index":"1","pubkey":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","activation_epoch":"2"}`),
+			err:   "incorrect length 47 for public key",
+		},
+		{
+			name:  "ActivationEpochMissing",
+			input: []byte(`{"index":"1","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"}`),
+			err:   "activation epoch missing",
+		},
+		{
+			name:  "ActivationEpochInvalid",
+			input: []byte(`{"index":"1","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","activation_epoch":"-1"}`),
+			err:   "invalid value for activation epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"index":"1","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","activation_epoch":"2"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ValidatorIdentity
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -162,6 +163,65 @@ func (v ValidatorState) HasBalance() bool {
 	return v != ValidatorStateUnknown
 }
 
+// validatorMetaStates are the states covered by each meta status.
+var validatorMetaStates = map[string][]ValidatorState{
+	"pending": {
+		ValidatorStatePendingInitialized,
+		ValidatorStatePendingQueued,
+	},
+	"active": {
+		ValidatorStateActiveOngoing,
+		ValidatorStateActiveExiting,
+		ValidatorStateActiveSlashed,
+	},
+	"exited": {
+		ValidatorStateExitedUnslashed,
+		ValidatorStateExitedSlashed,
+	},
+	"withdrawal": {
+		ValidatorStateWithdrawalPossible,
+		ValidatorStateWithdrawalDone,
+	},
+}
+
+// ValidatorStatesFromStrings converts validator status strings, as used by the
+// beacon API, to validator states.  In addition to the individual states the
+// meta statuses "pending", "active", "exited" and "withdrawal" are accepted,
+// and expand to all of the states they cover.  Duplicate states are removed,
+// and the order of first appearance is retained.
+func ValidatorStatesFromStrings(input []string) ([]ValidatorState, error) {
+	res := make([]ValidatorState, 0, len(input))
+	seen := make(map[ValidatorState]struct{}, len(input))
+	add := func(state ValidatorState) {
+		if _, exists := seen[state]; !exists {
+			seen[state] = struct{}{}
+			res = append(res, state)
+		}
+	}
+
+	for _, status := range input {
+		status = strings.ToLower(strings.TrimSpace(status))
+		if states, exists := validatorMetaStates[status]; exists {
+			for _, state := range states {
+				add(state)
+			}
+
+			continue
+		}
+
+		var state ValidatorState
+		if err := state.UnmarshalJSON([]byte(fmt.Sprintf("%q", status))); err != nil {
+			return nil, err
+		}
+		if state == ValidatorStateUnknown {
+			return nil, errors.New("validator state unknown cannot be used as a filter")
+		}
+		add(state)
+	}
+
+	return res, nil
+}
+
 // ValidatorToState is a helper that calculates the validator status given a validator struct.
 func ValidatorToState(validator *phase0.Validator,
 	balance *phase0.Gwei,
@@ -458,3 +458,77 @@ func TestMarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorStatesFromStrings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []api.ValidatorState
+		err      string
+	}{
+		{
+			name:     "Nil",
+			expected: []api.ValidatorState{},
+		},
+		{
+			name:  "Single",
+			input: []string{"active_ongoing"},
+			expected: []api.ValidatorState{
+				api.ValidatorStateActiveOngoing,
+			},
+		},
+		{
+			name:  "MixedCase",
+			input: []string{" Exited_Slashed "},
+			expected: []api.ValidatorState{
+				api.ValidatorStateExitedSlashed,
+			},
+		},
+		{
+			name:  "MetaStatuses",
+			input: []string{"pending", "active", "exited", "withdrawal"},
+			expected: []api.ValidatorState{
+				api.ValidatorStatePendingInitialized,
+				api.ValidatorStatePendingQueued,
+				api.ValidatorStateActiveOngoing,
+				api.ValidatorStateActiveExiting,
+				api.ValidatorStateActiveSlashed,
+				api.ValidatorStateExitedUnslashed,
+				api.ValidatorStateExitedSlashed,
+				api.ValidatorStateWithdrawalPossible,
+				api.ValidatorStateWithdrawalDone,
+			},
+		},
+		{
+			name:  "Duplicates",
+			input: []string{"active_exiting", "active", "active_slashed"},
+			expected: []api.ValidatorState{
+				api.ValidatorStateActiveExiting,
+				api.ValidatorStateActiveOngoing,
+				api.ValidatorStateActiveSlashed,
+			},
+		},
+		{
+			name:  "Unknown",
+			input: []string{"unknown"},
+			err:   "validator state unknown cannot be used as a filter",
+		},
+		{
+			name:  "Invalid",
+			input: []string{"active", "bad"},
+			err:   `unrecognised validator state "bad"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.ValidatorStatesFromStrings(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
@@ -33,5 +33,8 @@ type ValidatorsOpts struct {
 	PubKeys []phase0.BLSPubKey
 	// ValidatorStates is a list of validator states to restrict the returned values.
 	// If no validator states are supplied then no filter will be applied.
+	// Meta statuses such as "active" can be converted to the states they cover
+	// with apiv1.ValidatorStatesFromStrings.
+	// The filter is applied by the node where it is supported, otherwise locally.
 	ValidatorStates []apiv1.ValidatorState
 }
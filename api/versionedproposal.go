@@ -14,6 +14,7 @@
 package api
 
 import (
+	"fmt"
 	"math/big"
 
 	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
@@ -501,36 +502,45 @@ func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
 	}
 }
 
+// IsBlinded returns true if the proposal is blinded.  A proposal is considered
+// blinded if it is flagged as such, or if only the blinded variant of the
+// block for its version is present.
+func (v *VersionedProposal) IsBlinded() bool {
+	if v.Blinded {
+		return true
+	}
+
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		return v.Bellatrix == nil && v.BellatrixBlinded != nil
+	case spec.DataVersionCapella:
+		return v.Capella == nil && v.CapellaBlinded != nil
+	case spec.DataVersionDeneb:
+		return v.Deneb == nil && v.DenebBlinded != nil
+	case spec.DataVersionElectra:
+		return v.Electra == nil && v.ElectraBlinded != nil
+	default:
+		return false
+	}
+}
+
 // Transactions returns the transactions of the proposal.
 func (v *VersionedProposal) Transactions() ([]bellatrix.Transaction, error) {
+	if v.Version >= spec.DataVersionBellatrix && v.IsBlinded() {
+		return nil, ErrBlindedDataMissing
+	}
 	if v.Version >= spec.DataVersionBellatrix && !v.payloadPresent() {
 		return nil, ErrDataMissing
 	}
 
 	switch v.Version {
 	case spec.DataVersionBellatrix:
-		if v.Blinded {
-			return nil, ErrDataMissing
-		}
-
 		return v.Bellatrix.Body.ExecutionPayload.Transactions, nil
 	case spec.DataVersionCapella:
-		if v.Blinded {
-			return nil, ErrDataMissing
-		}
-
 		return v.Capella.Body.ExecutionPayload.Transactions, nil
 	case spec.DataVersionDeneb:
-		if v.Blinded {
-			return nil, ErrDataMissing
-		}
-
 		return v.Deneb.Block.Body.ExecutionPayload.Transactions, nil
 	case spec.DataVersionElectra:
-		if v.Blinded {
-			return nil, ErrDataMissing
-		}
-
 		return v.Electra.Block.Body.ExecutionPayload.Transactions, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -645,25 +655,80 @@ func (v *VersionedProposal) GasLimit() (uint64, error) {
 	}
 }
 
+// ExecutionParentHash returns the parent hash of the execution payload of the proposal.
+func (v *VersionedProposal) ExecutionParentHash() (phase0.Hash32, error) {
+	if v.Version >= spec.DataVersionBellatrix && !v.payloadPresent() {
+		return phase0.Hash32{}, ErrDataMissing
+	}
+
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			return v.BellatrixBlinded.Body.ExecutionPayloadHeader.ParentHash, nil
+		}
+
+		return v.Bellatrix.Body.ExecutionPayload.ParentHash, nil
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			return v.CapellaBlinded.Body.ExecutionPayloadHeader.ParentHash, nil
+		}
+
+		return v.Capella.Body.ExecutionPayload.ParentHash, nil
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			return v.DenebBlinded.Body.ExecutionPayloadHeader.ParentHash, nil
+		}
+
+		return v.Deneb.Block.Body.ExecutionPayload.ParentHash, nil
+	case spec.DataVersionElectra:
+		if v.Blinded {
+			return v.ElectraBlinded.Body.ExecutionPayloadHeader.ParentHash, nil
+		}
+
+		return v.Electra.Block.Body.ExecutionPayload.ParentHash, nil
+	default:
+		return phase0.Hash32{}, ErrUnsupportedVersion
+	}
+}
+
 // Blobs returns the blobs of the proposal.
 func (v *VersionedProposal) Blobs() ([]deneb.Blob, error) {
+	if v.Version >= spec.DataVersionDeneb && v.IsBlinded() {
+		return nil, ErrBlindedDataMissing
+	}
 	if v.Version >= spec.DataVersionDeneb && !v.payloadPresent() {
 		return nil, ErrDataMissing
 	}
 
+	switch v.Version {
+	case spec.DataVersionDeneb:
+		return v.Deneb.Blobs, nil
+	case spec.DataVersionElectra:
+		return v.Electra.Blobs, nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// BlobKZGCommitments returns the blob KZG commitments of the proposal.
+func (v *VersionedProposal) BlobKZGCommitments() ([]deneb.KZGCommitment, error) {
+	if v.Version >= spec.DataVersionDeneb && !v.bodyPresent() {
+		return nil, ErrDataMissing
+	}
+
 	switch v.Version {
 	case spec.DataVersionDeneb:
 		if v.Blinded {
-			return nil, ErrDataMissing
+			return v.DenebBlinded.Body.BlobKZGCommitments, nil
 		}
 
-		return v.Deneb.Blobs, nil
+		return v.Deneb.Block.Body.BlobKZGCommitments, nil
 	case spec.DataVersionElectra:
 		if v.Blinded {
-			return nil, ErrDataMissing
+			return v.ElectraBlinded.Body.BlobKZGCommitments, nil
 		}
 
-		return v.Electra.Blobs, nil
+		return v.Electra.Block.Body.BlobKZGCommitments, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -671,22 +736,17 @@ func (v *VersionedProposal) Blobs() ([]deneb.Blob, error) {
 
 // KZGProofs returns the KZG proofs of the proposal.
 func (v *VersionedProposal) KZGProofs() ([]deneb.KZGProof, error) {
+	if v.Version >= spec.DataVersionDeneb && v.IsBlinded() {
+		return nil, ErrBlindedDataMissing
+	}
 	if v.Version >= spec.DataVersionDeneb && !v.payloadPresent() {
 		return nil, ErrDataMissing
 	}
 
 	switch v.Version {
 	case spec.DataVersionDeneb:
-		if v.Blinded {
-			return nil, ErrDataMissing
-		}
-
 		return v.Deneb.KZGProofs, nil
 	case spec.DataVersionElectra:
-		if v.Blinded {
-			return nil, ErrDataMissing
-		}
-
 		return v.Electra.KZGProofs, nil
 	default:
 		return nil, ErrUnsupportedVersion
@@ -694,7 +754,15 @@ func (v *VersionedProposal) KZGProofs() ([]deneb.KZGProof, error) {
 }
 
 // Value returns the value of the proposal, in Wei.
+//
+// Deprecated: use TotalValue.
 func (v *VersionedProposal) Value() *big.Int {
+	return v.TotalValue()
+}
+
+// TotalValue returns the total value of the proposal, in Wei.  This is the
+// sum of the consensus block value and the execution payload value.
+func (v *VersionedProposal) TotalValue() *big.Int {
 	value := big.NewInt(0)
 	if v.ConsensusValue != nil {
 		value = value.Add(value, v.ConsensusValue)
@@ -706,6 +774,30 @@ func (v *VersionedProposal) Value() *big.Int {
 	return value
 }
 
+// ConsensusValueGwei returns the consensus block value of the proposal, in Gwei.
+func (v *VersionedProposal) ConsensusValueGwei() (phase0.Gwei, error) {
+	return weiToGwei(v.ConsensusValue)
+}
+
+// ExecutionValueGwei returns the execution payload value of the proposal, in Gwei.
+func (v *VersionedProposal) ExecutionValueGwei() (phase0.Gwei, error) {
+	return weiToGwei(v.ExecutionValue)
+}
+
+// TotalValueGwei returns the total value of the proposal, in Gwei.
+func (v *VersionedProposal) TotalValueGwei() (phase0.Gwei, error) {
+	return weiToGwei(v.TotalValue())
+}
+
+// weiToGwei converts a value in Wei to Gwei, truncating any fractional Gwei.
+func weiToGwei(value *big.Int) (phase0.Gwei, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	return phase0.GweiFromWei(value)
+}
+
 // String returns a string version of the structure.
 func (v *VersionedProposal) String() string {
 	switch v.Version {
@@ -859,3 +951,18 @@ func (v *VersionedProposal) payloadPresent() bool {
 
 	return false
 }
+
+// Short returns a short string version of the proposal suitable for logging,
+// containing its version, slot and a prefix of its root.
+func (v *VersionedProposal) Short() string {
+	slot, err := v.Slot()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	root, err := v.Root()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return fmt.Sprintf("%s proposal slot %d root %#x", v.Version, slot, root[:4])
+}
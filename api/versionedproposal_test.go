@@ -0,0 +1,233 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedProposalValues(t *testing.T) {
+	overflow, _ := new(big.Int).SetString("18446744073709551616000000000", 10)
+
+	tests := []struct {
+		name          string
+		proposal      *api.VersionedProposal
+		total         *big.Int
+		consensusGwei phase0.Gwei
+		executionGwei phase0.Gwei
+		totalGwei     phase0.Gwei
+		err           string
+	}{
+		{
+			name:     "Empty",
+			proposal: &api.VersionedProposal{},
+			total:    big.NewInt(0),
+		},
+		{
+			name: "ConsensusOnly",
+			proposal: &api.VersionedProposal{
+				ConsensusValue: big.NewInt(12_000_000_000),
+			},
+			total:         big.NewInt(12_000_000_000),
+			consensusGwei: 12,
+			totalGwei:     12,
+		},
+		{
+			name: "Both",
+			proposal: &api.VersionedProposal{
+				ConsensusValue: big.NewInt(1_500_000_000),
+				ExecutionValue: big.NewInt(2_600_000_000),
+			},
+			total:         big.NewInt(4_100_000_000),
+			consensusGwei: 1,
+			executionGwei: 2,
+			totalGwei:     4,
+		},
+		{
+			name: "Negative",
+			proposal: &api.VersionedProposal{
+				ExecutionValue: big.NewInt(-1),
+			},
+			total: big.NewInt(-1),
+			err:   "value is negative",
+		},
+		{
+			name: "Overflow",
+			proposal: &api.VersionedProposal{
+				ExecutionValue: overflow,
+			},
+			total: overflow,
+			err:   "value too large for Gwei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, 0, test.total.Cmp(test.proposal.TotalValue()))
+
+			consensusGwei, err := test.proposal.ConsensusValueGwei()
+			require.NoError(t, err)
+			require.Equal(t, test.consensusGwei, consensusGwei)
+
+			executionGwei, err := test.proposal.ExecutionValueGwei()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.executionGwei, executionGwei)
+			}
+
+			totalGwei, err := test.proposal.TotalValueGwei()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.totalGwei, totalGwei)
+			}
+		})
+	}
+}
+
+func TestVersionedProposalBlinded(t *testing.T) {
+	denebFull := &apiv1deneb.BlockContents{
+		Block: &deneb.BeaconBlock{
+			Body: &deneb.BeaconBlockBody{
+				ExecutionPayload: &deneb.ExecutionPayload{
+					Transactions: []bellatrix.Transaction{{0x01}},
+				},
+			},
+		},
+		KZGProofs: []deneb.KZGProof{{0x02}},
+		Blobs:     []deneb.Blob{{0x03}},
+	}
+	denebBlinded := &apiv1deneb.BlindedBeaconBlock{
+		Body: &apiv1deneb.BlindedBeaconBlockBody{
+			ExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{},
+		},
+	}
+	electraFull := &apiv1electra.BlockContents{
+		Block: &electra.BeaconBlock{
+			Body: &electra.BeaconBlockBody{
+				ExecutionPayload: &deneb.ExecutionPayload{
+					Transactions: []bellatrix.Transaction{{0x04}},
+				},
+			},
+		},
+		KZGProofs: []deneb.KZGProof{{0x05}},
+		Blobs:     []deneb.Blob{{0x06}},
+	}
+
+	tests := []struct {
+		name         string
+		proposal     *api.VersionedProposal
+		blinded      bool
+		transactions []bellatrix.Transaction
+		blobs        []deneb.Blob
+		proofs       []deneb.KZGProof
+		txErr        error
+		blobErr      error
+	}{
+		{
+			name:     "Phase0",
+			proposal: &api.VersionedProposal{Version: spec.DataVersionPhase0, Phase0: &phase0.BeaconBlock{}},
+			txErr:    api.ErrUnsupportedVersion,
+			blobErr:  api.ErrUnsupportedVersion,
+		},
+		{
+			name:     "DenebMissing",
+			proposal: &api.VersionedProposal{Version: spec.DataVersionDeneb},
+			txErr:    api.ErrDataMissing,
+			blobErr:  api.ErrDataMissing,
+		},
+		{
+			name:         "DenebFull",
+			proposal:     &api.VersionedProposal{Version: spec.DataVersionDeneb, Deneb: denebFull},
+			transactions: denebFull.Block.Body.ExecutionPayload.Transactions,
+			blobs:        denebFull.Blobs,
+			proofs:       denebFull.KZGProofs,
+		},
+		{
+			name:     "DenebBlinded",
+			proposal: &api.VersionedProposal{Version: spec.DataVersionDeneb, Blinded: true, DenebBlinded: denebBlinded},
+			blinded:  true,
+			txErr:    api.ErrBlindedDataMissing,
+			blobErr:  api.ErrBlindedDataMissing,
+		},
+		{
+			name:     "DenebBlindedUnflagged",
+			proposal: &api.VersionedProposal{Version: spec.DataVersionDeneb, DenebBlinded: denebBlinded},
+			blinded:  true,
+			txErr:    api.ErrBlindedDataMissing,
+			blobErr:  api.ErrBlindedDataMissing,
+		},
+		{
+			name:         "ElectraFull",
+			proposal:     &api.VersionedProposal{Version: spec.DataVersionElectra, Electra: electraFull},
+			transactions: electraFull.Block.Body.ExecutionPayload.Transactions,
+			blobs:        electraFull.Blobs,
+			proofs:       electraFull.KZGProofs,
+		},
+		{
+			name:     "ElectraBlinded",
+			proposal: &api.VersionedProposal{Version: spec.DataVersionElectra, Blinded: true},
+			blinded:  true,
+			txErr:    api.ErrBlindedDataMissing,
+			blobErr:  api.ErrBlindedDataMissing,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.blinded, test.proposal.IsBlinded())
+
+			transactions, err := test.proposal.Transactions()
+			if test.txErr != nil {
+				require.ErrorIs(t, err, test.txErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.transactions, transactions)
+			}
+
+			blobs, err := test.proposal.Blobs()
+			if test.blobErr != nil {
+				require.ErrorIs(t, err, test.blobErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.blobs, blobs)
+			}
+
+			proofs, err := test.proposal.KZGProofs()
+			if test.blobErr != nil {
+				require.ErrorIs(t, err, test.blobErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.proofs, proofs)
+			}
+		})
+	}
+
+	// Blinded data is still reported as missing data.
+	require.ErrorIs(t, api.ErrBlindedDataMissing, api.ErrDataMissing)
+}
@@ -14,6 +14,8 @@
 package api
 
 import (
+	"fmt"
+
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
@@ -618,3 +620,50 @@ func (v *VersionedSignedBlindedBeaconBlock) Signature() (phase0.BLSSignature, er
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
 }
+
+// String returns a string version of the structure.
+func (v *VersionedSignedBlindedBeaconBlock) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	default:
+		return "unknown version"
+	}
+}
+
+// Short returns a short string version of the block suitable for logging,
+// containing its version, slot and a prefix of its root.
+func (v *VersionedSignedBlindedBeaconBlock) Short() string {
+	slot, err := v.Slot()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	root, err := v.Root()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return fmt.Sprintf("%s blinded block slot %d root %#x", v.Version, slot, root[:4])
+}
@@ -14,6 +14,8 @@
 package api
 
 import (
+	"errors"
+
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
@@ -31,6 +33,33 @@ type VersionedSignedBlindedProposal struct {
 	Electra   *apiv1electra.SignedBlindedBeaconBlock
 }
 
+// AssertPresent throws an error if the expected proposal
+// given the version is not present.
+func (v *VersionedSignedBlindedProposal) AssertPresent() error {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return errors.New("bellatrix blinded proposal not present")
+		}
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return errors.New("capella blinded proposal not present")
+		}
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return errors.New("deneb blinded proposal not present")
+		}
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return errors.New("electra blinded proposal not present")
+		}
+	default:
+		return errors.New("unsupported version")
+	}
+
+	return nil
+}
+
 // Slot returns the slot of the signed blinded proposal.
 func (v *VersionedSignedBlindedProposal) Slot() (phase0.Slot, error) {
 	switch v.Version {
@@ -553,3 +582,35 @@ func (v *VersionedSignedBlindedProposal) Signature() (phase0.BLSSignature, error
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
 }
+
+// String returns a string version of the structure.
+func (v *VersionedSignedBlindedProposal) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+)
+
+// MaxProposalSize is the maximum size of an SSZ-encoded signed beacon block
+// that can be sent over the network.
+const MaxProposalSize = 10 * 1024 * 1024
+
+// proposalContents are the sizes of the parts of a proposal that are subject
+// to limits.
+type proposalContents struct {
+	proposerSlashings     int
+	attesterSlashings     int
+	attestations          int
+	deposits              int
+	voluntaryExits        int
+	blsToExecutionChanges int
+	withdrawals           int
+	blobCommitments       int
+	blobs                 int
+	kzgProofs             int
+	depositRequests       int
+	withdrawalRequests    int
+	consolidationRequests int
+	// size is the size of the SSZ-encoded signed block.
+	size int
+}
+
+// PreflightValidate checks that the proposal is within the limits of its
+// fork, so that problems can be reported before submission rather than as a
+// rejection from the beacon node.  List lengths are checked against the
+// supplied limits, or the mainnet limits if nil, and the size of the signed
+// block against MaxProposalSize.  Errors for exceeded limits wrap
+// ErrLimitExceeded.
+//
+//nolint:gocyclo
+func (v *VersionedSignedProposal) PreflightValidate(limits *spec.Limits) error {
+	if err := v.assertMessagePresentAllVersions(); err != nil {
+		return err
+	}
+	if limits == nil {
+		limits = spec.MainnetLimits()
+	}
+
+	contents, err := v.preflightContents()
+	if err != nil {
+		return err
+	}
+
+	maxAttesterSlashings := limits.MaxAttesterSlashings
+	maxAttestations := limits.MaxAttestations
+	maxBlobs := limits.MaxBlobsPerBlock
+	if v.Version >= spec.DataVersionElectra {
+		maxAttesterSlashings = limits.MaxAttesterSlashingsElectra
+		maxAttestations = limits.MaxAttestationsElectra
+		maxBlobs = limits.MaxBlobsPerBlockElectra
+	}
+	// The number of blobs is bounded by both the list limit for commitments
+	// and the configured maximum number of blobs per block.
+	maxBlobCommitments := limits.MaxBlobCommitmentsPerBlock
+	if maxBlobs < maxBlobCommitments {
+		maxBlobCommitments = maxBlobs
+	}
+
+	checks := []struct {
+		name  string
+		count int
+		limit uint64
+	}{
+		{"proposer slashings", contents.proposerSlashings, limits.MaxProposerSlashings},
+		{"attester slashings", contents.attesterSlashings, maxAttesterSlashings},
+		{"attestations", contents.attestations, maxAttestations},
+		{"deposits", contents.deposits, limits.MaxDeposits},
+		{"voluntary exits", contents.voluntaryExits, limits.MaxVoluntaryExits},
+		{"BLS to execution changes", contents.blsToExecutionChanges, limits.MaxBLSToExecutionChanges},
+		{"withdrawals", contents.withdrawals, limits.MaxWithdrawalsPerPayload},
+		{"blob KZG commitments", contents.blobCommitments, maxBlobCommitments},
+		{"deposit requests", contents.depositRequests, limits.MaxDepositRequestsPerPayload},
+		{"withdrawal requests", contents.withdrawalRequests, limits.MaxWithdrawalRequestsPerPayload},
+		{"consolidation requests", contents.consolidationRequests, limits.MaxConsolidationRequestsPerPayload},
+	}
+	for _, check := range checks {
+		if uint64(check.count) > check.limit {
+			return fmt.Errorf("%w: %s proposal has %d %s, maximum is %d",
+				ErrLimitExceeded, v.Version, check.count, check.name, check.limit)
+		}
+	}
+
+	if !v.Blinded && v.Version >= spec.DataVersionDeneb {
+		if contents.blobs != contents.blobCommitments {
+			return fmt.Errorf("%s proposal has %d blobs but %d blob KZG commitments", v.Version, contents.blobs, contents.blobCommitments)
+		}
+		if contents.kzgProofs != contents.blobs {
+			return fmt.Errorf("%s proposal has %d KZG proofs but %d blobs", v.Version, contents.kzgProofs, contents.blobs)
+		}
+	}
+
+	if contents.size > MaxProposalSize {
+		return fmt.Errorf("%w: %s proposal is %d bytes, maximum is %d",
+			ErrLimitExceeded, v.Version, contents.size, MaxProposalSize)
+	}
+
+	return nil
+}
+
+// assertMessagePresentAllVersions throws an error if the message of the
+// proposal is not present, for all versions.
+func (v *VersionedSignedProposal) assertMessagePresentAllVersions() error {
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
+			return ErrDataMissing
+		}
+	case spec.DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return ErrDataMissing
+		}
+	default:
+		if err := v.assertMessagePresent(); err != nil {
+			return err
+		}
+		if err := v.assertExecutionPayloadPresent(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// preflightContents obtains the contents of the proposal that are subject to
+// limits.  The message of the proposal must be present.
+//
+//nolint:gocyclo
+func (v *VersionedSignedProposal) preflightContents() (*proposalContents, error) {
+	var contents proposalContents
+	var err error
+
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		body := v.Phase0.Message.Body
+		contents = proposalContents{
+			proposerSlashings: len(body.ProposerSlashings),
+			attesterSlashings: len(body.AttesterSlashings),
+			attestations:      len(body.Attestations),
+			deposits:          len(body.Deposits),
+			voluntaryExits:    len(body.VoluntaryExits),
+		}
+		contents.size = v.Phase0.SizeSSZ()
+	case spec.DataVersionAltair:
+		body := v.Altair.Message.Body
+		contents = proposalContents{
+			proposerSlashings: len(body.ProposerSlashings),
+			attesterSlashings: len(body.AttesterSlashings),
+			attestations:      len(body.Attestations),
+			deposits:          len(body.Deposits),
+			voluntaryExits:    len(body.VoluntaryExits),
+		}
+		contents.size = v.Altair.SizeSSZ()
+	case spec.DataVersionBellatrix:
+		if v.Blinded {
+			body := v.BellatrixBlinded.Message.Body
+			contents = proposalContents{
+				proposerSlashings: len(body.ProposerSlashings),
+				attesterSlashings: len(body.AttesterSlashings),
+				attestations:      len(body.Attestations),
+				deposits:          len(body.Deposits),
+				voluntaryExits:    len(body.VoluntaryExits),
+			}
+			contents.size = v.BellatrixBlinded.SizeSSZ()
+		} else {
+			body := v.Bellatrix.Message.Body
+			contents = proposalContents{
+				proposerSlashings: len(body.ProposerSlashings),
+				attesterSlashings: len(body.AttesterSlashings),
+				attestations:      len(body.Attestations),
+				deposits:          len(body.Deposits),
+				voluntaryExits:    len(body.VoluntaryExits),
+			}
+			contents.size = v.Bellatrix.SizeSSZ()
+		}
+	case spec.DataVersionCapella:
+		if v.Blinded {
+			body := v.CapellaBlinded.Message.Body
+			contents = proposalContents{
+				proposerSlashings:     len(body.ProposerSlashings),
+				attesterSlashings:     len(body.AttesterSlashings),
+				attestations:          len(body.Attestations),
+				deposits:              len(body.Deposits),
+				voluntaryExits:        len(body.VoluntaryExits),
+				blsToExecutionChanges: len(body.BLSToExecutionChanges),
+			}
+			contents.size = v.CapellaBlinded.SizeSSZ()
+		} else {
+			body := v.Capella.Message.Body
+			contents = proposalContents{
+				proposerSlashings:     len(body.ProposerSlashings),
+				attesterSlashings:     len(body.AttesterSlashings),
+				attestations:          len(body.Attestations),
+				deposits:              len(body.Deposits),
+				voluntaryExits:        len(body.VoluntaryExits),
+				blsToExecutionChanges: len(body.BLSToExecutionChanges),
+				withdrawals:           len(body.ExecutionPayload.Withdrawals),
+			}
+			contents.size = v.Capella.SizeSSZ()
+		}
+	case spec.DataVersionDeneb:
+		if v.Blinded {
+			body := v.DenebBlinded.Message.Body
+			contents = proposalContents{
+				proposerSlashings:     len(body.ProposerSlashings),
+				attesterSlashings:     len(body.AttesterSlashings),
+				attestations:          len(body.Attestations),
+				deposits:              len(body.Deposits),
+				voluntaryExits:        len(body.VoluntaryExits),
+				blsToExecutionChanges: len(body.BLSToExecutionChanges),
+				blobCommitments:       len(body.BlobKZGCommitments),
+			}
+			contents.size = v.DenebBlinded.SizeSSZ()
+		} else {
+			body := v.Deneb.SignedBlock.Message.Body
+			contents = proposalContents{
+				proposerSlashings:     len(body.ProposerSlashings),
+				attesterSlashings:     len(body.AttesterSlashings),
+				attestations:          len(body.Attestations),
+				deposits:              len(body.Deposits),
+				voluntaryExits:        len(body.VoluntaryExits),
+				blsToExecutionChanges: len(body.BLSToExecutionChanges),
+				withdrawals:           len(body.ExecutionPayload.Withdrawals),
+				blobCommitments:       len(body.BlobKZGCommitments),
+				blobs:                 len(v.Deneb.Blobs),
+				kzgProofs:             len(v.Deneb.KZGProofs),
+			}
+			contents.size = v.Deneb.SignedBlock.SizeSSZ()
+		}
+	case spec.DataVersionElectra:
+		var requests *electra.ExecutionRequests
+		if v.Blinded {
+			body := v.ElectraBlinded.Message.Body
+			contents = proposalContents{
+				proposerSlashings:     len(body.ProposerSlashings),
+				attesterSlashings:     len(body.AttesterSlashings),
+				attestations:          len(body.Attestations),
+				deposits:              len(body.Deposits),
+				voluntaryExits:        len(body.VoluntaryExits),
+				blsToExecutionChanges: len(body.BLSToExecutionChanges),
+				blobCommitments:       len(body.BlobKZGCommitments),
+			}
+			requests = body.ExecutionRequests
+			contents.size = v.ElectraBlinded.SizeSSZ()
+		} else {
+			body := v.Electra.SignedBlock.Message.Body
+			contents = proposalContents{
+				proposerSlashings:     len(body.ProposerSlashings),
+				attesterSlashings:     len(body.AttesterSlashings),
+				attestations:          len(body.Attestations),
+				deposits:              len(body.Deposits),
+				voluntaryExits:        len(body.VoluntaryExits),
+				blsToExecutionChanges: len(body.BLSToExecutionChanges),
+				withdrawals:           len(body.ExecutionPayload.Withdrawals),
+				blobCommitments:       len(body.BlobKZGCommitments),
+				blobs:                 len(v.Electra.Blobs),
+				kzgProofs:             len(v.Electra.KZGProofs),
+			}
+			requests = body.ExecutionRequests
+			contents.size = v.Electra.SignedBlock.SizeSSZ()
+		}
+		if requests == nil {
+			err = errors.New("execution requests missing")
+		} else {
+			contents.depositRequests = len(requests.Deposits)
+			contents.withdrawalRequests = len(requests.Withdrawals)
+			contents.consolidationRequests = len(requests.Consolidations)
+		}
+	default:
+		err = ErrUnsupportedVersion
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &contents, nil
+}
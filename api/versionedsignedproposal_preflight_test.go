@@ -0,0 +1,198 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func electraProposal(t *testing.T) *api.VersionedSignedProposal {
+	t.Helper()
+
+	block, err := testfixtures.Block(spec.DataVersionElectra)
+	require.NoError(t, err)
+
+	return &api.VersionedSignedProposal{
+		Version: spec.DataVersionElectra,
+		Electra: &apiv1electra.SignedBlockContents{
+			SignedBlock: block.Electra,
+			KZGProofs:   []deneb.KZGProof{},
+			Blobs:       []deneb.Blob{},
+		},
+	}
+}
+
+func TestPreflightValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      func(t *testing.T) *api.VersionedSignedProposal
+		limits        *spec.Limits
+		err           string
+		limitExceeded bool
+	}{
+		{
+			name: "Missing",
+			proposal: func(_ *testing.T) *api.VersionedSignedProposal {
+				return &api.VersionedSignedProposal{Version: spec.DataVersionPhase0}
+			},
+			err: "data missing",
+		},
+		{
+			name:     "Good",
+			proposal: electraProposal,
+		},
+		{
+			name: "TooManyAttestations",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				attestation, err := testfixtures.Attestation(spec.DataVersionElectra)
+				require.NoError(t, err)
+				body := proposal.Electra.SignedBlock.Message.Body
+				body.Attestations = make([]*electra.Attestation, 9)
+				for i := range body.Attestations {
+					body.Attestations[i] = attestation.Electra
+				}
+
+				return proposal
+			},
+			err:           "limit exceeded: electra proposal has 9 attestations, maximum is 8",
+			limitExceeded: true,
+		},
+		{
+			name: "TooManyConsolidationRequests",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				proposal.Electra.SignedBlock.Message.Body.ExecutionRequests.Consolidations = make([]*electra.ConsolidationRequest, 3)
+
+				return proposal
+			},
+			err:           "limit exceeded: electra proposal has 3 consolidation requests, maximum is 2",
+			limitExceeded: true,
+		},
+		{
+			name: "ExecutionRequestsMissing",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				proposal.Electra.SignedBlock.Message.Body.ExecutionRequests = nil
+
+				return proposal
+			},
+			err: "execution requests missing",
+		},
+		{
+			name: "TooManyBlobs",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				proposal.Electra.SignedBlock.Message.Body.BlobKZGCommitments = make([]deneb.KZGCommitment, 10)
+
+				return proposal
+			},
+			err:           "limit exceeded: electra proposal has 10 blob KZG commitments, maximum is 9",
+			limitExceeded: true,
+		},
+		{
+			name: "TooManyBlobsDeneb",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				block, err := testfixtures.Block(spec.DataVersionDeneb)
+				require.NoError(t, err)
+				block.Deneb.Message.Body.BlobKZGCommitments = make([]deneb.KZGCommitment, 7)
+
+				return &api.VersionedSignedProposal{
+					Version: spec.DataVersionDeneb,
+					Deneb: &apiv1deneb.SignedBlockContents{
+						SignedBlock: block.Deneb,
+						KZGProofs:   make([]deneb.KZGProof, 7),
+						Blobs:       make([]deneb.Blob, 7),
+					},
+				}
+			},
+			err:           "limit exceeded: deneb proposal has 7 blob KZG commitments, maximum is 6",
+			limitExceeded: true,
+		},
+		{
+			name: "BlobsMismatch",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				proposal.Electra.SignedBlock.Message.Body.BlobKZGCommitments = []deneb.KZGCommitment{{}}
+
+				return proposal
+			},
+			err: "electra proposal has 0 blobs but 1 blob KZG commitments",
+		},
+		{
+			name: "ProofsMismatch",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				proposal.Electra.SignedBlock.Message.Body.BlobKZGCommitments = []deneb.KZGCommitment{{}}
+				proposal.Electra.Blobs = []deneb.Blob{{}}
+
+				return proposal
+			},
+			err: "electra proposal has 0 KZG proofs but 1 blobs",
+		},
+		{
+			name: "TooLarge",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				proposal := electraProposal(t)
+				proposal.Electra.SignedBlock.Message.Body.ExecutionPayload.Transactions = []bellatrix.Transaction{
+					make([]byte, api.MaxProposalSize),
+				}
+
+				return proposal
+			},
+			err:           "limit exceeded: electra proposal is 10486884 bytes, maximum is 10485760",
+			limitExceeded: true,
+		},
+		{
+			name: "WithdrawalsMinimalLimits",
+			proposal: func(t *testing.T) *api.VersionedSignedProposal {
+				block, err := testfixtures.Block(spec.DataVersionCapella)
+				require.NoError(t, err)
+				block.Capella.Message.Body.ExecutionPayload.Withdrawals = make([]*capella.Withdrawal, 5)
+
+				return &api.VersionedSignedProposal{
+					Version: spec.DataVersionCapella,
+					Capella: block.Capella,
+				}
+			},
+			limits:        spec.MinimalLimits(),
+			err:           "limit exceeded: capella proposal has 5 withdrawals, maximum is 4",
+			limitExceeded: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.proposal(t).PreflightValidate(test.limits)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Equal(t, test.limitExceeded, errors.Is(err, api.ErrLimitExceeded))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apiserver provides server-side utilities for building beacon API
+// proxies and middleware on top of the types in this module.
+//
+// A Codec decodes request bodies in to typed objects and encodes typed
+// objects as responses, handling JSON and SSZ encodings, content negotiation
+// and the consensus version header in the same way as a beacon node.
+// Versioned containers, such as spec.VersionedSignedBeaconBlock, are handled
+// by selecting the field for their version.
+package apiserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	eth2http "github.com/attestantio/go-eth2-client/http"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+type sszUnmarshaler interface {
+	UnmarshalSSZ(data []byte) error
+}
+
+// Codec encodes and decodes beacon API requests and responses.
+type Codec struct {
+	dynSSZ         *dynssz.DynSsz
+	maxRequestSize int64
+	strictJSON     bool
+}
+
+// New creates a new codec.
+func New(params ...Parameter) (*Codec, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	dynSSZ, err := dynamicSSZ(parameters.spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Codec{
+		dynSSZ:         dynSSZ,
+		maxRequestSize: parameters.maxRequestSize,
+		strictJSON:     parameters.strictJSON,
+	}, nil
+}
+
+// DecodeRequest decodes the body of a request in to res, which must be a
+// pointer.  The body is decoded as JSON or SSZ according to the request's
+// Content-Type header.
+func (c *Codec) DecodeRequest(r *http.Request, res any) error {
+	contentType, err := RequestContentType(r)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.readBody(r)
+	if err != nil {
+		return err
+	}
+
+	return c.decode(contentType, body, res)
+}
+
+// DecodeVersionedRequest decodes the body of a request in to res, which must
+// be a pointer to a versioned container.  The version is obtained from the
+// request's Eth-Consensus-Version header, and the body decoded in to the
+// field for that version.  For containers that can hold blinded data, set
+// Blinded on res before calling to decode in to the blinded field.
+func (c *Codec) DecodeVersionedRequest(r *http.Request, res any) error {
+	version, err := ConsensusVersion(r)
+	if err != nil {
+		return err
+	}
+	target, err := versionedTarget(res, version)
+	if err != nil {
+		return err
+	}
+
+	return c.DecodeRequest(r, target)
+}
+
+// WriteResponse writes data as a successful response, encoded as JSON or SSZ
+// according to the request's Accept header.  If data is a versioned container
+// the response carries its version, both in the Eth-Consensus-Version header
+// and in the JSON body.  Metadata is added to the JSON body alongside the data.
+func (c *Codec) WriteResponse(w http.ResponseWriter, r *http.Request, data any, metadata map[string]any) error {
+	version, item, err := versionedData(data)
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to encode response")
+
+		return err
+	}
+
+	supported := []eth2http.ContentType{eth2http.ContentTypeJSON}
+	if _, isSSZ := item.(sszMarshaler); isSSZ {
+		supported = append(supported, eth2http.ContentTypeSSZ)
+	}
+	contentType := NegotiateContentType(r.Header.Get("Accept"), supported...)
+
+	var body []byte
+	switch contentType {
+	case eth2http.ContentTypeSSZ:
+		body, err = c.encodeSSZ(item)
+	case eth2http.ContentTypeJSON:
+		response := make(map[string]any, len(metadata)+2)
+		for k, v := range metadata {
+			response[k] = v
+		}
+		if version != nil {
+			response["version"] = version.String()
+		}
+		response["data"] = item
+		body, err = json.Marshal(response)
+	default:
+		WriteError(w, http.StatusNotAcceptable, "no acceptable content type")
+
+		return errors.New("no acceptable content type")
+	}
+	if err != nil {
+		WriteError(w, http.StatusInternalServerError, "failed to encode response")
+
+		return errors.Join(fmt.Errorf("failed to encode response as %s", contentType), err)
+	}
+
+	w.Header().Set("Content-Type", contentType.MediaType())
+	if version != nil {
+		w.Header().Set("Eth-Consensus-Version", version.String())
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		return errors.Join(errors.New("failed to write response"), err)
+	}
+
+	return nil
+}
+
+// WriteError writes an error response in the format used by the beacon API.
+func WriteError(w http.ResponseWriter, statusCode int, message string) {
+	data, err := json.Marshal(map[string]any{
+		"code":    statusCode,
+		"message": message,
+	})
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		data = []byte(fmt.Sprintf(`{"code":%d,"message":"failed to marshal response"}`, statusCode))
+	}
+
+	w.Header().Set("Content-Type", eth2http.ContentTypeJSON.MediaType())
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
+
+func (c *Codec) readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, errors.New("no request body")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, c.maxRequestSize+1))
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read request body"), err)
+	}
+	if int64(len(body)) > c.maxRequestSize {
+		return nil, fmt.Errorf("request body exceeds maximum size of %d bytes", c.maxRequestSize)
+	}
+	if len(body) == 0 {
+		return nil, errors.New("empty request body")
+	}
+
+	return body, nil
+}
+
+func (c *Codec) decode(contentType eth2http.ContentType, body []byte, res any) error {
+	switch contentType {
+	case eth2http.ContentTypeJSON:
+		if err := codecs.UnmarshalJSON(body, res, codecs.WithStrictJSON(c.strictJSON)); err != nil {
+			return errors.Join(errors.New("failed to decode JSON request"), err)
+		}
+	case eth2http.ContentTypeSSZ:
+		if err := c.decodeSSZ(body, res); err != nil {
+			return errors.Join(errors.New("failed to decode SSZ request"), err)
+		}
+	default:
+		return fmt.Errorf("unsupported content type %s", contentType)
+	}
+
+	return nil
+}
+
+func (c *Codec) decodeSSZ(body []byte, res any) error {
+	if c.dynSSZ != nil {
+		return c.dynSSZ.UnmarshalSSZ(res, body)
+	}
+	unmarshaler, isSSZ := res.(sszUnmarshaler)
+	if !isSSZ {
+		return fmt.Errorf("%T cannot be decoded from SSZ", res)
+	}
+
+	return unmarshaler.UnmarshalSSZ(body)
+}
+
+func (c *Codec) encodeSSZ(item any) ([]byte, error) {
+	if c.dynSSZ != nil {
+		return c.dynSSZ.MarshalSSZ(item)
+	}
+	marshaler, isSSZ := item.(sszMarshaler)
+	if !isSSZ {
+		return nil, fmt.Errorf("%T cannot be encoded as SSZ", item)
+	}
+
+	return marshaler.MarshalSSZ()
+}
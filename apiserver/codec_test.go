@@ -0,0 +1,274 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/apiserver"
+	eth2http "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	_, err := apiserver.New(apiserver.WithMaxRequestSize(0))
+	require.EqualError(t, err, "problem with parameters\nmax request size must be greater than 0")
+
+	_, err = apiserver.New()
+	require.NoError(t, err)
+}
+
+func TestNegotiateContentType(t *testing.T) {
+	tests := []struct {
+		name      string
+		accept    string
+		supported []eth2http.ContentType
+		expected  eth2http.ContentType
+	}{
+		{
+			name:     "Empty",
+			expected: eth2http.ContentTypeJSON,
+		},
+		{
+			name:     "JSON",
+			accept:   "application/json",
+			expected: eth2http.ContentTypeJSON,
+		},
+		{
+			name:     "SSZ",
+			accept:   "application/octet-stream",
+			expected: eth2http.ContentTypeSSZ,
+		},
+		{
+			name:     "SSZPreferred",
+			accept:   "application/octet-stream;q=1,application/json;q=0.9",
+			expected: eth2http.ContentTypeSSZ,
+		},
+		{
+			name:     "JSONPreferred",
+			accept:   "application/octet-stream;q=0.5, application/json",
+			expected: eth2http.ContentTypeJSON,
+		},
+		{
+			name:     "Wildcard",
+			accept:   "*/*",
+			expected: eth2http.ContentTypeJSON,
+		},
+		{
+			name:      "SSZUnsupported",
+			accept:    "application/octet-stream;q=1,application/json;q=0.9",
+			supported: []eth2http.ContentType{eth2http.ContentTypeJSON},
+			expected:  eth2http.ContentTypeJSON,
+		},
+		{
+			name:     "Unacceptable",
+			accept:   "text/html",
+			expected: eth2http.ContentTypeUnknown,
+		},
+		{
+			name:     "QualityZero",
+			accept:   "application/json;q=0",
+			expected: eth2http.ContentTypeUnknown,
+		},
+		{
+			name:     "QualityMalformed",
+			accept:   "application/json;q=x",
+			expected: eth2http.ContentTypeUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, apiserver.NegotiateContentType(test.accept, test.supported...))
+		})
+	}
+}
+
+func TestConsensusVersion(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	_, err := apiserver.ConsensusVersion(r)
+	require.EqualError(t, err, "no consensus version supplied")
+
+	r.Header.Set("Eth-Consensus-Version", "electra")
+	version, err := apiserver.ConsensusVersion(r)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, version)
+
+	r.Header.Set("Eth-Consensus-Version", "unknown")
+	_, err = apiserver.ConsensusVersion(r)
+	require.ErrorContains(t, err, "failed to parse consensus version")
+}
+
+func TestDecodeRequest(t *testing.T) {
+	checkpoint := &phase0.Checkpoint{Epoch: 5, Root: phase0.Root{0x01}}
+	jsonData, err := json.Marshal(checkpoint)
+	require.NoError(t, err)
+	sszData, err := checkpoint.MarshalSSZ()
+	require.NoError(t, err)
+	extraData := append(bytes.Clone(jsonData[:len(jsonData)-1]), []byte(`,"extra":"1"}`)...)
+
+	tests := []struct {
+		name        string
+		params      []apiserver.Parameter
+		contentType string
+		body        []byte
+		err         string
+	}{
+		{
+			name: "JSONDefault",
+			body: jsonData,
+		},
+		{
+			name:        "JSON",
+			contentType: "application/json",
+			body:        jsonData,
+		},
+		{
+			name:        "SSZ",
+			contentType: "application/octet-stream",
+			body:        sszData,
+		},
+		{
+			name:        "ContentTypeUnknown",
+			contentType: "text/plain",
+			body:        jsonData,
+			err:         "unrecognised content type text/plain",
+		},
+		{
+			name:        "Empty",
+			contentType: "application/json",
+			err:         "empty request body",
+		},
+		{
+			name:        "TooLarge",
+			params:      []apiserver.Parameter{apiserver.WithMaxRequestSize(8)},
+			contentType: "application/json",
+			body:        jsonData,
+			err:         "request body exceeds maximum size of 8 bytes",
+		},
+		{
+			name:        "UnknownField",
+			contentType: "application/json",
+			body:        extraData,
+		},
+		{
+			name:        "UnknownFieldStrict",
+			params:      []apiserver.Parameter{apiserver.WithStrictJSON(true)},
+			contentType: "application/json",
+			body:        extraData,
+			err:         "failed to decode JSON request\nunknown field extra",
+		},
+		{
+			name:        "SSZInvalid",
+			contentType: "application/octet-stream",
+			body:        sszData[:10],
+			err:         "failed to decode SSZ request\nincorrect size",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			codec, err := apiserver.New(test.params...)
+			require.NoError(t, err)
+
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(test.body))
+			if test.contentType != "" {
+				r.Header.Set("Content-Type", test.contentType)
+			}
+			res := &phase0.Checkpoint{}
+			err = codec.DecodeRequest(r, res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, checkpoint, res)
+			}
+		})
+	}
+}
+
+func TestVersionedRoundTrip(t *testing.T) {
+	codec, err := apiserver.New()
+	require.NoError(t, err)
+
+	block, err := testfixtures.Block(spec.DataVersionElectra)
+	require.NoError(t, err)
+
+	for _, accept := range []string{"application/json", "application/octet-stream"} {
+		t.Run(accept, func(t *testing.T) {
+			// Encode the block as a response.
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept", accept)
+			require.NoError(t, codec.WriteResponse(w, r, block, map[string]any{"finalized": true}))
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, accept, w.Header().Get("Content-Type"))
+			require.Equal(t, "electra", w.Header().Get("Eth-Consensus-Version"))
+
+			body := w.Body.Bytes()
+			if accept == "application/json" {
+				var response struct {
+					Version   string          `json:"version"`
+					Finalized bool            `json:"finalized"`
+					Data      json.RawMessage `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(body, &response))
+				require.Equal(t, "electra", response.Version)
+				require.True(t, response.Finalized)
+				body = response.Data
+			}
+
+			// Decode the block as a request.
+			r = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+			r.Header.Set("Content-Type", accept)
+			r.Header.Set("Eth-Consensus-Version", "electra")
+			res := &spec.VersionedSignedBeaconBlock{}
+			require.NoError(t, codec.DecodeVersionedRequest(r, res))
+			require.Equal(t, block, res)
+		})
+	}
+}
+
+func TestWriteResponseErrors(t *testing.T) {
+	codec, err := apiserver.New()
+	require.NoError(t, err)
+
+	// Unacceptable content type.
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept", "text/html")
+	require.EqualError(t, codec.WriteResponse(w, r, &phase0.Checkpoint{}, nil), "no acceptable content type")
+	require.Equal(t, http.StatusNotAcceptable, w.Code)
+	require.JSONEq(t, `{"code":406,"message":"no acceptable content type"}`, w.Body.String())
+
+	// Versioned container without data for its version.
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	err = codec.WriteResponse(w, r, &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionDeneb}, nil)
+	require.EqualError(t, err, "*spec.VersionedSignedBeaconBlock has no data for version deneb")
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	// Decoding in to a container that is not versioned.
+	r = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(`{}`)))
+	r.Header.Set("Eth-Consensus-Version", "deneb")
+	err = codec.DecodeVersionedRequest(r, &phase0.Checkpoint{})
+	require.EqualError(t, err, "*phase0.Checkpoint is not a versioned container")
+}
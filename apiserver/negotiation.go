@@ -0,0 +1,120 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	eth2http "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// NegotiateContentType returns the content type of the response that best
+// matches the supplied Accept header, choosing from the supported content
+// types.  If the header is empty the first supported content type is
+// returned.  If none of the supported content types are acceptable then
+// eth2http.ContentTypeUnknown is returned.
+func NegotiateContentType(accept string, supported ...eth2http.ContentType) eth2http.ContentType {
+	if len(supported) == 0 {
+		supported = []eth2http.ContentType{eth2http.ContentTypeJSON, eth2http.ContentTypeSSZ}
+	}
+	if strings.TrimSpace(accept) == "" {
+		return supported[0]
+	}
+
+	best := eth2http.ContentTypeUnknown
+	bestQuality := 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, quality := parseAcceptPart(part)
+		if quality <= bestQuality {
+			continue
+		}
+		for _, contentType := range supported {
+			if mediaTypeMatches(mediaType, contentType) {
+				best = contentType
+				bestQuality = quality
+
+				break
+			}
+		}
+	}
+
+	return best
+}
+
+// parseAcceptPart parses a single entry of an Accept header, returning the
+// media type and its quality.
+func parseAcceptPart(part string) (string, float64) {
+	params := strings.Split(part, ";")
+	mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+	quality := 1.0
+	for _, param := range params[1:] {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found || strings.ToLower(strings.TrimSpace(key)) != "q" {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			// Treat a malformed quality as unacceptable.
+			return mediaType, 0
+		}
+		quality = parsed
+	}
+
+	return mediaType, quality
+}
+
+func mediaTypeMatches(mediaType string, contentType eth2http.ContentType) bool {
+	switch mediaType {
+	case "*/*", "application/*":
+		return true
+	default:
+		return mediaType == contentType.MediaType()
+	}
+}
+
+// RequestContentType returns the content type of the body of a request.
+// Requests without a Content-Type header are assumed to be JSON.
+func RequestContentType(r *http.Request) (eth2http.ContentType, error) {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return eth2http.ContentTypeJSON, nil
+	}
+
+	return eth2http.ParseFromMediaType(contentType)
+}
+
+// ConsensusVersion returns the consensus version of a request from its
+// Eth-Consensus-Version header.
+func ConsensusVersion(r *http.Request) (spec.DataVersion, error) {
+	versions := r.Header.Values("Eth-Consensus-Version")
+	switch len(versions) {
+	case 0:
+		return spec.DataVersionUnknown, errors.New("no consensus version supplied")
+	case 1:
+	default:
+		return spec.DataVersionUnknown, fmt.Errorf("malformed consensus version (%d entries)", len(versions))
+	}
+
+	version, err := spec.DataVersionFromString(strings.TrimSpace(versions[0]))
+	if err != nil {
+		return spec.DataVersionUnknown, errors.Join(errors.New("failed to parse consensus version"), err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+type parameters struct {
+	spec           map[string]any
+	maxRequestSize int64
+	strictJSON     bool
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithSpec sets the chain specification, as returned by the spec endpoint.
+// If the limits in the specification differ from the mainnet limits then SSZ
+// is encoded and decoded with a dynamic codec.  Defaults to mainnet.
+func WithSpec(spec map[string]any) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.spec = spec
+	})
+}
+
+// WithMaxRequestSize sets the maximum size of a request body that will be
+// decoded.  Defaults to 32MiB.
+func WithMaxRequestSize(maxRequestSize int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRequestSize = maxRequestSize
+	})
+}
+
+// WithStrictJSON rejects JSON request bodies that contain fields which are
+// not part of the type being decoded.  Defaults to false.
+func WithStrictJSON(strictJSON bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.strictJSON = strictJSON
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		maxRequestSize: 32 * 1024 * 1024,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.maxRequestSize <= 0 {
+		return nil, errors.New("max request size must be greater than 0")
+	}
+
+	return &parameters, nil
+}
+
+// dynamicSSZ returns a dynamic SSZ codec if the supplied specification
+// requires one, or nil if the static codec can be used.
+func dynamicSSZ(data map[string]any) (*dynssz.DynSsz, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	limits, err := spec.LimitsFromSpec(data)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain limits from spec"), err)
+	}
+	if limits.StaticSSZCompatible() {
+		return nil, nil
+	}
+
+	return dynssz.NewDynSsz(data), nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+var dataVersionType = reflect.TypeOf(spec.DataVersionUnknown)
+
+// versionedStruct returns the struct referenced by data if it is a versioned
+// container, that is a struct with a Version field of type spec.DataVersion.
+func versionedStruct(data any) (reflect.Value, bool) {
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Pointer || val.IsNil() {
+		return reflect.Value{}, false
+	}
+	val = val.Elem()
+	if val.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	versionField := val.FieldByName("Version")
+	if !versionField.IsValid() || versionField.Type() != dataVersionType {
+		return reflect.Value{}, false
+	}
+
+	return val, true
+}
+
+// versionedFieldName returns the name of the field in a versioned container
+// that holds data for the given version, for example "Electra" or
+// "ElectraBlinded".
+func versionedFieldName(val reflect.Value, version spec.DataVersion) string {
+	name := version.String()
+	name = strings.ToUpper(name[:1]) + name[1:]
+
+	blindedField := val.FieldByName("Blinded")
+	if blindedField.IsValid() && blindedField.Kind() == reflect.Bool && blindedField.Bool() {
+		name += "Blinded"
+	}
+
+	return name
+}
+
+// versionedData returns the version and the data for that version of a
+// versioned container.  If data is not a versioned container it is returned
+// unchanged, with a nil version.
+func versionedData(data any) (*spec.DataVersion, any, error) {
+	val, isVersioned := versionedStruct(data)
+	if !isVersioned {
+		return nil, data, nil
+	}
+
+	version, isVersion := val.FieldByName("Version").Interface().(spec.DataVersion)
+	if !isVersion {
+		return nil, nil, errors.New("invalid version field")
+	}
+	name := versionedFieldName(val, version)
+	field := val.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Pointer {
+		return nil, nil, fmt.Errorf("%T does not support version %s", data, version)
+	}
+	if field.IsNil() {
+		return nil, nil, fmt.Errorf("%T has no data for version %s", data, version)
+	}
+
+	return &version, field.Interface(), nil
+}
+
+// versionedTarget sets the version of a versioned container and returns the
+// field for that version, allocating it if required, ready to be decoded.
+func versionedTarget(res any, version spec.DataVersion) (any, error) {
+	val, isVersioned := versionedStruct(res)
+	if !isVersioned {
+		return nil, fmt.Errorf("%T is not a versioned container", res)
+	}
+
+	name := versionedFieldName(val, version)
+	field := val.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("%T does not support version %s", res, version)
+	}
+	val.FieldByName("Version").Set(reflect.ValueOf(version))
+	if field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
+	}
+
+	return field.Interface(), nil
+}
@@ -0,0 +1,51 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationpacker
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Committees contains the validators in each committee, keyed by slot and
+// then by committee index.
+type Committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex
+
+// NewCommittees creates committees from the values returned by a beacon
+// committees provider.  Committees for multiple epochs can be supplied.
+func NewCommittees(beaconCommittees []*apiv1.BeaconCommittee) Committees {
+	committees := make(Committees)
+	for _, beaconCommittee := range beaconCommittees {
+		if beaconCommittee == nil {
+			continue
+		}
+		if _, exists := committees[beaconCommittee.Slot]; !exists {
+			committees[beaconCommittee.Slot] = make(map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+		}
+		committees[beaconCommittee.Slot][beaconCommittee.Index] = beaconCommittee.Validators
+	}
+
+	return committees
+}
+
+// committee returns the validators in the given committee, if known.
+func (c Committees) committee(slot phase0.Slot, index phase0.CommitteeIndex) ([]phase0.ValidatorIndex, bool) {
+	slotCommittees, exists := c[slot]
+	if !exists {
+		return nil, false
+	}
+	validators, exists := slotCommittees[index]
+
+	return validators, exists
+}
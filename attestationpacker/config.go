@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationpacker
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Config contains the chain configuration values used for packing.
+type Config struct {
+	SlotsPerEpoch                uint64
+	MinAttestationInclusionDelay phase0.Slot
+	MaxAttestations              uint64
+	MaxAttestationsElectra       uint64
+}
+
+// ConfigFromSpec creates a configuration from the values returned by a spec
+// provider.
+func ConfigFromSpec(data map[string]any) (*Config, error) {
+	config := &Config{}
+
+	values := []spec.Uint64Value{
+		{Key: "SLOTS_PER_EPOCH", Apply: func(v uint64) { config.SlotsPerEpoch = v }},
+		{Key: "MIN_ATTESTATION_INCLUSION_DELAY", Apply: func(v uint64) { config.MinAttestationInclusionDelay = phase0.Slot(v) }},
+		{Key: "MAX_ATTESTATIONS", Apply: func(v uint64) { config.MaxAttestations = v }},
+		{Key: "MAX_ATTESTATIONS_ELECTRA", Apply: func(v uint64) { config.MaxAttestationsElectra = v }},
+	}
+	if err := spec.ReadUint64Values(data, values); err != nil {
+		return nil, err
+	}
+
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check ensures that required values are present.
+func (c *Config) check() error {
+	switch {
+	case c.SlotsPerEpoch == 0:
+		return errors.New("SLOTS_PER_EPOCH cannot be 0")
+	case c.MaxAttestations == 0:
+		return errors.New("MAX_ATTESTATIONS cannot be 0")
+	case c.MaxAttestationsElectra == 0:
+		return errors.New("MAX_ATTESTATIONS_ELECTRA cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,419 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package attestationpacker simulates the packing of attestations from the
+// attestation pool into a block body.
+//
+// Attestations are first aggregated where they have the same data and
+// committee and do not overlap.  The aggregates are then selected greedily,
+// each time picking the one that adds the most validators not already
+// covered, until the per-fork maximum number of attestations is reached.
+// From Electra onwards the aggregates for different committees with the
+// same data are combined into on-chain aggregates before selection.
+//
+// Signatures are aggregated by an implementation of
+// bls.SignatureAggregator supplied by the caller.
+package attestationpacker
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/aggregation"
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// Packer packs attestations into block bodies.
+type Packer struct {
+	config     *Config
+	committees Committees
+	aggregator bls.SignatureAggregator
+}
+
+// Result is the result of packing attestations into a block body.
+type Result struct {
+	// Attestations are the attestations to include in the block, in the
+	// order in which they were selected.
+	Attestations []*spec.VersionedAttestation
+	// Validators is the number of distinct validator attestations covered
+	// by the attestations.
+	Validators int
+	// Skipped is the number of pool attestations that could not be
+	// included in the block, for example because they were outside of the
+	// inclusion window or their committee was not known.
+	Skipped int
+}
+
+// attester is a validator attesting in a given slot.
+type attester struct {
+	slot      phase0.Slot
+	validator phase0.ValidatorIndex
+}
+
+// aggregate is an aggregate of pool attestations for a single committee.
+type aggregate struct {
+	attestation    *spec.VersionedAttestation
+	bits           bitfield.Bitlist
+	slot           phase0.Slot
+	committeeIndex phase0.CommitteeIndex
+	committee      []phase0.ValidatorIndex
+}
+
+// group is a set of aggregates that can be included together as a single
+// block attestation.
+type group struct {
+	aggregates map[phase0.CommitteeIndex][]*aggregate
+}
+
+// groupKey identifies a group.  Prior to Electra each block attestation
+// covers a single committee, so the committee index is part of the key.
+type groupKey struct {
+	dataRoot       phase0.Root
+	committeeIndex phase0.CommitteeIndex
+}
+
+// New creates a new packer for the given configuration and committees.
+func New(config *Config,
+	committees Committees,
+	aggregator bls.SignatureAggregator,
+) (
+	*Packer,
+	error,
+) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	if committees == nil {
+		return nil, errors.New("no committees supplied")
+	}
+	if aggregator == nil {
+		return nil, errors.New("no signature aggregator supplied")
+	}
+
+	return &Packer{
+		config:     config,
+		committees: committees,
+		aggregator: aggregator,
+	}, nil
+}
+
+// Pack packs the supplied pool attestations into a block of the given
+// version at the given slot.  Pool attestations must be of the same version
+// as the block; from Electra onwards they must each be for a single
+// committee, as they are when gossiped.
+func (p *Packer) Pack(version spec.DataVersion,
+	slot phase0.Slot,
+	pool []*spec.VersionedAttestation,
+) (
+	*Result,
+	error,
+) {
+	var maxAttestations uint64
+	switch version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair, spec.DataVersionBellatrix, spec.DataVersionCapella, spec.DataVersionDeneb:
+		maxAttestations = p.config.MaxAttestations
+	case spec.DataVersionElectra:
+		maxAttestations = p.config.MaxAttestationsElectra
+	default:
+		return nil, fmt.Errorf("unsupported version %v", version)
+	}
+
+	res := &Result{
+		Attestations: make([]*spec.VersionedAttestation, 0),
+	}
+
+	groupKeys, groups, err := p.groups(version, slot, pool, res)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := make(map[attester]struct{})
+	for uint64(len(res.Attestations)) < maxAttestations {
+		var bestKey groupKey
+		var best []*aggregate
+		bestScore := 0
+		for _, key := range groupKeys {
+			selected, score := groups[key].best(covered)
+			if score > bestScore {
+				bestKey = key
+				best = selected
+				bestScore = score
+			}
+		}
+		if bestScore == 0 {
+			break
+		}
+
+		attestation, err := p.blockAttestation(version, best)
+		if err != nil {
+			return nil, err
+		}
+		res.Attestations = append(res.Attestations, attestation)
+		res.Validators += bestScore
+		for _, selected := range best {
+			selected.cover(covered)
+			groups[bestKey].remove(selected)
+		}
+	}
+
+	return res, nil
+}
+
+// groups aggregates the pool attestations and places the aggregates in
+// groups, returning the group keys in the order in which they were created.
+func (p *Packer) groups(version spec.DataVersion,
+	slot phase0.Slot,
+	pool []*spec.VersionedAttestation,
+	res *Result,
+) (
+	[]groupKey,
+	map[groupKey]*group,
+	error,
+) {
+	type keyedCandidate struct {
+		candidate *aggregate
+		dataRoot  phase0.Root
+	}
+	candidates := make([]*keyedCandidate, 0, len(pool))
+	for _, attestation := range pool {
+		candidate, dataRoot, ok := p.candidate(version, slot, attestation)
+		if !ok {
+			res.Skipped++
+
+			continue
+		}
+		candidates = append(candidates, &keyedCandidate{
+			candidate: candidate,
+			dataRoot:  dataRoot,
+		})
+	}
+	// Aggregate the largest attestations first, as they are most likely
+	// to end up in the block.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].candidate.bits.Count() > candidates[j].candidate.bits.Count()
+	})
+
+	groupKeys := make([]groupKey, 0)
+	groups := make(map[groupKey]*group)
+	for _, keyed := range candidates {
+		candidate := keyed.candidate
+		key := groupKey{dataRoot: keyed.dataRoot}
+		if version < spec.DataVersionElectra {
+			key.committeeIndex = candidate.committeeIndex
+		}
+		if _, exists := groups[key]; !exists {
+			groupKeys = append(groupKeys, key)
+			groups[key] = &group{
+				aggregates: make(map[phase0.CommitteeIndex][]*aggregate),
+			}
+		}
+		if err := groups[key].add(p.aggregator, candidate); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return groupKeys, groups, nil
+}
+
+// candidate returns a single-attestation aggregate for the pool attestation
+// and the root of its data, or false if it cannot be included in the block.
+func (p *Packer) candidate(version spec.DataVersion,
+	slot phase0.Slot,
+	attestation *spec.VersionedAttestation,
+) (
+	*aggregate,
+	phase0.Root,
+	bool,
+) {
+	if attestation == nil || attestation.Version != version || attestation.IsEmpty() {
+		return nil, phase0.Root{}, false
+	}
+	data, err := attestation.Data()
+	if err != nil || data == nil || data.Source == nil || data.Target == nil {
+		return nil, phase0.Root{}, false
+	}
+	if !p.inclusionWindow(version, slot, data.Slot) {
+		return nil, phase0.Root{}, false
+	}
+	committeeIndex, err := attestation.CommitteeIndex()
+	if err != nil {
+		return nil, phase0.Root{}, false
+	}
+	committee, exists := p.committees.committee(data.Slot, committeeIndex)
+	if !exists {
+		return nil, phase0.Root{}, false
+	}
+	bits, err := attestation.AggregationBits()
+	if err != nil || bits.Len() != uint64(len(committee)) || bits.Count() == 0 {
+		return nil, phase0.Root{}, false
+	}
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, phase0.Root{}, false
+	}
+
+	return &aggregate{
+		attestation:    attestation,
+		bits:           bits,
+		slot:           data.Slot,
+		committeeIndex: committeeIndex,
+		committee:      committee,
+	}, dataRoot, true
+}
+
+// inclusionWindow returns true if an attestation for the given slot can be
+// included in a block at the block slot.
+func (p *Packer) inclusionWindow(version spec.DataVersion,
+	blockSlot phase0.Slot,
+	attestationSlot phase0.Slot,
+) bool {
+	if attestationSlot+p.config.MinAttestationInclusionDelay > blockSlot {
+		return false
+	}
+	if version >= spec.DataVersionDeneb {
+		// Attestations from the current and previous epochs are allowed.
+		blockEpoch := uint64(blockSlot) / p.config.SlotsPerEpoch
+		attestationEpoch := uint64(attestationSlot) / p.config.SlotsPerEpoch
+
+		return attestationEpoch+1 >= blockEpoch
+	}
+
+	return blockSlot <= attestationSlot+phase0.Slot(p.config.SlotsPerEpoch)
+}
+
+// blockAttestation creates the block attestation for the selected aggregates.
+func (p *Packer) blockAttestation(version spec.DataVersion,
+	selected []*aggregate,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	if version < spec.DataVersionElectra {
+		return selected[0].attestation, nil
+	}
+
+	attestations := make([]*electra.Attestation, len(selected))
+	for i := range selected {
+		attestations[i] = selected[i].attestation.Electra
+	}
+	attestation, err := aggregation.CombineCommitteeAttestations(p.aggregator, attestations...)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to combine committee attestations"), err)
+	}
+
+	return &spec.VersionedAttestation{
+		Version: version,
+		Electra: attestation,
+	}, nil
+}
+
+// add adds an attestation to the group, merging it in to the first existing
+// aggregate with which it does not overlap.
+func (g *group) add(aggregator bls.SignatureAggregator, candidate *aggregate) error {
+	aggregates := g.aggregates[candidate.committeeIndex]
+	for _, existing := range aggregates {
+		overlaps, err := existing.bits.Overlaps(candidate.bits)
+		if err != nil || overlaps {
+			continue
+		}
+		merged, err := aggregation.MergeVersionedAttestations(aggregator, existing.attestation, candidate.attestation)
+		if err != nil {
+			return errors.Join(errors.New("failed to merge attestations"), err)
+		}
+		bits, err := merged.AggregationBits()
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain merged aggregation bits"), err)
+		}
+		existing.attestation = merged
+		existing.bits = bits
+
+		return nil
+	}
+
+	g.aggregates[candidate.committeeIndex] = append(aggregates, candidate)
+
+	return nil
+}
+
+// best selects the aggregate for each committee in the group that covers
+// the most validators not already covered, returning the selected
+// aggregates in committee index order and the number of newly covered
+// validators.
+func (g *group) best(covered map[attester]struct{}) ([]*aggregate, int) {
+	committeeIndices := make([]phase0.CommitteeIndex, 0, len(g.aggregates))
+	for committeeIndex := range g.aggregates {
+		committeeIndices = append(committeeIndices, committeeIndex)
+	}
+	sort.Slice(committeeIndices, func(i, j int) bool {
+		return committeeIndices[i] < committeeIndices[j]
+	})
+
+	selected := make([]*aggregate, 0)
+	score := 0
+	for _, committeeIndex := range committeeIndices {
+		var best *aggregate
+		bestScore := 0
+		for _, aggregate := range g.aggregates[committeeIndex] {
+			if newlyCovered := aggregate.newlyCovered(covered); newlyCovered > bestScore {
+				best = aggregate
+				bestScore = newlyCovered
+			}
+		}
+		if best != nil {
+			selected = append(selected, best)
+			score += bestScore
+		}
+	}
+
+	return selected, score
+}
+
+// remove removes an aggregate from the group.
+func (g *group) remove(selected *aggregate) {
+	aggregates := g.aggregates[selected.committeeIndex]
+	for i := range aggregates {
+		if aggregates[i] == selected {
+			g.aggregates[selected.committeeIndex] = append(aggregates[:i], aggregates[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// newlyCovered returns the number of validators in the aggregate that are
+// not already covered.
+func (a *aggregate) newlyCovered(covered map[attester]struct{}) int {
+	res := 0
+	for _, index := range a.bits.BitIndices() {
+		if _, exists := covered[attester{slot: a.slot, validator: a.committee[index]}]; !exists {
+			res++
+		}
+	}
+
+	return res
+}
+
+// cover marks the validators in the aggregate as covered.
+func (a *aggregate) cover(covered map[attester]struct{}) {
+	for _, index := range a.bits.BitIndices() {
+		covered[attester{slot: a.slot, validator: a.committee[index]}] = struct{}{}
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationpacker_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/attestationpacker"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// xorAggregator is a signature aggregator that XORs signatures together.
+type xorAggregator struct{}
+
+func (xorAggregator) AggregateSignatures(signatures []phase0.BLSSignature) (phase0.BLSSignature, error) {
+	var res phase0.BLSSignature
+	for _, signature := range signatures {
+		for i := range signature {
+			res[i] ^= signature[i]
+		}
+	}
+
+	return res, nil
+}
+
+func testConfig() *attestationpacker.Config {
+	return &attestationpacker.Config{
+		SlotsPerEpoch:                8,
+		MinAttestationInclusionDelay: 1,
+		MaxAttestations:              2,
+		MaxAttestationsElectra:       1,
+	}
+}
+
+// testCommittees returns two committees of four validators at slot 1.
+func testCommittees() attestationpacker.Committees {
+	return attestationpacker.Committees{
+		1: {
+			0: {0, 1, 2, 3},
+			1: {4, 5, 6, 7},
+		},
+	}
+}
+
+func testData(slot phase0.Slot, index phase0.CommitteeIndex, root byte) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            slot,
+		Index:           index,
+		BeaconBlockRoot: phase0.Root{root},
+		Source:          &phase0.Checkpoint{Epoch: 0, Root: phase0.Root{0x02}},
+		Target:          &phase0.Checkpoint{Epoch: 0, Root: phase0.Root{0x03}},
+	}
+}
+
+func testBits(length uint64, indices ...uint64) bitfield.Bitlist {
+	bits := bitfield.NewBitlist(length)
+	for _, index := range indices {
+		bits.SetBitAt(index, true)
+	}
+
+	return bits
+}
+
+func phase0Attestation(data *phase0.AttestationData, indices ...uint64) *spec.VersionedAttestation {
+	return &spec.VersionedAttestation{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.Attestation{
+			AggregationBits: testBits(4, indices...),
+			Data:            data,
+			Signature:       phase0.BLSSignature{byte(len(indices))},
+		},
+	}
+}
+
+func electraAttestation(data *phase0.AttestationData, committeeIndex uint64, indices ...uint64) *spec.VersionedAttestation {
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(committeeIndex, true)
+
+	return &spec.VersionedAttestation{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.Attestation{
+			AggregationBits: testBits(4, indices...),
+			Data:            data,
+			Signature:       phase0.BLSSignature{byte(len(indices))},
+			CommitteeBits:   committeeBits,
+		},
+	}
+}
+
+func TestConfigFromSpec(t *testing.T) {
+	specValues := map[string]any{
+		"SLOTS_PER_EPOCH":                 uint64(32),
+		"MIN_ATTESTATION_INCLUSION_DELAY": uint64(1),
+		"MAX_ATTESTATIONS":                uint64(128),
+		"MAX_ATTESTATIONS_ELECTRA":        uint64(8),
+	}
+	config, err := attestationpacker.ConfigFromSpec(specValues)
+	require.NoError(t, err)
+	require.Equal(t, uint64(128), config.MaxAttestations)
+	require.Equal(t, uint64(8), config.MaxAttestationsElectra)
+
+	delete(specValues, "MAX_ATTESTATIONS_ELECTRA")
+	_, err = attestationpacker.ConfigFromSpec(specValues)
+	require.EqualError(t, err, "MAX_ATTESTATIONS_ELECTRA not found in spec")
+
+	specValues["MAX_ATTESTATIONS_ELECTRA"] = "8"
+	_, err = attestationpacker.ConfigFromSpec(specValues)
+	require.EqualError(t, err, "MAX_ATTESTATIONS_ELECTRA of unexpected type")
+
+	specValues["MAX_ATTESTATIONS_ELECTRA"] = uint64(8)
+	specValues["SLOTS_PER_EPOCH"] = uint64(0)
+	_, err = attestationpacker.ConfigFromSpec(specValues)
+	require.EqualError(t, err, "SLOTS_PER_EPOCH cannot be 0")
+}
+
+func TestNew(t *testing.T) {
+	_, err := attestationpacker.New(nil, testCommittees(), xorAggregator{})
+	require.EqualError(t, err, "no config supplied")
+
+	_, err = attestationpacker.New(testConfig(), nil, xorAggregator{})
+	require.EqualError(t, err, "no committees supplied")
+
+	_, err = attestationpacker.New(testConfig(), testCommittees(), nil)
+	require.EqualError(t, err, "no signature aggregator supplied")
+
+	_, err = attestationpacker.New(testConfig(), testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+}
+
+func TestPackUnsupportedVersion(t *testing.T) {
+	packer, err := attestationpacker.New(testConfig(), testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+
+	_, err = packer.Pack(spec.DataVersionUnknown, 2, nil)
+	require.EqualError(t, err, "unsupported version unknown")
+}
+
+func TestPackPhase0(t *testing.T) {
+	packer, err := attestationpacker.New(testConfig(), testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+
+	pool := []*spec.VersionedAttestation{
+		phase0Attestation(testData(1, 0, 0x01), 0, 1),
+		phase0Attestation(testData(1, 0, 0x01), 2),
+		// Overlaps with the aggregate above, so is not merged.
+		phase0Attestation(testData(1, 0, 0x01), 1, 2),
+		phase0Attestation(testData(1, 1, 0x01), 0, 1, 2, 3),
+		// Unknown committee.
+		phase0Attestation(testData(1, 5, 0x01), 0),
+		// Too recent for inclusion.
+		phase0Attestation(testData(2, 0, 0x01), 0),
+		// Wrong version.
+		electraAttestation(testData(1, 0, 0x01), 0, 3),
+	}
+
+	res, err := packer.Pack(spec.DataVersionPhase0, 2, pool)
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 2)
+	require.Equal(t, 7, res.Validators)
+	require.Equal(t, 3, res.Skipped)
+	require.Equal(t, phase0.CommitteeIndex(1), res.Attestations[0].Phase0.Data.Index)
+	require.Equal(t, phase0.CommitteeIndex(0), res.Attestations[1].Phase0.Data.Index)
+	require.Equal(t, []int{0, 1, 2}, res.Attestations[1].Phase0.AggregationBits.BitIndices())
+}
+
+func TestPackLimit(t *testing.T) {
+	config := testConfig()
+	config.MaxAttestations = 1
+	packer, err := attestationpacker.New(config, testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+
+	pool := []*spec.VersionedAttestation{
+		phase0Attestation(testData(1, 0, 0x01), 0),
+		phase0Attestation(testData(1, 1, 0x01), 0, 1),
+	}
+
+	res, err := packer.Pack(spec.DataVersionPhase0, 2, pool)
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 1)
+	require.Equal(t, 2, res.Validators)
+}
+
+func TestPackInclusionWindow(t *testing.T) {
+	pool := []*spec.VersionedAttestation{
+		phase0Attestation(testData(1, 0, 0x01), 0),
+	}
+	denebPool := []*spec.VersionedAttestation{
+		{
+			Version: spec.DataVersionDeneb,
+			Deneb:   pool[0].Phase0,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		version   spec.DataVersion
+		slot      phase0.Slot
+		pool      []*spec.VersionedAttestation
+		validator int
+	}{
+		{
+			name:      "Phase0LastSlot",
+			version:   spec.DataVersionPhase0,
+			slot:      9,
+			pool:      pool,
+			validator: 1,
+		},
+		{
+			name:    "Phase0Expired",
+			version: spec.DataVersionPhase0,
+			slot:    10,
+			pool:    pool,
+		},
+		{
+			name:      "DenebNextEpoch",
+			version:   spec.DataVersionDeneb,
+			slot:      15,
+			pool:      denebPool,
+			validator: 1,
+		},
+		{
+			name:    "DenebExpired",
+			version: spec.DataVersionDeneb,
+			slot:    16,
+			pool:    denebPool,
+		},
+	}
+
+	packer, err := attestationpacker.New(testConfig(), testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := packer.Pack(test.version, test.slot, test.pool)
+			require.NoError(t, err)
+			require.Equal(t, test.validator, res.Validators)
+			require.Len(t, res.Attestations, test.validator)
+		})
+	}
+}
+
+func TestPackElectra(t *testing.T) {
+	pool := []*spec.VersionedAttestation{
+		electraAttestation(testData(1, 0, 0x01), 0, 0, 1),
+		electraAttestation(testData(1, 0, 0x01), 1, 0),
+		electraAttestation(testData(1, 0, 0x02), 0, 2, 3),
+		// Wrong version.
+		phase0Attestation(testData(1, 0, 0x01), 3),
+	}
+
+	packer, err := attestationpacker.New(testConfig(), testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+	res, err := packer.Pack(spec.DataVersionElectra, 2, pool)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Skipped)
+	require.Len(t, res.Attestations, 1)
+	require.Equal(t, 3, res.Validators)
+	attestation := res.Attestations[0].Electra
+	require.Equal(t, []int{0, 1}, attestation.CommitteeBits.BitIndices())
+	require.Equal(t, uint64(8), attestation.AggregationBits.Len())
+	require.Equal(t, []int{0, 1, 4}, attestation.AggregationBits.BitIndices())
+
+	config := testConfig()
+	config.MaxAttestationsElectra = 2
+	packer, err = attestationpacker.New(config, testCommittees(), xorAggregator{})
+	require.NoError(t, err)
+	res, err = packer.Pack(spec.DataVersionElectra, 2, pool)
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 2)
+	require.Equal(t, 5, res.Validators)
+	require.Equal(t, []int{0}, res.Attestations[1].Electra.CommitteeBits.BitIndices())
+}
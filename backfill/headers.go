@@ -0,0 +1,117 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"golang.org/x/sync/errgroup"
+)
+
+// SlotHeader is the canonical block header for a slot.
+type SlotHeader struct {
+	// Slot is the slot of the header.
+	Slot phase0.Slot
+	// Missed is true if there is no canonical block at the slot, in which
+	// case Root and Header are empty.
+	Missed bool
+	// Root is the root of the block.
+	Root phase0.Root
+	// Header is the block header.
+	Header *phase0.BeaconBlockHeader
+}
+
+// Headers fetches the canonical block headers for slots from the start slot
+// up to but not including the end slot.  The returned slice contains an entry
+// for each slot in order, so the header for a slot is at index slot-startSlot.
+// Slots without a canonical block are marked as missed.  If any header cannot
+// be obtained after retries then an error is returned.
+func (s *Service) Headers(ctx context.Context, startSlot phase0.Slot, endSlot phase0.Slot) ([]*SlotHeader, error) {
+	if s.beaconBlockHeadersProvider == nil {
+		return nil, errors.New("no beacon block headers provider available")
+	}
+	if endSlot < startSlot {
+		return nil, errors.New("end slot before start slot")
+	}
+
+	headers := make([]*SlotHeader, endSlot-startSlot)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(s.parallelism)
+	for slot := startSlot; slot < endSlot; slot++ {
+		if groupCtx.Err() != nil {
+			break
+		}
+		slot := slot
+		group.Go(func() error {
+			header, err := s.fetchHeader(groupCtx, slot)
+			if err != nil {
+				return err
+			}
+			headers[slot-startSlot] = header
+
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+// fetchHeader fetches the canonical header for a single slot, retrying on failure.
+func (s *Service) fetchHeader(ctx context.Context, slot phase0.Slot) (*SlotHeader, error) {
+	var blockHeader *apiv1.BeaconBlockHeader
+	err := s.request(ctx, slot, "header", func(ctx context.Context) error {
+		response, err := s.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+			Common: api.CommonOpts{
+				Timeout: s.timeout,
+			},
+			Block: strconv.FormatUint(uint64(slot), 10),
+		})
+		if err != nil {
+			return err
+		}
+		if response != nil {
+			blockHeader = response.Data
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if blockHeader == nil ||
+		!blockHeader.Canonical ||
+		blockHeader.Header == nil ||
+		blockHeader.Header.Message == nil ||
+		blockHeader.Header.Message.Slot != slot {
+		return &SlotHeader{Slot: slot, Missed: true}, nil
+	}
+
+	return &SlotHeader{
+		Slot:   slot,
+		Root:   blockHeader.Root,
+		Header: blockHeader.Header.Message,
+	}, nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/backfill"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// headerFunc returns headers for slots, with no block for slots divisible by
+// 5 and a non-canonical block for slots divisible by 7.
+func headerFunc(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	slot, err := strconv.ParseUint(opts.Block, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if slot%5 == 0 {
+		return nil, &api.Error{
+			Method:     http.MethodGet,
+			StatusCode: http.StatusNotFound,
+		}
+	}
+
+	return &api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Root:      phase0.Root{byte(slot)},
+			Canonical: slot%7 != 0,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(slot),
+				},
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+func TestHeaders(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.BeaconBlockHeaderFunc = headerFunc
+
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithBeaconBlockHeadersProvider(client),
+		backfill.WithParallelism(4),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Fetch(ctx, 1, 2)
+	require.EqualError(t, err, "no signed beacon block provider available")
+
+	_, err = s.Headers(ctx, 10, 9)
+	require.EqualError(t, err, "end slot before start slot")
+
+	headers, err := s.Headers(ctx, 3, 53)
+	require.NoError(t, err)
+	require.Len(t, headers, 50)
+	for i, header := range headers {
+		slot := phase0.Slot(3 + i)
+		require.Equal(t, slot, header.Slot)
+		if slot%5 == 0 || slot%7 == 0 {
+			require.True(t, header.Missed)
+			require.Nil(t, header.Header)
+		} else {
+			require.False(t, header.Missed)
+			require.Equal(t, phase0.Root{byte(slot)}, header.Root)
+			require.Equal(t, slot, header.Header.Slot)
+		}
+	}
+
+	headers, err = s.Headers(ctx, 3, 3)
+	require.NoError(t, err)
+	require.Empty(t, headers)
+}
+
+func TestHeadersFailure(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.BeaconBlockHeaderFunc = func(ctx context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+		if opts.Block == "4" {
+			return nil, errors.New("failed")
+		}
+
+		return headerFunc(ctx, opts)
+	}
+
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithBeaconBlockHeadersProvider(client),
+		backfill.WithRetries(1),
+		backfill.WithRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Headers(ctx, 1, 10)
+	require.EqualError(t, err, "failed to obtain header for slot 4\nfailed")
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"iter"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Blocks returns an iterator over the results of fetching the blocks for
+// slots from the start slot up to but not including the end slot, in slot
+// order.  It behaves as Fetch, with fetching canceled if the caller stops
+// iterating.  If fetching cannot be started then a single result containing
+// the error is yielded.
+func (s *Service) Blocks(ctx context.Context, startSlot phase0.Slot, endSlot phase0.Slot) iter.Seq[*Result] {
+	return func(yield func(*Result) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results, err := s.Fetch(ctx, startSlot, endSlot)
+		if err != nil {
+			yield(&Result{Slot: startSlot, Err: err})
+
+			return
+		}
+
+		for result := range results {
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/backfill"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	provider := newTestProvider(0)
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithSignedBeaconBlockProvider(provider),
+		backfill.WithParallelism(4),
+		backfill.WithRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// Full range.
+	slot := phase0.Slot(10)
+	for result := range s.Blocks(ctx, 10, 30) {
+		require.NoError(t, result.Err)
+		require.Equal(t, slot, result.Slot)
+		slot++
+	}
+	require.Equal(t, phase0.Slot(30), slot)
+
+	// Early termination.
+	slot = 10
+	for result := range s.Blocks(ctx, 10, 1000) {
+		require.Equal(t, slot, result.Slot)
+		if slot == 15 {
+			break
+		}
+		slot++
+	}
+	require.Eventually(t, func() bool { return provider.active.Load() == 0 }, time.Second, time.Millisecond)
+
+	// Invalid range.
+	results := 0
+	for result := range s.Blocks(ctx, 30, 10) {
+		require.EqualError(t, result.Err, "end slot before start slot")
+		results++
+	}
+	require.Equal(t, 1, results)
+}
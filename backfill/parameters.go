@@ -0,0 +1,148 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                   zerolog.Level
+	signedBeaconBlockProvider  consensusclient.SignedBeaconBlockProvider
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	parallelism                int
+	timeout                    time.Duration
+	retries                    int
+	retryInterval              time.Duration
+	finalityProvider           consensusclient.FinalityProvider
+	clock                      clock.Clock
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the provider from which blocks are fetched.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithBeaconBlockHeadersProvider sets the provider from which block headers are fetched.
+func WithBeaconBlockHeadersProvider(provider consensusclient.BeaconBlockHeadersProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconBlockHeadersProvider = provider
+	})
+}
+
+// WithFinalityProvider sets the provider from which finality is fetched when
+// reconciling roots.
+func WithFinalityProvider(provider consensusclient.FinalityProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalityProvider = provider
+	})
+}
+
+// WithParallelism sets the maximum number of blocks fetched concurrently.
+func WithParallelism(parallelism int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parallelism = parallelism
+	})
+}
+
+// WithTimeout sets the timeout for each request to obtain a block.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithRetries sets the number of times a failed request for a block is retried.
+func WithRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retries = retries
+	})
+}
+
+// WithRetryInterval sets the interval between retries of a failed request for a block.
+func WithRetryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryInterval = interval
+	})
+}
+
+// WithClock sets the clock used to wait between retries.  Defaults to the system clock.
+func WithClock(clock clock.Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:      zerolog.GlobalLevel(),
+		parallelism:   8,
+		timeout:       30 * time.Second,
+		retries:       3,
+		retryInterval: time.Second,
+		clock:         clock.System(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.signedBeaconBlockProvider == nil && parameters.beaconBlockHeadersProvider == nil {
+		return nil, errors.New("no signed beacon block or beacon block headers provider specified")
+	}
+	if parameters.parallelism <= 0 {
+		return nil, errors.New("parallelism must be greater than 0")
+	}
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+	if parameters.retries < 0 {
+		return nil, errors.New("retries cannot be negative")
+	}
+	if parameters.retryInterval <= 0 {
+		return nil, errors.New("no retry interval specified")
+	}
+	if parameters.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SlotRoots is the canonical block and state root for a slot, reconciled
+// against a root previously stored by the caller.
+type SlotRoots struct {
+	// Slot is the slot of the roots.
+	Slot phase0.Slot
+	// Missed is true if there is no canonical block at the slot, in which
+	// case BlockRoot and StateRoot are empty.
+	Missed bool
+	// BlockRoot is the root of the canonical block at the slot.
+	BlockRoot phase0.Root
+	// StateRoot is the state root of the canonical block at the slot.
+	StateRoot phase0.Root
+	// Finalized is true if the slot is at or before the latest finalized
+	// block, in which case the roots can no longer change.
+	Finalized bool
+	// Reorged is true if the caller supplied a stored root for the slot that
+	// is not the canonical block root, that is the block has been reorged out
+	// or the slot is now missed.
+	Reorged bool
+	// StoredRoot is the root supplied by the caller for the slot, if any.
+	StoredRoot *phase0.Root
+}
+
+// Reconcile fetches the canonical block and state roots for slots from the
+// start slot up to but not including the end slot, and compares them with
+// the block roots the caller has stored for those slots.  The returned slice
+// contains an entry for each slot in order, so the roots for a slot are at
+// index slot-startSlot.  Stored roots that are no longer canonical are flagged
+// as reorged; slots at or before the latest finalized block are flagged as
+// finalized.  If any data cannot be obtained after retries then an error is
+// returned.
+func (s *Service) Reconcile(ctx context.Context,
+	startSlot phase0.Slot,
+	endSlot phase0.Slot,
+	stored map[phase0.Slot]phase0.Root,
+) (
+	[]*SlotRoots,
+	error,
+) {
+	if s.beaconBlockHeadersProvider == nil {
+		return nil, errors.New("no beacon block headers provider available")
+	}
+	if s.finalityProvider == nil {
+		return nil, errors.New("no finality provider available")
+	}
+	if endSlot < startSlot {
+		return nil, errors.New("end slot before start slot")
+	}
+
+	// Finality is obtained before the headers, so that any slot considered
+	// finalized was finalized when its header was fetched.
+	finalizedSlot, err := s.finalizedSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := s.Headers(ctx, startSlot, endSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]*SlotRoots, len(headers))
+	for i, header := range headers {
+		slotRoots := &SlotRoots{
+			Slot:      header.Slot,
+			Missed:    header.Missed,
+			Finalized: header.Slot <= finalizedSlot,
+		}
+		if !header.Missed {
+			slotRoots.BlockRoot = header.Root
+			slotRoots.StateRoot = header.Header.StateRoot
+		}
+		if storedRoot, exists := stored[header.Slot]; exists {
+			slotRoots.StoredRoot = &storedRoot
+			slotRoots.Reorged = header.Missed || storedRoot != header.Root
+		}
+		roots[i] = slotRoots
+	}
+
+	return roots, nil
+}
+
+// finalizedSlot obtains the slot of the latest finalized block.
+func (s *Service) finalizedSlot(ctx context.Context) (phase0.Slot, error) {
+	var finality *apiv1.Finality
+	err := s.retry(ctx, s.log, "finality", func(ctx context.Context) error {
+		response, err := s.finalityProvider.Finality(ctx, &api.FinalityOpts{
+			Common: api.CommonOpts{
+				Timeout: s.timeout,
+			},
+			State: "head",
+		})
+		if err != nil {
+			return err
+		}
+		if response != nil {
+			finality = response.Data
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if finality == nil || finality.Finalized == nil {
+		return 0, errors.New("no finality returned")
+	}
+	if finality.Finalized.Root.IsZero() {
+		// Nothing finalized beyond genesis.
+		return 0, nil
+	}
+
+	var blockHeader *apiv1.BeaconBlockHeader
+	err = s.retry(ctx, s.log, "finalized header", func(ctx context.Context) error {
+		response, err := s.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+			Common: api.CommonOpts{
+				Timeout: s.timeout,
+			},
+			Block: fmt.Sprintf("%#x", finality.Finalized.Root),
+		})
+		if err != nil {
+			return err
+		}
+		if response != nil {
+			blockHeader = response.Data
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if blockHeader == nil || blockHeader.Header == nil || blockHeader.Header.Message == nil {
+		return 0, fmt.Errorf("no header for finalized block %#x", finality.Finalized.Root)
+	}
+
+	return blockHeader.Header.Message.Slot, nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/backfill"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	finalizedRoot := phase0.Root{0xff}
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.FinalityFunc = func(_ context.Context, _ *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		return &api.Response[*apiv1.Finality]{
+			Data: &apiv1.Finality{
+				Finalized: &phase0.Checkpoint{Epoch: 1, Root: finalizedRoot},
+			},
+			Metadata: make(map[string]any),
+		}, nil
+	}
+	client.BeaconBlockHeaderFunc = func(ctx context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+		if opts.Block == "0xff00000000000000000000000000000000000000000000000000000000000000" {
+			return &api.Response[*apiv1.BeaconBlockHeader]{
+				Data: &apiv1.BeaconBlockHeader{
+					Root:      finalizedRoot,
+					Canonical: true,
+					Header: &phase0.SignedBeaconBlockHeader{
+						Message: &phase0.BeaconBlockHeader{Slot: 12},
+					},
+				},
+				Metadata: make(map[string]any),
+			}, nil
+		}
+
+		response, err := headerFunc(ctx, opts)
+		if err == nil {
+			response.Data.Header.Message.StateRoot = phase0.Root{0x80, response.Data.Root[0]}
+		}
+
+		return response, err
+	}
+
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithBeaconBlockHeadersProvider(client),
+	)
+	require.NoError(t, err)
+	_, err = s.Reconcile(ctx, 1, 2, nil)
+	require.EqualError(t, err, "no finality provider available")
+
+	s, err = backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithBeaconBlockHeadersProvider(client),
+		backfill.WithFinalityProvider(client),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Reconcile(ctx, 10, 9, nil)
+	require.EqualError(t, err, "end slot before start slot")
+
+	stored := map[phase0.Slot]phase0.Root{
+		// Canonical.
+		11: {11},
+		// Reorged out, replaced by another block.
+		13: {0xaa},
+		// Reorged out, slot now missed.
+		14: {14},
+	}
+	roots, err := s.Reconcile(ctx, 10, 20, stored)
+	require.NoError(t, err)
+	require.Len(t, roots, 10)
+	for i, slotRoots := range roots {
+		slot := phase0.Slot(10 + i)
+		require.Equal(t, slot, slotRoots.Slot)
+		require.Equal(t, slot <= 12, slotRoots.Finalized)
+		if slot%5 == 0 || slot%7 == 0 {
+			require.True(t, slotRoots.Missed)
+			require.True(t, slotRoots.BlockRoot.IsZero())
+			require.True(t, slotRoots.StateRoot.IsZero())
+		} else {
+			require.False(t, slotRoots.Missed)
+			require.Equal(t, phase0.Root{byte(slot)}, slotRoots.BlockRoot)
+			require.Equal(t, phase0.Root{0x80, byte(slot)}, slotRoots.StateRoot)
+		}
+		storedRoot, exists := stored[slot]
+		if exists {
+			require.Equal(t, &storedRoot, slotRoots.StoredRoot)
+		} else {
+			require.Nil(t, slotRoots.StoredRoot)
+		}
+		require.Equal(t, slot == 13 || slot == 14, slotRoots.Reorged)
+	}
+}
+
+func TestReconcileFinalityFailure(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.BeaconBlockHeaderFunc = headerFunc
+	client.FinalityFunc = func(_ context.Context, _ *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		return nil, errors.New("failed")
+	}
+
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithBeaconBlockHeadersProvider(client),
+		backfill.WithFinalityProvider(client),
+		backfill.WithRetries(0),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Reconcile(ctx, 1, 10, nil)
+	require.EqualError(t, err, "failed to obtain finality\nfailed")
+}
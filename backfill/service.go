@@ -0,0 +1,219 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backfill fetches ranges of historical blocks and block headers
+// concurrently, delivering them in slot order, and reconciles previously
+// stored block roots against the canonical chain.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Result is the result of fetching the block for a slot.
+type Result struct {
+	// Slot is the slot for which the block was fetched.
+	Slot phase0.Slot
+	// Block is the block at the slot, or nil if the slot has no block.
+	Block *spec.VersionedSignedBeaconBlock
+	// Err is set if the block could not be obtained, in which case this is
+	// the final result sent.
+	Err error
+}
+
+// Service fetches ranges of blocks.
+type Service struct {
+	log                        zerolog.Logger
+	signedBeaconBlockProvider  consensusclient.SignedBeaconBlockProvider
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	parallelism                int
+	timeout                    time.Duration
+	retries                    int
+	retryInterval              time.Duration
+	finalityProvider           consensusclient.FinalityProvider
+	clock                      clock.Clock
+}
+
+// New creates a new backfill service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "backfill").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                        log,
+		signedBeaconBlockProvider:  parameters.signedBeaconBlockProvider,
+		beaconBlockHeadersProvider: parameters.beaconBlockHeadersProvider,
+		parallelism:                parameters.parallelism,
+		timeout:                    parameters.timeout,
+		retries:                    parameters.retries,
+		retryInterval:              parameters.retryInterval,
+		finalityProvider:           parameters.finalityProvider,
+		clock:                      parameters.clock,
+	}, nil
+}
+
+// Fetch fetches the blocks for slots from the start slot up to but not
+// including the end slot, returning a channel on which a result for each slot
+// is sent in slot order.  Slots without a block are sent with a nil block.
+// If a block cannot be obtained after retries then a result containing the
+// error is sent and fetching stops.  The channel is closed when fetching is
+// complete, has failed, or the context is canceled.
+func (s *Service) Fetch(ctx context.Context, startSlot phase0.Slot, endSlot phase0.Slot) (<-chan *Result, error) {
+	if s.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider available")
+	}
+	if endSlot < startSlot {
+		return nil, errors.New("end slot before start slot")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Each slot has its own single-entry channel for its result; these are
+	// queued in slot order, which limits how far ahead fetching can run.
+	pending := make(chan chan *Result, s.parallelism)
+	sem := make(chan struct{}, s.parallelism)
+	go func() {
+		defer close(pending)
+		for slot := startSlot; slot < endSlot; slot++ {
+			slotResult := make(chan *Result, 1)
+			select {
+			case pending <- slotResult:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(slot phase0.Slot) {
+				defer func() { <-sem }()
+				slotResult <- s.fetch(ctx, slot)
+			}(slot)
+		}
+	}()
+
+	results := make(chan *Result)
+	go func() {
+		defer cancel()
+		defer close(results)
+		for slotResult := range pending {
+			var result *Result
+			select {
+			case result = <-slotResult:
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+			if result.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+// fetch fetches the block for a single slot, retrying on failure.
+func (s *Service) fetch(ctx context.Context, slot phase0.Slot) *Result {
+	var block *spec.VersionedSignedBeaconBlock
+	err := s.request(ctx, slot, "block", func(ctx context.Context) error {
+		response, err := s.signedBeaconBlockProvider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
+			Common: api.CommonOpts{
+				Timeout: s.timeout,
+			},
+			Block: strconv.FormatUint(uint64(slot), 10),
+		})
+		if err != nil {
+			return err
+		}
+		if response != nil {
+			block = response.Data
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &Result{
+			Slot: slot,
+			Err:  err,
+		}
+	}
+
+	return &Result{Slot: slot, Block: block}
+}
+
+// request makes a request for an item at a slot, retrying on failure.  A
+// response from the beacon node that the item was not found is not an error.
+func (s *Service) request(ctx context.Context, slot phase0.Slot, item string, fn func(ctx context.Context) error) error {
+	log := s.log.With().Uint64("slot", uint64(slot)).Logger()
+
+	return s.retry(ctx, log, fmt.Sprintf("%s for slot %d", item, slot), fn)
+}
+
+// retry makes a request for an item, retrying on failure.  A response from
+// the beacon node that the item was not found is not an error.
+func (s *Service) retry(ctx context.Context, log zerolog.Logger, item string, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = fn(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// No item available.
+			return nil
+		}
+		if attempt == s.retries || ctx.Err() != nil {
+			break
+		}
+
+		log.Debug().Int("attempt", attempt+1).Err(err).Msgf("Failed to obtain %s; retrying", item)
+		select {
+		case <-ctx.Done():
+		case <-s.clock.After(s.retryInterval):
+		}
+	}
+
+	return errors.Join(fmt.Errorf("failed to obtain %s", item), err)
+}
@@ -0,0 +1,276 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/backfill"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testProvider serves blocks after a random delay, returning not found for
+// slots divisible by 5 and failing the given number of times for each slot.
+type testProvider struct {
+	failures    int
+	attemptsMu  sync.Mutex
+	attempts    map[string]int
+	active      atomic.Int32
+	maxActive   atomic.Int32
+	notFoundErr error
+}
+
+func newTestProvider(failures int) *testProvider {
+	return &testProvider{
+		failures: failures,
+		attempts: make(map[string]int),
+		notFoundErr: &api.Error{
+			Method:     http.MethodGet,
+			StatusCode: http.StatusNotFound,
+		},
+	}
+}
+
+func (p *testProvider) SignedBeaconBlock(ctx context.Context,
+	opts *api.SignedBeaconBlockOpts,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	active := p.active.Add(1)
+	defer p.active.Add(-1)
+	for {
+		maxActive := p.maxActive.Load()
+		if active <= maxActive || p.maxActive.CompareAndSwap(maxActive, active) {
+			break
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Duration(rand.Intn(5)) * time.Millisecond):
+	}
+
+	p.attemptsMu.Lock()
+	p.attempts[opts.Block]++
+	attempts := p.attempts[opts.Block]
+	p.attemptsMu.Unlock()
+	if attempts <= p.failures {
+		return nil, errors.New("failed")
+	}
+
+	slot, err := strconv.ParseUint(opts.Block, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if slot%5 == 0 {
+		return nil, p.notFoundErr
+	}
+
+	return &api.Response[*spec.VersionedSignedBeaconBlock]{
+		Data: &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionPhase0,
+			Phase0: &phase0.SignedBeaconBlock{
+				Message: &phase0.BeaconBlock{
+					Slot: phase0.Slot(slot),
+				},
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []backfill.Parameter
+		err    string
+	}{
+		{
+			name: "ProviderMissing",
+			params: []backfill.Parameter{
+				backfill.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters\nno signed beacon block or beacon block headers provider specified",
+		},
+		{
+			name: "ParallelismZero",
+			params: []backfill.Parameter{
+				backfill.WithLogLevel(zerolog.Disabled),
+				backfill.WithSignedBeaconBlockProvider(client),
+				backfill.WithParallelism(0),
+			},
+			err: "problem with parameters\nparallelism must be greater than 0",
+		},
+		{
+			name: "TimeoutZero",
+			params: []backfill.Parameter{
+				backfill.WithLogLevel(zerolog.Disabled),
+				backfill.WithSignedBeaconBlockProvider(client),
+				backfill.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "RetriesNegative",
+			params: []backfill.Parameter{
+				backfill.WithLogLevel(zerolog.Disabled),
+				backfill.WithSignedBeaconBlockProvider(client),
+				backfill.WithRetries(-1),
+			},
+			err: "problem with parameters\nretries cannot be negative",
+		},
+		{
+			name: "RetryIntervalZero",
+			params: []backfill.Parameter{
+				backfill.WithLogLevel(zerolog.Disabled),
+				backfill.WithSignedBeaconBlockProvider(client),
+				backfill.WithRetryInterval(0),
+			},
+			err: "problem with parameters\nno retry interval specified",
+		},
+		{
+			name: "Good",
+			params: []backfill.Parameter{
+				backfill.WithLogLevel(zerolog.Disabled),
+				backfill.WithSignedBeaconBlockProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := backfill.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+
+	provider := newTestProvider(1)
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithSignedBeaconBlockProvider(provider),
+		backfill.WithParallelism(4),
+		backfill.WithRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Fetch(ctx, 10, 9)
+	require.EqualError(t, err, "end slot before start slot")
+
+	results, err := s.Fetch(ctx, 3, 103)
+	require.NoError(t, err)
+
+	expected := phase0.Slot(3)
+	for result := range results {
+		require.NoError(t, result.Err)
+		require.Equal(t, expected, result.Slot)
+		if result.Slot%5 == 0 {
+			require.Nil(t, result.Block)
+		} else {
+			require.NotNil(t, result.Block)
+			slot, err := result.Block.Slot()
+			require.NoError(t, err)
+			require.Equal(t, result.Slot, slot)
+		}
+		expected++
+	}
+	require.Equal(t, phase0.Slot(103), expected)
+	require.LessOrEqual(t, provider.maxActive.Load(), int32(4))
+	require.Greater(t, provider.maxActive.Load(), int32(1))
+
+	// Empty range.
+	results, err = s.Fetch(ctx, 3, 3)
+	require.NoError(t, err)
+	_, open := <-results
+	require.False(t, open)
+}
+
+func TestFetchFailure(t *testing.T) {
+	ctx := context.Background()
+
+	provider := newTestProvider(3)
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithSignedBeaconBlockProvider(provider),
+		backfill.WithRetries(2),
+		backfill.WithRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	results, err := s.Fetch(ctx, 1, 20)
+	require.NoError(t, err)
+
+	result, open := <-results
+	require.True(t, open)
+	require.Equal(t, phase0.Slot(1), result.Slot)
+	require.EqualError(t, result.Err, "failed to obtain block for slot 1\nfailed")
+	_, open = <-results
+	require.False(t, open)
+}
+
+func TestFetchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	provider := newTestProvider(0)
+	s, err := backfill.New(ctx,
+		backfill.WithLogLevel(zerolog.Disabled),
+		backfill.WithSignedBeaconBlockProvider(provider),
+	)
+	require.NoError(t, err)
+
+	results, err := s.Fetch(ctx, 1, 1000000)
+	require.NoError(t, err)
+
+	result := <-results
+	require.Equal(t, phase0.Slot(1), result.Slot)
+	cancel()
+
+	require.Eventually(t, func() bool {
+		for result := range results {
+			require.NoError(t, result.Err)
+		}
+
+		return true
+	}, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		return provider.active.Load() == 0
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancehistory
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                  zerolog.Level
+	chainTime                 *chaintime.Service
+	validatorBalancesProvider consensusclient.ValidatorBalancesProvider
+	parallelism               int
+	timeout                   time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service used to map epochs to states.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithValidatorBalancesProvider sets the provider from which balances are fetched.
+func WithValidatorBalancesProvider(provider consensusclient.ValidatorBalancesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorBalancesProvider = provider
+	})
+}
+
+// WithParallelism sets the maximum number of states queried concurrently.
+func WithParallelism(parallelism int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parallelism = parallelism
+	})
+}
+
+// WithTimeout sets the timeout for each request to obtain balances.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:    zerolog.GlobalLevel(),
+		parallelism: 4,
+		timeout:     time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.validatorBalancesProvider == nil {
+		return nil, errors.New("no validator balances provider specified")
+	}
+	if parameters.parallelism <= 0 {
+		return nil, errors.New("parallelism must be greater than 0")
+	}
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+
+	return &parameters, nil
+}
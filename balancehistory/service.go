@@ -0,0 +1,258 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package balancehistory fetches the balances of a set of validators across
+// a range of epochs, for example to track rewards over time.
+package balancehistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// Balance is the balance of a validator at the start of an epoch.
+type Balance struct {
+	Epoch   phase0.Epoch
+	Balance phase0.Gwei
+}
+
+// History is the balance history of a set of validators.
+type History struct {
+	// Balances are the balances of each validator in epoch order.  Epochs
+	// for which the validator did not exist or the state was unavailable are
+	// omitted.
+	Balances map[phase0.ValidatorIndex][]*Balance
+	// Unavailable are the epochs for which the beacon node could not supply
+	// a state, for example because it has been pruned.
+	Unavailable []phase0.Epoch
+}
+
+// Service fetches balance histories.
+type Service struct {
+	log                       zerolog.Logger
+	chainTime                 *chaintime.Service
+	validatorBalancesProvider consensusclient.ValidatorBalancesProvider
+	parallelism               int
+	timeout                   time.Duration
+}
+
+// New creates a new balance history service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "balancehistory").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                       log,
+		chainTime:                 parameters.chainTime,
+		validatorBalancesProvider: parameters.validatorBalancesProvider,
+		parallelism:               parameters.parallelism,
+		timeout:                   parameters.timeout,
+	}, nil
+}
+
+// Fetch fetches the balances of the given validators at the start of each
+// epoch from the start epoch up to but not including the end epoch.
+//
+// Beacon nodes that prune historical states cannot supply balances for
+// earlier epochs.  If the state for the start epoch is unavailable then the
+// earliest available epoch in the range is located, and earlier epochs are
+// reported as unavailable without being queried individually.
+func (s *Service) Fetch(ctx context.Context,
+	indices []phase0.ValidatorIndex,
+	startEpoch phase0.Epoch,
+	endEpoch phase0.Epoch,
+) (
+	*History,
+	error,
+) {
+	if len(indices) == 0 {
+		return nil, errors.New("no validator indices specified")
+	}
+	if endEpoch < startEpoch {
+		return nil, errors.New("end epoch before start epoch")
+	}
+
+	var mu sync.Mutex
+	balances := make(map[phase0.Epoch]map[phase0.ValidatorIndex]phase0.Gwei)
+	unavailable := make([]phase0.Epoch, 0)
+
+	firstEpoch := startEpoch
+	if startEpoch < endEpoch {
+		var err error
+		firstEpoch, err = s.firstAvailableEpoch(ctx, indices, startEpoch, endEpoch, balances)
+		if err != nil {
+			return nil, err
+		}
+		for epoch := startEpoch; epoch < firstEpoch; epoch++ {
+			unavailable = append(unavailable, epoch)
+		}
+	}
+
+	epochs := make([]phase0.Epoch, 0)
+	for epoch := firstEpoch; epoch < endEpoch; epoch++ {
+		if _, exists := balances[epoch]; !exists {
+			epochs = append(epochs, epoch)
+		}
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(s.parallelism)
+	for _, epoch := range epochs {
+		if groupCtx.Err() != nil {
+			break
+		}
+		epoch := epoch
+		group.Go(func() error {
+			epochBalances, available, err := s.balances(groupCtx, indices, epoch)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if available {
+				balances[epoch] = epochBalances
+			} else {
+				unavailable = append(unavailable, epoch)
+			}
+
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(unavailable, func(i, j int) bool {
+		return unavailable[i] < unavailable[j]
+	})
+
+	history := &History{
+		Balances:    make(map[phase0.ValidatorIndex][]*Balance, len(indices)),
+		Unavailable: unavailable,
+	}
+	for _, index := range indices {
+		series := make([]*Balance, 0, int(endEpoch-startEpoch))
+		for epoch := startEpoch; epoch < endEpoch; epoch++ {
+			balance, exists := balances[epoch][index]
+			if !exists {
+				continue
+			}
+			series = append(series, &Balance{
+				Epoch:   epoch,
+				Balance: balance,
+			})
+		}
+		history.Balances[index] = series
+	}
+
+	return history, nil
+}
+
+// firstAvailableEpoch returns the first epoch in the range for which the
+// beacon node has a state, or the end epoch if there is none.  Balances
+// obtained while searching are added to the supplied map.
+func (s *Service) firstAvailableEpoch(ctx context.Context,
+	indices []phase0.ValidatorIndex,
+	startEpoch phase0.Epoch,
+	endEpoch phase0.Epoch,
+	balances map[phase0.Epoch]map[phase0.ValidatorIndex]phase0.Gwei,
+) (
+	phase0.Epoch,
+	error,
+) {
+	epochBalances, available, err := s.balances(ctx, indices, startEpoch)
+	if err != nil {
+		return 0, err
+	}
+	if available {
+		balances[startEpoch] = epochBalances
+
+		return startEpoch, nil
+	}
+
+	// States are pruned from the start of the chain, so search for the
+	// boundary between unavailable and available states.
+	s.log.Debug().Uint64("epoch", uint64(startEpoch)).Msg("State unavailable; searching for first available state")
+	low := startEpoch + 1
+	high := endEpoch
+	for low < high {
+		mid := low + (high-low)/2
+		epochBalances, available, err := s.balances(ctx, indices, mid)
+		if err != nil {
+			return 0, err
+		}
+		if available {
+			balances[mid] = epochBalances
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low, nil
+}
+
+// balances obtains the balances of the validators at the start of the given
+// epoch, returning false if the state is unavailable.
+func (s *Service) balances(ctx context.Context,
+	indices []phase0.ValidatorIndex,
+	epoch phase0.Epoch,
+) (
+	map[phase0.ValidatorIndex]phase0.Gwei,
+	bool,
+	error,
+) {
+	response, err := s.validatorBalancesProvider.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+		Common: api.CommonOpts{
+			Timeout: s.timeout,
+		},
+		State:   strconv.FormatUint(uint64(s.chainTime.EpochStartSlot(epoch)), 10),
+		Indices: indices,
+	})
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, false, nil
+		}
+
+		return nil, false, errors.Join(fmt.Errorf("failed to obtain balances for epoch %d", epoch), err)
+	}
+
+	return response.Data, true, nil
+}
@@ -0,0 +1,210 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balancehistory_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/balancehistory"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// balancesClient creates a test client with states available from epoch 5
+// except for epoch 8, and validator 2 activated at epoch 7.
+func balancesClient(t *testing.T, queried *[]phase0.Epoch, mu *sync.Mutex) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now(), nil)
+	require.NoError(t, err)
+	client.ValidatorBalancesFunc = func(_ context.Context, opts *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error) {
+		slot, err := strconv.ParseUint(opts.State, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if slot%4 != 0 {
+			return nil, errors.New("state not at start of epoch")
+		}
+		epoch := phase0.Epoch(slot / 4)
+		mu.Lock()
+		*queried = append(*queried, epoch)
+		mu.Unlock()
+
+		if epoch == 20 {
+			return nil, errors.New("failed")
+		}
+		if epoch < 5 || epoch == 8 {
+			return nil, testfixtures.ErrNotFound
+		}
+
+		balances := make(map[phase0.ValidatorIndex]phase0.Gwei)
+		for _, index := range opts.Indices {
+			if index == 2 && epoch < 7 {
+				continue
+			}
+			balances[index] = phase0.Gwei(uint64(index)*1000 + uint64(epoch))
+		}
+
+		return &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{Data: balances}, nil
+	}
+
+	return client
+}
+
+func newService(t *testing.T, client *mock.Service) *balancehistory.Service {
+	t.Helper()
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	s, err := balancehistory.New(context.Background(),
+		balancehistory.WithLogLevel(zerolog.Disabled),
+		balancehistory.WithChainTime(chainTime),
+		balancehistory.WithValidatorBalancesProvider(client),
+		balancehistory.WithParallelism(2),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestNew(t *testing.T) {
+	client, err := mock.New(context.Background())
+	require.NoError(t, err)
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []balancehistory.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []balancehistory.Parameter{
+				balancehistory.WithLogLevel(zerolog.Disabled),
+				balancehistory.WithValidatorBalancesProvider(client),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "ProviderMissing",
+			params: []balancehistory.Parameter{
+				balancehistory.WithLogLevel(zerolog.Disabled),
+				balancehistory.WithChainTime(chainTime),
+			},
+			err: "problem with parameters\nno validator balances provider specified",
+		},
+		{
+			name: "ParallelismZero",
+			params: []balancehistory.Parameter{
+				balancehistory.WithLogLevel(zerolog.Disabled),
+				balancehistory.WithChainTime(chainTime),
+				balancehistory.WithValidatorBalancesProvider(client),
+				balancehistory.WithParallelism(0),
+			},
+			err: "problem with parameters\nparallelism must be greater than 0",
+		},
+		{
+			name: "TimeoutZero",
+			params: []balancehistory.Parameter{
+				balancehistory.WithLogLevel(zerolog.Disabled),
+				balancehistory.WithChainTime(chainTime),
+				balancehistory.WithValidatorBalancesProvider(client),
+				balancehistory.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "Good",
+			params: []balancehistory.Parameter{
+				balancehistory.WithLogLevel(zerolog.Disabled),
+				balancehistory.WithChainTime(chainTime),
+				balancehistory.WithValidatorBalancesProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := balancehistory.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	queried := make([]phase0.Epoch, 0)
+	s := newService(t, balancesClient(t, &queried, &mu))
+
+	_, err := s.Fetch(ctx, nil, 0, 1)
+	require.EqualError(t, err, "no validator indices specified")
+	_, err = s.Fetch(ctx, []phase0.ValidatorIndex{1}, 2, 1)
+	require.EqualError(t, err, "end epoch before start epoch")
+
+	history, err := s.Fetch(ctx, []phase0.ValidatorIndex{1, 2}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.Epoch{0, 1, 2, 3, 4, 8}, history.Unavailable)
+	require.Equal(t, []*balancehistory.Balance{
+		{Epoch: 5, Balance: 1005},
+		{Epoch: 6, Balance: 1006},
+		{Epoch: 7, Balance: 1007},
+		{Epoch: 9, Balance: 1009},
+	}, history.Balances[1])
+	require.Equal(t, []*balancehistory.Balance{
+		{Epoch: 7, Balance: 2007},
+		{Epoch: 9, Balance: 2009},
+	}, history.Balances[2])
+
+	// Pruned epochs are located without querying each one.
+	unavailableQueries := 0
+	for _, epoch := range queried {
+		if epoch < 5 {
+			unavailableQueries++
+		}
+	}
+	require.Less(t, unavailableQueries, 5)
+
+	// Nothing available.
+	history, err = s.Fetch(ctx, []phase0.ValidatorIndex{1}, 0, 5)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.Epoch{0, 1, 2, 3, 4}, history.Unavailable)
+	require.Empty(t, history.Balances[1])
+
+	// Empty range.
+	history, err = s.Fetch(ctx, []phase0.ValidatorIndex{1}, 5, 5)
+	require.NoError(t, err)
+	require.Empty(t, history.Unavailable)
+	require.Empty(t, history.Balances[1])
+
+	// Failure.
+	_, err = s.Fetch(ctx, []phase0.ValidatorIndex{1}, 18, 22)
+	require.EqualError(t, err, "failed to obtain balances for epoch 20\nfailed")
+}
@@ -0,0 +1,53 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SlotBlock returns the block at the given slot, or nil if the slot has no
+// block.
+func SlotBlock(ctx context.Context,
+	provider SignedBeaconBlockProvider,
+	slot phase0.Slot,
+) (
+	*spec.VersionedSignedBeaconBlock,
+	error,
+) {
+	response, err := provider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
+		Block: strconv.FormatUint(uint64(slot), 10),
+	})
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Join(fmt.Errorf("failed to obtain block for slot %d", slot), err)
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	return response.Data, nil
+}
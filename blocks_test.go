@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlotBlock(t *testing.T) {
+	ctx := context.Background()
+
+	block := &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionPhase0}
+
+	tests := []struct {
+		name  string
+		slot  phase0.Slot
+		block *spec.VersionedSignedBeaconBlock
+		err   string
+	}{
+		{
+			name:  "Good",
+			slot:  1,
+			block: block,
+		},
+		{
+			name: "Missing",
+			slot: 2,
+		},
+		{
+			name: "Error",
+			slot: 3,
+			err:  "failed to obtain block for slot 3\nGET failed with status 500",
+		},
+	}
+
+	provider, err := mock.New(ctx)
+	require.NoError(t, err)
+	provider.SignedBeaconBlockFunc = func(_ context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+		switch opts.Block {
+		case "1":
+			return &api.Response[*spec.VersionedSignedBeaconBlock]{Data: block}, nil
+		case "2":
+			return nil, &api.Error{Method: http.MethodGet, StatusCode: http.StatusNotFound}
+		default:
+			return nil, &api.Error{Method: http.MethodGet, StatusCode: http.StatusInternalServerError}
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := client.SlotBlock(ctx, provider, test.slot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.block, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bls defines the interfaces through which modules in this
+// repository sign, verify and aggregate BLS signatures.  Implementations are
+// supplied by the caller, so that the modules do not depend on any
+// particular BLS library.
+package bls
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Signer is the interface for a BLS signing backend.
+type Signer interface {
+	// Sign signs the supplied root.
+	Sign(root phase0.Root) (phase0.BLSSignature, error)
+}
+
+// SignatureVerifier is the interface for a BLS signature verification backend.
+type SignatureVerifier interface {
+	// VerifySignature returns true if the signature is valid for the root and public key.
+	VerifySignature(pubKey phase0.BLSPubKey, root phase0.Root, signature phase0.BLSSignature) (bool, error)
+}
+
+// SignatureAggregator is the interface for a BLS signature aggregation backend.
+type SignatureAggregator interface {
+	// AggregateSignatures aggregates the supplied signatures into a single signature.
+	AggregateSignatures(signatures []phase0.BLSSignature) (phase0.BLSSignature, error)
+}
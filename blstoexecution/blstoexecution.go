@@ -0,0 +1,216 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blstoexecution provides helpers to generate, validate and submit
+// changes of withdrawal credentials from BLS to execution addresses.
+//
+// These changes are signed with the genesis fork version regardless of the
+// current fork, so that they remain valid across forks.  Changes are
+// signed and verified by the bls.Signer and bls.SignatureVerifier supplied by
+// the caller.
+package blstoexecution
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DomainBLSToExecutionChange is the domain type for BLS to execution changes.
+var DomainBLSToExecutionChange = phase0.DomainType{0x0a, 0x00, 0x00, 0x00}
+
+// ErrInvalidSignature is returned when the signature of a change does not verify.
+var ErrInvalidSignature = errors.New("invalid BLS to execution change signature")
+
+// Domain computes the signature domain for the chain with the given genesis
+// fork version and genesis validators root.
+func Domain(genesisForkVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Domain, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        genesisForkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	forkDataRoot, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, errors.Join(errors.New("failed to calculate fork data root"), err)
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], DomainBLSToExecutionChange[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return domain, nil
+}
+
+// DomainFromProvider computes the signature domain for the chain of a beacon node.
+func DomainFromProvider(ctx context.Context, genesisProvider consensusclient.GenesisProvider) (phase0.Domain, error) {
+	if genesisProvider == nil {
+		return phase0.Domain{}, errors.New("no genesis provider supplied")
+	}
+
+	response, err := genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return phase0.Domain{}, errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+
+	return Domain(response.Data.GenesisForkVersion, response.Data.GenesisValidatorsRoot)
+}
+
+// SigningRoot computes the root signed by the withdrawal key for the change.
+func SigningRoot(change *capella.BLSToExecutionChange, domain phase0.Domain) (phase0.Root, error) {
+	if change == nil {
+		return phase0.Root{}, errors.New("no change supplied")
+	}
+
+	changeRoot, err := change.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate change root"), err)
+	}
+
+	signingData := &phase0.SigningData{
+		ObjectRoot: changeRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate signing root"), err)
+	}
+
+	return root, nil
+}
+
+// New creates a signed change of the withdrawal credentials of a validator
+// to the given execution address.  The signer must hold the withdrawal key
+// with the given public key.
+func New(index phase0.ValidatorIndex,
+	fromBLSPubkey phase0.BLSPubKey,
+	toExecutionAddress bellatrix.ExecutionAddress,
+	domain phase0.Domain,
+	signer bls.Signer,
+) (
+	*capella.SignedBLSToExecutionChange,
+	error,
+) {
+	if signer == nil {
+		return nil, errors.New("no signer supplied")
+	}
+	if toExecutionAddress.IsZero() {
+		return nil, errors.New("no execution address supplied")
+	}
+
+	change := &capella.BLSToExecutionChange{
+		ValidatorIndex:     index,
+		FromBLSPubkey:      fromBLSPubkey,
+		ToExecutionAddress: toExecutionAddress,
+	}
+	signingRoot, err := SigningRoot(change, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signer.Sign(signingRoot)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to sign change"), err)
+	}
+
+	return &capella.SignedBLSToExecutionChange{
+		Message:   change,
+		Signature: signature,
+	}, nil
+}
+
+// Verify verifies the signature of a signed change.
+func Verify(signedChange *capella.SignedBLSToExecutionChange, domain phase0.Domain, verifier bls.SignatureVerifier) error {
+	if signedChange == nil || signedChange.Message == nil {
+		return errors.New("no change supplied")
+	}
+	if verifier == nil {
+		return errors.New("no signature verifier supplied")
+	}
+
+	signingRoot, err := SigningRoot(signedChange.Message, domain)
+	if err != nil {
+		return err
+	}
+
+	valid, err := verifier.VerifySignature(signedChange.Message.FromBLSPubkey, signingRoot, signedChange.Signature)
+	if err != nil {
+		return errors.Join(errors.New("failed to verify change signature"), err)
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Check checks that a change applies to the given validator, that is the
+// validator has BLS withdrawal credentials derived from the public key in
+// the change.
+func Check(change *capella.BLSToExecutionChange, validator *phase0.Validator) error {
+	if change == nil {
+		return errors.New("no change supplied")
+	}
+	if validator == nil {
+		return errors.New("no validator supplied")
+	}
+	if len(validator.WithdrawalCredentials) != 32 {
+		return fmt.Errorf("withdrawal credentials must be 32 bytes, got %d", len(validator.WithdrawalCredentials))
+	}
+	if validator.WithdrawalCredentials[0] != 0x00 {
+		return fmt.Errorf("validator %d does not have BLS withdrawal credentials", change.ValidatorIndex)
+	}
+
+	hash := sha256.Sum256(change.FromBLSPubkey[:])
+	if !bytes.Equal(validator.WithdrawalCredentials[1:], hash[1:]) {
+		return fmt.Errorf("public key does not match withdrawal credentials of validator %d", change.ValidatorIndex)
+	}
+
+	return nil
+}
+
+// Submit submits signed changes to a beacon node in batches of at most the
+// given size, or all together if the batch size is 0.  Submission stops at
+// the first batch that fails.
+func Submit(ctx context.Context,
+	submitter consensusclient.BLSToExecutionChangesSubmitter,
+	signedChanges []*capella.SignedBLSToExecutionChange,
+	batchSize int,
+) error {
+	if submitter == nil {
+		return errors.New("no submitter supplied")
+	}
+	if batchSize < 0 {
+		return errors.New("batch size cannot be negative")
+	}
+	if batchSize == 0 {
+		batchSize = len(signedChanges)
+	}
+
+	for start := 0; start < len(signedChanges); start += batchSize {
+		end := min(start+batchSize, len(signedChanges))
+		if err := submitter.SubmitBLSToExecutionChanges(ctx, signedChanges[start:end]); err != nil {
+			return errors.Join(fmt.Errorf("failed to submit changes %d to %d", start, end-1), err)
+		}
+	}
+
+	return nil
+}
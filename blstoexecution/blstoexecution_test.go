@@ -0,0 +1,184 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blstoexecution_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/blstoexecution"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// rootSigner is a test signer that uses the signing root as the signature.
+type rootSigner struct{}
+
+func (rootSigner) Sign(root phase0.Root) (phase0.BLSSignature, error) {
+	var signature phase0.BLSSignature
+	copy(signature[:], root[:])
+
+	return signature, nil
+}
+
+func (rootSigner) VerifySignature(_ phase0.BLSPubKey, root phase0.Root, signature phase0.BLSSignature) (bool, error) {
+	return bytes.Equal(signature[:32], root[:]), nil
+}
+
+type erroringSigner struct{}
+
+func (erroringSigner) Sign(_ phase0.Root) (phase0.BLSSignature, error) {
+	return phase0.BLSSignature{}, errors.New("sign failed")
+}
+
+// batchSubmitter records the batches submitted to it.
+type batchSubmitter struct {
+	batches [][]*capella.SignedBLSToExecutionChange
+	failAt  int
+}
+
+func (s *batchSubmitter) SubmitBLSToExecutionChanges(_ context.Context, changes []*capella.SignedBLSToExecutionChange) error {
+	if len(s.batches) == s.failAt {
+		return errors.New("submit failed")
+	}
+	s.batches = append(s.batches, changes)
+
+	return nil
+}
+
+func TestDomain(t *testing.T) {
+	domain, err := blstoexecution.Domain(phase0.Version{0x00, 0x00, 0x00, 0x00}, phase0.Root{0x01})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a, 0x00, 0x00, 0x00}, domain[:4])
+
+	// The genesis validators root is part of the domain.
+	otherDomain, err := blstoexecution.Domain(phase0.Version{0x00, 0x00, 0x00, 0x00}, phase0.Root{0x02})
+	require.NoError(t, err)
+	require.NotEqual(t, domain, otherDomain)
+
+	client, err := mock.New(context.Background())
+	require.NoError(t, err)
+	client.GenesisFunc = func(_ context.Context, _ *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+		return &api.Response[*apiv1.Genesis]{
+			Data: &apiv1.Genesis{
+				GenesisValidatorsRoot: phase0.Root{0x01},
+			},
+			Metadata: make(map[string]any),
+		}, nil
+	}
+	providerDomain, err := blstoexecution.DomainFromProvider(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, domain, providerDomain)
+
+	_, err = blstoexecution.DomainFromProvider(context.Background(), nil)
+	require.EqualError(t, err, "no genesis provider supplied")
+}
+
+func TestNewAndVerify(t *testing.T) {
+	domain, err := blstoexecution.Domain(phase0.Version{0x00, 0x00, 0x10, 0x20}, phase0.Root{0x01})
+	require.NoError(t, err)
+	pubkey := phase0.BLSPubKey{0x01}
+	address := bellatrix.ExecutionAddress{0x02}
+
+	_, err = blstoexecution.New(1, pubkey, address, domain, nil)
+	require.EqualError(t, err, "no signer supplied")
+	_, err = blstoexecution.New(1, pubkey, bellatrix.ExecutionAddress{}, domain, rootSigner{})
+	require.EqualError(t, err, "no execution address supplied")
+	_, err = blstoexecution.New(1, pubkey, address, domain, erroringSigner{})
+	require.EqualError(t, err, "failed to sign change\nsign failed")
+
+	signedChange, err := blstoexecution.New(1, pubkey, address, domain, rootSigner{})
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1), signedChange.Message.ValidatorIndex)
+	require.Equal(t, pubkey, signedChange.Message.FromBLSPubkey)
+	require.Equal(t, address, signedChange.Message.ToExecutionAddress)
+
+	signingRoot, err := blstoexecution.SigningRoot(signedChange.Message, domain)
+	require.NoError(t, err)
+	require.Equal(t, signingRoot[:], signedChange.Signature[:32])
+
+	require.NoError(t, blstoexecution.Verify(signedChange, domain, rootSigner{}))
+
+	// Signatures are not valid with another domain.
+	otherDomain, err := blstoexecution.Domain(phase0.Version{0x00, 0x00, 0x10, 0x20}, phase0.Root{0x02})
+	require.NoError(t, err)
+	require.ErrorIs(t, blstoexecution.Verify(signedChange, otherDomain, rootSigner{}), blstoexecution.ErrInvalidSignature)
+
+	require.EqualError(t, blstoexecution.Verify(nil, domain, rootSigner{}), "no change supplied")
+	require.EqualError(t, blstoexecution.Verify(signedChange, domain, nil), "no signature verifier supplied")
+}
+
+func TestCheck(t *testing.T) {
+	pubkey := phase0.BLSPubKey{0x01}
+	hash := sha256.Sum256(pubkey[:])
+	credentials := append([]byte{0x00}, hash[1:]...)
+	change := &capella.BLSToExecutionChange{
+		ValidatorIndex:     5,
+		FromBLSPubkey:      pubkey,
+		ToExecutionAddress: bellatrix.ExecutionAddress{0x02},
+	}
+
+	require.NoError(t, blstoexecution.Check(change, &phase0.Validator{WithdrawalCredentials: credentials}))
+
+	require.EqualError(t, blstoexecution.Check(nil, &phase0.Validator{}), "no change supplied")
+	require.EqualError(t, blstoexecution.Check(change, nil), "no validator supplied")
+	require.EqualError(t, blstoexecution.Check(change, &phase0.Validator{WithdrawalCredentials: credentials[:31]}),
+		"withdrawal credentials must be 32 bytes, got 31")
+
+	executionCredentials := bytes.Clone(credentials)
+	executionCredentials[0] = 0x01
+	require.EqualError(t, blstoexecution.Check(change, &phase0.Validator{WithdrawalCredentials: executionCredentials}),
+		"validator 5 does not have BLS withdrawal credentials")
+
+	otherCredentials := bytes.Clone(credentials)
+	otherCredentials[31]++
+	require.EqualError(t, blstoexecution.Check(change, &phase0.Validator{WithdrawalCredentials: otherCredentials}),
+		"public key does not match withdrawal credentials of validator 5")
+}
+
+func TestSubmit(t *testing.T) {
+	ctx := context.Background()
+	changes := make([]*capella.SignedBLSToExecutionChange, 5)
+	for i := range changes {
+		changes[i] = &capella.SignedBLSToExecutionChange{
+			Message: &capella.BLSToExecutionChange{ValidatorIndex: phase0.ValidatorIndex(i)},
+		}
+	}
+
+	require.EqualError(t, blstoexecution.Submit(ctx, nil, changes, 2), "no submitter supplied")
+	require.EqualError(t, blstoexecution.Submit(ctx, &batchSubmitter{failAt: -1}, changes, -1), "batch size cannot be negative")
+
+	submitter := &batchSubmitter{failAt: -1}
+	require.NoError(t, blstoexecution.Submit(ctx, submitter, changes, 2))
+	require.Len(t, submitter.batches, 3)
+	require.Len(t, submitter.batches[2], 1)
+	require.Equal(t, phase0.ValidatorIndex(4), submitter.batches[2][0].Message.ValidatorIndex)
+
+	submitter = &batchSubmitter{failAt: -1}
+	require.NoError(t, blstoexecution.Submit(ctx, submitter, changes, 0))
+	require.Len(t, submitter.batches, 1)
+	require.Len(t, submitter.batches[0], 5)
+
+	submitter = &batchSubmitter{failAt: 1}
+	require.EqualError(t, blstoexecution.Submit(ctx, submitter, changes, 2), "failed to submit changes 2 to 3\nsubmit failed")
+	require.Len(t, submitter.batches, 1)
+}
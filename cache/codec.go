@@ -0,0 +1,165 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Entries are stored as a single byte holding the data version followed by
+// the SSZ encoding of the data.
+
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+type sszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+func encode(version spec.DataVersion, data sszMarshaler) ([]byte, error) {
+	ssz, err := data.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
+	}
+
+	res := make([]byte, 1+len(ssz))
+	res[0] = byte(version)
+	copy(res[1:], ssz)
+
+	return res, nil
+}
+
+func encodeSignedBeaconBlock(block *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		return encode(block.Version, block.Phase0)
+	case spec.DataVersionAltair:
+		return encode(block.Version, block.Altair)
+	case spec.DataVersionBellatrix:
+		return encode(block.Version, block.Bellatrix)
+	case spec.DataVersionCapella:
+		return encode(block.Version, block.Capella)
+	case spec.DataVersionDeneb:
+		return encode(block.Version, block.Deneb)
+	case spec.DataVersionElectra:
+		return encode(block.Version, block.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", block.Version)
+	}
+}
+
+func decodeSignedBeaconBlock(data []byte) (*spec.VersionedSignedBeaconBlock, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no data")
+	}
+
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersion(data[0]),
+	}
+	var target sszUnmarshaler
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		block.Phase0 = &phase0.SignedBeaconBlock{}
+		target = block.Phase0
+	case spec.DataVersionAltair:
+		block.Altair = &altair.SignedBeaconBlock{}
+		target = block.Altair
+	case spec.DataVersionBellatrix:
+		block.Bellatrix = &bellatrix.SignedBeaconBlock{}
+		target = block.Bellatrix
+	case spec.DataVersionCapella:
+		block.Capella = &capella.SignedBeaconBlock{}
+		target = block.Capella
+	case spec.DataVersionDeneb:
+		block.Deneb = &deneb.SignedBeaconBlock{}
+		target = block.Deneb
+	case spec.DataVersionElectra:
+		block.Electra = &electra.SignedBeaconBlock{}
+		target = block.Electra
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", block.Version)
+	}
+	if err := target.UnmarshalSSZ(data[1:]); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to decode %s signed beacon block", block.Version), err)
+	}
+
+	return block, nil
+}
+
+func encodeBeaconState(state *spec.VersionedBeaconState) ([]byte, error) {
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		return encode(state.Version, state.Phase0)
+	case spec.DataVersionAltair:
+		return encode(state.Version, state.Altair)
+	case spec.DataVersionBellatrix:
+		return encode(state.Version, state.Bellatrix)
+	case spec.DataVersionCapella:
+		return encode(state.Version, state.Capella)
+	case spec.DataVersionDeneb:
+		return encode(state.Version, state.Deneb)
+	case spec.DataVersionElectra:
+		return encode(state.Version, state.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled state version %s", state.Version)
+	}
+}
+
+func decodeBeaconState(data []byte) (*spec.VersionedBeaconState, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no data")
+	}
+
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersion(data[0]),
+	}
+	var target sszUnmarshaler
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		state.Phase0 = &phase0.BeaconState{}
+		target = state.Phase0
+	case spec.DataVersionAltair:
+		state.Altair = &altair.BeaconState{}
+		target = state.Altair
+	case spec.DataVersionBellatrix:
+		state.Bellatrix = &bellatrix.BeaconState{}
+		target = state.Bellatrix
+	case spec.DataVersionCapella:
+		state.Capella = &capella.BeaconState{}
+		target = state.Capella
+	case spec.DataVersionDeneb:
+		state.Deneb = &deneb.BeaconState{}
+		target = state.Deneb
+	case spec.DataVersionElectra:
+		state.Electra = &electra.BeaconState{}
+		target = state.Electra
+	default:
+		return nil, fmt.Errorf("unhandled state version %s", state.Version)
+	}
+	if err := target.UnmarshalSSZ(data[1:]); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to decode %s beacon state", state.Version), err)
+	}
+
+	return state, nil
+}
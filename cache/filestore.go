@@ -0,0 +1,288 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+)
+
+// validKey matches keys that can be used directly as file names.
+var validKey = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// FileStore is a store that holds each entry as a file in a directory.
+// Entries are evicted when they have not been used within the store's time
+// to live, or when the total size of the store exceeds its maximum, in which
+// case the least recently used entries are evicted first.  The modification
+// time of each file records when the entry was last used.
+type FileStore struct {
+	dir     string
+	maxSize int64
+	ttl     time.Duration
+	clock   clock.Clock
+	mu      sync.Mutex
+}
+
+// FileStoreParameter is the interface for file store parameters.
+type FileStoreParameter interface {
+	applyFileStore(s *FileStore)
+}
+
+type fileStoreParameterFunc func(*FileStore)
+
+func (f fileStoreParameterFunc) applyFileStore(s *FileStore) {
+	f(s)
+}
+
+// WithClock sets the clock used by a file store to record the use of entries
+// and to expire them.  Defaults to the system clock.
+func WithClock(clock clock.Clock) FileStoreParameter {
+	return fileStoreParameterFunc(func(s *FileStore) {
+		s.clock = clock
+	})
+}
+
+// NewFileStore creates a new file store in the given directory, creating the
+// directory if required.  A maximum size of 0 places no limit on the size of
+// the store, and a time to live of 0 keeps entries indefinitely.
+func NewFileStore(dir string, maxSize int64, ttl time.Duration, params ...FileStoreParameter) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("no directory specified")
+	}
+	if maxSize < 0 {
+		return nil, errors.New("maximum size cannot be negative")
+	}
+	if ttl < 0 {
+		return nil, errors.New("time to live cannot be negative")
+	}
+
+	s := &FileStore{
+		dir:     dir,
+		maxSize: maxSize,
+		ttl:     ttl,
+		clock:   clock.System(),
+	}
+	for _, p := range params {
+		if p != nil {
+			p.applyFileStore(s)
+		}
+	}
+	if s.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Join(errors.New("failed to create directory"), err)
+	}
+
+	return s, nil
+}
+
+// Get returns the data for the given key, or ErrNotFound if there is none.
+func (s *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain entry information"), err)
+	}
+	now := s.clock.Now()
+	if s.expired(info, now) {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, errors.Join(errors.New("failed to remove expired entry"), err)
+		}
+
+		return nil, ErrNotFound
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read entry"), err)
+	}
+
+	// Record the use of the entry.
+	if err := os.Chtimes(path, now, now); err != nil {
+		return nil, errors.Join(errors.New("failed to update entry access time"), err)
+	}
+
+	return data, nil
+}
+
+// Put stores the data for the given key, replacing any existing data.
+func (s *FileStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Write to a temporary file and rename, so that readers never see a partial entry.
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return errors.Join(errors.New("failed to create temporary file"), err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return errors.Join(errors.New("failed to write entry"), err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Join(errors.New("failed to close entry"), err)
+	}
+	now := s.clock.Now()
+	if err := os.Chtimes(tmp.Name(), now, now); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Join(errors.New("failed to set entry access time"), err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Join(errors.New("failed to store entry"), err)
+	}
+
+	return s.evict()
+}
+
+// Delete removes the data for the given key.
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Join(errors.New("failed to remove entry"), err)
+	}
+
+	return nil
+}
+
+// Evict removes expired entries, and the least recently used entries if the
+// store exceeds its maximum size.  This is carried out automatically when
+// data is stored, but can be called to tidy the store on startup.
+func (s *FileStore) Evict() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.evict()
+}
+
+type fileEntry struct {
+	path string
+	size int64
+	used time.Time
+}
+
+// evict carries out eviction; the lock must be held by the caller.
+func (s *FileStore) evict() error {
+	if s.maxSize == 0 && s.ttl == 0 {
+		return nil
+	}
+
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return errors.Join(errors.New("failed to read directory"), err)
+	}
+
+	now := s.clock.Now()
+	entries := make([]*fileEntry, 0, len(dirEntries))
+	totalSize := int64(0)
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !validKey.MatchString(dirEntry.Name()) || dirEntry.Name()[0] == '.' {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain entry information"), err)
+		}
+		path := filepath.Join(s.dir, dirEntry.Name())
+		if s.expired(info, now) {
+			if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return errors.Join(errors.New("failed to remove expired entry"), err)
+			}
+
+			continue
+		}
+		entries = append(entries, &fileEntry{
+			path: path,
+			size: info.Size(),
+			used: info.ModTime(),
+		})
+		totalSize += info.Size()
+	}
+
+	if s.maxSize == 0 || totalSize <= s.maxSize {
+		return nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].used.Before(entries[j].used)
+	})
+	for _, entry := range entries {
+		if totalSize <= s.maxSize {
+			break
+		}
+		if err := os.Remove(entry.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return errors.Join(errors.New("failed to remove entry"), err)
+		}
+		totalSize -= entry.size
+	}
+
+	return nil
+}
+
+// expired returns true if the entry has not been used within the store's time to live.
+func (s *FileStore) expired(info fs.FileInfo, now time.Time) bool {
+	return s.ttl > 0 && now.Sub(info.ModTime()) > s.ttl
+}
+
+// path returns the path of the file for the given key.
+func (s *FileStore) path(key string) (string, error) {
+	if !validKey.MatchString(key) || key[0] == '.' {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.dir, key), nil
+}
@@ -0,0 +1,145 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileStore(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := cache.NewFileStore("", 0, 0)
+	require.EqualError(t, err, "no directory specified")
+	_, err = cache.NewFileStore(dir, -1, 0)
+	require.EqualError(t, err, "maximum size cannot be negative")
+	_, err = cache.NewFileStore(dir, 0, -time.Second)
+	require.EqualError(t, err, "time to live cannot be negative")
+
+	_, err = cache.NewFileStore(filepath.Join(dir, "sub", "dir"), 0, 0)
+	require.NoError(t, err)
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := cache.NewFileStore(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, "missing")
+	require.True(t, errors.Is(err, cache.ErrNotFound))
+
+	require.NoError(t, store.Put(ctx, "key", []byte("value")))
+	data, err := store.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), data)
+
+	require.NoError(t, store.Put(ctx, "key", []byte("updated")))
+	data, err = store.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("updated"), data)
+
+	require.NoError(t, store.Delete(ctx, "key"))
+	_, err = store.Get(ctx, "key")
+	require.True(t, errors.Is(err, cache.ErrNotFound))
+	require.NoError(t, store.Delete(ctx, "key"))
+
+	require.EqualError(t, store.Put(ctx, "../escape", []byte("value")), `invalid key "../escape"`)
+	require.EqualError(t, store.Put(ctx, ".hidden", []byte("value")), `invalid key ".hidden"`)
+	_, err = store.Get(ctx, "")
+	require.EqualError(t, err, `invalid key ""`)
+}
+
+func TestFileStoreTTL(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := cache.NewFileStore(dir, 0, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "old", []byte("value")))
+	require.NoError(t, store.Put(ctx, "new", []byte("value")))
+	past := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "old"), past, past))
+
+	_, err = store.Get(ctx, "old")
+	require.True(t, errors.Is(err, cache.ErrNotFound))
+	_, err = os.Stat(filepath.Join(dir, "old"))
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "new"), past, past))
+	require.NoError(t, store.Evict())
+	_, err = os.Stat(filepath.Join(dir, "new"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFileStoreClock(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := cache.NewFileStore(t.TempDir(), 0, time.Hour, cache.WithClock(nil))
+	require.EqualError(t, err, "no clock specified")
+
+	virtualClock := clock.NewVirtual(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	store, err := cache.NewFileStore(t.TempDir(), 0, time.Hour, cache.WithClock(virtualClock))
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "key", []byte("value")))
+	virtualClock.Advance(59 * time.Minute)
+	_, err = store.Get(ctx, "key")
+	require.NoError(t, err)
+
+	// The entry was used by the get, so expires an hour after that.
+	virtualClock.Advance(59 * time.Minute)
+	_, err = store.Get(ctx, "key")
+	require.NoError(t, err)
+	virtualClock.Advance(61 * time.Minute)
+	_, err = store.Get(ctx, "key")
+	require.True(t, errors.Is(err, cache.ErrNotFound))
+}
+
+func TestFileStoreMaxSize(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := cache.NewFileStore(dir, 25, 0)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i, key := range []string{"a", "b"} {
+		require.NoError(t, store.Put(ctx, key, make([]byte, 10)))
+		used := now.Add(time.Duration(i-10) * time.Minute)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, key), used, used))
+	}
+
+	// Use a so that b is the least recently used.
+	_, err = store.Get(ctx, "a")
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "c", make([]byte, 10)))
+	_, err = store.Get(ctx, "b")
+	require.True(t, errors.Is(err, cache.ErrNotFound))
+	_, err = store.Get(ctx, "a")
+	require.NoError(t, err)
+	_, err = store.Get(ctx, "c")
+	require.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                  zerolog.Level
+	store                     Store
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	beaconStateProvider       consensusclient.BeaconStateProvider
+	finalityProvider          consensusclient.FinalityProvider
+	specProvider              consensusclient.SpecProvider
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithStore sets the store in which cached data is persisted.
+func WithStore(store Store) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.store = store
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the provider from which blocks are fetched on a cache miss.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithBeaconStateProvider sets the provider from which states are fetched on a cache miss.
+func WithBeaconStateProvider(provider consensusclient.BeaconStateProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconStateProvider = provider
+	})
+}
+
+// WithFinalityProvider sets the provider used to find the finalized slot.
+// If supplied, along with a spec provider, blocks and states requested by
+// slot are also cached once their slot is finalized.
+func WithFinalityProvider(provider consensusclient.FinalityProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalityProvider = provider
+	})
+}
+
+// WithSpecProvider sets the provider used to obtain the number of slots in an epoch.
+func WithSpecProvider(provider consensusclient.SpecProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specProvider = provider
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.store == nil {
+		return nil, errors.New("no store specified")
+	}
+	if parameters.signedBeaconBlockProvider == nil && parameters.beaconStateProvider == nil {
+		return nil, errors.New("no signed beacon block or beacon state provider specified")
+	}
+	if (parameters.finalityProvider == nil) != (parameters.specProvider == nil) {
+		return nil, errors.New("finality and spec providers must be specified together")
+	}
+
+	return &parameters, nil
+}
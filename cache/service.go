@@ -0,0 +1,372 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a read-through cache of signed beacon blocks and
+// beacon states backed by a persistent store, so that restarted processes do
+// not need to refetch historical data from the beacon node.
+//
+// Data is cached by root, and checked against its root when read back from
+// the store.  Requests by root are served from the cache where possible;
+// requests by slot are served from the cache once the slot is finalized, if
+// finality and spec providers are supplied.  Requests for other identifiers
+// are always passed to the beacon node, but their results are cached for
+// later requests by root.
+package cache
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a persistent cache of blocks and states.
+type Service struct {
+	log                       zerolog.Logger
+	store                     Store
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	beaconStateProvider       consensusclient.BeaconStateProvider
+	finalityProvider          consensusclient.FinalityProvider
+	slotsPerEpoch             uint64
+
+	finalizedSlotMu sync.Mutex
+	finalizedSlot   phase0.Slot
+}
+
+// New creates a new cache service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:                       log,
+		store:                     parameters.store,
+		signedBeaconBlockProvider: parameters.signedBeaconBlockProvider,
+		beaconStateProvider:       parameters.beaconStateProvider,
+		finalityProvider:          parameters.finalityProvider,
+	}
+
+	if parameters.specProvider != nil {
+		specResponse, err := parameters.specProvider.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain spec"), err)
+		}
+		slotsPerEpoch, isUint64 := specResponse.Data["SLOTS_PER_EPOCH"].(uint64)
+		if !isUint64 || slotsPerEpoch == 0 {
+			return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+		}
+		s.slotsPerEpoch = slotsPerEpoch
+	}
+
+	return s, nil
+}
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID, using the
+// cache where possible.
+func (s *Service) SignedBeaconBlock(ctx context.Context,
+	opts *api.SignedBeaconBlockOpts,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	if opts == nil {
+		return nil, consensusclient.ErrNoOptions
+	}
+	if s.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider configured")
+	}
+
+	slot, slotCacheable := s.cacheableSlot(ctx, opts.Block)
+	root, rootKnown := s.cachedRoot(ctx, "block", opts.Block, slot, slotCacheable)
+	if rootKnown {
+		if block := s.cachedSignedBeaconBlock(ctx, root); block != nil {
+			return &api.Response[*spec.VersionedSignedBeaconBlock]{
+				Data:     block,
+				Metadata: make(map[string]any),
+			}, nil
+		}
+	}
+
+	response, err := s.signedBeaconBlockProvider.SignedBeaconBlock(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data == nil {
+		return response, nil
+	}
+
+	blockRoot, err := response.Data.Root()
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain block root; not caching")
+
+		return response, nil
+	}
+	data, err := encodeSignedBeaconBlock(response.Data)
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to encode block; not caching")
+
+		return response, nil
+	}
+	s.put(ctx, "block", blockRoot, data, slot, slotCacheable)
+
+	return response, nil
+}
+
+// BeaconState fetches a beacon state given a state ID, using the cache where
+// possible.
+func (s *Service) BeaconState(ctx context.Context,
+	opts *api.BeaconStateOpts,
+) (
+	*api.Response[*spec.VersionedBeaconState],
+	error,
+) {
+	if opts == nil {
+		return nil, consensusclient.ErrNoOptions
+	}
+	if s.beaconStateProvider == nil {
+		return nil, errors.New("no beacon state provider configured")
+	}
+
+	slot, slotCacheable := s.cacheableSlot(ctx, opts.State)
+	root, rootKnown := s.cachedRoot(ctx, "state", opts.State, slot, slotCacheable)
+	if rootKnown {
+		if state := s.cachedBeaconState(ctx, root); state != nil {
+			return &api.Response[*spec.VersionedBeaconState]{
+				Data:     state,
+				Metadata: make(map[string]any),
+			}, nil
+		}
+	}
+
+	response, err := s.beaconStateProvider.BeaconState(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data == nil || response.Data.IsEmpty() {
+		return response, nil
+	}
+
+	stateRoot, err := response.Data.Root()
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain state root; not caching")
+
+		return response, nil
+	}
+	data, err := encodeBeaconState(response.Data)
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to encode state; not caching")
+
+		return response, nil
+	}
+	s.put(ctx, "state", stateRoot, data, slot, slotCacheable)
+
+	return response, nil
+}
+
+// cachedSignedBeaconBlock returns the block with the given root from the
+// store, or nil if it is not present or fails its integrity check.
+func (s *Service) cachedSignedBeaconBlock(ctx context.Context, root phase0.Root) *spec.VersionedSignedBeaconBlock {
+	key := dataKey("block", root)
+	data := s.get(ctx, key)
+	if data == nil {
+		return nil
+	}
+
+	block, err := decodeSignedBeaconBlock(data)
+	if err == nil {
+		var blockRoot phase0.Root
+		blockRoot, err = block.Root()
+		if err == nil && blockRoot != root {
+			err = fmt.Errorf("root %#x does not match", blockRoot)
+		}
+	}
+	if err != nil {
+		s.log.Warn().Str("key", key).Err(err).Msg("Cached block failed integrity check; removing")
+		s.delete(ctx, key)
+
+		return nil
+	}
+
+	return block
+}
+
+// cachedBeaconState returns the state with the given root from the store,
+// or nil if it is not present or fails its integrity check.
+func (s *Service) cachedBeaconState(ctx context.Context, root phase0.Root) *spec.VersionedBeaconState {
+	key := dataKey("state", root)
+	data := s.get(ctx, key)
+	if data == nil {
+		return nil
+	}
+
+	state, err := decodeBeaconState(data)
+	if err == nil {
+		var stateRoot phase0.Root
+		stateRoot, err = state.Root()
+		if err == nil && stateRoot != root {
+			err = fmt.Errorf("root %#x does not match", stateRoot)
+		}
+	}
+	if err != nil {
+		s.log.Warn().Str("key", key).Err(err).Msg("Cached state failed integrity check; removing")
+		s.delete(ctx, key)
+
+		return nil
+	}
+
+	return state
+}
+
+// cachedRoot returns the root for the given identifier if it is a root, or
+// if it is a finalized slot for which the root has been cached.
+func (s *Service) cachedRoot(ctx context.Context,
+	kind string,
+	id string,
+	slot phase0.Slot,
+	slotCacheable bool,
+) (
+	phase0.Root,
+	bool,
+) {
+	if root, isRoot := parseRoot(id); isRoot {
+		return root, true
+	}
+	if !slotCacheable {
+		return phase0.Root{}, false
+	}
+
+	data := s.get(ctx, slotKey(kind, slot))
+	if len(data) != phase0.RootLength {
+		return phase0.Root{}, false
+	}
+
+	return phase0.Root(data), true
+}
+
+// cacheableSlot returns the slot for the given identifier if it is a slot
+// that is finalized.
+func (s *Service) cacheableSlot(ctx context.Context, id string) (phase0.Slot, bool) {
+	if s.finalityProvider == nil {
+		return 0, false
+	}
+	val, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	slot := phase0.Slot(val)
+
+	s.finalizedSlotMu.Lock()
+	defer s.finalizedSlotMu.Unlock()
+
+	if slot <= s.finalizedSlot {
+		return slot, true
+	}
+
+	// The slot is beyond the finalized slot we know about, so refresh it.
+	response, err := s.finalityProvider.Finality(ctx, &api.FinalityOpts{
+		State: "head",
+	})
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain finality")
+
+		return 0, false
+	}
+	if response.Data == nil || response.Data.Finalized == nil {
+		return 0, false
+	}
+	s.finalizedSlot = phase0.Slot(uint64(response.Data.Finalized.Epoch) * s.slotsPerEpoch)
+
+	return slot, slot <= s.finalizedSlot
+}
+
+// put stores data by root, along with the slot index if applicable.
+func (s *Service) put(ctx context.Context,
+	kind string,
+	root phase0.Root,
+	data []byte,
+	slot phase0.Slot,
+	slotCacheable bool,
+) {
+	key := dataKey(kind, root)
+	if err := s.store.Put(ctx, key, data); err != nil {
+		s.log.Warn().Str("key", key).Err(err).Msg("Failed to store data")
+
+		return
+	}
+	if slotCacheable {
+		key = slotKey(kind, slot)
+		if err := s.store.Put(ctx, key, root[:]); err != nil {
+			s.log.Warn().Str("key", key).Err(err).Msg("Failed to store slot index")
+		}
+	}
+}
+
+// get fetches data from the store, returning nil if it is not present.
+func (s *Service) get(ctx context.Context, key string) []byte {
+	data, err := s.store.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			s.log.Warn().Str("key", key).Err(err).Msg("Failed to obtain data from store")
+		}
+
+		return nil
+	}
+
+	return data
+}
+
+// delete removes data from the store.
+func (s *Service) delete(ctx context.Context, key string) {
+	if err := s.store.Delete(ctx, key); err != nil {
+		s.log.Warn().Str("key", key).Err(err).Msg("Failed to remove data from store")
+	}
+}
+
+func dataKey(kind string, root phase0.Root) string {
+	return fmt.Sprintf("%s-%#x", kind, root)
+}
+
+func slotKey(kind string, slot phase0.Slot) string {
+	return fmt.Sprintf("%s-slot-%d", kind, slot)
+}
+
+// parseRoot parses the identifier as a root.
+func parseRoot(id string) (phase0.Root, bool) {
+	if !strings.HasPrefix(id, "0x") || len(id) != 2+2*phase0.RootLength {
+		return phase0.Root{}, false
+	}
+	data, err := hex.DecodeString(id[2:])
+	if err != nil {
+		return phase0.Root{}, false
+	}
+
+	return phase0.Root(data), true
+}
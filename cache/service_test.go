@@ -0,0 +1,292 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type rooter interface {
+	Root() (phase0.Root, error)
+}
+
+func requireSameRoot(t *testing.T, expected rooter, actual rooter) {
+	t.Helper()
+
+	expectedRoot, err := expected.Root()
+	require.NoError(t, err)
+	actualRoot, err := actual.Root()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, actualRoot)
+}
+
+// newTestClient returns a mock client serving blocks and states by slot or
+// root, counting the requests made.
+func newTestClient(t *testing.T, blockRequests *int, stateRequests *int) *mock.Service {
+	t.Helper()
+
+	client, err := mock.New(context.Background())
+	require.NoError(t, err)
+
+	blocks := make([]*spec.VersionedSignedBeaconBlock, 0, 64)
+	for slot := phase0.Slot(0); slot < 64; slot++ {
+		block, err := testfixtures.Block(spec.DataVersionPhase0, testfixtures.WithSlot(slot))
+		require.NoError(t, err)
+		blocks = append(blocks, block)
+	}
+
+	client.SignedBeaconBlockFunc = func(_ context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+		*blockRequests++
+		for slot, block := range blocks {
+			root, err := block.Root()
+			require.NoError(t, err)
+			if opts.Block == strconv.Itoa(slot) || opts.Block == fmt.Sprintf("%#x", root) {
+				return &api.Response[*spec.VersionedSignedBeaconBlock]{Data: block, Metadata: make(map[string]any)}, nil
+			}
+		}
+
+		return nil, errors.New("not found")
+	}
+	client.BeaconStateFunc = func(_ context.Context, opts *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error) {
+		*stateRequests++
+		slot, err := strconv.ParseUint(opts.State, 10, 64)
+		if err != nil {
+			return nil, errors.New("not found")
+		}
+
+		state, err := testfixtures.State(spec.DataVersionPhase0, testfixtures.WithSlot(phase0.Slot(slot)))
+		if err != nil {
+			return nil, err
+		}
+
+		return &api.Response[*spec.VersionedBeaconState]{Data: state, Metadata: make(map[string]any)}, nil
+	}
+	client.FinalityFunc = func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		return &api.Response[*apiv1.Finality]{
+			Data: &apiv1.Finality{
+				Finalized: &phase0.Checkpoint{Epoch: 1},
+			},
+		}, nil
+	}
+
+	return client
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	store, err := cache.NewFileStore(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []cache.Parameter
+		err    string
+	}{
+		{
+			name: "StoreMissing",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithSignedBeaconBlockProvider(client),
+			},
+			err: "problem with parameters\nno store specified",
+		},
+		{
+			name: "ProvidersMissing",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithStore(store),
+			},
+			err: "problem with parameters\nno signed beacon block or beacon state provider specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithStore(store),
+				cache.WithSignedBeaconBlockProvider(client),
+				cache.WithFinalityProvider(client),
+			},
+			err: "problem with parameters\nfinality and spec providers must be specified together",
+		},
+		{
+			name: "Good",
+			params: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithStore(store),
+				cache.WithSignedBeaconBlockProvider(client),
+				cache.WithBeaconStateProvider(client),
+				cache.WithFinalityProvider(client),
+				cache.WithSpecProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := cache.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSignedBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+
+	blockRequests, stateRequests := 0, 0
+	client := newTestClient(t, &blockRequests, &stateRequests)
+	dir := t.TempDir()
+	store, err := cache.NewFileStore(dir, 0, 0)
+	require.NoError(t, err)
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithStore(store),
+		cache.WithSignedBeaconBlockProvider(client),
+	)
+	require.NoError(t, err)
+
+	_, err = s.SignedBeaconBlock(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+	_, err = s.BeaconState(ctx, &api.BeaconStateOpts{State: "head"})
+	require.EqualError(t, err, "no beacon state provider configured")
+
+	block5, err := testfixtures.Block(spec.DataVersionPhase0, testfixtures.WithSlot(5))
+	require.NoError(t, err)
+	block6, err := testfixtures.Block(spec.DataVersionPhase0, testfixtures.WithSlot(6))
+	require.NoError(t, err)
+	root, err := block5.Root()
+	require.NoError(t, err)
+	rootID := fmt.Sprintf("%#x", root)
+
+	// Fetch by slot, which is not cached without finality but stores the block by root.
+	response, err := s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "5"})
+	require.NoError(t, err)
+	requireSameRoot(t, block5, response.Data)
+	require.Equal(t, 1, blockRequests)
+	_, err = s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "5"})
+	require.NoError(t, err)
+	require.Equal(t, 2, blockRequests)
+
+	// Fetch by root, served from the cache.
+	response, err = s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: rootID})
+	require.NoError(t, err)
+	requireSameRoot(t, block5, response.Data)
+	require.Equal(t, 2, blockRequests)
+
+	// Corrupt the cached block, which should be detected and refetched.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "block-"+rootID), []byte{0x00, 0x01}, 0o600))
+	response, err = s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: rootID})
+	require.NoError(t, err)
+	requireSameRoot(t, block5, response.Data)
+	require.Equal(t, 3, blockRequests)
+
+	// Replace the cached block with a valid block of a different root.
+	otherData, err := os.ReadFile(filepath.Join(dir, "block-"+rootID))
+	require.NoError(t, err)
+	otherRoot, err := block6.Root()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("block-%#x", otherRoot)), otherData, 0o600))
+	response, err = s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: fmt.Sprintf("%#x", otherRoot)})
+	require.NoError(t, err)
+	requireSameRoot(t, block6, response.Data)
+	require.Equal(t, 4, blockRequests)
+
+	// A new service using the same store starts with the cached data.
+	s, err = cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithStore(store),
+		cache.WithSignedBeaconBlockProvider(client),
+	)
+	require.NoError(t, err)
+	_, err = s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: rootID})
+	require.NoError(t, err)
+	require.Equal(t, 4, blockRequests)
+}
+
+func TestFinalizedSlots(t *testing.T) {
+	ctx := context.Background()
+
+	blockRequests, stateRequests := 0, 0
+	client := newTestClient(t, &blockRequests, &stateRequests)
+	store, err := cache.NewFileStore(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithStore(store),
+		cache.WithSignedBeaconBlockProvider(client),
+		cache.WithBeaconStateProvider(client),
+		cache.WithFinalityProvider(client),
+		cache.WithSpecProvider(client),
+	)
+	require.NoError(t, err)
+
+	block32, err := testfixtures.Block(spec.DataVersionPhase0, testfixtures.WithSlot(32))
+	require.NoError(t, err)
+	state10, err := testfixtures.State(spec.DataVersionPhase0, testfixtures.WithSlot(10))
+	require.NoError(t, err)
+
+	// Slot 32 is finalized, so is cached.
+	for i := 0; i < 2; i++ {
+		response, err := s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "32"})
+		require.NoError(t, err)
+		requireSameRoot(t, block32, response.Data)
+	}
+	require.Equal(t, 1, blockRequests)
+
+	// Slot 33 is not finalized, so is not cached.
+	for i := 0; i < 2; i++ {
+		_, err := s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "33"})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, blockRequests)
+
+	// States follow the same rules.
+	for i := 0; i < 2; i++ {
+		response, err := s.BeaconState(ctx, &api.BeaconStateOpts{State: "10"})
+		require.NoError(t, err)
+		requireSameRoot(t, state10, response.Data)
+	}
+	require.Equal(t, 1, stateRequests)
+	for i := 0; i < 2; i++ {
+		_, err := s.BeaconState(ctx, &api.BeaconStateOpts{State: "40"})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, stateRequests)
+
+	// Errors from the provider are returned.
+	_, err = s.BeaconState(ctx, &api.BeaconStateOpts{State: "head"})
+	require.EqualError(t, err, "not found")
+}
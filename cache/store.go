@@ -0,0 +1,36 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by a store when no data is held for a key.
+var ErrNotFound = errors.New("not found")
+
+// Store is the interface for a persistent key/value store used to hold
+// cached data.  Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the data for the given key, or ErrNotFound if there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores the data for the given key, replacing any existing data.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete removes the data for the given key.  It is not an error if
+	// there is no data for the key.
+	Delete(ctx context.Context, key string) error
+}
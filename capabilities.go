@@ -0,0 +1,95 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// ForkSupporter is the interface for services that can report whether their
+// beacon node supports a fork.
+type ForkSupporter interface {
+	// SupportsFork returns true if the beacon node supports the given fork.
+	SupportsFork(ctx context.Context, version spec.DataVersion) (bool, error)
+}
+
+// ElectraAttestationSubmitter is the interface for submitting attestations
+// in the Electra format.  Attestations submitted from Electra onwards are
+// single attestations, which are only accepted by nodes that support the
+// Electra fork.
+type ElectraAttestationSubmitter interface {
+	AttestationsSubmitter
+	ForkSupporter
+}
+
+// forkProviders are the provider interfaces whose endpoints are only
+// available from a given fork.
+var forkProviders = map[reflect.Type]spec.DataVersion{
+	typeOf[SyncCommitteesProvider]():              spec.DataVersionAltair,
+	typeOf[SyncCommitteeDutiesProvider]():         spec.DataVersionAltair,
+	typeOf[SyncCommitteeMessagesSubmitter]():      spec.DataVersionAltair,
+	typeOf[SyncCommitteeSubscriptionsSubmitter](): spec.DataVersionAltair,
+	typeOf[SyncCommitteeContributionProvider]():   spec.DataVersionAltair,
+	typeOf[SyncCommitteeContributionsSubmitter](): spec.DataVersionAltair,
+	typeOf[SyncCommitteeRewardsProvider]():        spec.DataVersionAltair,
+	typeOf[BlindedBlockProvider]():                spec.DataVersionBellatrix,
+	typeOf[BlindedProposalSubmitter]():            spec.DataVersionBellatrix,
+	typeOf[ValidatorRegistrationsSubmitter]():     spec.DataVersionBellatrix,
+	typeOf[BLSToExecutionChangesSubmitter]():      spec.DataVersionCapella,
+	typeOf[BlobSidecarsProvider]():                spec.DataVersionDeneb,
+	typeOf[ElectraAttestationSubmitter]():         spec.DataVersionElectra,
+	typeOf[PendingDepositProvider]():              spec.DataVersionElectra,
+	typeOf[PendingPartialWithdrawalsProvider]():   spec.DataVersionElectra,
+	typeOf[PendingConsolidationsProvider]():       spec.DataVersionElectra,
+}
+
+// typeOf returns the type of an interface.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Supports returns true if the service implements the provider interface T
+// and, where T is only available from a given fork, the beacon node of the
+// service supports that fork.  This allows callers to degrade gracefully
+// when a node or client does not support newer endpoints, for example:
+//
+//	if supported, err := client.Supports[client.BlobSidecarsProvider](ctx, service); err == nil && supported {
+//		...
+//	}
+//
+// If the service does not implement ForkSupporter then only the interface
+// is checked.
+func Supports[T any](ctx context.Context, service Service) (bool, error) {
+	if service == nil {
+		return false, errors.New("no service supplied")
+	}
+	if _, isProvider := service.(T); !isProvider {
+		return false, nil
+	}
+
+	version, exists := forkProviders[typeOf[T]()]
+	if !exists {
+		return true, nil
+	}
+	forkSupporter, isForkSupporter := service.(ForkSupporter)
+	if !isForkSupporter {
+		return true, nil
+	}
+
+	return forkSupporter.SupportsFork(ctx, version)
+}
@@ -0,0 +1,76 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+// basicService is a service that provides nothing.
+type basicService struct{}
+
+func (basicService) Name() string    { return "basic" }
+func (basicService) Address() string { return "basic" }
+func (basicService) IsActive() bool  { return true }
+func (basicService) IsSynced() bool  { return true }
+
+func TestSupports(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := client.Supports[client.BlobSidecarsProvider](ctx, nil)
+	require.EqualError(t, err, "no service supplied")
+
+	// Interface not implemented.
+	supported, err := client.Supports[client.BlobSidecarsProvider](ctx, basicService{})
+	require.NoError(t, err)
+	require.False(t, supported)
+
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+	service.SupportsForkFunc = func(_ context.Context, version spec.DataVersion) (bool, error) {
+		return version <= spec.DataVersionDeneb, nil
+	}
+
+	// Interface not gated by fork.
+	supported, err = client.Supports[client.GenesisProvider](ctx, service)
+	require.NoError(t, err)
+	require.True(t, supported)
+
+	// Fork supported.
+	supported, err = client.Supports[client.SyncCommitteesProvider](ctx, service)
+	require.NoError(t, err)
+	require.True(t, supported)
+
+	// Fork not supported.
+	supported, err = client.Supports[client.ElectraAttestationSubmitter](ctx, service)
+	require.NoError(t, err)
+	require.False(t, supported)
+	supported, err = client.Supports[client.PendingConsolidationsProvider](ctx, service)
+	require.NoError(t, err)
+	require.False(t, supported)
+
+	// Fork support unavailable.
+	service.SupportsForkFunc = func(_ context.Context, _ spec.DataVersion) (bool, error) {
+		return false, errors.New("failed")
+	}
+	_, err = client.Supports[client.SyncCommitteesProvider](ctx, service)
+	require.EqualError(t, err, "failed")
+}
@@ -0,0 +1,102 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainmetrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	headDelayMetric       prometheus.Gauge
+	finalityLagMetric     prometheus.Gauge
+	missedProposalsMetric prometheus.Gauge
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if headDelayMetric != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	headDelayMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "consensusclient",
+		Subsystem: "chain",
+		Name:      "head_delay_seconds",
+		Help:      "Time from the start of the slot to receipt of the head event for the slot",
+	})
+	if err := prometheus.Register(headDelayMetric); err != nil {
+		return errors.Join(errors.New("failed to register head_delay_seconds"), err)
+	}
+
+	finalityLagMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "consensusclient",
+		Subsystem: "chain",
+		Name:      "finality_lag_epochs",
+		Help:      "Number of epochs between the current epoch and the finalized epoch",
+	})
+	if err := prometheus.Register(finalityLagMetric); err != nil {
+		return errors.Join(errors.New("failed to register finality_lag_epochs"), err)
+	}
+
+	missedProposalsMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "consensusclient",
+		Subsystem: "chain",
+		Name:      "missed_proposals",
+		Help:      "Number of slots without a block in the last completed epoch",
+	})
+	if err := prometheus.Register(missedProposalsMetric); err != nil {
+		return errors.Join(errors.New("failed to register missed_proposals"), err)
+	}
+
+	return nil
+}
+
+func monitorHeadDelay(seconds float64) {
+	if headDelayMetric == nil {
+		return
+	}
+
+	headDelayMetric.Set(seconds)
+}
+
+func monitorFinalityLag(epochs uint64) {
+	if finalityLagMetric == nil {
+		return
+	}
+
+	finalityLagMetric.Set(float64(epochs))
+}
+
+func monitorMissedProposals(missed uint64) {
+	if missedProposalsMetric == nil {
+		return
+	}
+
+	missedProposalsMetric.Set(float64(missed))
+}
@@ -0,0 +1,114 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainmetrics
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel         zerolog.Level
+	monitor          metrics.Service
+	eventsProvider   consensusclient.EventsProvider
+	finalityProvider consensusclient.FinalityProvider
+	chainTime        *chaintime.Service
+	clock            clock.Clock
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the service.  Gauges are exposed if the
+// monitor presents prometheus metrics.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithEventsProvider sets the events provider.
+func WithEventsProvider(provider consensusclient.EventsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsProvider = provider
+	})
+}
+
+// WithFinalityProvider sets the finality provider.  If supplied, the
+// finalized checkpoint is obtained at startup rather than waiting for the
+// first finalized checkpoint event.
+func WithFinalityProvider(provider consensusclient.FinalityProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalityProvider = provider
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithClock sets the clock used to measure head delays.  Defaults to the system clock.
+func WithClock(clock clock.Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		clock:    clock.System(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.eventsProvider == nil {
+		return nil, errors.New("no events provider specified")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,225 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chainmetrics consumes the event stream to provide metrics about the
+// health of the chain: the delay between the start of a slot and the head
+// event for that slot, the number of epochs since finality, and the number of
+// missed proposals in each epoch.
+package chainmetrics
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// missedProposalsHistory is the number of completed epochs for which missed
+// proposals are retained.
+const missedProposalsHistory = 16
+
+// Service provides chain metrics.
+type Service struct {
+	log       zerolog.Logger
+	chainTime *chaintime.Service
+	clock     clock.Clock
+
+	mu        sync.RWMutex
+	headSeen  bool
+	headSlot  phase0.Slot
+	headDelay time.Duration
+
+	finalizedSeen  bool
+	finalizedEpoch phase0.Epoch
+
+	// epoch is the epoch for which missed proposals are being counted.
+	epoch phase0.Epoch
+	// epochComplete is true if the epoch has been observed from its first slot.
+	epochComplete bool
+	epochMissed   uint64
+	// missed is the number of missed proposals in recent completed epochs.
+	missed map[phase0.Epoch]uint64
+}
+
+// New creates a new chain metrics service.  The service runs until the
+// supplied context is canceled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "chainmetrics").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.Join(errors.New("failed to register metrics"), err)
+	}
+
+	s := &Service{
+		log:       log,
+		chainTime: parameters.chainTime,
+		clock:     parameters.clock,
+		missed:    make(map[phase0.Epoch]uint64),
+	}
+
+	if parameters.finalityProvider != nil {
+		response, err := parameters.finalityProvider.Finality(ctx, &api.FinalityOpts{
+			State: "head",
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain initial finality"), err)
+		}
+		if response.Data != nil && response.Data.Finalized != nil {
+			s.handleFinalizedCheckpoint(response.Data.Finalized.Epoch)
+		}
+	}
+
+	if err := parameters.eventsProvider.Events(ctx, &api.EventsOpts{
+		Topics: []string{"head", "finalized_checkpoint"},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			s.handleHead(event.Slot)
+		},
+		FinalizedCheckpointHandler: func(_ context.Context, event *apiv1.FinalizedCheckpointEvent) {
+			s.handleFinalizedCheckpoint(event.Epoch)
+		},
+	}); err != nil {
+		return nil, errors.Join(errors.New("failed to subscribe to events"), err)
+	}
+
+	return s, nil
+}
+
+// HeadDelay returns the delay between the start of the slot and the head
+// event for the most recent head slot, and false if no head event has been
+// received.
+func (s *Service) HeadDelay() (time.Duration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.headDelay, s.headSeen
+}
+
+// FinalityLag returns the number of epochs between the current epoch and the
+// finalized epoch, and false if the finalized epoch is not yet known.
+func (s *Service) FinalityLag() (uint64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.finalityLag(), s.finalizedSeen
+}
+
+// MissedProposals returns the number of slots without a block in the given
+// epoch, and false if the epoch was not observed in full or is too old to be
+// retained.
+func (s *Service) MissedProposals(epoch phase0.Epoch) (uint64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	missed, exists := s.missed[epoch]
+
+	return missed, exists
+}
+
+// handleHead handles the head moving to a new slot.
+func (s *Service) handleHead(slot phase0.Slot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.headSeen && slot <= s.headSlot {
+		// Reorg to the same or an earlier slot; not a new head.
+		return
+	}
+
+	s.headDelay = s.clock.Now().Sub(s.chainTime.SlotToTime(slot))
+	monitorHeadDelay(s.headDelay.Seconds())
+
+	if !s.headSeen {
+		s.headSeen = true
+		s.epoch = s.chainTime.SlotToEpoch(slot)
+		s.epochComplete = slot == s.chainTime.EpochStartSlot(s.epoch)
+	} else {
+		// Slots between the previous head and this one had no block.
+		for missedSlot := s.headSlot + 1; missedSlot < slot; missedSlot++ {
+			s.recordSlot(missedSlot, true)
+		}
+		s.recordSlot(slot, false)
+	}
+	s.headSlot = slot
+
+	if s.finalizedSeen {
+		monitorFinalityLag(s.finalityLag())
+	}
+}
+
+// recordSlot records a slot, completing the tracked epoch if the slot is in
+// a later epoch.
+// Must be called with the lock held.
+func (s *Service) recordSlot(slot phase0.Slot, missed bool) {
+	epoch := s.chainTime.SlotToEpoch(slot)
+	if epoch > s.epoch {
+		if s.epochComplete {
+			s.missed[s.epoch] = s.epochMissed
+			monitorMissedProposals(s.epochMissed)
+			s.log.Trace().Uint64("epoch", uint64(s.epoch)).Uint64("missed", s.epochMissed).Msg("Epoch complete")
+			if s.epoch >= missedProposalsHistory {
+				delete(s.missed, s.epoch-missedProposalsHistory)
+			}
+		}
+		s.epoch = epoch
+		s.epochComplete = true
+		s.epochMissed = 0
+	}
+	if missed {
+		s.epochMissed++
+	}
+}
+
+// handleFinalizedCheckpoint handles a new finalized checkpoint.
+func (s *Service) handleFinalizedCheckpoint(epoch phase0.Epoch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.finalizedSeen && epoch <= s.finalizedEpoch {
+		return
+	}
+	s.finalizedSeen = true
+	s.finalizedEpoch = epoch
+
+	monitorFinalityLag(s.finalityLag())
+}
+
+// finalityLag returns the number of epochs since finality.
+// Must be called with the lock held.
+func (s *Service) finalityLag() uint64 {
+	if !s.finalizedSeen {
+		return 0
+	}
+	currentEpoch := s.chainTime.CurrentEpoch()
+	if currentEpoch <= s.finalizedEpoch {
+		return 0
+	}
+
+	return uint64(currentEpoch - s.finalizedEpoch)
+}
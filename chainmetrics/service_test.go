@@ -0,0 +1,222 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chainmetrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chainmetrics"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	failingClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	failingClient.FinalityFunc = func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		return nil, errors.New("mock error")
+	}
+	failingClient.EventsFunc = func(context.Context, *api.EventsOpts) error {
+		return errors.New("mock error")
+	}
+
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []chainmetrics.Parameter
+		err    string
+	}{
+		{
+			name: "EventsProviderMissing",
+			params: []chainmetrics.Parameter{
+				chainmetrics.WithLogLevel(zerolog.Disabled),
+				chainmetrics.WithChainTime(chainTime),
+			},
+			err: "problem with parameters\nno events provider specified",
+		},
+		{
+			name: "ChainTimeMissing",
+			params: []chainmetrics.Parameter{
+				chainmetrics.WithLogLevel(zerolog.Disabled),
+				chainmetrics.WithEventsProvider(client),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "ClockNil",
+			params: []chainmetrics.Parameter{
+				chainmetrics.WithLogLevel(zerolog.Disabled),
+				chainmetrics.WithEventsProvider(client),
+				chainmetrics.WithChainTime(chainTime),
+				chainmetrics.WithClock(nil),
+			},
+			err: "problem with parameters\nno clock specified",
+		},
+		{
+			name: "FinalityFails",
+			params: []chainmetrics.Parameter{
+				chainmetrics.WithLogLevel(zerolog.Disabled),
+				chainmetrics.WithEventsProvider(client),
+				chainmetrics.WithFinalityProvider(failingClient),
+				chainmetrics.WithChainTime(chainTime),
+			},
+			err: "failed to obtain initial finality\nmock error",
+		},
+		{
+			name: "EventsFails",
+			params: []chainmetrics.Parameter{
+				chainmetrics.WithLogLevel(zerolog.Disabled),
+				chainmetrics.WithEventsProvider(failingClient),
+				chainmetrics.WithChainTime(chainTime),
+			},
+			err: "failed to subscribe to events\nmock error",
+		},
+		{
+			name: "Good",
+			params: []chainmetrics.Parameter{
+				chainmetrics.WithLogLevel(zerolog.Disabled),
+				chainmetrics.WithEventsProvider(client),
+				chainmetrics.WithFinalityProvider(client),
+				chainmetrics.WithChainTime(chainTime),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chainmetrics.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1600000000, 0)
+	slotTime := func(slot phase0.Slot) time.Time {
+		return genesisTime.Add(time.Duration(slot) * 12 * time.Second)
+	}
+	// Current time is in epoch 10.
+	virtualClock := clock.NewVirtual(slotTime(330))
+
+	client, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+	var headHandler api.HeadEventHandlerFunc
+	var finalizedCheckpointHandler api.FinalizedCheckpointEventHandlerFunc
+	client.EventsFunc = func(_ context.Context, opts *api.EventsOpts) error {
+		require.ElementsMatch(t, []string{"head", "finalized_checkpoint"}, opts.Topics)
+		headHandler = opts.HeadHandler
+		finalizedCheckpointHandler = opts.FinalizedCheckpointHandler
+
+		return nil
+	}
+
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+		chaintime.WithClock(virtualClock),
+	)
+	require.NoError(t, err)
+
+	s, err := chainmetrics.New(ctx,
+		chainmetrics.WithLogLevel(zerolog.Disabled),
+		chainmetrics.WithEventsProvider(client),
+		chainmetrics.WithFinalityProvider(client),
+		chainmetrics.WithChainTime(chainTime),
+		chainmetrics.WithClock(virtualClock),
+	)
+	require.NoError(t, err)
+
+	_, found := s.HeadDelay()
+	require.False(t, found)
+
+	// Initial finality from the mock is epoch 6.
+	lag, found := s.FinalityLag()
+	require.True(t, found)
+	require.Equal(t, uint64(4), lag)
+
+	head := func(slot phase0.Slot) {
+		headHandler(ctx, &apiv1.HeadEvent{Slot: slot})
+	}
+
+	// Start part way through epoch 1, which is not counted.
+	head(40)
+	// Epoch 2 has two missed slots.
+	for slot := phase0.Slot(41); slot < 96; slot++ {
+		if slot == 66 || slot == 70 {
+			continue
+		}
+		head(slot)
+	}
+	// A reorg to an earlier slot does not alter the counts.
+	head(95)
+	// Complete epoch 2, then miss the rest of epoch 3 and all of epoch 4.
+	head(96)
+	head(161)
+
+	_, found = s.MissedProposals(1)
+	require.False(t, found)
+	missed, found := s.MissedProposals(2)
+	require.True(t, found)
+	require.Equal(t, uint64(2), missed)
+	missed, found = s.MissedProposals(3)
+	require.True(t, found)
+	require.Equal(t, uint64(31), missed)
+	missed, found = s.MissedProposals(4)
+	require.True(t, found)
+	require.Equal(t, uint64(32), missed)
+	_, found = s.MissedProposals(5)
+	require.False(t, found)
+
+	// Head delay is measured from the start of the slot.
+	virtualClock.Set(slotTime(330).Add(2500 * time.Millisecond))
+	head(330)
+	delay, found := s.HeadDelay()
+	require.True(t, found)
+	require.Equal(t, 2500*time.Millisecond, delay)
+
+	// Finality lag follows finalized checkpoint events, ignoring old ones.
+	finalizedCheckpointHandler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 8})
+	lag, _ = s.FinalityLag()
+	require.Equal(t, uint64(2), lag)
+	finalizedCheckpointHandler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 7})
+	lag, _ = s.FinalityLag()
+	require.Equal(t, uint64(2), lag)
+}
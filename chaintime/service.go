@@ -0,0 +1,234 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaintime converts between slots, epochs and wall-clock time for a
+// chain, using its genesis time and spec.
+package chaintime
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service provides chain time conversions.
+type Service struct {
+	log           zerolog.Logger
+	clock         clock.Clock
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+}
+
+// New creates a new chain time service.  Genesis and spec are obtained once,
+// at creation.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "chaintime").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	genesisResponse, err := parameters.genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+
+	specResponse, err := parameters.specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	slotDuration, isDuration := specResponse.Data["SECONDS_PER_SLOT"].(time.Duration)
+	if !isDuration || slotDuration <= 0 {
+		return nil, errors.New("SECONDS_PER_SLOT not found in spec")
+	}
+	slotsPerEpoch, isUint64 := specResponse.Data["SLOTS_PER_EPOCH"].(uint64)
+	if !isUint64 || slotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+	}
+
+	s := &Service{
+		log:           log,
+		clock:         parameters.clock,
+		genesisTime:   genesisResponse.Data.GenesisTime,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+	}
+	log.Trace().Time("genesis_time", s.genesisTime).Dur("slot_duration", slotDuration).Uint64("slots_per_epoch", slotsPerEpoch).Msg("Chain time obtained")
+
+	return s, nil
+}
+
+// GenesisTime returns the genesis time of the chain.
+func (s *Service) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// Clock returns the clock used by the service.
+func (s *Service) Clock() clock.Clock {
+	return s.clock
+}
+
+// SlotDuration returns the duration of a slot.
+func (s *Service) SlotDuration() time.Duration {
+	return s.slotDuration
+}
+
+// SlotsPerEpoch returns the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// SlotToTime returns the start time of the given slot.
+func (s *Service) SlotToTime(slot phase0.Slot) time.Time {
+	return s.genesisTime.Add(time.Duration(uint64(slot)) * s.slotDuration)
+}
+
+// TimeToSlot returns the slot at the given time.  Times before genesis
+// return slot 0.
+func (s *Service) TimeToSlot(t time.Time) phase0.Slot {
+	if t.Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(uint64(t.Sub(s.genesisTime) / s.slotDuration))
+}
+
+// TimeToEpoch returns the epoch at the given time.  Times before genesis
+// return epoch 0.
+func (s *Service) TimeToEpoch(t time.Time) phase0.Epoch {
+	return s.SlotToEpoch(s.TimeToSlot(t))
+}
+
+// SlotToEpoch returns the epoch containing the given slot.
+func (s *Service) SlotToEpoch(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// EpochStartSlot returns the first slot of the given epoch.
+func (s *Service) EpochStartSlot(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// EpochStart returns the start time of the given epoch.
+func (s *Service) EpochStart(epoch phase0.Epoch) time.Time {
+	return s.SlotToTime(s.EpochStartSlot(epoch))
+}
+
+// CurrentSlot returns the current slot.  Before genesis this is slot 0.
+func (s *Service) CurrentSlot() phase0.Slot {
+	return s.TimeToSlot(s.clock.Now())
+}
+
+// CurrentEpoch returns the current epoch.  Before genesis this is epoch 0.
+func (s *Service) CurrentEpoch() phase0.Epoch {
+	return s.TimeToEpoch(s.clock.Now())
+}
+
+// SlotTicker returns a channel that receives each slot as it starts, until
+// the context is canceled.  The first value sent is the next slot to start
+// (or slot 0 if before genesis); slots are skipped if the receiver falls
+// behind.
+func (s *Service) SlotTicker(ctx context.Context) <-chan phase0.Slot {
+	ch := make(chan phase0.Slot, 1)
+	go func() {
+		defer close(ch)
+		slot := s.nextSlot()
+		timer := s.clock.NewTimer(s.SlotToTime(slot).Sub(s.clock.Now()))
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				s.log.Trace().Msg("Context done; stopping slot ticker")
+
+				return
+			case <-timer.C():
+			}
+
+			select {
+			case ch <- slot:
+			default:
+				s.log.Debug().Uint64("slot", uint64(slot)).Msg("Slot ticker receiver not ready; skipping slot")
+			}
+			slot = max(slot+1, s.nextSlot())
+			timer.Reset(s.SlotToTime(slot).Sub(s.clock.Now()))
+		}
+	}()
+
+	return ch
+}
+
+// EpochTicker returns a channel that receives each epoch as it starts,
+// until the context is canceled.  The first value sent is the next epoch to
+// start (or epoch 0 if before genesis); epochs are skipped if the receiver
+// falls behind.
+func (s *Service) EpochTicker(ctx context.Context) <-chan phase0.Epoch {
+	ch := make(chan phase0.Epoch, 1)
+	go func() {
+		defer close(ch)
+		epoch := s.nextEpoch()
+		timer := s.clock.NewTimer(s.EpochStart(epoch).Sub(s.clock.Now()))
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				s.log.Trace().Msg("Context done; stopping epoch ticker")
+
+				return
+			case <-timer.C():
+			}
+
+			select {
+			case ch <- epoch:
+			default:
+				s.log.Debug().Uint64("epoch", uint64(epoch)).Msg("Epoch ticker receiver not ready; skipping epoch")
+			}
+			epoch = max(epoch+1, s.nextEpoch())
+			timer.Reset(s.EpochStart(epoch).Sub(s.clock.Now()))
+		}
+	}()
+
+	return ch
+}
+
+// nextSlot returns the next slot to start.
+func (s *Service) nextSlot() phase0.Slot {
+	now := s.clock.Now()
+	if now.Before(s.genesisTime) {
+		return 0
+	}
+
+	return s.TimeToSlot(now) + 1
+}
+
+// nextEpoch returns the next epoch to start.
+func (s *Service) nextEpoch() phase0.Epoch {
+	now := s.clock.Now()
+	if now.Before(s.genesisTime) {
+		return 0
+	}
+
+	return s.TimeToEpoch(now) + 1
+}
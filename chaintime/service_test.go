@@ -0,0 +1,233 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	badSpecClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	badSpecClient.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"SECONDS_PER_SLOT": 12 * time.Second,
+			},
+		}, nil
+	}
+
+	tests := []struct {
+		name   string
+		params []chaintime.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithSpecProvider(client),
+			},
+			err: "problem with parameters\nno genesis provider specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisProvider(client),
+			},
+			err: "problem with parameters\nno spec provider specified",
+		},
+		{
+			name: "ClockNil",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisProvider(client),
+				chaintime.WithSpecProvider(client),
+				chaintime.WithClock(nil),
+			},
+			err: "problem with parameters\nno clock specified",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisProvider(client),
+				chaintime.WithSpecProvider(badSpecClient),
+			},
+			err: "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "Good",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisProvider(client),
+				chaintime.WithSpecProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chaintime.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1606824023, 0)
+	client, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+
+	virtualClock := clock.NewVirtual(genesisTime.Add(-time.Second))
+	s, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+		chaintime.WithClock(virtualClock),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, genesisTime, s.GenesisTime())
+	require.Equal(t, 12*time.Second, s.SlotDuration())
+	require.Equal(t, uint64(32), s.SlotsPerEpoch())
+
+	// Before genesis.
+	require.Equal(t, phase0.Slot(0), s.CurrentSlot())
+	require.Equal(t, phase0.Epoch(0), s.CurrentEpoch())
+	require.Equal(t, phase0.Slot(0), s.TimeToSlot(genesisTime.Add(-time.Hour)))
+
+	// After genesis.
+	virtualClock.Set(genesisTime.Add(12*32*time.Second*10 + 13*time.Second))
+	require.Equal(t, phase0.Slot(321), s.CurrentSlot())
+	require.Equal(t, phase0.Epoch(10), s.CurrentEpoch())
+
+	require.Equal(t, genesisTime.Add(12*time.Second), s.SlotToTime(1))
+	require.Equal(t, phase0.Slot(1), s.TimeToSlot(genesisTime.Add(23*time.Second)))
+	require.Equal(t, phase0.Slot(2), s.TimeToSlot(genesisTime.Add(24*time.Second)))
+	require.Equal(t, phase0.Epoch(1), s.TimeToEpoch(genesisTime.Add(12*32*time.Second)))
+	require.Equal(t, phase0.Epoch(0), s.SlotToEpoch(31))
+	require.Equal(t, phase0.Epoch(1), s.SlotToEpoch(32))
+	require.Equal(t, phase0.Slot(64), s.EpochStartSlot(2))
+	require.Equal(t, genesisTime.Add(12*64*time.Second), s.EpochStart(2))
+}
+
+func TestTickers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := mock.New(context.Background(), mock.WithGenesisTime(time.Now().Add(50*time.Millisecond)))
+	require.NoError(t, err)
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"SECONDS_PER_SLOT": 50 * time.Millisecond,
+				"SLOTS_PER_EPOCH":  uint64(2),
+			},
+		}, nil
+	}
+
+	s, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+	)
+	require.NoError(t, err)
+
+	slots := s.SlotTicker(ctx)
+	epochs := s.EpochTicker(ctx)
+
+	// Ticks start at genesis.
+	require.Equal(t, phase0.Slot(0), <-slots)
+	require.Equal(t, phase0.Epoch(0), <-epochs)
+	require.Equal(t, phase0.Slot(1), <-slots)
+	require.Equal(t, phase0.Slot(2), <-slots)
+	require.Equal(t, phase0.Epoch(1), <-epochs)
+
+	// Channels are closed when the context is canceled.
+	cancel()
+	require.Eventually(t, func() bool {
+		_, open := <-slots
+
+		return !open
+	}, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		_, open := <-epochs
+
+		return !open
+	}, time.Second, time.Millisecond)
+}
+
+func TestVirtualTickers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	genesisTime := time.Unix(1606824023, 0)
+	client, err := mock.New(context.Background(), mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+
+	virtualClock := clock.NewVirtual(genesisTime.Add(-time.Second))
+	s, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+		chaintime.WithClock(virtualClock),
+	)
+	require.NoError(t, err)
+	require.Equal(t, virtualClock, s.Clock())
+
+	slots := s.SlotTicker(ctx)
+	epochs := s.EpochTicker(ctx)
+
+	// Ticks start at genesis.
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 2))
+	virtualClock.Set(genesisTime)
+	require.Equal(t, phase0.Slot(0), <-slots)
+	require.Equal(t, phase0.Epoch(0), <-epochs)
+
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 2))
+	virtualClock.Advance(12 * time.Second)
+	require.Equal(t, phase0.Slot(1), <-slots)
+
+	// Jumping ahead skips intervening slots.
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 2))
+	virtualClock.Set(s.EpochStart(1))
+	require.Equal(t, phase0.Slot(2), <-slots)
+	require.Equal(t, phase0.Epoch(1), <-epochs)
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 2))
+	virtualClock.Set(s.SlotToTime(33))
+	require.Equal(t, phase0.Slot(33), <-slots)
+}
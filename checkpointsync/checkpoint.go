@@ -0,0 +1,165 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpointsync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Checkpoint is a weak subjectivity checkpoint, containing a state and the
+// latest block applied to that state.
+type Checkpoint struct {
+	State     *spec.VersionedBeaconState
+	StateRoot phase0.Root
+	Block     *spec.VersionedSignedBeaconBlock
+	BlockRoot phase0.Root
+}
+
+// StateSSZ returns the SSZ encoding of the checkpoint state.
+func (c *Checkpoint) StateSSZ() ([]byte, error) {
+	if c.State == nil {
+		return nil, errors.New("no state")
+	}
+
+	switch c.State.Version {
+	case spec.DataVersionPhase0:
+		if c.State.Phase0 == nil {
+			return nil, errors.New("no phase0 state")
+		}
+
+		return c.State.Phase0.MarshalSSZ()
+	case spec.DataVersionAltair:
+		if c.State.Altair == nil {
+			return nil, errors.New("no altair state")
+		}
+
+		return c.State.Altair.MarshalSSZ()
+	case spec.DataVersionBellatrix:
+		if c.State.Bellatrix == nil {
+			return nil, errors.New("no bellatrix state")
+		}
+
+		return c.State.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		if c.State.Capella == nil {
+			return nil, errors.New("no capella state")
+		}
+
+		return c.State.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		if c.State.Deneb == nil {
+			return nil, errors.New("no deneb state")
+		}
+
+		return c.State.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		if c.State.Electra == nil {
+			return nil, errors.New("no electra state")
+		}
+
+		return c.State.Electra.MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("unhandled state version %s", c.State.Version)
+	}
+}
+
+// BlockSSZ returns the SSZ encoding of the checkpoint block.
+func (c *Checkpoint) BlockSSZ() ([]byte, error) {
+	if c.Block == nil {
+		return nil, errors.New("no block")
+	}
+
+	switch c.Block.Version {
+	case spec.DataVersionPhase0:
+		if c.Block.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+
+		return c.Block.Phase0.MarshalSSZ()
+	case spec.DataVersionAltair:
+		if c.Block.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+
+		return c.Block.Altair.MarshalSSZ()
+	case spec.DataVersionBellatrix:
+		if c.Block.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return c.Block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		if c.Block.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return c.Block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		if c.Block.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return c.Block.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		if c.Block.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return c.Block.Electra.MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("unhandled block version %s", c.Block.Version)
+	}
+}
+
+// WriteFiles writes the SSZ-encoded state and block to the given directory,
+// returning the paths of the files written.
+// The files are named state_<fork>_<slot>-<root>.ssz and block_<fork>_<slot>-<root>.ssz
+// respectively, and are suitable for passing to the checkpoint state and checkpoint
+// block options of consensus clients.
+func (c *Checkpoint) WriteFiles(dir string) (string, string, error) {
+	stateData, err := c.StateSSZ()
+	if err != nil {
+		return "", "", errors.Join(errors.New("failed to encode state"), err)
+	}
+	blockData, err := c.BlockSSZ()
+	if err != nil {
+		return "", "", errors.Join(errors.New("failed to encode block"), err)
+	}
+
+	stateSlot, err := c.State.Slot()
+	if err != nil {
+		return "", "", errors.Join(errors.New("failed to obtain state slot"), err)
+	}
+	blockSlot, err := c.Block.Slot()
+	if err != nil {
+		return "", "", errors.Join(errors.New("failed to obtain block slot"), err)
+	}
+
+	statePath := filepath.Join(dir, fmt.Sprintf("state_%s_%d-%#x.ssz", c.State.Version, stateSlot, c.StateRoot))
+	if err := os.WriteFile(statePath, stateData, 0o600); err != nil {
+		return "", "", errors.Join(errors.New("failed to write state"), err)
+	}
+	blockPath := filepath.Join(dir, fmt.Sprintf("block_%s_%d-%#x.ssz", c.Block.Version, blockSlot, c.BlockRoot))
+	if err := os.WriteFile(blockPath, blockData, 0o600); err != nil {
+		return "", "", errors.Join(errors.New("failed to write block"), err)
+	}
+
+	return statePath, blockPath, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpointsync
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                  zerolog.Level
+	timeout                   time.Duration
+	beaconStateProvider       consensusclient.BeaconStateProvider
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithTimeout sets the timeout for requests to obtain the state and block.
+// States can be large, so this is generally longer than the default client timeout.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithBeaconStateProvider sets the beacon state provider.
+func WithBeaconStateProvider(provider consensusclient.BeaconStateProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconStateProvider = provider
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the signed beacon block provider.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		timeout:  5 * time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+	if parameters.beaconStateProvider == nil {
+		return nil, errors.New("no beacon state provider specified")
+	}
+	if parameters.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,162 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpointsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service obtains weak subjectivity checkpoints from a beacon node.
+type Service struct {
+	log                       zerolog.Logger
+	timeout                   time.Duration
+	beaconStateProvider       consensusclient.BeaconStateProvider
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+}
+
+// New creates a new checkpoint sync service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "checkpointsync").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                       log,
+		timeout:                   parameters.timeout,
+		beaconStateProvider:       parameters.beaconStateProvider,
+		signedBeaconBlockProvider: parameters.signedBeaconBlockProvider,
+	}, nil
+}
+
+// Checkpoint obtains the state for the given state ID along with the block
+// referenced by its latest block header, and verifies that the two are consistent.
+// The state ID is usually "finalized", but can be any value accepted by the
+// beacon node's debug state endpoint.
+func (s *Service) Checkpoint(ctx context.Context, stateID string) (*Checkpoint, error) {
+	if stateID == "" {
+		return nil, errors.New("no state specified")
+	}
+
+	stateResponse, err := s.beaconStateProvider.BeaconState(ctx, &api.BeaconStateOpts{
+		Common: api.CommonOpts{
+			Timeout: s.timeout,
+		},
+		State: stateID,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain state"), err)
+	}
+	state := stateResponse.Data
+	if state == nil || state.IsEmpty() {
+		return nil, errors.New("no state returned")
+	}
+
+	stateSlot, err := state.Slot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain state slot"), err)
+	}
+	stateRoot, err := state.Root()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate state root"), err)
+	}
+	blockRoot, err := latestBlockRoot(state, stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Trace().Uint64("slot", uint64(stateSlot)).Stringer("state_root", stateRoot).Stringer("block_root", blockRoot).Msg("Obtained state")
+
+	blockResponse, err := s.signedBeaconBlockProvider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
+		Common: api.CommonOpts{
+			Timeout: s.timeout,
+		},
+		Block: fmt.Sprintf("%#x", blockRoot),
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block"), err)
+	}
+	block := blockResponse.Data
+	if block == nil {
+		return nil, errors.New("no block returned")
+	}
+
+	obtainedBlockRoot, err := block.Root()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate block root"), err)
+	}
+	if obtainedBlockRoot != blockRoot {
+		return nil, fmt.Errorf("block root %#x does not match state's latest block root %#x", obtainedBlockRoot, blockRoot)
+	}
+	blockSlot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block slot"), err)
+	}
+	if blockSlot > stateSlot {
+		return nil, fmt.Errorf("block slot %d is after state slot %d", blockSlot, stateSlot)
+	}
+	if blockSlot == stateSlot {
+		blockStateRoot, err := block.StateRoot()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain block state root"), err)
+		}
+		if blockStateRoot != stateRoot {
+			return nil, fmt.Errorf("block state root %#x does not match state root %#x", blockStateRoot, stateRoot)
+		}
+	}
+
+	return &Checkpoint{
+		State:     state,
+		StateRoot: stateRoot,
+		Block:     block,
+		BlockRoot: blockRoot,
+	}, nil
+}
+
+// latestBlockRoot calculates the root of the latest block header in the state.
+// The state root of the header is not populated until the slot after the block
+// is processed, in which case it is the root of the state itself.
+func latestBlockRoot(state *spec.VersionedBeaconState, stateRoot phase0.Root) (phase0.Root, error) {
+	header, err := state.LatestBlockHeader()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to obtain latest block header"), err)
+	}
+
+	latestHeader := *header
+	if latestHeader.StateRoot.IsZero() {
+		latestHeader.StateRoot = stateRoot
+	}
+
+	root, err := latestHeader.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate latest block root"), err)
+	}
+
+	return root, nil
+}
@@ -0,0 +1,224 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpointsync_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/checkpointsync"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testHeader(t *testing.T, block *spec.VersionedSignedBeaconBlock) *phase0.BeaconBlockHeader {
+	t.Helper()
+
+	bodyRoot, err := block.BodyRoot()
+	require.NoError(t, err)
+	stateRoot, err := block.StateRoot()
+	require.NoError(t, err)
+	slot, err := block.Slot()
+	require.NoError(t, err)
+	proposerIndex, err := block.ProposerIndex()
+	require.NoError(t, err)
+	parentRoot, err := block.ParentRoot()
+	require.NoError(t, err)
+
+	return &phase0.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		BodyRoot:      bodyRoot,
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []checkpointsync.Parameter
+		err    string
+	}{
+		{
+			name: "BeaconStateProviderMissing",
+			params: []checkpointsync.Parameter{
+				checkpointsync.WithLogLevel(zerolog.Disabled),
+				checkpointsync.WithSignedBeaconBlockProvider(client),
+			},
+			err: "problem with parameters\nno beacon state provider specified",
+		},
+		{
+			name: "SignedBeaconBlockProviderMissing",
+			params: []checkpointsync.Parameter{
+				checkpointsync.WithLogLevel(zerolog.Disabled),
+				checkpointsync.WithBeaconStateProvider(client),
+			},
+			err: "problem with parameters\nno signed beacon block provider specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []checkpointsync.Parameter{
+				checkpointsync.WithLogLevel(zerolog.Disabled),
+				checkpointsync.WithBeaconStateProvider(client),
+				checkpointsync.WithSignedBeaconBlockProvider(client),
+				checkpointsync.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "Good",
+			params: []checkpointsync.Parameter{
+				checkpointsync.WithLogLevel(zerolog.Disabled),
+				checkpointsync.WithBeaconStateProvider(client),
+				checkpointsync.WithSignedBeaconBlockProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := checkpointsync.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	ctx := context.Background()
+
+	// Block at the same slot as the state; header state root is unset in the state.
+	sameSlotBlock, sameSlotState, err := testfixtures.BlockAndState(spec.DataVersionPhase0, testfixtures.WithSlot(32))
+	require.NoError(t, err)
+
+	// Block before the state, with empty slots in between.
+	earlierBlock, err := testfixtures.Block(spec.DataVersionPhase0,
+		testfixtures.WithSlot(30),
+		testfixtures.WithStateRoot(phase0.Root{0x01}),
+	)
+	require.NoError(t, err)
+	earlierState, err := testfixtures.State(spec.DataVersionPhase0, testfixtures.WithSlot(32))
+	require.NoError(t, err)
+	earlierState.Phase0.LatestBlockHeader = testHeader(t, earlierBlock)
+
+	// Block that does not match the state.
+	mismatchedBlock, err := testfixtures.Block(spec.DataVersionPhase0,
+		testfixtures.WithSlot(31),
+		testfixtures.WithStateRoot(phase0.Root{0x01}),
+	)
+	require.NoError(t, err)
+	mismatchState, err := testfixtures.State(spec.DataVersionPhase0, testfixtures.WithSlot(32))
+	require.NoError(t, err)
+	mismatchState.Phase0.LatestBlockHeader = testHeader(t, mismatchedBlock)
+	mismatchBlock, err := testfixtures.Block(spec.DataVersionPhase0,
+		testfixtures.WithSlot(31),
+		testfixtures.WithStateRoot(phase0.Root{0x02}),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		stateID string
+		state   *spec.VersionedBeaconState
+		block   *spec.VersionedSignedBeaconBlock
+		err     string
+	}{
+		{
+			name: "StateIDMissing",
+			err:  "no state specified",
+		},
+		{
+			name:    "SameSlot",
+			stateID: "finalized",
+			state:   sameSlotState,
+			block:   sameSlotBlock,
+		},
+		{
+			name:    "EarlierBlock",
+			stateID: "finalized",
+			state:   earlierState,
+			block:   earlierBlock,
+		},
+		{
+			name:    "Mismatch",
+			stateID: "finalized",
+			state:   mismatchState,
+			block:   mismatchBlock,
+			err:     "block root",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := mock.New(ctx)
+			require.NoError(t, err)
+			client.BeaconStateFunc = func(_ context.Context, _ *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error) {
+				return &api.Response[*spec.VersionedBeaconState]{Data: test.state}, nil
+			}
+			client.SignedBeaconBlockFunc = func(_ context.Context, _ *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+				return &api.Response[*spec.VersionedSignedBeaconBlock]{Data: test.block}, nil
+			}
+
+			s, err := checkpointsync.New(ctx,
+				checkpointsync.WithLogLevel(zerolog.Disabled),
+				checkpointsync.WithBeaconStateProvider(client),
+				checkpointsync.WithSignedBeaconBlockProvider(client),
+			)
+			require.NoError(t, err)
+
+			checkpoint, err := s.Checkpoint(ctx, test.stateID)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			dir := t.TempDir()
+			statePath, blockPath, err := checkpoint.WriteFiles(dir)
+			require.NoError(t, err)
+
+			stateData, err := os.ReadFile(statePath)
+			require.NoError(t, err)
+			state := &phase0.BeaconState{}
+			require.NoError(t, state.UnmarshalSSZ(stateData))
+			stateRoot, err := state.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, checkpoint.StateRoot, phase0.Root(stateRoot))
+
+			blockData, err := os.ReadFile(blockPath)
+			require.NoError(t, err)
+			block := &phase0.SignedBeaconBlock{}
+			require.NoError(t, block.UnmarshalSSZ(blockData))
+			blockRoot, err := block.Message.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, checkpoint.BlockRoot, phase0.Root(blockRoot))
+		})
+	}
+}
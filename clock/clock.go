@@ -0,0 +1,107 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clock provides an injectable source of time, so that code which
+// waits on the wall clock can be run in virtual time by tests and
+// simulations.
+package clock
+
+import (
+	"time"
+)
+
+// Clock provides the current time, and timers and tickers based on it.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+	// NewTimer creates a timer that sends the current time on its channel
+	// after at least the duration has elapsed.
+	NewTimer(d time.Duration) Timer
+	// NewTicker creates a ticker that sends the current time on its channel
+	// each time the duration elapses.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, returning false if the timer has
+	// already fired or been stopped.
+	Stop() bool
+	// Reset changes the timer to fire after the duration, returning false
+	// if the timer had already fired or been stopped.
+	Reset(d time.Duration) bool
+}
+
+// Ticker delivers ticks at intervals.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// System returns a clock backed by the system time.
+func System() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return &systemTimer{timer: time.NewTimer(d)}
+}
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return &systemTicker{ticker: time.NewTicker(d)}
+}
+
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t *systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t *systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+func (t *systemTimer) Reset(d time.Duration) bool {
+	return t.timer.Reset(d)
+}
+
+type systemTicker struct {
+	ticker *time.Ticker
+}
+
+func (t *systemTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t *systemTicker) Stop() {
+	t.ticker.Stop()
+}
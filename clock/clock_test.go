@@ -0,0 +1,123 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystem(t *testing.T) {
+	c := clock.System()
+	require.WithinDuration(t, time.Now(), c.Now(), time.Second)
+
+	<-c.After(time.Millisecond)
+
+	timer := c.NewTimer(time.Hour)
+	require.True(t, timer.Stop())
+	timer.Reset(time.Millisecond)
+	<-timer.C()
+
+	ticker := c.NewTicker(time.Millisecond)
+	<-ticker.C()
+	ticker.Stop()
+}
+
+func TestVirtualTimers(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	c := clock.NewVirtual(start)
+	require.Equal(t, start, c.Now())
+
+	after := c.After(time.Second)
+	timer := c.NewTimer(2 * time.Second)
+	stopped := c.NewTimer(3 * time.Second)
+	require.Equal(t, 3, c.Waiters())
+	require.True(t, stopped.Stop())
+	require.False(t, stopped.Stop())
+	require.Equal(t, 2, c.Waiters())
+
+	c.Advance(500 * time.Millisecond)
+	select {
+	case <-after:
+		require.Fail(t, "timer fired early")
+	default:
+	}
+
+	// Timers fire at their deadline.
+	c.Advance(2 * time.Second)
+	require.Equal(t, start.Add(time.Second), <-after)
+	require.Equal(t, start.Add(2*time.Second), <-timer.C())
+	require.Equal(t, start.Add(2500*time.Millisecond), c.Now())
+	require.Equal(t, 0, c.Waiters())
+
+	// Reset reschedules relative to the current time.
+	require.False(t, timer.Reset(time.Second))
+	c.Advance(time.Second)
+	require.Equal(t, start.Add(3500*time.Millisecond), <-timer.C())
+
+	// Non-positive durations fire immediately.
+	require.Equal(t, c.Now(), <-c.After(0))
+
+	// Setting an earlier time has no effect.
+	c.Set(start)
+	require.Equal(t, start.Add(3500*time.Millisecond), c.Now())
+}
+
+func TestVirtualTicker(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	c := clock.NewVirtual(start)
+
+	ticker := c.NewTicker(time.Second)
+	c.Advance(time.Second)
+	require.Equal(t, start.Add(time.Second), <-ticker.C())
+	c.Advance(time.Second)
+	require.Equal(t, start.Add(2*time.Second), <-ticker.C())
+
+	// Ticks that are not received are dropped.
+	c.Advance(3 * time.Second)
+	require.Equal(t, start.Add(3*time.Second), <-ticker.C())
+	select {
+	case <-ticker.C():
+		require.Fail(t, "unexpected tick")
+	default:
+	}
+
+	ticker.Stop()
+	require.Equal(t, 0, c.Waiters())
+
+	require.Panics(t, func() { c.NewTicker(0) })
+}
+
+func TestVirtualWaitForWaiters(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(1600000000, 0)
+	c := clock.NewVirtual(start)
+
+	done := make(chan time.Time)
+	go func() {
+		done <- <-c.After(time.Minute)
+	}()
+
+	require.NoError(t, c.WaitForWaiters(ctx, 1))
+	c.Advance(time.Minute)
+	require.Equal(t, start.Add(time.Minute), <-done)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, c.WaitForWaiters(ctx, 1), context.DeadlineExceeded)
+}
@@ -0,0 +1,242 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Virtual is a clock whose time only changes when it is advanced, firing
+// any timers and tickers that fall due in order.
+type Virtual struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*waiter
+	// changed is closed and replaced whenever the set of waiters changes.
+	changed chan struct{}
+}
+
+// waiter is a pending timer or ticker.
+type waiter struct {
+	clock    *Virtual
+	deadline time.Time
+	// period is the interval of a ticker; 0 for a timer.
+	period time.Duration
+	ch     chan time.Time
+}
+
+// NewVirtual creates a virtual clock starting at the given time.
+func NewVirtual(now time.Time) *Virtual {
+	return &Virtual{
+		now:     now,
+		changed: make(chan struct{}),
+	}
+}
+
+// Now returns the current virtual time.
+func (c *Virtual) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After waits for the duration to elapse in virtual time and then sends the
+// virtual time on the returned channel.
+func (c *Virtual) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer creates a timer that fires once the virtual time has advanced by
+// the duration.
+func (c *Virtual) NewTimer(d time.Duration) Timer {
+	w := &waiter{
+		clock: c,
+		ch:    make(chan time.Time, 1),
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schedule(w, d)
+
+	return w
+}
+
+// NewTicker creates a ticker that fires each time the virtual time advances
+// by the duration.
+func (c *Virtual) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	w := &waiter{
+		clock:  c,
+		period: d,
+		ch:     make(chan time.Time, 1),
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schedule(w, d)
+
+	return &virtualTicker{waiter: w}
+}
+
+// Advance moves the virtual time forward by the duration, firing timers and
+// tickers that fall due in deadline order.
+func (c *Virtual) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the virtual time forward to the given time, firing timers and
+// tickers that fall due in deadline order.  Times before the current virtual
+// time are ignored.
+func (c *Virtual) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.waiters) > 0 && !c.waiters[0].deadline.After(t) {
+		w := c.waiters[0]
+		c.waiters = c.waiters[1:]
+		if w.deadline.After(c.now) {
+			c.now = w.deadline
+		}
+		w.fire(c.now)
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+			c.insert(w)
+		}
+	}
+	if t.After(c.now) {
+		c.now = t
+	}
+	c.notify()
+}
+
+// Waiters returns the number of timers and tickers that have yet to fire.
+func (c *Virtual) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.waiters)
+}
+
+// WaitForWaiters blocks until at least the given number of timers and
+// tickers are pending, allowing tests to ensure that code under test is
+// waiting on the clock before advancing it.
+func (c *Virtual) WaitForWaiters(ctx context.Context, n int) error {
+	for {
+		c.mu.Lock()
+		pending := len(c.waiters)
+		changed := c.changed
+		c.mu.Unlock()
+
+		if pending >= n {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// schedule schedules a waiter to fire after the duration.
+// Must be called with the lock held.
+func (c *Virtual) schedule(w *waiter, d time.Duration) {
+	w.deadline = c.now.Add(d)
+	if d <= 0 {
+		w.fire(c.now)
+		c.notify()
+
+		return
+	}
+	c.insert(w)
+	c.notify()
+}
+
+// insert adds a waiter in deadline order.
+// Must be called with the lock held.
+func (c *Virtual) insert(w *waiter) {
+	i := sort.Search(len(c.waiters), func(i int) bool {
+		return c.waiters[i].deadline.After(w.deadline)
+	})
+	c.waiters = append(c.waiters, nil)
+	copy(c.waiters[i+1:], c.waiters[i:])
+	c.waiters[i] = w
+}
+
+// remove removes a waiter, returning true if it was pending.
+// Must be called with the lock held.
+func (c *Virtual) remove(w *waiter) bool {
+	for i := range c.waiters {
+		if c.waiters[i] == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			c.notify()
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// notify signals that the waiters have changed.
+// Must be called with the lock held.
+func (c *Virtual) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// fire sends the time on the waiter's channel, dropping it if a previous
+// value has not been received.
+func (w *waiter) fire(t time.Time) {
+	select {
+	case w.ch <- t:
+	default:
+	}
+}
+
+func (w *waiter) C() <-chan time.Time {
+	return w.ch
+}
+
+// Stop stops the timer, returning true if it had yet to fire.
+func (w *waiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	return w.clock.remove(w)
+}
+
+func (w *waiter) Reset(d time.Duration) bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	pending := w.clock.remove(w)
+	w.clock.schedule(w, d)
+
+	return pending
+}
+
+// virtualTicker is a ticker on a virtual clock.
+type virtualTicker struct {
+	*waiter
+}
+
+func (t *virtualTicker) Stop() {
+	t.waiter.Stop()
+}
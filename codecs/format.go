@@ -0,0 +1,231 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// The canonical spec JSON formatting rules are:
+//   - byte values are lower-case hex with a 0x prefix, except for execution
+//     addresses which carry an EIP-55 checksum;
+//   - integer values are decimal strings, never JSON numbers;
+//   - base64 is never used.
+// The functions in this file encode and decode values according to these
+// rules, and CheckCanonicalJSON confirms that a document follows them.
+
+// EncodeHex encodes data as a lower-case hex string with a 0x prefix.
+// Empty data is encoded as "0x".
+func EncodeHex(data []byte) string {
+	return "0x" + hex.EncodeToString(data)
+}
+
+// DecodeHex decodes a hex string with a 0x prefix.
+func DecodeHex(input string) ([]byte, error) {
+	if !strings.HasPrefix(input, "0x") {
+		return nil, errors.New("invalid prefix")
+	}
+	data, err := hex.DecodeString(input[2:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value %s", input[2:])
+	}
+
+	return data, nil
+}
+
+// DecodeHexFixed decodes a hex string with a 0x prefix, ensuring that the
+// result is of the given length.
+func DecodeHexFixed(input string, length int) ([]byte, error) {
+	if len(input) != 2+length*2 {
+		return nil, errors.New("incorrect length")
+	}
+
+	return DecodeHex(input)
+}
+
+// EncodeUint64 encodes a value as a decimal string.
+func EncodeUint64(val uint64) string {
+	return strconv.FormatUint(val, 10)
+}
+
+// DecodeUint64 decodes a decimal string.
+func DecodeUint64(input string) (uint64, error) {
+	val, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid value %s", input)
+	}
+
+	return val, nil
+}
+
+// MarshalHexJSON returns data as a quoted JSON hex string.
+func MarshalHexJSON(data []byte) []byte {
+	return []byte(`"` + EncodeHex(data) + `"`)
+}
+
+// UnmarshalHexJSON decodes a quoted JSON hex string.  If length is not
+// negative then the result must be of that length.
+func UnmarshalHexJSON(input []byte, length int) ([]byte, error) {
+	str, err := unquote(input)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return DecodeHex(str)
+	}
+
+	return DecodeHexFixed(str, length)
+}
+
+// MarshalUint64JSON returns a value as a quoted JSON decimal string.
+func MarshalUint64JSON(val uint64) []byte {
+	return []byte(fmt.Sprintf(`"%d"`, val))
+}
+
+// UnmarshalUint64JSON decodes a quoted JSON decimal string.
+func UnmarshalUint64JSON(input []byte) (uint64, error) {
+	str, err := unquote(input)
+	if err != nil {
+		return 0, err
+	}
+
+	return DecodeUint64(str)
+}
+
+func unquote(input []byte) (string, error) {
+	if len(input) == 0 {
+		return "", errors.New("input missing")
+	}
+	if len(input) < 2 {
+		return "", errors.New("input malformed")
+	}
+	if !bytes.HasPrefix(input, []byte{'"'}) {
+		return "", errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'"'}) {
+		return "", errors.New("invalid suffix")
+	}
+
+	return string(input[1 : len(input)-1]), nil
+}
+
+// CheckCanonicalJSON checks that all values in the supplied JSON document
+// follow the canonical spec JSON formatting rules.  Every value must be an
+// object, an array, a boolean, null, a decimal string, a lower-case hex
+// string with a 0x prefix or an EIP-55 checksummed execution address.
+func CheckCanonicalJSON(input []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return checkCanonicalValue("", data)
+}
+
+func checkCanonicalValue(path string, data any) error {
+	switch val := data.(type) {
+	case map[string]any:
+		for k, v := range val {
+			if err := checkCanonicalValue(path+"."+k, v); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i := range val {
+			if err := checkCanonicalValue(fmt.Sprintf("%s[%d]", path, i), val[i]); err != nil {
+				return err
+			}
+		}
+	case json.Number:
+		return fmt.Errorf("%s: unquoted number %s", path, val.String())
+	case string:
+		if !canonicalString(val) {
+			return fmt.Errorf("%s: non-canonical value %q", path, val)
+		}
+	}
+
+	return nil
+}
+
+func canonicalString(val string) bool {
+	if strings.HasPrefix(val, "0x") {
+		if len(val)%2 != 0 {
+			return false
+		}
+		if len(val) == 2+executionAddressLength*2 && checksummed(val) {
+			return true
+		}
+		for _, c := range val[2:] {
+			if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if val == "" {
+		return false
+	}
+	if len(val) > 1 && val[0] == '0' {
+		// Leading zeros are not canonical.
+		return false
+	}
+	for _, c := range val {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// executionAddressLength is the length of an execution address in bytes.
+const executionAddressLength = 20
+
+// checksummed returns true if the 0x-prefixed hex string carries a valid
+// EIP-55 checksum.
+func checksummed(val string) bool {
+	data := []byte(strings.ToLower(val[2:]))
+	if _, err := hex.DecodeString(string(data)); err != nil {
+		return false
+	}
+
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write(data)
+	hash := keccak.Sum(nil)
+	for i := range data {
+		hashByte := hash[i/2]
+		if i%2 == 0 {
+			hashByte >>= 4
+		} else {
+			hashByte &= 0xf
+		}
+		if data[i] > '9' && hashByte > 7 {
+			data[i] -= 32
+		}
+	}
+
+	return string(data) == val[2:]
+}
@@ -0,0 +1,262 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeHex(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{
+			name:     "Nil",
+			expected: "0x",
+		},
+		{
+			name:     "Empty",
+			input:    []byte{},
+			expected: "0x",
+		},
+		{
+			name:     "Single",
+			input:    []byte{0x0a},
+			expected: "0x0a",
+		},
+		{
+			name:     "LowerCase",
+			input:    []byte{0xab, 0xcd, 0xef, 0x01},
+			expected: "0xabcdef01",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, codecs.EncodeHex(test.input))
+			require.Equal(t, `"`+test.expected+`"`, string(codecs.MarshalHexJSON(test.input)))
+		})
+	}
+}
+
+func TestDecodeHex(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		length   int
+		expected []byte
+		err      string
+	}{
+		{
+			name:     "Empty",
+			input:    "0x",
+			length:   -1,
+			expected: []byte{},
+		},
+		{
+			name:   "PrefixMissing",
+			input:  "abcd",
+			length: -1,
+			err:    "invalid prefix",
+		},
+		{
+			name:   "Base64",
+			input:  "q80=",
+			length: -1,
+			err:    "invalid prefix",
+		},
+		{
+			name:   "OddLength",
+			input:  "0xabc",
+			length: -1,
+			err:    "invalid value abc: encoding/hex: odd length hex string",
+		},
+		{
+			name:     "Good",
+			input:    "0xabcd",
+			length:   -1,
+			expected: []byte{0xab, 0xcd},
+		},
+		{
+			name:     "UpperCase",
+			input:    "0xABCD",
+			length:   -1,
+			expected: []byte{0xab, 0xcd},
+		},
+		{
+			name:   "FixedShort",
+			input:  "0xabcd",
+			length: 3,
+			err:    "incorrect length",
+		},
+		{
+			name:     "Fixed",
+			input:    "0xabcdef",
+			length:   3,
+			expected: []byte{0xab, 0xcd, 0xef},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res []byte
+			var err error
+			if test.length < 0 {
+				res, err = codecs.DecodeHex(test.input)
+			} else {
+				res, err = codecs.DecodeHexFixed(test.input, test.length)
+			}
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+
+			res, err = codecs.UnmarshalHexJSON([]byte(`"`+test.input+`"`), test.length)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected uint64
+		err      string
+	}{
+		{
+			name: "Missing",
+			err:  "input missing",
+		},
+		{
+			name:  "Unquoted",
+			input: []byte(`12`),
+			err:   "invalid prefix",
+		},
+		{
+			name:  "Negative",
+			input: []byte(`"-1"`),
+			err:   `invalid value -1: strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		{
+			name:  "Overflow",
+			input: []byte(`"18446744073709551616"`),
+			err:   `invalid value 18446744073709551616: strconv.ParseUint: parsing "18446744073709551616": value out of range`,
+		},
+		{
+			name:     "Zero",
+			input:    []byte(`"0"`),
+			expected: 0,
+		},
+		{
+			name:     "Max",
+			input:    []byte(`"18446744073709551615"`),
+			expected: 18446744073709551615,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := codecs.UnmarshalUint64JSON(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+				require.Equal(t, string(test.input), string(codecs.MarshalUint64JSON(res)))
+				require.Equal(t, string(test.input[1:len(test.input)-1]), codecs.EncodeUint64(res))
+			}
+		})
+	}
+}
+
+func TestCheckCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "Invalid",
+			input: `{`,
+			err:   "invalid JSON: unexpected EOF",
+		},
+		{
+			name:  "UnquotedNumber",
+			input: `{"slot":1}`,
+			err:   ".slot: unquoted number 1",
+		},
+		{
+			name:  "UpperCaseHex",
+			input: `{"root":"0xABCD"}`,
+			err:   `.root: non-canonical value "0xABCD"`,
+		},
+		{
+			name:  "BadChecksum",
+			input: `{"address":"0x5AAEB6053F3E94C9b9A09f33669435E7Ef1BeAed"}`,
+			err:   `.address: non-canonical value "0x5AAEB6053F3E94C9b9A09f33669435E7Ef1BeAed"`,
+		},
+		{
+			name:  "OddHex",
+			input: `{"root":"0xabc"}`,
+			err:   `.root: non-canonical value "0xabc"`,
+		},
+		{
+			name:  "Base64",
+			input: `{"data":["q80="]}`,
+			err:   `.data[0]: non-canonical value "q80="`,
+		},
+		{
+			name:  "LeadingZero",
+			input: `{"slot":"01"}`,
+			err:   `.slot: non-canonical value "01"`,
+		},
+		{
+			name:  "Empty",
+			input: `{"slot":""}`,
+			err:   `.slot: non-canonical value ""`,
+		},
+		{
+			name:  "Good",
+			input: `{"slot":"0","root":"0x","data":[{"a":"12","b":"0xabcd"}],"flag":true,"none":null}`,
+		},
+		{
+			name:  "ChecksummedAddress",
+			input: `{"address":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := codecs.CheckCanonicalJSON([]byte(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
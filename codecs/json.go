@@ -14,14 +14,130 @@
 package codecs
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 )
 
+// JSONOption is an option for UnmarshalJSON.
+type JSONOption func(*jsonOptions)
+
+type jsonOptions struct {
+	strict bool
+}
+
+// WithStrictJSON sets strict JSON decoding.  When enabled, decoding fails if
+// the input contains fields, at any depth, that are not part of the value
+// being decoded.  This is intended for conformance tooling that needs to
+// detect non-spec fields returned by nodes, or fields missing from this module.
+func WithStrictJSON(strict bool) JSONOption {
+	return func(o *jsonOptions) {
+		o.strict = strict
+	}
+}
+
+// UnmarshalJSON unmarshals JSON input in to the supplied value.
+func UnmarshalJSON(input []byte, v any, opts ...JSONOption) error {
+	options := jsonOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if err := json.Unmarshal(input, v); err != nil {
+		return err
+	}
+	if !options.strict {
+		return nil
+	}
+
+	// Fields that are not part of the value are dropped when decoding, and
+	// so are missing when the value is encoded again.  Comparing the two
+	// finds them regardless of the value's own UnmarshalJSON implementation.
+	output, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode value")
+	}
+	var decodedInput, decodedOutput any
+	if err := json.Unmarshal(input, &decodedInput); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(output, &decodedOutput); err != nil {
+		return errors.Wrap(err, "failed to decode encoded value")
+	}
+
+	return unknownFields("", decodedInput, decodedOutput)
+}
+
+// unknownFields returns an error if the input has an object field that is
+// not in the output.
+func unknownFields(path string, input any, output any) error {
+	switch inputVal := input.(type) {
+	case map[string]any:
+		outputVal, _ := output.(map[string]any)
+		keys := make([]string, 0, len(inputVal))
+		for key := range inputVal {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			outputField, exists := outputVal[key]
+			if !exists {
+				if emptyJSON(inputVal[key]) {
+					// Empty fields may be omitted when encoding.
+					continue
+				}
+
+				return fmt.Errorf("unknown field %s", fieldPath)
+			}
+			if err := unknownFields(fieldPath, inputVal[key], outputField); err != nil {
+				return err
+			}
+		}
+	case []any:
+		outputVal, _ := output.([]any)
+		for i := range inputVal {
+			if i >= len(outputVal) {
+				break
+			}
+			if err := unknownFields(fmt.Sprintf("%s[%d]", path, i), inputVal[i], outputVal[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// emptyJSON returns true if the decoded JSON value is one that is dropped by
+// the omitempty option.
+func emptyJSON(val any) bool {
+	switch v := val.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
 // RawJSON generates raw JSON for a struct,
 // ensuring that all values are present.
 func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
@@ -60,3 +176,37 @@ func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
 
 	return base, nil
 }
+
+// ObjectKeys returns the keys of the supplied JSON object in the order in
+// which they appear in the input.  Nested objects are not examined.
+func ObjectKeys(input []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid JSON")
+	}
+	if delim, isDelim := token.(json.Delim); !isDelim || delim != '{' {
+		return nil, errors.New("not a JSON object")
+	}
+
+	keys := make([]string, 0)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid JSON")
+		}
+		key, isString := token.(string)
+		if !isString {
+			return nil, errors.New("invalid key")
+		}
+		keys = append(keys, key)
+
+		// Skip the value.
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", key)
+		}
+	}
+
+	return keys, nil
+}
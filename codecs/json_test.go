@@ -0,0 +1,172 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+type strictTest struct {
+	A string        `json:"a"`
+	B string        `json:"b,omitempty"`
+	C *strictNested `json:"c,omitempty"`
+}
+
+type strictNested struct {
+	D string `json:"d"`
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		err       string
+		strictErr string
+	}{
+		{
+			name:  "Good",
+			input: `{"a":"1","b":"2","c":{"d":"3"}}`,
+		},
+		{
+			name:  "EmptyOmitted",
+			input: `{"a":"1","b":"","c":null}`,
+		},
+		{
+			name:      "UnknownField",
+			input:     `{"a":"1","e":"3"}`,
+			strictErr: "unknown field e",
+		},
+		{
+			name:      "UnknownNestedField",
+			input:     `{"a":"1","c":{"d":"3","e":"4"}}`,
+			strictErr: "unknown field c.e",
+		},
+		{
+			name:      "TrailingData",
+			input:     `{"a":"1"}{}`,
+			err:       "invalid character '{' after top-level value",
+			strictErr: "invalid character '{' after top-level value",
+		},
+		{
+			name:      "Invalid",
+			input:     `{"a":1}`,
+			err:       "json: cannot unmarshal number into Go struct field strictTest.a of type string",
+			strictErr: "json: cannot unmarshal number into Go struct field strictTest.a of type string",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res strictTest
+			err := codecs.UnmarshalJSON([]byte(test.input), &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			res = strictTest{}
+			err = codecs.UnmarshalJSON([]byte(test.input), &res, codecs.WithStrictJSON(true))
+			if test.strictErr != "" {
+				require.EqualError(t, err, test.strictErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStrictJSONTypes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		res   any
+		err   string
+	}{
+		{
+			name:  "Fork",
+			input: `{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"1","extra":"1"}`,
+			res:   &phase0.Fork{},
+			err:   "unknown field extra",
+		},
+		{
+			name:  "NestedCheckpoint",
+			input: `{"slot":"1","index":"2","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"1","root":"0x0000000000000000000000000000000000000000000000000000000000000000","extra":"1"},"target":{"epoch":"2","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}}`,
+			res:   &phase0.AttestationData{},
+			err:   "unknown field source.extra",
+		},
+		{
+			name:  "WithdrawalRequests",
+			input: `[{"source_address":"0x0000000000000000000000000000000000000000","validator_pubkey":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","amount":"1","extra":"1"}]`,
+			res:   &[]*electra.WithdrawalRequest{},
+			err:   "unknown field [0].extra",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, json.Unmarshal([]byte(test.input), test.res))
+			require.NoError(t, codecs.UnmarshalJSON([]byte(test.input), test.res))
+			require.EqualError(t, codecs.UnmarshalJSON([]byte(test.input), test.res, codecs.WithStrictJSON(true)), test.err)
+		})
+	}
+}
+
+func TestObjectKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		keys  []string
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: `{}`,
+			keys:  []string{},
+		},
+		{
+			name:  "Ordered",
+			input: `{"b":"1","a":{"d":"2","c":"3"},"c":["4"]}`,
+			keys:  []string{"b", "a", "c"},
+		},
+		{
+			name:  "NotObject",
+			input: `["a"]`,
+			err:   "not a JSON object",
+		},
+		{
+			name:  "Invalid",
+			input: `{"a":}`,
+			err:   "invalid value for a: invalid character '}' looking for beginning of value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keys, err := codecs.ObjectKeys([]byte(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.keys, keys)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func fill(length int, val byte) []byte {
+	res := make([]byte, length)
+	for i := range res {
+		res[i] = val
+	}
+
+	return res
+}
+
+// TestSpecGolden ensures that spec types across forks generate
+// byte-identical output following the canonical formatting rules.
+func TestSpecGolden(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{
+			name: "Phase0Fork",
+			input: &phase0.Fork{
+				PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+				CurrentVersion:  phase0.Version{0x01, 0xab, 0xcd, 0xef},
+				Epoch:           74240,
+			},
+			expected: `{"previous_version":"0x00000000","current_version":"0x01abcdef","epoch":"74240"}`,
+		},
+		{
+			name: "Phase0AttestationData",
+			input: &phase0.AttestationData{
+				Slot:            12345,
+				Index:           0,
+				BeaconBlockRoot: phase0.Root(fill(32, 0xab)),
+				Source: &phase0.Checkpoint{
+					Epoch: 384,
+					Root:  phase0.Root(fill(32, 0x01)),
+				},
+				Target: &phase0.Checkpoint{
+					Epoch: 385,
+					Root:  phase0.Root(fill(32, 0xcd)),
+				},
+			},
+			expected: `{"slot":"12345","index":"0","beacon_block_root":"0xabababababababababababababababababababababababababababababababab","source":{"epoch":"384","root":"0x0101010101010101010101010101010101010101010101010101010101010101"},"target":{"epoch":"385","root":"0xcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd"}}`,
+		},
+		{
+			name: "AltairSyncAggregate",
+			input: &altair.SyncAggregate{
+				SyncCommitteeBits:      fill(64, 0xff),
+				SyncCommitteeSignature: phase0.BLSSignature(fill(96, 0xef)),
+			},
+			expected: `{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","sync_committee_signature":"0xefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefef"}`,
+		},
+		{
+			name: "CapellaWithdrawal",
+			input: &capella.Withdrawal{
+				Index:          18446744073709551615,
+				ValidatorIndex: 1,
+				Address:        bellatrix.ExecutionAddress(fill(20, 0xaa)),
+				Amount:         32000000000,
+			},
+			expected: `{"index":"18446744073709551615","validator_index":"1","address":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","amount":"32000000000"}`,
+		},
+		{
+			name: "DenebExecutionPayload",
+			input: &deneb.ExecutionPayload{
+				ParentHash:    phase0.Hash32(fill(32, 0x01)),
+				FeeRecipient:  bellatrix.ExecutionAddress(fill(20, 0x02)),
+				StateRoot:     phase0.Root(fill(32, 0x03)),
+				ReceiptsRoot:  phase0.Root(fill(32, 0x04)),
+				LogsBloom:     [256]byte{},
+				PrevRandao:    [32]byte(fill(32, 0x05)),
+				BlockNumber:   1,
+				GasLimit:      30000000,
+				GasUsed:       0,
+				Timestamp:     1700000000,
+				ExtraData:     []byte{},
+				BaseFeePerGas: uint256.NewInt(7),
+				BlockHash:     phase0.Hash32(fill(32, 0x06)),
+				Transactions:  []bellatrix.Transaction{{0xab, 0xcd}},
+				Withdrawals:   []*capella.Withdrawal{},
+				BlobGasUsed:   131072,
+				ExcessBlobGas: 0,
+			},
+			expected: `{"parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","fee_recipient":"0x0202020202020202020202020202020202020202","state_root":"0x0303030303030303030303030303030303030303030303030303030303030303","receipts_root":"0x0404040404040404040404040404040404040404040404040404040404040404","logs_bloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","prev_randao":"0x0505050505050505050505050505050505050505050505050505050505050505","block_number":"1","gas_limit":"30000000","gas_used":"0","timestamp":"1700000000","extra_data":"0x","base_fee_per_gas":"7","block_hash":"0x0606060606060606060606060606060606060606060606060606060606060606","transactions":["0xabcd"],"withdrawals":[],"blob_gas_used":"131072","excess_blob_gas":"0"}`,
+		},
+		{
+			name: "ElectraWithdrawalRequest",
+			input: &electra.WithdrawalRequest{
+				SourceAddress:   bellatrix.ExecutionAddress(fill(20, 0x0a)),
+				ValidatorPubkey: phase0.BLSPubKey(fill(48, 0x0b)),
+				Amount:          0,
+			},
+			expected: `{"source_address":"0x0A0A0a0a0a0a0a0A0a0a0A0a0A0A0A0a0a0a0a0a","validator_pubkey":"0x0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b","amount":"0"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.input)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, string(data))
+			require.NoError(t, codecs.CheckCanonicalJSON(data))
+
+			// Round trip must be byte-identical.
+			output := reflect.New(reflect.TypeOf(test.input).Elem()).Interface()
+			require.NoError(t, json.Unmarshal(data, output))
+			rt, err := json.Marshal(output)
+			require.NoError(t, err)
+			require.Equal(t, string(data), string(rt))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committeecache
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                 zerolog.Level
+	beaconCommitteesProvider consensusclient.BeaconCommitteesProvider
+	specProvider             consensusclient.SpecProvider
+	eventsProvider           consensusclient.EventsProvider
+	maxEpochs                int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithBeaconCommitteesProvider sets the provider from which committees are fetched on a cache miss.
+func WithBeaconCommitteesProvider(provider consensusclient.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithSpecProvider sets the spec provider.
+func WithSpecProvider(provider consensusclient.SpecProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specProvider = provider
+	})
+}
+
+// WithEventsProvider sets the events provider.  Head events are used to
+// track the head and to evict committees whose dependent root changes.
+func WithEventsProvider(provider consensusclient.EventsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsProvider = provider
+	})
+}
+
+// WithMaxEpochs sets the maximum number of epochs for which committees are
+// cached.  When the limit is reached the least recently used epoch is
+// evicted.
+func WithMaxEpochs(maxEpochs int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxEpochs = maxEpochs
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:  zerolog.GlobalLevel(),
+		maxEpochs: 8,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("no beacon committees provider specified")
+	}
+	if parameters.specProvider == nil {
+		return nil, errors.New("no spec provider specified")
+	}
+	if parameters.eventsProvider == nil {
+		return nil, errors.New("no events provider specified")
+	}
+	if parameters.maxEpochs <= 0 {
+		return nil, errors.New("max epochs must be positive")
+	}
+
+	return &parameters, nil
+}
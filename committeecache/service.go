@@ -0,0 +1,330 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package committeecache provides a beacon committees provider that caches
+// the committees for each epoch, serving requests filtered by slot or
+// committee index locally rather than refetching them from the beacon node.
+//
+// The committees for an epoch are fixed once the block on which attester
+// duties for the epoch depend is fixed.  Head events are used to track these
+// dependent roots, and cached committees are evicted if the dependent root for
+// their epoch changes due to a reorg.
+//
+// Requests are served from the cache if they identify a state on the
+// canonical chain: "head", "genesis", "justified", "finalized" or a slot.
+// Requests for a state root are always passed to the beacon node, as the
+// state may be on a fork.  Committees returned from the cache are shared, and
+// must not be modified.
+package committeecache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a caching beacon committees provider.
+type Service struct {
+	log                      zerolog.Logger
+	beaconCommitteesProvider consensusclient.BeaconCommitteesProvider
+	slotsPerEpoch            uint64
+	maxEpochs                int
+
+	mu       sync.Mutex
+	headSeen bool
+	headSlot phase0.Slot
+	// dependentRoots are the roots on which the committees of each epoch
+	// depend, as supplied by head events.
+	dependentRoots map[phase0.Epoch]phase0.Root
+	entries        map[phase0.Epoch]*entry
+	// uses is incremented on each access, to track recency of use.
+	uses uint64
+}
+
+// entry is the cached committees for an epoch.
+type entry struct {
+	committees []*apiv1.BeaconCommittee
+	// dependentRoot is the dependent root of the committees; zero if unknown.
+	dependentRoot phase0.Root
+	lastUsed      uint64
+}
+
+// New creates a new committee cache.  The cache tracks the head until the
+// supplied context is canceled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "committeecache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	specResponse, err := parameters.specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+	slotsPerEpoch, isUint64 := specResponse.Data["SLOTS_PER_EPOCH"].(uint64)
+	if !isUint64 || slotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+	}
+
+	s := &Service{
+		log:                      log,
+		beaconCommitteesProvider: parameters.beaconCommitteesProvider,
+		slotsPerEpoch:            slotsPerEpoch,
+		maxEpochs:                parameters.maxEpochs,
+		dependentRoots:           make(map[phase0.Epoch]phase0.Root),
+		entries:                  make(map[phase0.Epoch]*entry),
+	}
+
+	if err := parameters.eventsProvider.Events(ctx, &api.EventsOpts{
+		Topics: []string{"head"},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			s.handleHead(event)
+		},
+	}); err != nil {
+		return nil, errors.Join(errors.New("failed to subscribe to head events"), err)
+	}
+
+	return s, nil
+}
+
+// BeaconCommittees fetches beacon committees for the given options, using
+// the cache where possible.
+func (s *Service) BeaconCommittees(ctx context.Context,
+	opts *api.BeaconCommitteesOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommittee],
+	error,
+) {
+	if opts == nil {
+		return nil, consensusclient.ErrNoOptions
+	}
+	if opts.State == "" {
+		return nil, errors.Join(errors.New("no state specified"), consensusclient.ErrInvalidOptions)
+	}
+	if strings.HasPrefix(opts.State, "0x") {
+		// State may not be canonical, so do not use the cache.
+		return s.beaconCommitteesProvider.BeaconCommittees(ctx, opts)
+	}
+
+	epoch, epochKnown := s.requestEpoch(opts)
+	if epochKnown {
+		if committees, exists := s.cached(epoch); exists {
+			return &api.Response[[]*apiv1.BeaconCommittee]{
+				Data:     filter(committees, opts),
+				Metadata: make(map[string]any),
+			}, nil
+		}
+	}
+
+	// Fetch all committees for the epoch, so that later requests for other
+	// slots and indices can be served from the cache.
+	fetchOpts := &api.BeaconCommitteesOpts{
+		Common: opts.Common,
+		State:  opts.State,
+		Epoch:  opts.Epoch,
+	}
+	if epochKnown {
+		fetchOpts.Epoch = &epoch
+	}
+	// Dependent roots are captured before fetching, so that committees
+	// fetched across a reorg are not cached against the new root.
+	dependentRoots := s.currentDependentRoots()
+	response, err := s.beaconCommitteesProvider.BeaconCommittees(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Data) > 0 {
+		responseEpoch := phase0.Epoch(uint64(response.Data[0].Slot) / s.slotsPerEpoch)
+		if epochKnown && responseEpoch != epoch {
+			return nil, fmt.Errorf("received committees for epoch %d, expected epoch %d", responseEpoch, epoch)
+		}
+		s.store(responseEpoch, dependentRoots[responseEpoch], response.Data)
+	}
+
+	return &api.Response[[]*apiv1.BeaconCommittee]{
+		Data:     filter(response.Data, opts),
+		Metadata: response.Metadata,
+	}, nil
+}
+
+// requestEpoch returns the epoch of the committees requested, if known.
+func (s *Service) requestEpoch(opts *api.BeaconCommitteesOpts) (phase0.Epoch, bool) {
+	if opts.Epoch != nil {
+		return *opts.Epoch, true
+	}
+
+	switch opts.State {
+	case "genesis":
+		return 0, true
+	case "head":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return phase0.Epoch(uint64(s.headSlot) / s.slotsPerEpoch), s.headSeen
+	case "justified", "finalized":
+		return 0, false
+	default:
+		slot, err := strconv.ParseUint(opts.State, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		return phase0.Epoch(slot / s.slotsPerEpoch), true
+	}
+}
+
+// cached returns the cached committees for the epoch, if present.
+func (s *Service) cached(epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[epoch]
+	if !exists {
+		return nil, false
+	}
+	s.uses++
+	entry.lastUsed = s.uses
+
+	return entry.committees, true
+}
+
+// currentDependentRoots returns a copy of the known dependent roots.
+func (s *Service) currentDependentRoots() map[phase0.Epoch]phase0.Root {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	roots := make(map[phase0.Epoch]phase0.Root, len(s.dependentRoots))
+	for epoch, root := range s.dependentRoots {
+		roots[epoch] = root
+	}
+
+	return roots
+}
+
+// store stores the committees for the epoch, evicting the least recently
+// used epoch if the cache is full.  The committees are discarded if the
+// dependent root for the epoch has changed since they were fetched.
+func (s *Service) store(epoch phase0.Epoch,
+	dependentRoot phase0.Root,
+	committees []*apiv1.BeaconCommittee,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dependentRoots[epoch] != dependentRoot {
+		s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Dependent root changed during fetch; not caching committees")
+
+		return
+	}
+
+	if _, exists := s.entries[epoch]; !exists && len(s.entries) >= s.maxEpochs {
+		var oldest phase0.Epoch
+		var oldestUse uint64
+		first := true
+		for cachedEpoch, cachedEntry := range s.entries {
+			if first || cachedEntry.lastUsed < oldestUse {
+				oldest = cachedEpoch
+				oldestUse = cachedEntry.lastUsed
+				first = false
+			}
+		}
+		delete(s.entries, oldest)
+	}
+
+	s.uses++
+	s.entries[epoch] = &entry{
+		committees:    committees,
+		dependentRoot: dependentRoot,
+		lastUsed:      s.uses,
+	}
+}
+
+// handleHead handles a head event, tracking the head and evicting
+// committees whose dependent root has changed.
+func (s *Service) handleHead(event *apiv1.HeadEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.headSeen = true
+	s.headSlot = event.Slot
+
+	// Attester duties, and hence committees, for the head epoch depend on
+	// the previous duty dependent root, and for the following epoch on the
+	// current duty dependent root.
+	headEpoch := phase0.Epoch(uint64(event.Slot) / s.slotsPerEpoch)
+	s.updateDependentRoot(headEpoch, event.PreviousDutyDependentRoot)
+	s.updateDependentRoot(headEpoch+1, event.CurrentDutyDependentRoot)
+}
+
+// updateDependentRoot updates the dependent root for an epoch.
+// Must be called with the lock held.
+func (s *Service) updateDependentRoot(epoch phase0.Epoch, root phase0.Root) {
+	if root.IsZero() {
+		return
+	}
+	s.dependentRoots[epoch] = root
+	// Only recent epochs can be affected by a reorg.
+	for dependentEpoch := range s.dependentRoots {
+		if dependentEpoch+2 < epoch {
+			delete(s.dependentRoots, dependentEpoch)
+		}
+	}
+
+	entry, exists := s.entries[epoch]
+	if !exists {
+		return
+	}
+	// Committees stored before the dependent root was known cannot be shown
+	// to match the root, so are evicted along with those that do not match.
+	if entry.dependentRoot != root {
+		s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Dependent root changed; evicting committees")
+		delete(s.entries, epoch)
+	}
+}
+
+// filter returns the committees that match the slot and index in the options.
+func filter(committees []*apiv1.BeaconCommittee, opts *api.BeaconCommitteesOpts) []*apiv1.BeaconCommittee {
+	if opts.Slot == nil && opts.Index == nil {
+		return committees
+	}
+
+	res := make([]*apiv1.BeaconCommittee, 0)
+	for _, committee := range committees {
+		if opts.Slot != nil && committee.Slot != *opts.Slot {
+			continue
+		}
+		if opts.Index != nil && committee.Index != *opts.Index {
+			continue
+		}
+		res = append(res, committee)
+	}
+
+	return res
+}
@@ -0,0 +1,351 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committeecache_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/committeecache"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	failingClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	failingClient.EventsFunc = func(context.Context, *api.EventsOpts) error {
+		return errors.New("mock error")
+	}
+
+	tests := []struct {
+		name   string
+		params []committeecache.Parameter
+		err    string
+	}{
+		{
+			name: "BeaconCommitteesProviderMissing",
+			params: []committeecache.Parameter{
+				committeecache.WithLogLevel(zerolog.Disabled),
+				committeecache.WithSpecProvider(client),
+				committeecache.WithEventsProvider(client),
+			},
+			err: "problem with parameters\nno beacon committees provider specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []committeecache.Parameter{
+				committeecache.WithLogLevel(zerolog.Disabled),
+				committeecache.WithBeaconCommitteesProvider(client),
+				committeecache.WithEventsProvider(client),
+			},
+			err: "problem with parameters\nno spec provider specified",
+		},
+		{
+			name: "EventsProviderMissing",
+			params: []committeecache.Parameter{
+				committeecache.WithLogLevel(zerolog.Disabled),
+				committeecache.WithBeaconCommitteesProvider(client),
+				committeecache.WithSpecProvider(client),
+			},
+			err: "problem with parameters\nno events provider specified",
+		},
+		{
+			name: "MaxEpochsZero",
+			params: []committeecache.Parameter{
+				committeecache.WithLogLevel(zerolog.Disabled),
+				committeecache.WithBeaconCommitteesProvider(client),
+				committeecache.WithSpecProvider(client),
+				committeecache.WithEventsProvider(client),
+				committeecache.WithMaxEpochs(0),
+			},
+			err: "problem with parameters\nmax epochs must be positive",
+		},
+		{
+			name: "EventsFails",
+			params: []committeecache.Parameter{
+				committeecache.WithLogLevel(zerolog.Disabled),
+				committeecache.WithBeaconCommitteesProvider(client),
+				committeecache.WithSpecProvider(client),
+				committeecache.WithEventsProvider(failingClient),
+			},
+			err: "failed to subscribe to head events\nmock error",
+		},
+		{
+			name: "Good",
+			params: []committeecache.Parameter{
+				committeecache.WithLogLevel(zerolog.Disabled),
+				committeecache.WithBeaconCommitteesProvider(client),
+				committeecache.WithSpecProvider(client),
+				committeecache.WithEventsProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := committeecache.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// countingClient creates a test client with 32 slots per epoch that returns
+// two committees for each slot of the requested epoch, counting the requests
+// made.
+func countingClient(t *testing.T, calls *atomic.Int32, headHandler *api.HeadEventHandlerFunc) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now(), map[string]any{
+		"SLOTS_PER_EPOCH": uint64(32),
+	})
+	require.NoError(t, err)
+	client.BeaconCommitteesFunc = func(_ context.Context, opts *api.BeaconCommitteesOpts) (*api.Response[[]*apiv1.BeaconCommittee], error) {
+		calls.Add(1)
+		// Requests without an epoch are for the finalized epoch 3.
+		epoch := phase0.Epoch(3)
+		if opts.Epoch != nil {
+			epoch = *opts.Epoch
+		}
+		committees := make([]*apiv1.BeaconCommittee, 0)
+		for slot := phase0.Slot(uint64(epoch) * 32); slot < phase0.Slot(uint64(epoch+1)*32); slot++ {
+			for index := phase0.CommitteeIndex(0); index < 2; index++ {
+				if opts.Slot != nil && *opts.Slot != slot {
+					continue
+				}
+				if opts.Index != nil && *opts.Index != index {
+					continue
+				}
+				committees = append(committees, &apiv1.BeaconCommittee{
+					Slot:       slot,
+					Index:      index,
+					Validators: []phase0.ValidatorIndex{phase0.ValidatorIndex(slot), phase0.ValidatorIndex(index)},
+				})
+			}
+		}
+
+		return &api.Response[[]*apiv1.BeaconCommittee]{
+			Data:     committees,
+			Metadata: make(map[string]any),
+		}, nil
+	}
+	client.EventsFunc = func(_ context.Context, opts *api.EventsOpts) error {
+		*headHandler = opts.HeadHandler
+
+		return nil
+	}
+
+	return client
+}
+
+func TestBeaconCommittees(t *testing.T) {
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	var headHandler api.HeadEventHandlerFunc
+	client := countingClient(t, &calls, &headHandler)
+
+	s, err := committeecache.New(ctx,
+		committeecache.WithLogLevel(zerolog.Disabled),
+		committeecache.WithBeaconCommitteesProvider(client),
+		committeecache.WithSpecProvider(client),
+		committeecache.WithEventsProvider(client),
+	)
+	require.NoError(t, err)
+
+	_, err = s.BeaconCommittees(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{})
+	require.ErrorContains(t, err, "no state specified")
+
+	epoch := phase0.Epoch(5)
+	slot := phase0.Slot(170)
+	index := phase0.CommitteeIndex(1)
+
+	// First request fetches the full epoch.
+	response, err := s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch, Slot: &slot})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+	require.Equal(t, int32(1), calls.Load())
+
+	// Further requests for the epoch are served from the cache.
+	response, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 64)
+	response, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch, Index: &index})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 32)
+	response, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch, Slot: &slot, Index: &index})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, slot, response.Data[0].Slot)
+	require.Equal(t, index, response.Data[0].Index)
+	// A state given by slot in the epoch is served from the cache.
+	response, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "175"})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 64)
+	require.Equal(t, int32(1), calls.Load())
+
+	// Requests by state root are always passed through.
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: "0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+		Epoch: &epoch,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+
+	// Requests for finalized are passed through, but cached for later requests.
+	response, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "finalized", Slot: &slot})
+	require.NoError(t, err)
+	require.Empty(t, response.Data)
+	require.Equal(t, int32(3), calls.Load())
+	response, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "96"})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 64)
+	require.Equal(t, int32(3), calls.Load())
+
+	// Head is known once a head event is received.  Committees cached before
+	// the dependent root was known are evicted, as they cannot be shown to
+	// match it.
+	headHandler(ctx, &apiv1.HeadEvent{
+		Slot:                      slot,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x02},
+	})
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head"})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), calls.Load())
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head"})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), calls.Load())
+
+	// A head event with the same dependent root leaves the cache intact.
+	headHandler(ctx, &apiv1.HeadEvent{
+		Slot:                      slot + 1,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x02},
+	})
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), calls.Load())
+
+	// A reorg changing the dependent root evicts the epoch.
+	headHandler(ctx, &apiv1.HeadEvent{
+		Slot:                      slot + 2,
+		PreviousDutyDependentRoot: phase0.Root{0x03},
+		CurrentDutyDependentRoot:  phase0.Root{0x04},
+	})
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), calls.Load())
+}
+
+func TestReorgDuringFetch(t *testing.T) {
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	var headHandler api.HeadEventHandlerFunc
+	client := countingClient(t, &calls, &headHandler)
+
+	s, err := committeecache.New(ctx,
+		committeecache.WithLogLevel(zerolog.Disabled),
+		committeecache.WithBeaconCommitteesProvider(client),
+		committeecache.WithSpecProvider(client),
+		committeecache.WithEventsProvider(client),
+	)
+	require.NoError(t, err)
+
+	epoch := phase0.Epoch(5)
+	headHandler(ctx, &apiv1.HeadEvent{
+		Slot:                      160,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x02},
+	})
+
+	// A reorg while the committees are being fetched changes the dependent
+	// root, so the fetched committees are not cached.
+	fetch := client.BeaconCommitteesFunc
+	client.BeaconCommitteesFunc = func(ctx context.Context, opts *api.BeaconCommitteesOpts) (*api.Response[[]*apiv1.BeaconCommittee], error) {
+		headHandler(ctx, &apiv1.HeadEvent{
+			Slot:                      161,
+			PreviousDutyDependentRoot: phase0.Root{0x03},
+			CurrentDutyDependentRoot:  phase0.Root{0x04},
+		})
+
+		return fetch(ctx, opts)
+	}
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), calls.Load())
+
+	client.BeaconCommitteesFunc = fetch
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+	_, err = s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestEviction(t *testing.T) {
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	var headHandler api.HeadEventHandlerFunc
+	client := countingClient(t, &calls, &headHandler)
+
+	s, err := committeecache.New(ctx,
+		committeecache.WithLogLevel(zerolog.Disabled),
+		committeecache.WithBeaconCommitteesProvider(client),
+		committeecache.WithSpecProvider(client),
+		committeecache.WithEventsProvider(client),
+		committeecache.WithMaxEpochs(2),
+	)
+	require.NoError(t, err)
+
+	fetch := func(epoch phase0.Epoch) {
+		_, err := s.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+		require.NoError(t, err)
+	}
+
+	fetch(1)
+	fetch(2)
+	fetch(1)
+	require.Equal(t, int32(2), calls.Load())
+
+	// Epoch 2 is least recently used, so is evicted.
+	fetch(3)
+	require.Equal(t, int32(3), calls.Load())
+	fetch(1)
+	require.Equal(t, int32(3), calls.Load())
+	fetch(2)
+	require.Equal(t, int32(4), calls.Load())
+}
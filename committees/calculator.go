@@ -0,0 +1,360 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package committees calculates beacon committees, block proposers and sync
+// committees directly from a beacon state, following the shuffling and seed
+// calculations in the specification.  This allows duties to be obtained for
+// any epoch for which the state holds the required information, without
+// further calls to a beacon node.
+package committees
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+var (
+	domainBeaconProposer = phase0.DomainType{0x00, 0x00, 0x00, 0x00}
+	domainBeaconAttester = phase0.DomainType{0x01, 0x00, 0x00, 0x00}
+	domainSyncCommittee  = phase0.DomainType{0x07, 0x00, 0x00, 0x00}
+)
+
+const (
+	// maxRandomByte is the maximum random value prior to Electra.
+	maxRandomByte = uint64(1<<8 - 1)
+	// maxRandomValue is the maximum random value from Electra onwards.
+	maxRandomValue = uint64(1<<16 - 1)
+)
+
+// Calculator calculates duties from a beacon state.
+type Calculator struct {
+	config *Config
+	state  *State
+	epoch  phase0.Epoch
+
+	mu sync.Mutex
+	// shufflings are the attester shufflings already calculated, by epoch.
+	shufflings map[phase0.Epoch]*shuffling
+}
+
+// shuffling is the attester shuffling for an epoch.
+type shuffling struct {
+	active   []phase0.ValidatorIndex
+	shuffled []uint64
+}
+
+// New creates a new calculator for the given configuration and state.
+func New(config *Config, state *State) (*Calculator, error) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+	if uint64(len(state.RANDAOMixes)) != config.EpochsPerHistoricalVector {
+		return nil, fmt.Errorf("state has %d RANDAO mixes, expected %d", len(state.RANDAOMixes), config.EpochsPerHistoricalVector)
+	}
+	if state.Version >= spec.DataVersionElectra && config.MaxEffectiveBalanceElectra == 0 {
+		return nil, errors.New("MAX_EFFECTIVE_BALANCE_ELECTRA required for Electra state")
+	}
+
+	return &Calculator{
+		config:     config,
+		state:      state,
+		epoch:      phase0.Epoch(uint64(state.Slot) / config.SlotsPerEpoch),
+		shufflings: make(map[phase0.Epoch]*shuffling),
+	}, nil
+}
+
+// CommitteesPerSlot returns the number of beacon committees in each slot of
+// the given epoch.
+func (c *Calculator) CommitteesPerSlot(epoch phase0.Epoch) (uint64, error) {
+	shuffling, err := c.shuffling(epoch)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.committeesPerSlot(uint64(len(shuffling.active))), nil
+}
+
+// BeaconCommittee returns the validators in the given beacon committee.
+func (c *Calculator) BeaconCommittee(slot phase0.Slot, index phase0.CommitteeIndex) ([]phase0.ValidatorIndex, error) {
+	epoch := phase0.Epoch(uint64(slot) / c.config.SlotsPerEpoch)
+	shuffling, err := c.shuffling(epoch)
+	if err != nil {
+		return nil, err
+	}
+	committeesPerSlot := c.committeesPerSlot(uint64(len(shuffling.active)))
+	if uint64(index) >= committeesPerSlot {
+		return nil, fmt.Errorf("committee index %d not below committees per slot %d", index, committeesPerSlot)
+	}
+
+	return c.committee(shuffling, slot, index, committeesPerSlot), nil
+}
+
+// BeaconCommittees returns all of the beacon committees for the given epoch.
+func (c *Calculator) BeaconCommittees(epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	shuffling, err := c.shuffling(epoch)
+	if err != nil {
+		return nil, err
+	}
+	committeesPerSlot := c.committeesPerSlot(uint64(len(shuffling.active)))
+
+	res := make([]*apiv1.BeaconCommittee, 0, c.config.SlotsPerEpoch*committeesPerSlot)
+	startSlot := phase0.Slot(uint64(epoch) * c.config.SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+phase0.Slot(c.config.SlotsPerEpoch); slot++ {
+		for index := phase0.CommitteeIndex(0); uint64(index) < committeesPerSlot; index++ {
+			res = append(res, &apiv1.BeaconCommittee{
+				Slot:       slot,
+				Index:      index,
+				Validators: c.committee(shuffling, slot, index, committeesPerSlot),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// ProposerDuties returns the block proposers for the given epoch.  As
+// proposer selection depends on effective balances, which change at epoch
+// boundaries, only the epoch of the state is supported.
+func (c *Calculator) ProposerDuties(epoch phase0.Epoch) ([]*apiv1.ProposerDuty, error) {
+	if epoch != c.epoch {
+		return nil, fmt.Errorf("proposers can only be calculated for the state epoch %d", c.epoch)
+	}
+
+	active := c.activeValidatorIndices(epoch)
+	if len(active) == 0 {
+		return nil, errors.New("no active validators")
+	}
+	epochSeed := c.seed(epoch, domainBeaconProposer)
+
+	res := make([]*apiv1.ProposerDuty, 0, c.config.SlotsPerEpoch)
+	startSlot := phase0.Slot(uint64(epoch) * c.config.SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+phase0.Slot(c.config.SlotsPerEpoch); slot++ {
+		var input [40]byte
+		copy(input[:], epochSeed[:])
+		binary.LittleEndian.PutUint64(input[32:], uint64(slot))
+		proposer := c.proposerIndex(active, sha256.Sum256(input[:]))
+		res = append(res, &apiv1.ProposerDuty{
+			PubKey:         c.state.Validators[proposer].PublicKey,
+			Slot:           slot,
+			ValidatorIndex: proposer,
+		})
+	}
+
+	return res, nil
+}
+
+// SyncCommittee returns the validators in the sync committee for the given
+// period, which must be either the period of the state or the following
+// period.  Validators are returned in sync committee position order, and
+// can appear more than once.
+func (c *Calculator) SyncCommittee(period uint64) ([]phase0.ValidatorIndex, error) {
+	if c.state.Version < spec.DataVersionAltair {
+		return nil, errors.New("sync committees not available prior to Altair")
+	}
+
+	statePeriod := uint64(c.epoch) / c.config.EpochsPerSyncCommitteePeriod
+	var pubKeys []phase0.BLSPubKey
+	switch period {
+	case statePeriod:
+		pubKeys = c.state.CurrentSyncCommittee
+	case statePeriod + 1:
+		pubKeys = c.state.NextSyncCommittee
+	default:
+		return nil, fmt.Errorf("sync committee for period %d not available from state in period %d", period, statePeriod)
+	}
+	if len(pubKeys) == 0 {
+		return nil, fmt.Errorf("sync committee for period %d missing from state", period)
+	}
+
+	indices := make(map[phase0.BLSPubKey]phase0.ValidatorIndex, len(c.state.Validators))
+	for i, validator := range c.state.Validators {
+		indices[validator.PublicKey] = phase0.ValidatorIndex(i)
+	}
+	res := make([]phase0.ValidatorIndex, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		index, exists := indices[pubKey]
+		if !exists {
+			return nil, fmt.Errorf("sync committee member %#x not found in validators", pubKey)
+		}
+		res[i] = index
+	}
+
+	return res, nil
+}
+
+// NextSyncCommitteeIndices calculates the indices of the validators for the
+// next sync committee, as per get_next_sync_committee_indices in the
+// specification.  The state calculates this at the end of the last epoch of
+// each sync committee period, at which point the result becomes the
+// committee for the period after the following period.
+func (c *Calculator) NextSyncCommitteeIndices() ([]phase0.ValidatorIndex, error) {
+	if c.state.Version < spec.DataVersionAltair {
+		return nil, errors.New("sync committees not available prior to Altair")
+	}
+
+	epoch := c.epoch + 1
+	active := c.activeValidatorIndices(epoch)
+	if len(active) == 0 {
+		return nil, errors.New("no active validators")
+	}
+	seed := c.seed(epoch, domainSyncCommittee)
+	count := uint64(len(active))
+
+	res := make([]phase0.ValidatorIndex, 0, c.config.SyncCommitteeSize)
+	for i := uint64(0); uint64(len(res)) < c.config.SyncCommitteeSize; i++ {
+		candidate := active[shuffledIndex(i%count, count, seed, c.config.ShuffleRoundCount)]
+		if c.selected(seed, i, candidate) {
+			res = append(res, candidate)
+		}
+	}
+
+	return res, nil
+}
+
+// shuffling returns the attester shuffling for the given epoch.
+func (c *Calculator) shuffling(epoch phase0.Epoch) (*shuffling, error) {
+	if err := c.checkEpoch(epoch); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if res, exists := c.shufflings[epoch]; exists {
+		return res, nil
+	}
+
+	active := c.activeValidatorIndices(epoch)
+	res := &shuffling{
+		active:   active,
+		shuffled: shuffledIndices(uint64(len(active)), c.seed(epoch, domainBeaconAttester), c.config.ShuffleRoundCount),
+	}
+	c.shufflings[epoch] = res
+
+	return res, nil
+}
+
+// checkEpoch ensures that the state contains the RANDAO mix required to
+// calculate the seed for the given epoch.
+func (c *Calculator) checkEpoch(epoch phase0.Epoch) error {
+	if epoch > c.epoch+c.config.MinSeedLookahead {
+		return fmt.Errorf("epoch %d too far in the future for state epoch %d", epoch, c.epoch)
+	}
+	if uint64(c.epoch)+uint64(c.config.MinSeedLookahead)+1 >= uint64(epoch)+c.config.EpochsPerHistoricalVector {
+		return fmt.Errorf("epoch %d too far in the past for state epoch %d", epoch, c.epoch)
+	}
+
+	return nil
+}
+
+// committeesPerSlot returns the number of committees per slot, as per
+// get_committee_count_per_slot in the specification.
+func (c *Calculator) committeesPerSlot(activeValidators uint64) uint64 {
+	return max(1, min(c.config.MaxCommitteesPerSlot, activeValidators/c.config.SlotsPerEpoch/c.config.TargetCommitteeSize))
+}
+
+// committee returns the validators in a committee, as per compute_committee
+// in the specification.
+func (c *Calculator) committee(shuffling *shuffling,
+	slot phase0.Slot,
+	index phase0.CommitteeIndex,
+	committeesPerSlot uint64,
+) []phase0.ValidatorIndex {
+	count := committeesPerSlot * c.config.SlotsPerEpoch
+	committee := (uint64(slot)%c.config.SlotsPerEpoch)*committeesPerSlot + uint64(index)
+	total := uint64(len(shuffling.active))
+	start := total * committee / count
+	end := total * (committee + 1) / count
+
+	res := make([]phase0.ValidatorIndex, 0, end-start)
+	for i := start; i < end; i++ {
+		res = append(res, shuffling.active[shuffling.shuffled[i]])
+	}
+
+	return res
+}
+
+// activeValidatorIndices returns the indices of the validators active at
+// the given epoch.
+func (c *Calculator) activeValidatorIndices(epoch phase0.Epoch) []phase0.ValidatorIndex {
+	res := make([]phase0.ValidatorIndex, 0, len(c.state.Validators))
+	for i, validator := range c.state.Validators {
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			res = append(res, phase0.ValidatorIndex(i))
+		}
+	}
+
+	return res
+}
+
+// seed returns the seed for the given epoch and domain type, as per
+// get_seed in the specification.
+func (c *Calculator) seed(epoch phase0.Epoch, domainType phase0.DomainType) [32]byte {
+	ehv := c.config.EpochsPerHistoricalVector
+	mix := c.state.RANDAOMixes[(uint64(epoch)+ehv-uint64(c.config.MinSeedLookahead)-1)%ehv]
+
+	var input [44]byte
+	copy(input[:4], domainType[:])
+	binary.LittleEndian.PutUint64(input[4:12], uint64(epoch))
+	copy(input[12:], mix[:])
+
+	return sha256.Sum256(input[:])
+}
+
+// proposerIndex selects a proposer from the active validators, as per
+// compute_proposer_index in the specification.
+func (c *Calculator) proposerIndex(active []phase0.ValidatorIndex, seed [32]byte) phase0.ValidatorIndex {
+	count := uint64(len(active))
+	for i := uint64(0); ; i++ {
+		candidate := active[shuffledIndex(i%count, count, seed, c.config.ShuffleRoundCount)]
+		if c.selected(seed, i, candidate) {
+			return candidate
+		}
+	}
+}
+
+// selected returns true if the candidate is selected at iteration i,
+// weighting selection by effective balance.
+func (c *Calculator) selected(seed [32]byte, i uint64, candidate phase0.ValidatorIndex) bool {
+	effectiveBalance := uint64(c.state.Validators[candidate].EffectiveBalance)
+
+	var input [40]byte
+	copy(input[:], seed[:])
+	if c.state.Version >= spec.DataVersionElectra {
+		binary.LittleEndian.PutUint64(input[32:], i/16)
+		hash := sha256.Sum256(input[:])
+		offset := (i % 16) * 2
+		randomValue := uint64(binary.LittleEndian.Uint16(hash[offset : offset+2]))
+
+		return effectiveBalance*maxRandomValue >= uint64(c.config.MaxEffectiveBalanceElectra)*randomValue
+	}
+
+	binary.LittleEndian.PutUint64(input[32:], i/32)
+	hash := sha256.Sum256(input[:])
+	randomByte := uint64(hash[i%32])
+
+	return effectiveBalance*maxRandomByte >= uint64(c.config.MaxEffectiveBalance)*randomByte
+}
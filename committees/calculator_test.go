@@ -0,0 +1,272 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/committees"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *committees.Config {
+	return &committees.Config{
+		SlotsPerEpoch:                4,
+		MaxCommitteesPerSlot:         4,
+		TargetCommitteeSize:          2,
+		ShuffleRoundCount:            90,
+		MinSeedLookahead:             1,
+		EpochsPerHistoricalVector:    16,
+		EpochsPerSyncCommitteePeriod: 2,
+		SyncCommitteeSize:            8,
+		MaxEffectiveBalance:          32_000_000_000,
+		MaxEffectiveBalanceElectra:   2_048_000_000_000,
+	}
+}
+
+// fixtureState creates a state at the given slot with 40 validators, of which
+// validator 5 exits at epoch 2 and validators 36-39 activate at epoch 3.
+// Every third validator has an effective balance of 16 ETH.
+func fixtureState(t *testing.T, version spec.DataVersion, slot phase0.Slot) *committees.State {
+	t.Helper()
+
+	beaconState, err := testfixtures.State(version,
+		testfixtures.WithSlot(slot),
+		testfixtures.WithValidators(40),
+	)
+	require.NoError(t, err)
+	state, err := committees.StateFromBeaconState(beaconState)
+	require.NoError(t, err)
+
+	for i, validator := range state.Validators {
+		if i%3 == 0 {
+			validator.EffectiveBalance = 16_000_000_000
+		}
+		if i >= 36 {
+			validator.ActivationEpoch = 3
+		}
+	}
+	state.Validators[5].ExitEpoch = 2
+
+	// Use a short RANDAO mix history to match the configuration.
+	state.RANDAOMixes = make([]phase0.Root, 16)
+	for i := range state.RANDAOMixes {
+		state.RANDAOMixes[i] = sha256.Sum256([]byte{byte(i)})
+	}
+
+	return state
+}
+
+func TestConfigFromSpec(t *testing.T) {
+	specValues := map[string]any{
+		"SLOTS_PER_EPOCH":                  uint64(32),
+		"MAX_COMMITTEES_PER_SLOT":          uint64(64),
+		"TARGET_COMMITTEE_SIZE":            uint64(128),
+		"SHUFFLE_ROUND_COUNT":              uint64(90),
+		"MIN_SEED_LOOKAHEAD":               uint64(1),
+		"EPOCHS_PER_HISTORICAL_VECTOR":     uint64(65536),
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(256),
+		"SYNC_COMMITTEE_SIZE":              uint64(512),
+		"MAX_EFFECTIVE_BALANCE":            uint64(32_000_000_000),
+	}
+	config, err := committees.ConfigFromSpec(specValues)
+	require.NoError(t, err)
+	require.Equal(t, uint64(90), config.ShuffleRoundCount)
+	require.Zero(t, config.MaxEffectiveBalanceElectra)
+
+	specValues["MAX_EFFECTIVE_BALANCE_ELECTRA"] = uint64(2_048_000_000_000)
+	config, err = committees.ConfigFromSpec(specValues)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(2_048_000_000_000), config.MaxEffectiveBalanceElectra)
+
+	delete(specValues, "SHUFFLE_ROUND_COUNT")
+	_, err = committees.ConfigFromSpec(specValues)
+	require.EqualError(t, err, "SHUFFLE_ROUND_COUNT not found in spec")
+
+	specValues["SHUFFLE_ROUND_COUNT"] = uint64(90)
+	specValues["TARGET_COMMITTEE_SIZE"] = uint64(0)
+	_, err = committees.ConfigFromSpec(specValues)
+	require.EqualError(t, err, "TARGET_COMMITTEE_SIZE cannot be 0")
+}
+
+func TestNew(t *testing.T) {
+	_, err := committees.New(nil, fixtureState(t, spec.DataVersionPhase0, 4))
+	require.EqualError(t, err, "no config supplied")
+
+	_, err = committees.New(testConfig(), nil)
+	require.EqualError(t, err, "no state supplied")
+
+	state := fixtureState(t, spec.DataVersionPhase0, 4)
+	state.RANDAOMixes = state.RANDAOMixes[:8]
+	_, err = committees.New(testConfig(), state)
+	require.EqualError(t, err, "state has 8 RANDAO mixes, expected 16")
+
+	config := testConfig()
+	config.MaxEffectiveBalanceElectra = 0
+	_, err = committees.New(config, fixtureState(t, spec.DataVersionElectra, 4))
+	require.EqualError(t, err, "MAX_EFFECTIVE_BALANCE_ELECTRA required for Electra state")
+}
+
+func TestBeaconCommittees(t *testing.T) {
+	calculator, err := committees.New(testConfig(), fixtureState(t, spec.DataVersionPhase0, 5))
+	require.NoError(t, err)
+
+	committeesPerSlot, err := calculator.CommitteesPerSlot(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), committeesPerSlot)
+
+	beaconCommittees, err := calculator.BeaconCommittees(1)
+	require.NoError(t, err)
+	require.Len(t, beaconCommittees, 16)
+	require.Equal(t, &apiv1.BeaconCommittee{Slot: 4, Index: 0, Validators: []phase0.ValidatorIndex{10, 26}}, beaconCommittees[0])
+	require.Equal(t, &apiv1.BeaconCommittee{Slot: 4, Index: 3, Validators: []phase0.ValidatorIndex{32, 15, 1}}, beaconCommittees[3])
+	require.Equal(t, &apiv1.BeaconCommittee{Slot: 7, Index: 3, Validators: []phase0.ValidatorIndex{7, 18, 35}}, beaconCommittees[15])
+
+	// Next epoch, in which validator 5 has exited.
+	committee, err := calculator.BeaconCommittee(9, 1)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1, 18, 14}, committee)
+	committee, err = calculator.BeaconCommittee(11, 3)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{29, 27, 11}, committee)
+
+	_, err = calculator.BeaconCommittee(9, 4)
+	require.EqualError(t, err, "committee index 4 not below committees per slot 4")
+
+	_, err = calculator.BeaconCommittees(3)
+	require.EqualError(t, err, "epoch 3 too far in the future for state epoch 1")
+}
+
+func TestBeaconCommitteesPast(t *testing.T) {
+	calculator, err := committees.New(testConfig(), fixtureState(t, spec.DataVersionPhase0, 4*15))
+	require.NoError(t, err)
+
+	_, err = calculator.BeaconCommittees(1)
+	require.EqualError(t, err, "epoch 1 too far in the past for state epoch 15")
+
+	_, err = calculator.BeaconCommittees(2)
+	require.NoError(t, err)
+}
+
+func TestProposerDuties(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   spec.DataVersion
+		proposers []phase0.ValidatorIndex
+	}{
+		{
+			name:      "Phase0",
+			version:   spec.DataVersionPhase0,
+			proposers: []phase0.ValidatorIndex{16, 33, 13, 29},
+		},
+		{
+			name:      "Electra",
+			version:   spec.DataVersionElectra,
+			proposers: []phase0.ValidatorIndex{3, 35, 1, 16},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calculator, err := committees.New(testConfig(), fixtureState(t, test.version, 6))
+			require.NoError(t, err)
+
+			duties, err := calculator.ProposerDuties(1)
+			require.NoError(t, err)
+			require.Len(t, duties, len(test.proposers))
+			for i, duty := range duties {
+				require.Equal(t, phase0.Slot(4+i), duty.Slot)
+				require.Equal(t, test.proposers[i], duty.ValidatorIndex)
+				require.Equal(t, testfixtures.PubKey(test.proposers[i]), duty.PubKey)
+			}
+
+			_, err = calculator.ProposerDuties(2)
+			require.EqualError(t, err, "proposers can only be calculated for the state epoch 1")
+		})
+	}
+}
+
+func TestNextSyncCommitteeIndices(t *testing.T) {
+	calculator, err := committees.New(testConfig(), fixtureState(t, spec.DataVersionPhase0, 6))
+	require.NoError(t, err)
+	_, err = calculator.NextSyncCommitteeIndices()
+	require.EqualError(t, err, "sync committees not available prior to Altair")
+
+	calculator, err = committees.New(testConfig(), fixtureState(t, spec.DataVersionAltair, 6))
+	require.NoError(t, err)
+	indices, err := calculator.NextSyncCommitteeIndices()
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{32, 1, 29, 9, 17, 7, 28, 6}, indices)
+
+	calculator, err = committees.New(testConfig(), fixtureState(t, spec.DataVersionElectra, 6))
+	require.NoError(t, err)
+	indices, err = calculator.NextSyncCommitteeIndices()
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{33, 30, 0, 7, 10, 19, 19, 31}, indices)
+}
+
+func TestSyncCommittee(t *testing.T) {
+	state := fixtureState(t, spec.DataVersionAltair, 6)
+	state.CurrentSyncCommittee = []phase0.BLSPubKey{testfixtures.PubKey(1), testfixtures.PubKey(2), testfixtures.PubKey(1)}
+	state.NextSyncCommittee = []phase0.BLSPubKey{testfixtures.PubKey(3)}
+	calculator, err := committees.New(testConfig(), state)
+	require.NoError(t, err)
+
+	indices, err := calculator.SyncCommittee(0)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1, 2, 1}, indices)
+
+	indices, err = calculator.SyncCommittee(1)
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{3}, indices)
+
+	_, err = calculator.SyncCommittee(2)
+	require.EqualError(t, err, "sync committee for period 2 not available from state in period 0")
+
+	state.CurrentSyncCommittee = []phase0.BLSPubKey{{0xff}}
+	calculator, err = committees.New(testConfig(), state)
+	require.NoError(t, err)
+	_, err = calculator.SyncCommittee(0)
+	require.ErrorContains(t, err, "not found in validators")
+}
+
+func TestStateFromBeaconState(t *testing.T) {
+	_, err := committees.StateFromBeaconState(nil)
+	require.EqualError(t, err, "no beacon state supplied")
+
+	beaconState := &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconState{
+			Slot:                 12,
+			Validators:           []*phase0.Validator{{}},
+			RANDAOMixes:          []phase0.Root{{0x01}},
+			CurrentSyncCommittee: &altair.SyncCommittee{Pubkeys: []phase0.BLSPubKey{{0x02}}},
+			NextSyncCommittee:    &altair.SyncCommittee{Pubkeys: []phase0.BLSPubKey{{0x03}}},
+		},
+	}
+	state, err := committees.StateFromBeaconState(beaconState)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionAltair, state.Version)
+	require.Equal(t, phase0.Slot(12), state.Slot)
+	require.Len(t, state.Validators, 1)
+	require.Equal(t, []phase0.Root{{0x01}}, state.RANDAOMixes)
+	require.Equal(t, []phase0.BLSPubKey{{0x02}}, state.CurrentSyncCommittee)
+	require.Equal(t, []phase0.BLSPubKey{{0x03}}, state.NextSyncCommittee)
+}
@@ -0,0 +1,84 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Config contains the chain configuration values used for calculation.
+type Config struct {
+	SlotsPerEpoch                uint64
+	MaxCommitteesPerSlot         uint64
+	TargetCommitteeSize          uint64
+	ShuffleRoundCount            uint64
+	MinSeedLookahead             phase0.Epoch
+	EpochsPerHistoricalVector    uint64
+	EpochsPerSyncCommitteePeriod uint64
+	SyncCommitteeSize            uint64
+	MaxEffectiveBalance          phase0.Gwei
+	// MaxEffectiveBalanceElectra is only required for Electra states.
+	MaxEffectiveBalanceElectra phase0.Gwei
+}
+
+// ConfigFromSpec creates a configuration from the values returned by a spec
+// provider.
+func ConfigFromSpec(data map[string]any) (*Config, error) {
+	config := &Config{}
+
+	values := []spec.Uint64Value{
+		{Key: "SLOTS_PER_EPOCH", Apply: func(v uint64) { config.SlotsPerEpoch = v }},
+		{Key: "MAX_COMMITTEES_PER_SLOT", Apply: func(v uint64) { config.MaxCommitteesPerSlot = v }},
+		{Key: "TARGET_COMMITTEE_SIZE", Apply: func(v uint64) { config.TargetCommitteeSize = v }},
+		{Key: "SHUFFLE_ROUND_COUNT", Apply: func(v uint64) { config.ShuffleRoundCount = v }},
+		{Key: "MIN_SEED_LOOKAHEAD", Apply: func(v uint64) { config.MinSeedLookahead = phase0.Epoch(v) }},
+		{Key: "EPOCHS_PER_HISTORICAL_VECTOR", Apply: func(v uint64) { config.EpochsPerHistoricalVector = v }},
+		{Key: "EPOCHS_PER_SYNC_COMMITTEE_PERIOD", Apply: func(v uint64) { config.EpochsPerSyncCommitteePeriod = v }},
+		{Key: "SYNC_COMMITTEE_SIZE", Apply: func(v uint64) { config.SyncCommitteeSize = v }},
+		{Key: "MAX_EFFECTIVE_BALANCE", Apply: func(v uint64) { config.MaxEffectiveBalance = phase0.Gwei(v) }},
+		{Key: "MAX_EFFECTIVE_BALANCE_ELECTRA", Optional: true, Apply: func(v uint64) { config.MaxEffectiveBalanceElectra = phase0.Gwei(v) }},
+	}
+	if err := spec.ReadUint64Values(data, values); err != nil {
+		return nil, err
+	}
+
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check ensures that values used as divisors or limits are present.
+func (c *Config) check() error {
+	switch {
+	case c.SlotsPerEpoch == 0:
+		return errors.New("SLOTS_PER_EPOCH cannot be 0")
+	case c.MaxCommitteesPerSlot == 0:
+		return errors.New("MAX_COMMITTEES_PER_SLOT cannot be 0")
+	case c.TargetCommitteeSize == 0:
+		return errors.New("TARGET_COMMITTEE_SIZE cannot be 0")
+	case c.EpochsPerHistoricalVector == 0:
+		return errors.New("EPOCHS_PER_HISTORICAL_VECTOR cannot be 0")
+	case c.EpochsPerSyncCommitteePeriod == 0:
+		return errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD cannot be 0")
+	case c.MaxEffectiveBalance == 0:
+		return errors.New("MAX_EFFECTIVE_BALANCE cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// pivot returns the pivot for the given round of the shuffle.
+func pivot(seed [32]byte, round uint64, count uint64) uint64 {
+	var input [33]byte
+	copy(input[:], seed[:])
+	input[32] = byte(round)
+	hash := sha256.Sum256(input[:])
+
+	return binary.LittleEndian.Uint64(hash[:8]) % count
+}
+
+// source returns the source of shuffle bits for the given round and
+// position.
+func source(seed [32]byte, round uint64, position uint64) [32]byte {
+	var input [37]byte
+	copy(input[:], seed[:])
+	input[32] = byte(round)
+	binary.LittleEndian.PutUint32(input[33:], uint32(position/256))
+
+	return sha256.Sum256(input[:])
+}
+
+// shuffledIndex returns the shuffled index of a single index, as per
+// compute_shuffled_index in the specification.
+func shuffledIndex(index uint64, count uint64, seed [32]byte, rounds uint64) uint64 {
+	for round := uint64(0); round < rounds; round++ {
+		flip := (pivot(seed, round, count) + count - index) % count
+		position := max(index, flip)
+		bits := source(seed, round, position)
+		if (bits[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+
+	return index
+}
+
+// shuffledIndices returns the shuffled index of every index up to count,
+// such that res[i] == shuffledIndex(i, count, seed, rounds).  This is
+// considerably faster than calling shuffledIndex for each index, as each
+// source hash is calculated only once per round.
+func shuffledIndices(count uint64, seed [32]byte, rounds uint64) []uint64 {
+	res := make([]uint64, count)
+	for i := range res {
+		res[i] = uint64(i)
+	}
+	if count == 0 {
+		return res
+	}
+
+	sources := make([][32]byte, (count+255)/256)
+	calculated := make([]bool, len(sources))
+	for round := uint64(0); round < rounds; round++ {
+		roundPivot := pivot(seed, round, count)
+		for i := range calculated {
+			calculated[i] = false
+		}
+		for i, index := range res {
+			flip := (roundPivot + count - index) % count
+			position := max(index, flip)
+			chunk := position / 256
+			if !calculated[chunk] {
+				sources[chunk] = source(seed, round, position)
+				calculated[chunk] = true
+			}
+			if (sources[chunk][(position%256)/8]>>(position%8))&1 == 1 {
+				res[i] = flip
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,46 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShuffledIndex(t *testing.T) {
+	seed := sha256.Sum256([]byte("seed"))
+
+	expected := []uint64{5, 6, 0, 3, 4, 1, 8, 9, 7, 2}
+	for i := uint64(0); i < uint64(len(expected)); i++ {
+		require.Equal(t, expected[i], shuffledIndex(i, 10, seed, 90))
+	}
+	require.Equal(t, expected, shuffledIndices(10, seed, 90))
+}
+
+func TestShuffledIndicesLarge(t *testing.T) {
+	seed := sha256.Sum256([]byte("large"))
+	count := uint64(1000)
+
+	shuffled := shuffledIndices(count, seed, 90)
+	seen := make(map[uint64]bool, count)
+	for i := uint64(0); i < count; i++ {
+		require.Equal(t, shuffledIndex(i, count, seed, 90), shuffled[i])
+		require.False(t, seen[shuffled[i]])
+		seen[shuffled[i]] = true
+	}
+
+	require.Empty(t, shuffledIndices(0, seed, 90))
+}
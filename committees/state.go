@@ -0,0 +1,72 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package committees
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// State contains the beacon state values used for calculation.
+type State struct {
+	Version     spec.DataVersion
+	Slot        phase0.Slot
+	Validators  []*phase0.Validator
+	RANDAOMixes []phase0.Root
+	// CurrentSyncCommittee and NextSyncCommittee are the public keys of the
+	// sync committee members; they are nil prior to Altair.
+	CurrentSyncCommittee []phase0.BLSPubKey
+	NextSyncCommittee    []phase0.BLSPubKey
+}
+
+// StateFromBeaconState creates a state from a beacon state.
+func StateFromBeaconState(beaconState *spec.VersionedBeaconState) (*State, error) {
+	if beaconState == nil {
+		return nil, errors.New("no beacon state supplied")
+	}
+
+	var err error
+	state := &State{
+		Version: beaconState.Version,
+	}
+	if state.Slot, err = beaconState.Slot(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain slot"), err)
+	}
+	if state.Validators, err = beaconState.Validators(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain validators"), err)
+	}
+	if state.RANDAOMixes, err = beaconState.RANDAOMixes(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain RANDAO mixes"), err)
+	}
+	if beaconState.Version >= spec.DataVersionAltair {
+		currentSyncCommittee, err := beaconState.CurrentSyncCommittee()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain current sync committee"), err)
+		}
+		if currentSyncCommittee != nil {
+			state.CurrentSyncCommittee = currentSyncCommittee.Pubkeys
+		}
+		nextSyncCommittee, err := beaconState.NextSyncCommittee()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain next sync committee"), err)
+		}
+		if nextSyncCommittee != nil {
+			state.NextSyncCommittee = nextSyncCommittee.Pubkeys
+		}
+	}
+
+	return state, nil
+}
@@ -0,0 +1,169 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Check is a conformance check against a single beacon API endpoint.
+type Check struct {
+	// Name is the name of the check, used in the report.
+	Name string
+	// Path is the path of the endpoint, including any query parameters.
+	Path string
+	// List is true if the data returned by the endpoint is an array.
+	List bool
+	// Fields are the fields that must be present in the data, or in each
+	// element of the data if it is an array.
+	Fields []string
+	// Metadata are the fields that must be present alongside the data.
+	Metadata []string
+	// Versioned is true if the response must carry a consensus version, both
+	// in the body and in the Eth-Consensus-Version header.
+	Versioned bool
+	// SSZ is true if the endpoint must also be served as SSZ.
+	SSZ bool
+	// Type returns a new value of the type in this module that holds the
+	// data, or each element of the data if it is an array.  If set, the data
+	// is decoded in to it with strict JSON decoding, so that fields unknown
+	// to this module are reported.
+	Type func() any
+}
+
+// DefaultChecks returns the default set of checks.  These cover endpoints
+// that do not require parameters beyond the head of the chain, and do not
+// include the beacon state as its size makes it unsuitable for routine checks.
+func DefaultChecks() []*Check {
+	return []*Check{
+		{
+			Name:   "NodeVersion",
+			Path:   "/eth/v1/node/version",
+			Fields: []string{"version"},
+		},
+		{
+			Name:   "NodeSyncing",
+			Path:   "/eth/v1/node/syncing",
+			Fields: []string{"head_slot", "sync_distance", "is_syncing"},
+		},
+		{
+			Name:   "NodeIdentity",
+			Path:   "/eth/v1/node/identity",
+			Fields: []string{"peer_id", "enr", "p2p_addresses", "discovery_addresses", "metadata"},
+		},
+		{
+			Name:   "NodePeerCount",
+			Path:   "/eth/v1/node/peer_count",
+			Fields: []string{"disconnected", "connecting", "connected", "disconnecting"},
+		},
+		{
+			Name:   "Genesis",
+			Path:   "/eth/v1/beacon/genesis",
+			Fields: []string{"genesis_time", "genesis_validators_root", "genesis_fork_version"},
+			Type:   func() any { return &apiv1.Genesis{} },
+		},
+		{
+			Name:   "Spec",
+			Path:   "/eth/v1/config/spec",
+			Fields: []string{"SECONDS_PER_SLOT", "SLOTS_PER_EPOCH"},
+		},
+		{
+			Name:   "ForkSchedule",
+			Path:   "/eth/v1/config/fork_schedule",
+			List:   true,
+			Fields: []string{"previous_version", "current_version", "epoch"},
+			Type:   func() any { return &phase0.Fork{} },
+		},
+		{
+			Name:   "DepositContract",
+			Path:   "/eth/v1/config/deposit_contract",
+			Fields: []string{"chain_id", "address"},
+			Type:   func() any { return &apiv1.DepositContract{} },
+		},
+		{
+			Name:     "BeaconBlockHeader",
+			Path:     "/eth/v1/beacon/headers/head",
+			Fields:   []string{"root", "canonical", "header"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+			Type:     func() any { return &apiv1.BeaconBlockHeader{} },
+		},
+		{
+			Name:     "BeaconBlockRoot",
+			Path:     "/eth/v1/beacon/blocks/head/root",
+			Fields:   []string{"root"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+		},
+		{
+			Name:      "SignedBeaconBlock",
+			Path:      "/eth/v2/beacon/blocks/head",
+			Fields:    []string{"message", "signature"},
+			Metadata:  []string{"execution_optimistic", "finalized"},
+			Versioned: true,
+			SSZ:       true,
+		},
+		{
+			Name:      "BlindedBeaconBlock",
+			Path:      "/eth/v1/beacon/blinded_blocks/head",
+			Fields:    []string{"message", "signature"},
+			Metadata:  []string{"execution_optimistic", "finalized"},
+			Versioned: true,
+			SSZ:       true,
+		},
+		{
+			Name:      "BlockAttestations",
+			Path:      "/eth/v2/beacon/blocks/head/attestations",
+			List:      true,
+			Fields:    []string{"aggregation_bits", "data", "signature"},
+			Metadata:  []string{"execution_optimistic", "finalized"},
+			Versioned: true,
+		},
+		{
+			Name:     "Fork",
+			Path:     "/eth/v1/beacon/states/head/fork",
+			Fields:   []string{"previous_version", "current_version", "epoch"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+			Type:     func() any { return &phase0.Fork{} },
+		},
+		{
+			Name:     "FinalityCheckpoints",
+			Path:     "/eth/v1/beacon/states/head/finality_checkpoints",
+			Fields:   []string{"previous_justified", "current_justified", "finalized"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+			Type:     func() any { return &apiv1.Finality{} },
+		},
+		{
+			Name:     "BeaconCommittees",
+			Path:     "/eth/v1/beacon/states/head/committees",
+			List:     true,
+			Fields:   []string{"index", "slot", "validators"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+			Type:     func() any { return &apiv1.BeaconCommittee{} },
+		},
+		{
+			Name:     "Validators",
+			Path:     "/eth/v1/beacon/states/head/validators?id=0",
+			List:     true,
+			Fields:   []string{"index", "balance", "status", "validator"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+			Type:     func() any { return &apiv1.Validator{} },
+		},
+		{
+			Name:     "Randao",
+			Path:     "/eth/v1/beacon/states/head/randao",
+			Fields:   []string{"randao"},
+			Metadata: []string{"execution_optimistic", "finalized"},
+		},
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel     zerolog.Level
+	address      string
+	timeout      time.Duration
+	checks       []*Check
+	extraHeaders map[string]string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithAddress sets the address of the beacon node to check.
+func WithAddress(address string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.address = address
+	})
+}
+
+// WithTimeout sets the maximum duration of each request to the beacon node.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithChecks sets the checks to run.  If not supplied the checks returned by
+// DefaultChecks are run.
+func WithChecks(checks []*Check) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.checks = checks
+	})
+}
+
+// WithExtraHeaders sets additional headers to be sent with each request.
+func WithExtraHeaders(headers map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.extraHeaders = headers
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		timeout:  30 * time.Second,
+		checks:   DefaultChecks(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.address == "" {
+		return nil, errors.New("no address specified")
+	}
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+	if len(parameters.checks) == 0 {
+		return nil, errors.New("no checks specified")
+	}
+	for _, check := range parameters.checks {
+		if check == nil {
+			return nil, errors.New("nil check specified")
+		}
+		if check.Name == "" {
+			return nil, errors.New("check with no name specified")
+		}
+		if check.Path == "" {
+			return nil, errors.New("check with no path specified")
+		}
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import "time"
+
+// Status is the status of a check.
+type Status string
+
+const (
+	// StatusPass is the status of a check that found no issues.
+	StatusPass Status = "pass"
+	// StatusFail is the status of a check that found one or more issues.
+	StatusFail Status = "fail"
+)
+
+// Result is the result of a single check.
+type Result struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Path is the path of the endpoint checked.
+	Path string `json:"path"`
+	// Status is the status of the check.
+	Status Status `json:"status"`
+	// StatusCode is the HTTP status code of the JSON response.
+	StatusCode int `json:"status_code"`
+	// Version is the consensus version returned by the endpoint, if any.
+	Version string `json:"version,omitempty"`
+	// Issues are the issues found by the check.
+	Issues []string `json:"issues,omitempty"`
+	// Duration is the time taken by the check, in nanoseconds.
+	Duration time.Duration `json:"duration"`
+}
+
+// Report is the result of a conformance run against a beacon node.
+type Report struct {
+	// Address is the address of the beacon node.
+	Address string `json:"address"`
+	// Started is the time at which the run started.
+	Started time.Time `json:"started"`
+	// Duration is the time taken by the run, in nanoseconds.
+	Duration time.Duration `json:"duration"`
+	// Passes is the number of checks that passed.
+	Passes int `json:"passes"`
+	// Failures is the number of checks that failed.
+	Failures int `json:"failures"`
+	// Results are the results of the individual checks.
+	Results []*Result `json:"results"`
+}
+
+// Success returns true if all checks passed.
+func (r *Report) Success() bool {
+	return r.Failures == 0
+}
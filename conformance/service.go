@@ -0,0 +1,351 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance checks a beacon node's API against the expectations of
+// the beacon API specification, exercising endpoints directly over HTTP so
+// that response shapes, headers, SSZ support and consensus versions can be
+// examined, and producing a machine-readable report of the results.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+const (
+	contentTypeJSON = "application/json"
+	contentTypeSSZ  = "application/octet-stream"
+	versionHeader   = "Eth-Consensus-Version"
+)
+
+// Service runs conformance checks against a beacon node.
+type Service struct {
+	log          zerolog.Logger
+	address      string
+	base         *url.URL
+	client       *http.Client
+	timeout      time.Duration
+	checks       []*Check
+	extraHeaders map[string]string
+}
+
+// New creates a new conformance service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "conformance").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	address := parameters.address
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	base, err := url.Parse(strings.TrimSuffix(address, "/"))
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid URL"), err)
+	}
+
+	return &Service{
+		log:          log,
+		address:      parameters.address,
+		base:         base,
+		client:       &http.Client{},
+		timeout:      parameters.timeout,
+		checks:       parameters.checks,
+		extraHeaders: parameters.extraHeaders,
+	}, nil
+}
+
+// Run runs the checks against the beacon node and returns a report.  Failed
+// checks are recorded in the report; an error is returned only if the run
+// itself could not complete.
+func (s *Service) Run(ctx context.Context) (*Report, error) {
+	report := &Report{
+		Address: s.address,
+		Started: time.Now(),
+		Results: make([]*Result, 0, len(s.checks)),
+	}
+
+	for _, check := range s.checks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result := s.run(ctx, check)
+		if len(result.Issues) == 0 {
+			result.Status = StatusPass
+			report.Passes++
+		} else {
+			result.Status = StatusFail
+			report.Failures++
+		}
+		s.log.Trace().Str("check", check.Name).Str("status", string(result.Status)).Strs("issues", result.Issues).Msg("Ran check")
+		report.Results = append(report.Results, result)
+	}
+	report.Duration = time.Since(report.Started)
+
+	return report, nil
+}
+
+// run runs a single check.
+func (s *Service) run(ctx context.Context, check *Check) *Result {
+	started := time.Now()
+	result := &Result{
+		Name:   check.Name,
+		Path:   check.Path,
+		Issues: make([]string, 0),
+	}
+	defer func() {
+		result.Duration = time.Since(started)
+	}()
+
+	resp, err := s.get(ctx, check.Path, contentTypeJSON)
+	if err != nil {
+		result.Issues = append(result.Issues, issue(err))
+
+		return result
+	}
+	result.StatusCode = resp.statusCode
+	if resp.statusCode != http.StatusOK {
+		result.Issues = append(result.Issues, fmt.Sprintf("status code %d", resp.statusCode))
+
+		return result
+	}
+	result.Issues = append(result.Issues, checkContentType(resp, contentTypeJSON)...)
+
+	body := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(resp.body, &body); err != nil {
+		result.Issues = append(result.Issues, fmt.Sprintf("invalid JSON: %v", err))
+
+		return result
+	}
+	result.Issues = append(result.Issues, checkData(check, body)...)
+	for _, field := range check.Metadata {
+		if _, exists := body[field]; !exists {
+			result.Issues = append(result.Issues, fmt.Sprintf("%s field missing", field))
+		}
+	}
+
+	if check.Versioned {
+		version, issues := checkVersion(body, resp.headers)
+		result.Version = version
+		result.Issues = append(result.Issues, issues...)
+	}
+
+	if check.SSZ {
+		result.Issues = append(result.Issues, s.checkSSZ(ctx, check, result.Version)...)
+	}
+
+	return result
+}
+
+// checkSSZ checks that the endpoint serves SSZ.
+func (s *Service) checkSSZ(ctx context.Context, check *Check, version string) []string {
+	resp, err := s.get(ctx, check.Path, contentTypeSSZ)
+	if err != nil {
+		return []string{fmt.Sprintf("SSZ: %s", issue(err))}
+	}
+	if resp.statusCode != http.StatusOK {
+		return []string{fmt.Sprintf("SSZ: status code %d", resp.statusCode)}
+	}
+
+	issues := make([]string, 0)
+	for _, issue := range checkContentType(resp, contentTypeSSZ) {
+		issues = append(issues, fmt.Sprintf("SSZ: %s", issue))
+	}
+	if len(resp.body) == 0 {
+		issues = append(issues, "SSZ: empty body")
+	}
+	if check.Versioned {
+		header := resp.headers.Get(versionHeader)
+		switch {
+		case header == "":
+			issues = append(issues, fmt.Sprintf("SSZ: %s header missing", versionHeader))
+		case version != "" && !strings.EqualFold(header, version):
+			issues = append(issues, fmt.Sprintf("SSZ: %s header %s does not match JSON version %s", versionHeader, header, version))
+		}
+	}
+
+	return issues
+}
+
+// checkContentType checks that the response has the expected content type.
+func checkContentType(resp *response, expected string) []string {
+	contentType := resp.headers.Get("Content-Type")
+	if contentType == "" {
+		return []string{"Content-Type header missing"}
+	}
+	if !strings.HasPrefix(contentType, expected) {
+		return []string{fmt.Sprintf("content type %s not %s", contentType, expected)}
+	}
+
+	return nil
+}
+
+// checkData checks the data field of the response body.
+func checkData(check *Check, body map[string]json.RawMessage) []string {
+	data, exists := body["data"]
+	if !exists {
+		return []string{"data field missing"}
+	}
+
+	if check.List {
+		elements := make([]map[string]json.RawMessage, 0)
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return []string{"data field is not an array of objects"}
+		}
+		rawElements := make([]json.RawMessage, 0)
+		if err := json.Unmarshal(data, &rawElements); err != nil {
+			return []string{"data field is not an array of objects"}
+		}
+		issues := make([]string, 0)
+		for i, element := range elements {
+			missing := missingFields(element, check.Fields)
+			for _, field := range missing {
+				issues = append(issues, fmt.Sprintf("data[%d].%s field missing", i, field))
+			}
+			if len(missing) == 0 {
+				issues = append(issues, checkType(check, fmt.Sprintf("data[%d]", i), rawElements[i])...)
+			}
+		}
+
+		return issues
+	}
+
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return []string{"data field is not an object"}
+	}
+	issues := make([]string, 0)
+	missing := missingFields(object, check.Fields)
+	for _, field := range missing {
+		issues = append(issues, fmt.Sprintf("data.%s field missing", field))
+	}
+	if len(missing) == 0 {
+		issues = append(issues, checkType(check, "data", data)...)
+	}
+
+	return issues
+}
+
+// checkType checks that the data decodes strictly in to the check's type.
+func checkType(check *Check, path string, data json.RawMessage) []string {
+	if check.Type == nil {
+		return nil
+	}
+
+	if err := codecs.UnmarshalJSON(data, check.Type(), codecs.WithStrictJSON(true)); err != nil {
+		return []string{fmt.Sprintf("%s: %v", path, err)}
+	}
+
+	return nil
+}
+
+// checkVersion checks the consensus version in the body and header of the
+// response, returning the version from the body.
+func checkVersion(body map[string]json.RawMessage, headers http.Header) (string, []string) {
+	issues := make([]string, 0)
+
+	version := ""
+	if rawVersion, exists := body["version"]; !exists {
+		issues = append(issues, "version field missing")
+	} else if err := json.Unmarshal(rawVersion, &version); err != nil {
+		issues = append(issues, "version field is not a string")
+	} else if _, err := spec.DataVersionFromString(version); err != nil {
+		issues = append(issues, fmt.Sprintf("version %s not recognised", version))
+	}
+
+	header := headers.Get(versionHeader)
+	switch {
+	case header == "":
+		issues = append(issues, fmt.Sprintf("%s header missing", versionHeader))
+	case version != "" && !strings.EqualFold(header, version):
+		issues = append(issues, fmt.Sprintf("%s header %s does not match version %s", versionHeader, header, version))
+	}
+
+	return version, issues
+}
+
+// issue flattens an error in to a single-line issue.
+func issue(err error) string {
+	return strings.ReplaceAll(err.Error(), "\n", ": ")
+}
+
+// missingFields returns the fields that are not present in the object.
+func missingFields(object map[string]json.RawMessage, fields []string) []string {
+	missing := make([]string, 0)
+	for _, field := range fields {
+		if _, exists := object[field]; !exists {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
+// response is a response from the beacon node.
+type response struct {
+	statusCode int
+	headers    http.Header
+	body       []byte
+}
+
+// get fetches the given path, accepting the given content type.
+func (s *Service) get(ctx context.Context, path string, accept string) (*response, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.base.String()+path, nil)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to create request"), err)
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Accept", accept)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(errors.New("request failed"), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read body"), err)
+	}
+
+	return &response{
+		statusCode: resp.StatusCode,
+		headers:    resp.Header,
+		body:       body,
+	}, nil
+}
@@ -0,0 +1,203 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/conformance"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/version", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"version":"test/v1.0.0"}}`))
+	})
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(`{"data":{"head_slot":"1"}}`))
+	})
+	mux.HandleFunc("/eth/v1/config/fork_schedule", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0","extra":"1"},{"epoch":"1"}]}`))
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/head", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Eth-Consensus-Version", "deneb")
+		if r.Header.Get("Accept") == "application/octet-stream" {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte{0x01, 0x02})
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"deneb","execution_optimistic":false,"finalized":false,"data":{"message":{},"signature":"0x"}}`))
+	})
+	mux.HandleFunc("/eth/v1/beacon/blinded_blocks/head", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") == "application/octet-stream" {
+			w.WriteHeader(http.StatusNotAcceptable)
+
+			return
+		}
+		w.Header().Set("Eth-Consensus-Version", "capella")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"deneb","data":{"message":{},"signature":"0x"}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []conformance.Parameter
+		err    string
+	}{
+		{
+			name: "AddressMissing",
+			err:  "problem with parameters\nno address specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []conformance.Parameter{
+				conformance.WithAddress("localhost:5052"),
+				conformance.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "ChecksEmpty",
+			params: []conformance.Parameter{
+				conformance.WithAddress("localhost:5052"),
+				conformance.WithChecks([]*conformance.Check{}),
+			},
+			err: "problem with parameters\nno checks specified",
+		},
+		{
+			name: "CheckNil",
+			params: []conformance.Parameter{
+				conformance.WithAddress("localhost:5052"),
+				conformance.WithChecks([]*conformance.Check{nil}),
+			},
+			err: "problem with parameters\nnil check specified",
+		},
+		{
+			name: "CheckNameMissing",
+			params: []conformance.Parameter{
+				conformance.WithAddress("localhost:5052"),
+				conformance.WithChecks([]*conformance.Check{{Path: "/eth/v1/node/version"}}),
+			},
+			err: "problem with parameters\ncheck with no name specified",
+		},
+		{
+			name: "CheckPathMissing",
+			params: []conformance.Parameter{
+				conformance.WithAddress("localhost:5052"),
+				conformance.WithChecks([]*conformance.Check{{Name: "NodeVersion"}}),
+			},
+			err: "problem with parameters\ncheck with no path specified",
+		},
+		{
+			name: "Good",
+			params: []conformance.Parameter{
+				conformance.WithAddress("localhost:5052"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := conformance.New(context.Background(), append(test.params, conformance.WithLogLevel(zerolog.Disabled))...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t)
+
+	checks := make([]*conformance.Check, 0)
+	for _, check := range conformance.DefaultChecks() {
+		switch check.Name {
+		case "NodeVersion", "NodeSyncing", "ForkSchedule", "SignedBeaconBlock", "BlindedBeaconBlock", "Genesis":
+			checks = append(checks, check)
+		}
+	}
+
+	s, err := conformance.New(ctx,
+		conformance.WithLogLevel(zerolog.Disabled),
+		conformance.WithAddress(server.URL),
+		conformance.WithChecks(checks),
+	)
+	require.NoError(t, err)
+
+	report, err := s.Run(ctx)
+	require.NoError(t, err)
+	require.False(t, report.Success())
+	require.Equal(t, 2, report.Passes)
+	require.Equal(t, 4, report.Failures)
+
+	issues := make(map[string][]string)
+	for _, result := range report.Results {
+		issues[result.Name] = result.Issues
+		require.Equal(t, len(result.Issues) == 0, result.Status == conformance.StatusPass)
+	}
+	require.Equal(t, map[string][]string{
+		"NodeVersion": {},
+		"NodeSyncing": {
+			"content type text/plain not application/json",
+			"data.sync_distance field missing",
+			"data.is_syncing field missing",
+		},
+		"Genesis": {
+			"status code 404",
+		},
+		"ForkSchedule": {
+			"data[0]: unknown field extra",
+			"data[1].previous_version field missing",
+			"data[1].current_version field missing",
+		},
+		"SignedBeaconBlock": {},
+		"BlindedBeaconBlock": {
+			"execution_optimistic field missing",
+			"finalized field missing",
+			"Eth-Consensus-Version header capella does not match version deneb",
+			"SSZ: status code 406",
+		},
+	}, issues)
+
+	// Ensure that the report is machine-readable.
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+	decoded := &conformance.Report{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.Equal(t, report.Failures, decoded.Failures)
+	require.Len(t, decoded.Results, len(report.Results))
+}
@@ -0,0 +1,215 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package consolidation provides helpers to build, check and encode Electra
+// consolidation requests.
+//
+// Consolidation requests are not signed with a validator key.  They are
+// authorised by the execution layer transaction that submits them to the
+// consolidation request contract, which must be sent from the withdrawal
+// address of the source validator.  A request with the same source and
+// target public key switches the source validator from 0x01 to 0x02
+// (compounding) withdrawal credentials.
+package consolidation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// RequestType is the EIP-7685 type of consolidation requests.
+const RequestType = byte(0x02)
+
+// requestSize is the size of an SSZ-encoded consolidation request.
+const requestSize = 20 + phase0.PublicKeyLength + phase0.PublicKeyLength
+
+// ContractAddress is the address of the consolidation request contract.
+var ContractAddress = bellatrix.ExecutionAddress{
+	0x00, 0x00, 0xbb, 0xdd, 0xc7, 0xce, 0x48, 0x86, 0x42, 0xfb,
+	0x57, 0x9f, 0x8b, 0x00, 0xf3, 0xa5, 0x90, 0x00, 0x72, 0x51,
+}
+
+// New creates a request to consolidate the source validator into the target
+// validator.  The source address is the withdrawal address of the source
+// validator.
+func New(sourceAddress bellatrix.ExecutionAddress,
+	sourcePubkey phase0.BLSPubKey,
+	targetPubkey phase0.BLSPubKey,
+) (
+	*electra.ConsolidationRequest,
+	error,
+) {
+	if sourceAddress.IsZero() {
+		return nil, errors.New("no source address supplied")
+	}
+	if sourcePubkey.IsZero() {
+		return nil, errors.New("no source public key supplied")
+	}
+	if targetPubkey.IsZero() {
+		return nil, errors.New("no target public key supplied")
+	}
+
+	return &electra.ConsolidationRequest{
+		SourceAddress: sourceAddress,
+		SourcePubkey:  sourcePubkey,
+		TargetPubkey:  targetPubkey,
+	}, nil
+}
+
+// NewSwitchToCompounding creates a request to switch a validator from 0x01 to
+// 0x02 withdrawal credentials.  The address is the withdrawal address of the
+// validator.
+func NewSwitchToCompounding(address bellatrix.ExecutionAddress,
+	pubkey phase0.BLSPubKey,
+) (
+	*electra.ConsolidationRequest,
+	error,
+) {
+	return New(address, pubkey, pubkey)
+}
+
+// IsSwitchToCompounding returns true if the request switches the source
+// validator to compounding withdrawal credentials rather than consolidating
+// it into another validator.
+func IsSwitchToCompounding(request *electra.ConsolidationRequest) bool {
+	return request != nil && request.SourcePubkey == request.TargetPubkey
+}
+
+// Check checks that a request is acceptable for the given source and target
+// validators, as far as can be determined from their withdrawal credentials.
+// Other conditions, such as the validators being active and not exiting, are
+// checked on-chain.
+func Check(request *electra.ConsolidationRequest, source *phase0.Validator, target *phase0.Validator) error {
+	if request == nil {
+		return errors.New("no request supplied")
+	}
+	if source == nil {
+		return errors.New("no source validator supplied")
+	}
+	if source.PublicKey != request.SourcePubkey {
+		return errors.New("source validator does not match request")
+	}
+	if err := checkExecutionCredentials(source.WithdrawalCredentials); err != nil {
+		return errors.Join(errors.New("invalid source withdrawal credentials"), err)
+	}
+	if !bytes.Equal(source.WithdrawalCredentials[12:], request.SourceAddress[:]) {
+		return errors.New("source address does not match source withdrawal credentials")
+	}
+
+	if IsSwitchToCompounding(request) {
+		if source.WithdrawalCredentials[0] != 0x01 {
+			return errors.New("source validator does not have 0x01 withdrawal credentials")
+		}
+
+		return nil
+	}
+
+	if target == nil {
+		return errors.New("no target validator supplied")
+	}
+	if target.PublicKey != request.TargetPubkey {
+		return errors.New("target validator does not match request")
+	}
+	if len(target.WithdrawalCredentials) != 32 || target.WithdrawalCredentials[0] != 0x02 {
+		return errors.New("target validator does not have 0x02 withdrawal credentials")
+	}
+
+	return nil
+}
+
+// Calldata returns the input data for a transaction that submits the request
+// to the consolidation request contract.  The transaction must be sent from
+// the source address of the request, with a value of at least the current
+// request fee.
+func Calldata(request *electra.ConsolidationRequest) ([]byte, error) {
+	if request == nil {
+		return nil, errors.New("no request supplied")
+	}
+
+	data := make([]byte, 0, phase0.PublicKeyLength*2)
+	data = append(data, request.SourcePubkey[:]...)
+	data = append(data, request.TargetPubkey[:]...)
+
+	return data, nil
+}
+
+// EncodeRequests encodes consolidation requests as an EIP-7685 execution
+// layer request, being the request type followed by the SSZ encoding of each
+// request.  Empty request lists are omitted from execution layer requests, so
+// nil is returned if there are no requests.
+func EncodeRequests(requests []*electra.ConsolidationRequest) ([]byte, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	data := make([]byte, 1, 1+len(requests)*requestSize)
+	data[0] = RequestType
+	for i, request := range requests {
+		if request == nil {
+			return nil, fmt.Errorf("request %d missing", i)
+		}
+		var err error
+		data, err = request.MarshalSSZTo(data)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to encode request %d", i), err)
+		}
+	}
+
+	return data, nil
+}
+
+// DecodeRequests decodes consolidation requests from an EIP-7685 execution
+// layer request.
+func DecodeRequests(data []byte) ([]*electra.ConsolidationRequest, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no data supplied")
+	}
+	if data[0] != RequestType {
+		return nil, fmt.Errorf("unexpected request type %#02x", data[0])
+	}
+	data = data[1:]
+	if len(data)%requestSize != 0 {
+		return nil, fmt.Errorf("invalid request data length %d", len(data))
+	}
+
+	requests := make([]*electra.ConsolidationRequest, 0, len(data)/requestSize)
+	for offset := 0; offset < len(data); offset += requestSize {
+		request := &electra.ConsolidationRequest{}
+		if err := request.UnmarshalSSZ(data[offset : offset+requestSize]); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to decode request %d", len(requests)), err)
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// checkExecutionCredentials checks that withdrawal credentials have an
+// execution address.
+func checkExecutionCredentials(withdrawalCredentials []byte) error {
+	if len(withdrawalCredentials) != 32 {
+		return fmt.Errorf("withdrawal credentials must be 32 bytes, got %d", len(withdrawalCredentials))
+	}
+
+	switch withdrawalCredentials[0] {
+	case 0x01, 0x02:
+		return nil
+	default:
+		return fmt.Errorf("withdrawal credentials prefix %#02x does not have an execution address", withdrawalCredentials[0])
+	}
+}
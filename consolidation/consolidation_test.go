@@ -0,0 +1,187 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consolidation_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/consolidation"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func credentials(prefix byte, address bellatrix.ExecutionAddress) []byte {
+	res := make([]byte, 32)
+	res[0] = prefix
+	copy(res[12:], address[:])
+
+	return res
+}
+
+func TestNew(t *testing.T) {
+	address := bellatrix.ExecutionAddress{0x01}
+	source := phase0.BLSPubKey{0x02}
+	target := phase0.BLSPubKey{0x03}
+
+	_, err := consolidation.New(bellatrix.ExecutionAddress{}, source, target)
+	require.EqualError(t, err, "no source address supplied")
+	_, err = consolidation.New(address, phase0.BLSPubKey{}, target)
+	require.EqualError(t, err, "no source public key supplied")
+	_, err = consolidation.New(address, source, phase0.BLSPubKey{})
+	require.EqualError(t, err, "no target public key supplied")
+
+	request, err := consolidation.New(address, source, target)
+	require.NoError(t, err)
+	require.False(t, consolidation.IsSwitchToCompounding(request))
+
+	request, err = consolidation.NewSwitchToCompounding(address, source)
+	require.NoError(t, err)
+	require.True(t, consolidation.IsSwitchToCompounding(request))
+	require.Equal(t, source, request.TargetPubkey)
+}
+
+func TestCheck(t *testing.T) {
+	address := bellatrix.ExecutionAddress{0x01}
+	sourcePubkey := phase0.BLSPubKey{0x02}
+	targetPubkey := phase0.BLSPubKey{0x03}
+	request, err := consolidation.New(address, sourcePubkey, targetPubkey)
+	require.NoError(t, err)
+	switchRequest, err := consolidation.NewSwitchToCompounding(address, sourcePubkey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		request *electra.ConsolidationRequest
+		source  *phase0.Validator
+		target  *phase0.Validator
+		err     string
+	}{
+		{
+			name: "RequestMissing",
+			err:  "no request supplied",
+		},
+		{
+			name:    "SourceMissing",
+			request: request,
+			err:     "no source validator supplied",
+		},
+		{
+			name:    "SourceMismatch",
+			request: request,
+			source:  &phase0.Validator{PublicKey: targetPubkey, WithdrawalCredentials: credentials(0x01, address)},
+			err:     "source validator does not match request",
+		},
+		{
+			name:    "SourceBLSCredentials",
+			request: request,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x00, address)},
+			err:     "invalid source withdrawal credentials\nwithdrawal credentials prefix 0x00 does not have an execution address",
+		},
+		{
+			name:    "SourceAddressMismatch",
+			request: request,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x01, bellatrix.ExecutionAddress{0x09})},
+			err:     "source address does not match source withdrawal credentials",
+		},
+		{
+			name:    "TargetMissing",
+			request: request,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x01, address)},
+			err:     "no target validator supplied",
+		},
+		{
+			name:    "TargetNotCompounding",
+			request: request,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x01, address)},
+			target:  &phase0.Validator{PublicKey: targetPubkey, WithdrawalCredentials: credentials(0x01, address)},
+			err:     "target validator does not have 0x02 withdrawal credentials",
+		},
+		{
+			name:    "Good",
+			request: request,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x01, address)},
+			target:  &phase0.Validator{PublicKey: targetPubkey, WithdrawalCredentials: credentials(0x02, bellatrix.ExecutionAddress{0x09})},
+		},
+		{
+			name:    "SwitchAlreadyCompounding",
+			request: switchRequest,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x02, address)},
+			err:     "source validator does not have 0x01 withdrawal credentials",
+		},
+		{
+			name:    "SwitchGood",
+			request: switchRequest,
+			source:  &phase0.Validator{PublicKey: sourcePubkey, WithdrawalCredentials: credentials(0x01, address)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := consolidation.Check(test.request, test.source, test.target)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCalldata(t *testing.T) {
+	_, err := consolidation.Calldata(nil)
+	require.EqualError(t, err, "no request supplied")
+
+	request, err := consolidation.New(bellatrix.ExecutionAddress{0x01}, phase0.BLSPubKey{0x02}, phase0.BLSPubKey{0x03})
+	require.NoError(t, err)
+	data, err := consolidation.Calldata(request)
+	require.NoError(t, err)
+	require.Len(t, data, 96)
+	require.Equal(t, byte(0x02), data[0])
+	require.Equal(t, byte(0x03), data[48])
+}
+
+func TestEncodeRequests(t *testing.T) {
+	data, err := consolidation.EncodeRequests(nil)
+	require.NoError(t, err)
+	require.Nil(t, data)
+
+	_, err = consolidation.EncodeRequests([]*electra.ConsolidationRequest{nil})
+	require.EqualError(t, err, "request 0 missing")
+
+	requests := []*electra.ConsolidationRequest{
+		{SourceAddress: bellatrix.ExecutionAddress{0x01}, SourcePubkey: phase0.BLSPubKey{0x02}, TargetPubkey: phase0.BLSPubKey{0x03}},
+		{SourceAddress: bellatrix.ExecutionAddress{0x04}, SourcePubkey: phase0.BLSPubKey{0x05}, TargetPubkey: phase0.BLSPubKey{0x05}},
+	}
+	data, err = consolidation.EncodeRequests(requests)
+	require.NoError(t, err)
+	require.Len(t, data, 1+2*116)
+	require.Equal(t, consolidation.RequestType, data[0])
+	require.Equal(t, byte(0x01), data[1])
+	require.Equal(t, byte(0x02), data[21])
+	require.Equal(t, byte(0x03), data[69])
+	require.Equal(t, byte(0x04), data[117])
+
+	decoded, err := consolidation.DecodeRequests(data)
+	require.NoError(t, err)
+	require.Equal(t, requests, decoded)
+
+	_, err = consolidation.DecodeRequests(nil)
+	require.EqualError(t, err, "no data supplied")
+	_, err = consolidation.DecodeRequests([]byte{0x01})
+	require.EqualError(t, err, "unexpected request type 0x01")
+	_, err = consolidation.DecodeRequests(data[:100])
+	require.EqualError(t, err, "invalid request data length 99")
+}
@@ -0,0 +1,160 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Chain contains the chain parameters against which deposit data is validated.
+type Chain struct {
+	GenesisForkVersion  phase0.Version
+	MinDepositAmount    phase0.Gwei
+	MaxEffectiveBalance phase0.Gwei
+	// MaxEffectiveBalanceElectra is the maximum effective balance for
+	// validators with compounding withdrawal credentials.  It is 0 if the
+	// chain does not support compounding withdrawal credentials.
+	MaxEffectiveBalanceElectra phase0.Gwei
+	DepositContract            *apiv1.DepositContract
+}
+
+// ChainFromProvider obtains the chain parameters for deposits from a beacon node.
+func ChainFromProvider(ctx context.Context,
+	specProvider consensusclient.SpecProvider,
+	depositContractProvider consensusclient.DepositContractProvider,
+) (
+	*Chain,
+	error,
+) {
+	if specProvider == nil {
+		return nil, errors.New("no spec provider supplied")
+	}
+	if depositContractProvider == nil {
+		return nil, errors.New("no deposit contract provider supplied")
+	}
+
+	specResponse, err := specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	depositContractResponse, err := depositContractProvider.DepositContract(ctx, &api.DepositContractOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain deposit contract"), err)
+	}
+
+	return ChainFromSpec(specResponse.Data, depositContractResponse.Data)
+}
+
+// ChainFromSpec creates chain parameters for deposits from the values
+// returned by a spec provider and a deposit contract provider.
+func ChainFromSpec(data map[string]any, depositContract *apiv1.DepositContract) (*Chain, error) {
+	if depositContract == nil {
+		return nil, errors.New("no deposit contract supplied")
+	}
+	if len(depositContract.Address) != 20 {
+		return nil, fmt.Errorf("deposit contract address must be 20 bytes, got %d", len(depositContract.Address))
+	}
+
+	chain := &Chain{
+		DepositContract: depositContract,
+	}
+
+	tmp, exists := data["GENESIS_FORK_VERSION"]
+	if !exists {
+		return nil, errors.New("GENESIS_FORK_VERSION not found in spec")
+	}
+	genesisForkVersion, isVersion := tmp.(phase0.Version)
+	if !isVersion {
+		return nil, errors.New("GENESIS_FORK_VERSION of unexpected type")
+	}
+	chain.GenesisForkVersion = genesisForkVersion
+
+	values := []spec.Uint64Value{
+		{Key: "MIN_DEPOSIT_AMOUNT", Apply: func(v uint64) { chain.MinDepositAmount = phase0.Gwei(v) }},
+		{Key: "MAX_EFFECTIVE_BALANCE", Apply: func(v uint64) { chain.MaxEffectiveBalance = phase0.Gwei(v) }},
+		{Key: "MAX_EFFECTIVE_BALANCE_ELECTRA", Optional: true, Apply: func(v uint64) { chain.MaxEffectiveBalanceElectra = phase0.Gwei(v) }},
+	}
+	if err := spec.ReadUint64Values(data, values); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+// New creates signed deposit data for the chain.
+func (c *Chain) New(pubKey phase0.BLSPubKey,
+	withdrawalCredentials []byte,
+	amount phase0.Gwei,
+	signer bls.Signer,
+) (
+	*phase0.DepositData,
+	error,
+) {
+	if err := c.checkAmount(withdrawalCredentials, amount); err != nil {
+		return nil, err
+	}
+
+	return New(pubKey, withdrawalCredentials, amount, c.GenesisForkVersion, signer)
+}
+
+// Validate checks that the deposit data is acceptable for the chain,
+// including that its signature is valid for the chain's genesis fork version.
+// If verifier is nil then the signature is not checked.
+func (c *Chain) Validate(data *phase0.DepositData, verifier bls.SignatureVerifier) error {
+	if data == nil {
+		return errors.New("no deposit data supplied")
+	}
+	if err := checkWithdrawalCredentials(data.WithdrawalCredentials); err != nil {
+		return err
+	}
+	if err := c.checkAmount(data.WithdrawalCredentials, data.Amount); err != nil {
+		return err
+	}
+
+	if verifier == nil {
+		return nil
+	}
+
+	return Verify(data, c.GenesisForkVersion, verifier)
+}
+
+// checkAmount checks that the deposit amount is within the bounds for the chain.
+func (c *Chain) checkAmount(withdrawalCredentials []byte, amount phase0.Gwei) error {
+	if amount < c.MinDepositAmount {
+		return fmt.Errorf("deposit amount %d below minimum %d", amount, c.MinDepositAmount)
+	}
+
+	maxAmount := c.MaxEffectiveBalance
+	if len(withdrawalCredentials) > 0 && withdrawalCredentials[0] == 0x02 {
+		if c.MaxEffectiveBalanceElectra == 0 {
+			return errors.New("compounding withdrawal credentials not supported by chain")
+		}
+		maxAmount = c.MaxEffectiveBalanceElectra
+	}
+	if amount > maxAmount {
+		return fmt.Errorf("deposit amount %d above maximum %d", amount, maxAmount)
+	}
+
+	return nil
+}
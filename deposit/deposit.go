@@ -0,0 +1,186 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deposit provides helpers to generate and validate deposit data.
+//
+// Deposit data is signed and verified by the bls.Signer and
+// bls.SignatureVerifier supplied by the caller.
+package deposit
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DomainDeposit is the domain type for deposits.
+var DomainDeposit = phase0.DomainType{0x03, 0x00, 0x00, 0x00}
+
+// ErrInvalidSignature is returned when the signature of deposit data does not verify.
+var ErrInvalidSignature = errors.New("invalid deposit signature")
+
+// Domain computes the deposit signature domain for the given fork version.
+// Deposits are valid across forks, so the domain does not depend on the
+// genesis validators root.
+func Domain(forkVersion phase0.Version) (phase0.Domain, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion: forkVersion,
+	}
+	forkDataRoot, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, errors.Join(errors.New("failed to calculate fork data root"), err)
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], DomainDeposit[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return domain, nil
+}
+
+// MessageRoot computes the root of the deposit message.
+func MessageRoot(message *phase0.DepositMessage) (phase0.Root, error) {
+	if message == nil {
+		return phase0.Root{}, errors.New("no deposit message supplied")
+	}
+
+	root, err := message.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate deposit message root"), err)
+	}
+
+	return root, nil
+}
+
+// SigningRoot computes the root signed by the validator for the deposit
+// message on the chain with the given genesis fork version.
+func SigningRoot(message *phase0.DepositMessage, forkVersion phase0.Version) (phase0.Root, error) {
+	messageRoot, err := MessageRoot(message)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	domain, err := Domain(forkVersion)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	signingData := &phase0.SigningData{
+		ObjectRoot: messageRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate signing root"), err)
+	}
+
+	return root, nil
+}
+
+// DataRoot computes the root of the deposit data, as supplied to the deposit contract.
+func DataRoot(data *phase0.DepositData) (phase0.Root, error) {
+	if data == nil {
+		return phase0.Root{}, errors.New("no deposit data supplied")
+	}
+
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Join(errors.New("failed to calculate deposit data root"), err)
+	}
+
+	return root, nil
+}
+
+// New creates signed deposit data for the chain with the given genesis fork version.
+func New(pubKey phase0.BLSPubKey,
+	withdrawalCredentials []byte,
+	amount phase0.Gwei,
+	forkVersion phase0.Version,
+	signer bls.Signer,
+) (
+	*phase0.DepositData,
+	error,
+) {
+	if signer == nil {
+		return nil, errors.New("no signer supplied")
+	}
+	if err := checkWithdrawalCredentials(withdrawalCredentials); err != nil {
+		return nil, err
+	}
+
+	message := &phase0.DepositMessage{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                amount,
+	}
+	signingRoot, err := SigningRoot(message, forkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signer.Sign(signingRoot)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to sign deposit message"), err)
+	}
+
+	return &phase0.DepositData{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                amount,
+		Signature:             signature,
+	}, nil
+}
+
+// Verify verifies the signature of the deposit data for the chain with the given genesis fork version.
+func Verify(data *phase0.DepositData, forkVersion phase0.Version, verifier bls.SignatureVerifier) error {
+	if data == nil {
+		return errors.New("no deposit data supplied")
+	}
+	if verifier == nil {
+		return errors.New("no signature verifier supplied")
+	}
+
+	signingRoot, err := SigningRoot(&phase0.DepositMessage{
+		PublicKey:             data.PublicKey,
+		WithdrawalCredentials: data.WithdrawalCredentials,
+		Amount:                data.Amount,
+	}, forkVersion)
+	if err != nil {
+		return err
+	}
+
+	valid, err := verifier.VerifySignature(data.PublicKey, signingRoot, data.Signature)
+	if err != nil {
+		return errors.Join(errors.New("failed to verify deposit signature"), err)
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func checkWithdrawalCredentials(withdrawalCredentials []byte) error {
+	if len(withdrawalCredentials) != 32 {
+		return fmt.Errorf("withdrawal credentials must be 32 bytes, got %d", len(withdrawalCredentials))
+	}
+
+	switch withdrawalCredentials[0] {
+	case 0x00, 0x01, 0x02:
+		return nil
+	default:
+		return fmt.Errorf("unsupported withdrawal credentials prefix %#02x", withdrawalCredentials[0])
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/bls"
+	"github.com/attestantio/go-eth2-client/deposit"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// rootSigner is a test signer that uses the signing root as the signature.
+type rootSigner struct{}
+
+func (rootSigner) Sign(root phase0.Root) (phase0.BLSSignature, error) {
+	var signature phase0.BLSSignature
+	copy(signature[:], root[:])
+
+	return signature, nil
+}
+
+func (rootSigner) VerifySignature(_ phase0.BLSPubKey, root phase0.Root, signature phase0.BLSSignature) (bool, error) {
+	return bytes.Equal(signature[:32], root[:]), nil
+}
+
+type erroringSigner struct{}
+
+func (erroringSigner) Sign(_ phase0.Root) (phase0.BLSSignature, error) {
+	return phase0.BLSSignature{}, errors.New("sign failed")
+}
+
+func withdrawalCredentials(prefix byte) []byte {
+	res := make([]byte, 32)
+	res[0] = prefix
+	res[31] = 0x01
+
+	return res
+}
+
+func TestDomain(t *testing.T) {
+	domain, err := deposit.Domain(phase0.Version{0x00, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+	// Well-known mainnet deposit domain.
+	require.Equal(t, "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", hex.EncodeToString(domain[:]))
+}
+
+func TestNewAndVerify(t *testing.T) {
+	pubKey := phase0.BLSPubKey{0x01}
+	forkVersion := phase0.Version{0x00, 0x00, 0x10, 0x20}
+
+	tests := []struct {
+		name                  string
+		withdrawalCredentials []byte
+		signer                bls.Signer
+		err                   string
+	}{
+		{
+			name:                  "SignerNil",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			err:                   "no signer supplied",
+		},
+		{
+			name:                  "CredentialsShort",
+			withdrawalCredentials: []byte{0x01},
+			signer:                rootSigner{},
+			err:                   "withdrawal credentials must be 32 bytes, got 1",
+		},
+		{
+			name:                  "CredentialsPrefixInvalid",
+			withdrawalCredentials: withdrawalCredentials(0x03),
+			signer:                rootSigner{},
+			err:                   "unsupported withdrawal credentials prefix 0x03",
+		},
+		{
+			name:                  "SignFails",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			signer:                erroringSigner{},
+			err:                   "failed to sign deposit message\nsign failed",
+		},
+		{
+			name:                  "Good",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			signer:                rootSigner{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := deposit.New(pubKey, test.withdrawalCredentials, 32_000_000_000, forkVersion, test.signer)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			signingRoot, err := deposit.SigningRoot(&phase0.DepositMessage{
+				PublicKey:             pubKey,
+				WithdrawalCredentials: test.withdrawalCredentials,
+				Amount:                32_000_000_000,
+			}, forkVersion)
+			require.NoError(t, err)
+			require.Equal(t, signingRoot[:], data.Signature[:32])
+
+			dataRoot, err := deposit.DataRoot(data)
+			require.NoError(t, err)
+			expectedRoot, err := data.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Root(expectedRoot), dataRoot)
+
+			require.NoError(t, deposit.Verify(data, forkVersion, rootSigner{}))
+			require.ErrorIs(t, deposit.Verify(data, phase0.Version{}, rootSigner{}), deposit.ErrInvalidSignature)
+		})
+	}
+}
+
+func TestChainFromProvider(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"GENESIS_FORK_VERSION":          phase0.Version{0x00, 0x00, 0x10, 0x20},
+				"MIN_DEPOSIT_AMOUNT":            uint64(1_000_000_000),
+				"MAX_EFFECTIVE_BALANCE":         uint64(32_000_000_000),
+				"MAX_EFFECTIVE_BALANCE_ELECTRA": uint64(2_048_000_000_000),
+			},
+		}, nil
+	}
+	client.DepositContractFunc = func(context.Context, *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error) {
+		return &api.Response[*apiv1.DepositContract]{
+			Data: &apiv1.DepositContract{
+				ChainID: 17000,
+				Address: make([]byte, 20),
+			},
+		}, nil
+	}
+
+	chain, err := deposit.ChainFromProvider(ctx, client, client)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x10, 0x20}, chain.GenesisForkVersion)
+	require.Equal(t, uint64(17000), chain.DepositContract.ChainID)
+
+	tests := []struct {
+		name                  string
+		withdrawalCredentials []byte
+		amount                phase0.Gwei
+		forkVersion           phase0.Version
+		err                   string
+	}{
+		{
+			name:                  "AmountLow",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			amount:                999_999_999,
+			forkVersion:           chain.GenesisForkVersion,
+			err:                   "deposit amount 999999999 below minimum 1000000000",
+		},
+		{
+			name:                  "AmountHigh",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			amount:                64_000_000_000,
+			forkVersion:           chain.GenesisForkVersion,
+			err:                   "deposit amount 64000000000 above maximum 32000000000",
+		},
+		{
+			name:                  "ForkVersionIncorrect",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			amount:                32_000_000_000,
+			forkVersion:           phase0.Version{},
+			err:                   "invalid deposit signature",
+		},
+		{
+			name:                  "Good",
+			withdrawalCredentials: withdrawalCredentials(0x01),
+			amount:                32_000_000_000,
+			forkVersion:           chain.GenesisForkVersion,
+		},
+		{
+			name:                  "CompoundingGood",
+			withdrawalCredentials: withdrawalCredentials(0x02),
+			amount:                64_000_000_000,
+			forkVersion:           chain.GenesisForkVersion,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Create the data directly so that chain checks are not applied.
+			data, err := deposit.New(phase0.BLSPubKey{0x01}, test.withdrawalCredentials, test.amount, test.forkVersion, rootSigner{})
+			require.NoError(t, err)
+
+			err = chain.Validate(data, rootSigner{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainFromSpec(t *testing.T) {
+	depositContract := &apiv1.DepositContract{
+		ChainID: 1,
+		Address: make([]byte, 20),
+	}
+
+	tests := []struct {
+		name            string
+		spec            map[string]any
+		depositContract *apiv1.DepositContract
+		err             string
+	}{
+		{
+			name:            "DepositContractMissing",
+			spec:            map[string]any{},
+			depositContract: nil,
+			err:             "no deposit contract supplied",
+		},
+		{
+			name: "DepositContractAddressInvalid",
+			spec: map[string]any{},
+			depositContract: &apiv1.DepositContract{
+				ChainID: 1,
+				Address: []byte{0x01},
+			},
+			err: "deposit contract address must be 20 bytes, got 1",
+		},
+		{
+			name:            "GenesisForkVersionMissing",
+			spec:            map[string]any{},
+			depositContract: depositContract,
+			err:             "GENESIS_FORK_VERSION not found in spec",
+		},
+		{
+			name: "MinDepositAmountMissing",
+			spec: map[string]any{
+				"GENESIS_FORK_VERSION": phase0.Version{},
+			},
+			depositContract: depositContract,
+			err:             "MIN_DEPOSIT_AMOUNT not found in spec",
+		},
+		{
+			name: "MaxEffectiveBalanceInvalid",
+			spec: map[string]any{
+				"GENESIS_FORK_VERSION":  phase0.Version{},
+				"MIN_DEPOSIT_AMOUNT":    uint64(1_000_000_000),
+				"MAX_EFFECTIVE_BALANCE": "32",
+			},
+			depositContract: depositContract,
+			err:             "MAX_EFFECTIVE_BALANCE of unexpected type",
+		},
+		{
+			name: "PreElectra",
+			spec: map[string]any{
+				"GENESIS_FORK_VERSION":  phase0.Version{},
+				"MIN_DEPOSIT_AMOUNT":    uint64(1_000_000_000),
+				"MAX_EFFECTIVE_BALANCE": uint64(32_000_000_000),
+			},
+			depositContract: depositContract,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chain, err := deposit.ChainFromSpec(test.spec, test.depositContract)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = chain.New(phase0.BLSPubKey{0x01}, withdrawalCredentials(0x02), 32_000_000_000, rootSigner{})
+			require.EqualError(t, err, "compounding withdrawal credentials not supported by chain")
+		})
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff produces human-readable structural differences between two
+// consensus-layer objects, such as blocks, proposals or states.
+//
+// Each difference is reported with the path of the field that differs and
+// the values on either side, for example:
+//
+//	Body.ExecutionPayload.GasUsed: 21000 != 42000
+//
+// which makes it straightforward to see why two nodes or relays returned
+// different data for the same request.
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Difference is a single difference between two objects.
+type Difference struct {
+	// Path is the path of the field that differs, for example
+	// "Body.Attestations[2].Data.Slot".  It is empty if the objects
+	// themselves differ.
+	Path string
+	// A is the value of the field in the first object.
+	A string
+	// B is the value of the field in the second object.
+	B string
+}
+
+// String returns a string representation of the difference.
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "<root>"
+	}
+
+	return fmt.Sprintf("%s: %s != %s", path, d.A, d.B)
+}
+
+// Differences is a list of differences.
+type Differences []Difference
+
+// String returns a string representation of the differences, one per line.
+func (d Differences) String() string {
+	lines := make([]string, len(d))
+	for i := range d {
+		lines[i] = d[i].String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+const (
+	// nilValue is the representation of a nil value.
+	nilValue = "<nil>"
+	// missingValue is the representation of a list element that is not present.
+	missingValue = "<missing>"
+)
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// Diff returns the differences between two objects of the same type.  An
+// empty result means that the objects are equal.
+func Diff(a, b any) (Differences, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("nil object supplied")
+	}
+
+	valA := reflect.ValueOf(a)
+	valB := reflect.ValueOf(b)
+	if valA.Type() != valB.Type() {
+		return nil, fmt.Errorf("objects are of different types %s and %s", valA.Type(), valB.Type())
+	}
+
+	differences := make(Differences, 0)
+	walk(&differences, "", valA, valB)
+
+	return differences, nil
+}
+
+// walk compares two values of the same type, adding any differences found.
+func walk(differences *Differences, path string, a reflect.Value, b reflect.Value) {
+	if isLeaf(a.Type()) {
+		strA := format(a)
+		strB := format(b)
+		if strA != strB {
+			*differences = append(*differences, Difference{Path: path, A: strA, B: strB})
+		}
+
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		switch {
+		case a.IsNil() && b.IsNil():
+		case a.IsNil():
+			*differences = append(*differences, Difference{Path: path, A: nilValue, B: "present"})
+		case b.IsNil():
+			*differences = append(*differences, Difference{Path: path, A: "present", B: nilValue})
+		case a.Elem().Type() != b.Elem().Type():
+			*differences = append(*differences, Difference{Path: path, A: a.Elem().Type().String(), B: b.Elem().Type().String()})
+		default:
+			walk(differences, path, a.Elem(), b.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			walk(differences, join(path, field.Name), a.Field(i), b.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			*differences = append(*differences, Difference{
+				Path: join(path, "len"),
+				A:    fmt.Sprintf("%d", a.Len()),
+				B:    fmt.Sprintf("%d", b.Len()),
+			})
+		}
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= a.Len():
+				*differences = append(*differences, Difference{Path: elementPath, A: missingValue, B: format(b.Index(i))})
+			case i >= b.Len():
+				*differences = append(*differences, Difference{Path: elementPath, A: format(a.Index(i)), B: missingValue})
+			default:
+				walk(differences, elementPath, a.Index(i), b.Index(i))
+			}
+		}
+	case reflect.Map:
+		keys := make(map[string]reflect.Value)
+		for _, key := range a.MapKeys() {
+			keys[fmt.Sprintf("%v", key.Interface())] = key
+		}
+		for _, key := range b.MapKeys() {
+			keys[fmt.Sprintf("%v", key.Interface())] = key
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			elementPath := fmt.Sprintf("%s[%s]", path, name)
+			valA := a.MapIndex(keys[name])
+			valB := b.MapIndex(keys[name])
+			switch {
+			case !valA.IsValid():
+				*differences = append(*differences, Difference{Path: elementPath, A: missingValue, B: format(valB)})
+			case !valB.IsValid():
+				*differences = append(*differences, Difference{Path: elementPath, A: format(valA), B: missingValue})
+			default:
+				walk(differences, elementPath, valA, valB)
+			}
+		}
+	default:
+		// Channels, functions and the like are not compared.
+	}
+}
+
+// isLeaf returns true if values of the type are compared as a whole rather
+// than field by field.
+func isLeaf(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map:
+		return false
+	case reflect.Slice, reflect.Array:
+		// Byte arrays, such as roots and keys, and types with their own
+		// representation, such as uint256 values, are single values.
+		return t.Elem().Kind() == reflect.Uint8 || implementsStringer(t)
+	case reflect.Struct:
+		// Structs without exported fields, such as time.Time, can only be
+		// compared as a whole.
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return true
+	}
+}
+
+// implementsStringer returns true if the type or a pointer to it implements
+// fmt.Stringer.
+func implementsStringer(t reflect.Type) bool {
+	return t.Implements(stringerType) || reflect.PointerTo(t).Implements(stringerType)
+}
+
+// format returns a string representation of a value.
+func format(val reflect.Value) string {
+	if !val.IsValid() {
+		return nilValue
+	}
+	if (val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface) && val.IsNil() {
+		return nilValue
+	}
+
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, val.Len())
+			reflect.Copy(reflect.ValueOf(bytes), val)
+
+			return fmt.Sprintf("%#x", bytes)
+		}
+	default:
+	}
+
+	if val.CanInterface() {
+		if stringer, isStringer := val.Interface().(fmt.Stringer); isStringer {
+			return strings.TrimSpace(stringer.String())
+		}
+		if val.CanAddr() {
+			if stringer, isStringer := val.Addr().Interface().(fmt.Stringer); isStringer {
+				return strings.TrimSpace(stringer.String())
+			}
+		}
+	}
+
+	if val.CanInterface() {
+		return fmt.Sprintf("%v", val.Interface())
+	}
+
+	return fmt.Sprintf("%v", val)
+}
+
+// join joins a field name on to a path.
+func join(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s.%s", path, name)
+}
@@ -0,0 +1,160 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff_test
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/diff"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureBlock creates a block with an attestation, to provide nested
+// structures and lists to compare.
+func fixtureBlock(t *testing.T) *phase0.BeaconBlock {
+	t.Helper()
+
+	attestation, err := testfixtures.Attestation(spec.DataVersionPhase0, testfixtures.WithSlot(1))
+	require.NoError(t, err)
+	block, err := testfixtures.Block(spec.DataVersionPhase0,
+		testfixtures.WithSlot(1),
+		testfixtures.WithProposer(2),
+		testfixtures.WithParentRoot(phase0.Root{0x01}),
+		testfixtures.WithAttestations([]*spec.VersionedAttestation{attestation}),
+	)
+	require.NoError(t, err)
+	block.Phase0.Message.Body.ETH1Data.DepositCount = 3
+
+	return block.Phase0.Message
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           any
+		b           any
+		modify      func(a any, b any)
+		differences string
+		err         string
+	}{
+		{
+			name: "Nil",
+			a:    nil,
+			b:    fixtureBlock(t),
+			err:  "nil object supplied",
+		},
+		{
+			name: "TypeMismatch",
+			a:    fixtureBlock(t),
+			b:    &phase0.BeaconBlockHeader{},
+			err:  "objects are of different types *phase0.BeaconBlock and *phase0.BeaconBlockHeader",
+		},
+		{
+			name: "Equal",
+			a:    fixtureBlock(t),
+			b:    fixtureBlock(t),
+		},
+		{
+			name: "Fields",
+			a:    fixtureBlock(t),
+			b:    fixtureBlock(t),
+			modify: func(_ any, b any) {
+				block := b.(*phase0.BeaconBlock)
+				block.Slot = 5
+				block.ParentRoot = phase0.Root{0x02}
+				block.Body.ETH1Data.DepositCount = 4
+			},
+			differences: `Slot: 1 != 5
+ParentRoot: 0x0100000000000000000000000000000000000000000000000000000000000000 != 0x0200000000000000000000000000000000000000000000000000000000000000
+Body.ETH1Data.DepositCount: 3 != 4`,
+		},
+		{
+			name: "Nils",
+			a:    fixtureBlock(t),
+			b:    fixtureBlock(t),
+			modify: func(_ any, b any) {
+				b.(*phase0.BeaconBlock).Body.ETH1Data = nil
+			},
+			differences: `Body.ETH1Data: present != <nil>`,
+		},
+		{
+			name: "Lists",
+			a:    fixtureBlock(t),
+			b:    fixtureBlock(t),
+			modify: func(a any, b any) {
+				a.(*phase0.BeaconBlock).Body.Attestations[0].Data.Slot = 2
+				b.(*phase0.BeaconBlock).Body.Attestations = append(b.(*phase0.BeaconBlock).Body.Attestations, &phase0.Attestation{})
+			},
+			differences: `Body.Attestations.len: 1 != 2
+Body.Attestations[0].Data.Slot: 2 != 1
+Body.Attestations[1]: <missing> != {aggregation_bits: '', data: null, signature: '0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000'}`,
+		},
+		{
+			name: "Versioned",
+			a: &spec.VersionedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0:  fixtureBlock(t),
+			},
+			b: &spec.VersionedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0:  fixtureBlock(t),
+			},
+			modify: func(_ any, b any) {
+				b.(*spec.VersionedBeaconBlock).Phase0.ProposerIndex = 3
+			},
+			differences: `Phase0.ProposerIndex: 2 != 3`,
+		},
+		{
+			name: "Values",
+			a: &apiv1.BidTrace{
+				Value: uint256.NewInt(1000),
+			},
+			b: &apiv1.BidTrace{
+				Value: uint256.NewInt(2000),
+			},
+			differences: `Value: 1000 != 2000`,
+		},
+		{
+			name: "Times",
+			a: &apiv1.ValidatorRegistration{
+				Timestamp: time.Unix(1, 0).UTC(),
+			},
+			b: &apiv1.ValidatorRegistration{
+				Timestamp: time.Unix(2, 0).UTC(),
+			},
+			differences: `Timestamp: 1970-01-01 00:00:01 +0000 UTC != 1970-01-01 00:00:02 +0000 UTC`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.modify != nil {
+				test.modify(test.a, test.b)
+			}
+			differences, err := diff.Diff(test.a, test.b)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.differences, differences.String())
+			}
+		})
+	}
+}
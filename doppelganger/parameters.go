@@ -0,0 +1,116 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doppelganger
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                  zerolog.Level
+	chainTime                 *chaintime.Service
+	validatorLivenessProvider consensusclient.ValidatorLivenessProvider
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	beaconCommitteesProvider  consensusclient.BeaconCommitteesProvider
+	epochs                    uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithValidatorLivenessProvider sets the provider from which validator liveness is obtained.
+func WithValidatorLivenessProvider(provider consensusclient.ValidatorLivenessProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorLivenessProvider = provider
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the provider from which blocks are scanned for attestations.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithBeaconCommitteesProvider sets the provider from which committee membership is obtained.
+func WithBeaconCommitteesProvider(provider consensusclient.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithEpochs sets the number of epochs prior to the current epoch that are
+// checked for activity.  Defaults to 2.
+func WithEpochs(epochs uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.epochs = epochs
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		epochs:   2,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.validatorLivenessProvider == nil {
+		return nil, errors.New("no validator liveness provider specified")
+	}
+	if parameters.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider specified")
+	}
+	if parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("no beacon committees provider specified")
+	}
+	if parameters.epochs == 0 {
+		return nil, errors.New("epochs must be greater than 0")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,273 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package doppelganger checks for signs that validators are already active
+// elsewhere before they start performing duties, to avoid slashing due to
+// the same validator keys running in more than one place.
+package doppelganger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Source is the source of evidence of validator activity.
+type Source string
+
+const (
+	// SourceLiveness is evidence from the beacon node's liveness data.
+	SourceLiveness Source = "liveness"
+	// SourceAttestation is evidence from an attestation included in a block.
+	SourceAttestation Source = "attestation"
+)
+
+// Evidence is evidence that a validator has been active.
+type Evidence struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Source is the source of the evidence.
+	Source Source
+	// Epoch is the epoch in which the validator was active.
+	Epoch phase0.Epoch
+	// Slot is the slot of the block containing the validator's attestation.
+	// Only set for attestation evidence.
+	Slot phase0.Slot
+}
+
+// Service checks for doppelgangers.
+type Service struct {
+	log                       zerolog.Logger
+	chainTime                 *chaintime.Service
+	validatorLivenessProvider consensusclient.ValidatorLivenessProvider
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	beaconCommitteesProvider  consensusclient.BeaconCommitteesProvider
+	epochs                    uint64
+}
+
+// New creates a new doppelganger checker.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "doppelganger").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                       log,
+		chainTime:                 parameters.chainTime,
+		validatorLivenessProvider: parameters.validatorLivenessProvider,
+		signedBeaconBlockProvider: parameters.signedBeaconBlockProvider,
+		beaconCommitteesProvider:  parameters.beaconCommitteesProvider,
+		epochs:                    parameters.epochs,
+	}, nil
+}
+
+// Check checks the given validators for activity over the configured number
+// of epochs prior to the current epoch and the current epoch itself, using
+// both the beacon node's liveness data and the attestations included in
+// blocks.  It returns true if no activity is found and so it is safe for the
+// validators to start performing duties, along with any evidence of activity.
+func (s *Service) Check(ctx context.Context, indices []phase0.ValidatorIndex) (bool, []*Evidence, error) {
+	if len(indices) == 0 {
+		return true, nil, nil
+	}
+
+	currentEpoch := s.chainTime.CurrentEpoch()
+	startEpoch := phase0.Epoch(0)
+	if uint64(currentEpoch) > s.epochs {
+		startEpoch = currentEpoch - phase0.Epoch(s.epochs)
+	}
+
+	evidence := make([]*Evidence, 0)
+	for epoch := startEpoch; epoch <= currentEpoch; epoch++ {
+		livenessEvidence, err := s.livenessEvidence(ctx, epoch, indices)
+		if err != nil {
+			return false, nil, err
+		}
+		evidence = append(evidence, livenessEvidence...)
+	}
+
+	attestationEvidence, err := s.attestationEvidence(ctx,
+		s.chainTime.EpochStartSlot(startEpoch),
+		s.chainTime.CurrentSlot(),
+		indices,
+	)
+	if err != nil {
+		return false, nil, err
+	}
+	evidence = append(evidence, attestationEvidence...)
+
+	sort.Slice(evidence, func(i, j int) bool {
+		if evidence[i].ValidatorIndex != evidence[j].ValidatorIndex {
+			return evidence[i].ValidatorIndex < evidence[j].ValidatorIndex
+		}
+		if evidence[i].Epoch != evidence[j].Epoch {
+			return evidence[i].Epoch < evidence[j].Epoch
+		}
+
+		return evidence[i].Slot < evidence[j].Slot
+	})
+
+	if len(evidence) > 0 {
+		s.log.Warn().Int("evidence", len(evidence)).Msg("Found evidence of validator activity")
+	}
+
+	return len(evidence) == 0, evidence, nil
+}
+
+// livenessEvidence returns evidence of activity from liveness data.
+func (s *Service) livenessEvidence(ctx context.Context,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*Evidence,
+	error,
+) {
+	response, err := s.validatorLivenessProvider.ValidatorLiveness(ctx, &api.ValidatorLivenessOpts{
+		Epoch:   epoch,
+		Indices: indices,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to obtain validator liveness for epoch %d", epoch), err)
+	}
+
+	evidence := make([]*Evidence, 0)
+	for _, liveness := range response.Data {
+		if liveness.IsLive {
+			evidence = append(evidence, &Evidence{
+				ValidatorIndex: liveness.Index,
+				Source:         SourceLiveness,
+				Epoch:          epoch,
+			})
+		}
+	}
+
+	return evidence, nil
+}
+
+// attestationEvidence returns evidence of activity from attestations
+// included in blocks from the start slot up to and including the end slot.
+func (s *Service) attestationEvidence(ctx context.Context,
+	startSlot phase0.Slot,
+	endSlot phase0.Slot,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*Evidence,
+	error,
+) {
+	checked := make(map[phase0.ValidatorIndex]struct{}, len(indices))
+	for _, index := range indices {
+		checked[index] = struct{}{}
+	}
+	committees := make(map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+
+	evidence := make([]*Evidence, 0)
+	for slot := startSlot; slot <= endSlot; slot++ {
+		block, err := consensusclient.SlotBlock(ctx, s.signedBeaconBlockProvider, slot)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			continue
+		}
+
+		attestations, err := block.Attestations()
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain attestations for slot %d", slot), err)
+		}
+		for _, attestation := range attestations {
+			data, err := attestation.Data()
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("failed to obtain attestation data for slot %d", slot), err)
+			}
+			slotCommittees, err := s.slotCommittees(ctx, committees, data.Slot)
+			if err != nil {
+				return nil, err
+			}
+			validators, err := attestation.AttestingIndices(slotCommittees)
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("failed to obtain attesting indices for slot %d", slot), err)
+			}
+			for _, validator := range validators {
+				if _, exists := checked[validator]; exists {
+					evidence = append(evidence, &Evidence{
+						ValidatorIndex: validator,
+						Source:         SourceAttestation,
+						Epoch:          s.chainTime.SlotToEpoch(data.Slot),
+						Slot:           slot,
+					})
+				}
+			}
+		}
+	}
+
+	return evidence, nil
+}
+
+// slotCommittees returns the committees for the given slot, adding those for
+// the slot's epoch to the supplied map if they are not already present.
+func (s *Service) slotCommittees(ctx context.Context,
+	committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	slot phase0.Slot,
+) (
+	map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	error,
+) {
+	if _, exists := committees[slot]; !exists {
+		if err := s.fetchCommittees(ctx, committees, s.chainTime.SlotToEpoch(slot)); err != nil {
+			return nil, err
+		}
+	}
+	slotCommittees, exists := committees[slot]
+	if !exists {
+		return nil, fmt.Errorf("no committees for slot %d", slot)
+	}
+
+	return slotCommittees, nil
+}
+
+// fetchCommittees adds the committees for the given epoch to the supplied map.
+func (s *Service) fetchCommittees(ctx context.Context,
+	committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	epoch phase0.Epoch,
+) error {
+	response, err := s.beaconCommitteesProvider.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: "head",
+		Epoch: &epoch,
+	})
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to obtain beacon committees for epoch %d", epoch), err)
+	}
+	for _, committee := range response.Data {
+		if _, exists := committees[committee.Slot]; !exists {
+			committees[committee.Slot] = make(map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+		}
+		committees[committee.Slot][committee.Index] = committee.Validators
+	}
+
+	return nil
+}
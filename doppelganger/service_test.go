@@ -0,0 +1,261 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doppelganger_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/doppelganger"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// livenessClient creates a test client with a current slot of 10, the
+// supplied blocks and the supplied live validators per epoch.
+func livenessClient(t *testing.T,
+	blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock,
+	live map[phase0.Epoch][]phase0.ValidatorIndex,
+) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now().Add(-10*12*time.Second-time.Second), nil)
+	require.NoError(t, err)
+	testfixtures.SetBeaconCommittees(client)
+	testfixtures.SetBlocks(client, blocks)
+	client.ValidatorLivenessFunc = func(_ context.Context, opts *api.ValidatorLivenessOpts) (*api.Response[[]*apiv1.ValidatorLiveness], error) {
+		liveness := make([]*apiv1.ValidatorLiveness, 0, len(opts.Indices))
+		for _, index := range opts.Indices {
+			isLive := false
+			for _, liveIndex := range live[opts.Epoch] {
+				if liveIndex == index {
+					isLive = true
+				}
+			}
+			liveness = append(liveness, &apiv1.ValidatorLiveness{Index: index, IsLive: isLive})
+		}
+
+		return &api.Response[[]*apiv1.ValidatorLiveness]{Data: liveness}, nil
+	}
+
+	return client
+}
+
+func newService(t *testing.T, client *mock.Service, params ...doppelganger.Parameter) *doppelganger.Service {
+	t.Helper()
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	s, err := doppelganger.New(context.Background(), append([]doppelganger.Parameter{
+		doppelganger.WithLogLevel(zerolog.Disabled),
+		doppelganger.WithChainTime(chainTime),
+		doppelganger.WithValidatorLivenessProvider(client),
+		doppelganger.WithSignedBeaconBlockProvider(client),
+		doppelganger.WithBeaconCommitteesProvider(client),
+	}, params...)...)
+	require.NoError(t, err)
+
+	return s
+}
+
+func phase0Block(slot phase0.Slot, attestations ...*phase0.Attestation) *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: slot,
+				Body: &phase0.BeaconBlockBody{
+					Attestations: attestations,
+				},
+			},
+		},
+	}
+}
+
+func attestation(slot phase0.Slot, index phase0.CommitteeIndex, set ...uint64) *phase0.Attestation {
+	aggregationBits := bitfield.NewBitlist(4)
+	for _, bit := range set {
+		aggregationBits.SetBitAt(bit, true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &phase0.AttestationData{
+			Slot:   slot,
+			Index:  index,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	client := livenessClient(t, nil, nil)
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []doppelganger.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []doppelganger.Parameter{
+				doppelganger.WithValidatorLivenessProvider(client),
+				doppelganger.WithSignedBeaconBlockProvider(client),
+				doppelganger.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "ValidatorLivenessProviderMissing",
+			params: []doppelganger.Parameter{
+				doppelganger.WithChainTime(chainTime),
+				doppelganger.WithSignedBeaconBlockProvider(client),
+				doppelganger.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno validator liveness provider specified",
+		},
+		{
+			name: "SignedBeaconBlockProviderMissing",
+			params: []doppelganger.Parameter{
+				doppelganger.WithChainTime(chainTime),
+				doppelganger.WithValidatorLivenessProvider(client),
+				doppelganger.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno signed beacon block provider specified",
+		},
+		{
+			name: "BeaconCommitteesProviderMissing",
+			params: []doppelganger.Parameter{
+				doppelganger.WithChainTime(chainTime),
+				doppelganger.WithValidatorLivenessProvider(client),
+				doppelganger.WithSignedBeaconBlockProvider(client),
+			},
+			err: "problem with parameters\nno beacon committees provider specified",
+		},
+		{
+			name: "EpochsZero",
+			params: []doppelganger.Parameter{
+				doppelganger.WithChainTime(chainTime),
+				doppelganger.WithValidatorLivenessProvider(client),
+				doppelganger.WithSignedBeaconBlockProvider(client),
+				doppelganger.WithBeaconCommitteesProvider(client),
+				doppelganger.WithEpochs(0),
+			},
+			err: "problem with parameters\nepochs must be greater than 0",
+		},
+		{
+			name: "Good",
+			params: []doppelganger.Parameter{
+				doppelganger.WithChainTime(chainTime),
+				doppelganger.WithValidatorLivenessProvider(client),
+				doppelganger.WithSignedBeaconBlockProvider(client),
+				doppelganger.WithBeaconCommitteesProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := doppelganger.New(context.Background(), append(test.params, doppelganger.WithLogLevel(zerolog.Disabled))...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+
+	// Current slot is 10, in epoch 2, so by default epochs 0 to 2 are checked.
+	blocks := map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+		// Validator 51 is the second member of committee 0 at slot 5.
+		6: phase0Block(6, attestation(5, 0, 1)),
+		// Validator 94 is the first member of committee 1 at slot 9.
+		10: phase0Block(10, attestation(9, 1, 0)),
+	}
+	live := map[phase0.Epoch][]phase0.ValidatorIndex{
+		0: {200},
+		2: {51},
+	}
+	client := livenessClient(t, blocks, live)
+
+	tests := []struct {
+		name     string
+		params   []doppelganger.Parameter
+		indices  []phase0.ValidatorIndex
+		safe     bool
+		evidence []*doppelganger.Evidence
+	}{
+		{
+			name: "Empty",
+			safe: true,
+		},
+		{
+			name:    "Inactive",
+			indices: []phase0.ValidatorIndex{50, 52, 95},
+			safe:    true,
+		},
+		{
+			name:    "Active",
+			indices: []phase0.ValidatorIndex{51, 94, 200},
+			safe:    false,
+			evidence: []*doppelganger.Evidence{
+				{ValidatorIndex: 51, Source: doppelganger.SourceAttestation, Epoch: 1, Slot: 6},
+				{ValidatorIndex: 51, Source: doppelganger.SourceLiveness, Epoch: 2},
+				{ValidatorIndex: 94, Source: doppelganger.SourceAttestation, Epoch: 2, Slot: 10},
+				{ValidatorIndex: 200, Source: doppelganger.SourceLiveness, Epoch: 0},
+			},
+		},
+		{
+			name:    "SingleEpoch",
+			params:  []doppelganger.Parameter{doppelganger.WithEpochs(1)},
+			indices: []phase0.ValidatorIndex{51, 94, 200},
+			safe:    false,
+			evidence: []*doppelganger.Evidence{
+				{ValidatorIndex: 51, Source: doppelganger.SourceAttestation, Epoch: 1, Slot: 6},
+				{ValidatorIndex: 51, Source: doppelganger.SourceLiveness, Epoch: 2},
+				{ValidatorIndex: 94, Source: doppelganger.SourceAttestation, Epoch: 2, Slot: 10},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newService(t, client, test.params...)
+			safe, evidence, err := s.Check(ctx, test.indices)
+			require.NoError(t, err)
+			require.Equal(t, test.safe, safe)
+			if len(test.evidence) == 0 {
+				require.Empty(t, evidence)
+			} else {
+				require.Equal(t, test.evidence, evidence)
+			}
+		})
+	}
+}
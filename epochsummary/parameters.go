@@ -0,0 +1,103 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package epochsummary
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                  zerolog.Level
+	chainTime                 *chaintime.Service
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	beaconCommitteesProvider  consensusclient.BeaconCommitteesProvider
+	proposerDutiesProvider    consensusclient.ProposerDutiesProvider
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the provider from which canonical blocks are obtained.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithBeaconCommitteesProvider sets the provider from which committee membership is obtained.
+func WithBeaconCommitteesProvider(provider consensusclient.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithProposerDutiesProvider sets the provider from which proposer duties are obtained.
+func WithProposerDutiesProvider(provider consensusclient.ProposerDutiesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proposerDutiesProvider = provider
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider specified")
+	}
+	if parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("no beacon committees provider specified")
+	}
+	if parameters.proposerDutiesProvider == nil {
+		return nil, errors.New("no proposer duties provider specified")
+	}
+
+	return &parameters, nil
+}
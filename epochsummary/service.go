@@ -0,0 +1,334 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package epochsummary summarises the performance of the chain for an
+// epoch, from its blocks and duties, for display in dashboards.
+package epochsummary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Proposal is a proposal that did not become part of the canonical chain.
+type Proposal struct {
+	// Slot is the slot of the proposal.
+	Slot phase0.Slot
+	// ProposerIndex is the index of the validator that was due to propose.
+	ProposerIndex phase0.ValidatorIndex
+	// Root is the root of the orphaned block, or zero if the proposal was missed.
+	Root phase0.Root
+}
+
+// EpochSummary is a summary of the performance of the chain for an epoch.
+type EpochSummary struct {
+	// Epoch is the epoch of the summary.
+	Epoch phase0.Epoch
+	// ExpectedProposals is the number of slots in the epoch with a proposer duty.
+	ExpectedProposals int
+	// Proposals is the number of canonical blocks in the epoch.
+	Proposals int
+	// MissedProposals are the proposals for which no block was seen.
+	MissedProposals []*Proposal
+	// OrphanedProposals are the proposals for which a block was observed
+	// but did not become canonical.
+	OrphanedProposals []*Proposal
+	// ExpectedAttesters is the number of validators due to attest in the epoch.
+	ExpectedAttesters int
+	// Attesters is the number of validators with an attestation for the
+	// epoch included in a canonical block.
+	Attesters int
+	// ParticipationRate is the ratio of attesters to expected attesters.
+	ParticipationRate float64
+	// SyncCommitteeExpected is the number of sync committee signatures
+	// expected in the canonical blocks of the epoch.
+	SyncCommitteeExpected int
+	// SyncCommitteeParticipants is the number of sync committee signatures
+	// included in the canonical blocks of the epoch.
+	SyncCommitteeParticipants int
+	// SyncCommitteeParticipationRate is the ratio of sync committee
+	// participants to expected signatures.
+	SyncCommitteeParticipationRate float64
+}
+
+// Service summarises epochs.
+type Service struct {
+	log                       zerolog.Logger
+	chainTime                 *chaintime.Service
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	beaconCommitteesProvider  consensusclient.BeaconCommitteesProvider
+	proposerDutiesProvider    consensusclient.ProposerDutiesProvider
+
+	mu       sync.Mutex
+	observed map[phase0.Slot]map[phase0.Root]struct{}
+}
+
+// New creates a new epoch summariser.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "epochsummary").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                       log,
+		chainTime:                 parameters.chainTime,
+		signedBeaconBlockProvider: parameters.signedBeaconBlockProvider,
+		beaconCommitteesProvider:  parameters.beaconCommitteesProvider,
+		proposerDutiesProvider:    parameters.proposerDutiesProvider,
+		observed:                  make(map[phase0.Slot]map[phase0.Root]struct{}),
+	}, nil
+}
+
+// ObserveBlock records a block seen at the given slot, for example from a
+// block event.  Observed blocks that are not canonical when their epoch is
+// summarised are reported as orphaned; without observations orphaned
+// proposals are reported as missed.
+func (s *Service) ObserveBlock(slot phase0.Slot, root phase0.Root) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.observed[slot]; !exists {
+		s.observed[slot] = make(map[phase0.Root]struct{})
+	}
+	s.observed[slot][root] = struct{}{}
+}
+
+// Summarise summarises the given epoch.  Attestations for an epoch can be
+// included up to the end of the following epoch, so the summary should be
+// requested once the following epoch has completed.  Observed blocks for
+// slots before the end of the epoch are discarded once it is summarised.
+func (s *Service) Summarise(ctx context.Context, epoch phase0.Epoch) (*EpochSummary, error) {
+	proposers, err := s.proposers(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	committees, err := s.committees(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &EpochSummary{
+		Epoch:             epoch,
+		ExpectedProposals: len(proposers),
+	}
+	expectedAttesters := make(map[phase0.ValidatorIndex]struct{})
+	for _, slotCommittees := range committees {
+		for _, committee := range slotCommittees {
+			for _, index := range committee {
+				expectedAttesters[index] = struct{}{}
+			}
+		}
+	}
+	summary.ExpectedAttesters = len(expectedAttesters)
+
+	startSlot := s.chainTime.EpochStartSlot(epoch)
+	endSlot := s.chainTime.EpochStartSlot(epoch + 1)
+	attesters := make(map[phase0.ValidatorIndex]struct{})
+
+	// Attestations for the epoch are included in blocks of this and the following epoch.
+	for slot := startSlot; slot < s.chainTime.EpochStartSlot(epoch+2); slot++ {
+		block, err := consensusclient.SlotBlock(ctx, s.signedBeaconBlockProvider, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		if slot < endSlot {
+			if err := s.summariseProposal(summary, proposers, slot, block); err != nil {
+				return nil, err
+			}
+		}
+		if block == nil {
+			continue
+		}
+		if err := s.addAttesters(attesters, committees, epoch, block); err != nil {
+			return nil, err
+		}
+	}
+
+	summary.Attesters = len(attesters)
+	summary.ParticipationRate = ratio(summary.Attesters, summary.ExpectedAttesters)
+	summary.SyncCommitteeParticipationRate = ratio(summary.SyncCommitteeParticipants, summary.SyncCommitteeExpected)
+
+	s.prune(endSlot)
+
+	return summary, nil
+}
+
+// summariseProposal adds the proposal at the given slot to the summary.
+func (s *Service) summariseProposal(summary *EpochSummary,
+	proposers map[phase0.Slot]phase0.ValidatorIndex,
+	slot phase0.Slot,
+	block *spec.VersionedSignedBeaconBlock,
+) error {
+	var canonicalRoot phase0.Root
+	if block != nil {
+		var err error
+		canonicalRoot, err = block.Root()
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to calculate root of block at slot %d", slot), err)
+		}
+		summary.Proposals++
+
+		if block.Version >= spec.DataVersionAltair {
+			syncAggregate, err := block.SyncAggregate()
+			if err != nil {
+				return errors.Join(fmt.Errorf("failed to obtain sync aggregate of block at slot %d", slot), err)
+			}
+			summary.SyncCommitteeExpected += int(syncAggregate.SyncCommitteeBits.Len())
+			summary.SyncCommitteeParticipants += int(syncAggregate.SyncCommitteeBits.Count())
+		}
+	}
+
+	s.mu.Lock()
+	orphaned := make([]phase0.Root, 0)
+	for root := range s.observed[slot] {
+		if root != canonicalRoot {
+			orphaned = append(orphaned, root)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(orphaned, func(i, j int) bool {
+		return string(orphaned[i][:]) < string(orphaned[j][:])
+	})
+
+	for _, root := range orphaned {
+		summary.OrphanedProposals = append(summary.OrphanedProposals, &Proposal{
+			Slot:          slot,
+			ProposerIndex: proposers[slot],
+			Root:          root,
+		})
+	}
+	if block == nil && len(orphaned) == 0 {
+		if _, exists := proposers[slot]; exists {
+			summary.MissedProposals = append(summary.MissedProposals, &Proposal{
+				Slot:          slot,
+				ProposerIndex: proposers[slot],
+			})
+		}
+	}
+
+	return nil
+}
+
+// addAttesters adds the validators with attestations for the epoch in the
+// block to the set of attesters.
+func (*Service) addAttesters(attesters map[phase0.ValidatorIndex]struct{},
+	committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	epoch phase0.Epoch,
+	block *spec.VersionedSignedBeaconBlock,
+) error {
+	attestations, err := block.Attestations()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain attestations"), err)
+	}
+
+	for _, attestation := range attestations {
+		data, err := attestation.Data()
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain attestation data"), err)
+		}
+		if data.Target == nil || data.Target.Epoch != epoch {
+			continue
+		}
+		validators, err := attestation.AttestingIndices(committees[data.Slot])
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain attesting indices"), err)
+		}
+		for _, index := range validators {
+			attesters[index] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// proposers returns the proposer for each slot of the epoch.
+func (s *Service) proposers(ctx context.Context, epoch phase0.Epoch) (map[phase0.Slot]phase0.ValidatorIndex, error) {
+	response, err := s.proposerDutiesProvider.ProposerDuties(ctx, &api.ProposerDutiesOpts{
+		Epoch: epoch,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to obtain proposer duties for epoch %d", epoch), err)
+	}
+
+	proposers := make(map[phase0.Slot]phase0.ValidatorIndex, len(response.Data))
+	for _, duty := range response.Data {
+		proposers[duty.Slot] = duty.ValidatorIndex
+	}
+
+	return proposers, nil
+}
+
+// committees returns the beacon committees for each slot of the epoch.
+func (s *Service) committees(ctx context.Context,
+	epoch phase0.Epoch,
+) (
+	map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	error,
+) {
+	response, err := s.beaconCommitteesProvider.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: "head",
+		Epoch: &epoch,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to obtain beacon committees for epoch %d", epoch), err)
+	}
+
+	committees := make(map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+	for _, committee := range response.Data {
+		if _, exists := committees[committee.Slot]; !exists {
+			committees[committee.Slot] = make(map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+		}
+		committees[committee.Slot][committee.Index] = committee.Validators
+	}
+
+	return committees, nil
+}
+
+// prune removes observed blocks before the given slot.
+func (s *Service) prune(slot phase0.Slot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for observedSlot := range s.observed {
+		if observedSlot < slot {
+			delete(s.observed, observedSlot)
+		}
+	}
+}
+
+func ratio(numerator int, denominator int) float64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	return float64(numerator) / float64(denominator)
+}
@@ -0,0 +1,236 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package epochsummary_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/epochsummary"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// dutiesClient creates a test client with a proposer duty for every slot and
+// the supplied blocks.
+func dutiesClient(t *testing.T, blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now(), nil)
+	require.NoError(t, err)
+	testfixtures.SetBeaconCommittees(client)
+	testfixtures.SetBlocks(client, blocks)
+	client.ProposerDutiesFunc = func(_ context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+		duties := make([]*apiv1.ProposerDuty, 0, testfixtures.ClientSlotsPerEpoch)
+		startSlot := phase0.Slot(uint64(opts.Epoch) * testfixtures.ClientSlotsPerEpoch)
+		for slot := startSlot; slot < startSlot+testfixtures.ClientSlotsPerEpoch; slot++ {
+			duties = append(duties, &apiv1.ProposerDuty{
+				Slot:           slot,
+				ValidatorIndex: phase0.ValidatorIndex(100 + slot),
+			})
+		}
+
+		return &api.Response[[]*apiv1.ProposerDuty]{Data: duties}, nil
+	}
+
+	return client
+}
+
+func newService(t *testing.T, client *mock.Service) *epochsummary.Service {
+	t.Helper()
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	s, err := epochsummary.New(context.Background(),
+		epochsummary.WithLogLevel(zerolog.Disabled),
+		epochsummary.WithChainTime(chainTime),
+		epochsummary.WithSignedBeaconBlockProvider(client),
+		epochsummary.WithBeaconCommitteesProvider(client),
+		epochsummary.WithProposerDutiesProvider(client),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+func attestation(slot phase0.Slot, index phase0.CommitteeIndex, set ...uint64) *phase0.Attestation {
+	aggregationBits := bitfield.NewBitlist(4)
+	for _, bit := range set {
+		aggregationBits.SetBitAt(bit, true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &phase0.AttestationData{
+			Slot:   slot,
+			Index:  index,
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{Epoch: phase0.Epoch(slot / 4)},
+		},
+	}
+}
+
+// altairBlock creates a block at the given slot with the given number of
+// sync committee participants and attestations.
+func altairBlock(t *testing.T, slot phase0.Slot, syncParticipants uint64, attestations ...*phase0.Attestation) *spec.VersionedSignedBeaconBlock {
+	t.Helper()
+
+	block, err := testfixtures.Block(spec.DataVersionAltair, testfixtures.WithSlot(slot))
+	require.NoError(t, err)
+	body := block.Altair.Message.Body
+	body.Attestations = attestations
+	body.SyncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+	for i := uint64(0); i < syncParticipants; i++ {
+		body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+
+	return block
+}
+
+func TestNew(t *testing.T) {
+	client := dutiesClient(t, nil)
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []epochsummary.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []epochsummary.Parameter{
+				epochsummary.WithSignedBeaconBlockProvider(client),
+				epochsummary.WithBeaconCommitteesProvider(client),
+				epochsummary.WithProposerDutiesProvider(client),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "SignedBeaconBlockProviderMissing",
+			params: []epochsummary.Parameter{
+				epochsummary.WithChainTime(chainTime),
+				epochsummary.WithBeaconCommitteesProvider(client),
+				epochsummary.WithProposerDutiesProvider(client),
+			},
+			err: "problem with parameters\nno signed beacon block provider specified",
+		},
+		{
+			name: "BeaconCommitteesProviderMissing",
+			params: []epochsummary.Parameter{
+				epochsummary.WithChainTime(chainTime),
+				epochsummary.WithSignedBeaconBlockProvider(client),
+				epochsummary.WithProposerDutiesProvider(client),
+			},
+			err: "problem with parameters\nno beacon committees provider specified",
+		},
+		{
+			name: "ProposerDutiesProviderMissing",
+			params: []epochsummary.Parameter{
+				epochsummary.WithChainTime(chainTime),
+				epochsummary.WithSignedBeaconBlockProvider(client),
+				epochsummary.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno proposer duties provider specified",
+		},
+		{
+			name: "Good",
+			params: []epochsummary.Parameter{
+				epochsummary.WithLogLevel(zerolog.Disabled),
+				epochsummary.WithChainTime(chainTime),
+				epochsummary.WithSignedBeaconBlockProvider(client),
+				epochsummary.WithBeaconCommitteesProvider(client),
+				epochsummary.WithProposerDutiesProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := epochsummary.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSummarise(t *testing.T) {
+	// Epoch 1 is slots 4-7.  Slot 5 is missed and slot 6 is orphaned.
+	blocks := map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+		// The attestation for epoch 0 is ignored.
+		4: altairBlock(t, 4, 10, attestation(3, 0, 0, 1, 2, 3)),
+		7: altairBlock(t, 7, 20, attestation(4, 0, 0, 1)),
+		// Attestations in the following epoch are counted, once per validator,
+		// but its sync aggregate is not.
+		8: altairBlock(t, 8, 30, attestation(7, 1, 0, 1, 2, 3), attestation(4, 0, 0)),
+	}
+	client := dutiesClient(t, blocks)
+	s := newService(t, client)
+
+	canonicalRoot, err := blocks[4].Root()
+	require.NoError(t, err)
+	s.ObserveBlock(4, canonicalRoot)
+	s.ObserveBlock(6, phase0.Root{0xaa})
+
+	summary, err := s.Summarise(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, &epochsummary.EpochSummary{
+		Epoch:             1,
+		ExpectedProposals: 4,
+		Proposals:         2,
+		MissedProposals: []*epochsummary.Proposal{
+			{Slot: 5, ProposerIndex: 105},
+		},
+		OrphanedProposals: []*epochsummary.Proposal{
+			{Slot: 6, ProposerIndex: 106, Root: phase0.Root{0xaa}},
+		},
+		ExpectedAttesters:              32,
+		Attesters:                      6,
+		ParticipationRate:              6.0 / 32.0,
+		SyncCommitteeExpected:          1024,
+		SyncCommitteeParticipants:      30,
+		SyncCommitteeParticipationRate: 30.0 / 1024.0,
+	}, summary)
+
+	// Observations are discarded once summarised, so the orphaned block is
+	// now reported as missed.
+	summary, err = s.Summarise(context.Background(), 1)
+	require.NoError(t, err)
+	require.Empty(t, summary.OrphanedProposals)
+	require.Len(t, summary.MissedProposals, 2)
+}
+
+func TestSummariseEmpty(t *testing.T) {
+	s := newService(t, dutiesClient(t, nil))
+
+	summary, err := s.Summarise(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, 0, summary.Proposals)
+	require.Len(t, summary.MissedProposals, 4)
+	require.Equal(t, 32, summary.ExpectedAttesters)
+	require.Zero(t, summary.ParticipationRate)
+	require.Zero(t, summary.SyncCommitteeParticipationRate)
+}
@@ -26,4 +26,12 @@ var (
 	ErrInvalidOptions = errors.New("invalid options")
 	// ErrInconsistentResult is returned when a request returns with data at odds to that requested.
 	ErrInconsistentResult = errors.New("inconsistent result")
+	// ErrProposalPolicyUnsatisfied is returned when a proposal cannot be obtained that meets the requested fallback policy.
+	ErrProposalPolicyUnsatisfied = errors.New("proposal fallback policy cannot be satisfied")
+	// ErrNetworkMismatch is returned when a client is connected to a node on a network other than that expected.
+	ErrNetworkMismatch = errors.New("network mismatch")
+	// ErrNotReady is returned when a node is not ready to carry out duties.
+	ErrNotReady = errors.New("node is not ready")
+	// ErrClosed is returned when a request is made to a client that has been closed.
+	ErrClosed = errors.New("client is closed")
 )
@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exitestimator
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Config contains the chain configuration values used for estimation.
+type Config struct {
+	SlotsPerEpoch                         uint64
+	MaxSeedLookahead                      phase0.Epoch
+	MinValidatorWithdrawabilityDelay      phase0.Epoch
+	MinPerEpochChurnLimit                 phase0.Gwei
+	MaxPerEpochActivationExitChurnLimit   phase0.Gwei
+	ChurnLimitQuotient                    uint64
+	EffectiveBalanceIncrement             phase0.Gwei
+	MinActivationBalance                  phase0.Gwei
+	MaxEffectiveBalance                   phase0.Gwei
+	MaxPendingPartialsPerWithdrawalsSweep uint64
+	MaxValidatorsPerWithdrawalsSweep      uint64
+	MaxWithdrawalsPerPayload              uint64
+}
+
+// ConfigFromSpec creates a configuration from the values returned by a spec
+// provider.
+func ConfigFromSpec(data map[string]any) (*Config, error) {
+	config := &Config{}
+
+	values := []spec.Uint64Value{
+		{Key: "SLOTS_PER_EPOCH", Apply: func(v uint64) { config.SlotsPerEpoch = v }},
+		{Key: "MAX_SEED_LOOKAHEAD", Apply: func(v uint64) { config.MaxSeedLookahead = phase0.Epoch(v) }},
+		{Key: "MIN_VALIDATOR_WITHDRAWABILITY_DELAY", Apply: func(v uint64) { config.MinValidatorWithdrawabilityDelay = phase0.Epoch(v) }},
+		{Key: "MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA", Apply: func(v uint64) { config.MinPerEpochChurnLimit = phase0.Gwei(v) }},
+		{Key: "MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT", Apply: func(v uint64) { config.MaxPerEpochActivationExitChurnLimit = phase0.Gwei(v) }},
+		{Key: "CHURN_LIMIT_QUOTIENT", Apply: func(v uint64) { config.ChurnLimitQuotient = v }},
+		{Key: "EFFECTIVE_BALANCE_INCREMENT", Apply: func(v uint64) { config.EffectiveBalanceIncrement = phase0.Gwei(v) }},
+		{Key: "MIN_ACTIVATION_BALANCE", Apply: func(v uint64) { config.MinActivationBalance = phase0.Gwei(v) }},
+		{Key: "MAX_EFFECTIVE_BALANCE_ELECTRA", Apply: func(v uint64) { config.MaxEffectiveBalance = phase0.Gwei(v) }},
+		{Key: "MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP", Apply: func(v uint64) { config.MaxPendingPartialsPerWithdrawalsSweep = v }},
+		{Key: "MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP", Apply: func(v uint64) { config.MaxValidatorsPerWithdrawalsSweep = v }},
+		{Key: "MAX_WITHDRAWALS_PER_PAYLOAD", Apply: func(v uint64) { config.MaxWithdrawalsPerPayload = v }},
+	}
+	if err := spec.ReadUint64Values(data, values); err != nil {
+		return nil, err
+	}
+
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check ensures that values used as divisors are present.
+func (c *Config) check() error {
+	switch {
+	case c.SlotsPerEpoch == 0:
+		return errors.New("SLOTS_PER_EPOCH cannot be 0")
+	case c.ChurnLimitQuotient == 0:
+		return errors.New("CHURN_LIMIT_QUOTIENT cannot be 0")
+	case c.EffectiveBalanceIncrement == 0:
+		return errors.New("EFFECTIVE_BALANCE_INCREMENT cannot be 0")
+	case c.MaxPendingPartialsPerWithdrawalsSweep == 0:
+		return errors.New("MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP cannot be 0")
+	case c.MaxValidatorsPerWithdrawalsSweep == 0:
+		return errors.New("MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP cannot be 0")
+	case c.MaxWithdrawalsPerPayload == 0:
+		return errors.New("MAX_WITHDRAWALS_PER_PAYLOAD cannot be 0")
+	}
+
+	return nil
+}
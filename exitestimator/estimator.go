@@ -0,0 +1,256 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exitestimator estimates when validators will exit and when their
+// funds will be withdrawn, using the electra rules for exit churn, pending
+// partial withdrawals and the withdrawal sweep.
+//
+// Estimates assume that no further exits are initiated ahead of the
+// validator, and that the validator set does not change significantly.
+package exitestimator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Estimator estimates exit and withdrawal timings.
+type Estimator struct {
+	config                   *Config
+	state                    *State
+	epoch                    phase0.Epoch
+	activationExitChurnLimit phase0.Gwei
+}
+
+// ExitEstimate is the estimated exit of a validator.
+type ExitEstimate struct {
+	// ExitEpoch is the epoch at which the validator exits.
+	ExitEpoch phase0.Epoch
+	// WithdrawableEpoch is the epoch from which the validator's balance can
+	// be withdrawn.
+	WithdrawableEpoch phase0.Epoch
+	// Scheduled is true if the exit has already been initiated, in which
+	// case the epochs are those in the state rather than estimates.
+	Scheduled bool
+}
+
+// PartialWithdrawalEstimate is the estimated processing of a pending partial
+// withdrawal.
+type PartialWithdrawalEstimate struct {
+	// Amount is the amount to withdraw.
+	Amount phase0.Gwei
+	// WithdrawableEpoch is the epoch from which the withdrawal can be processed.
+	WithdrawableEpoch phase0.Epoch
+	// Slot is the estimated slot at which the withdrawal is processed.
+	Slot phase0.Slot
+}
+
+// New creates a new estimator for the given configuration and state.
+func New(config *Config, state *State) (*Estimator, error) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+	if len(state.Validators) != len(state.Balances) {
+		return nil, errors.New("validators and balances differ in length")
+	}
+
+	e := &Estimator{
+		config: config,
+		state:  state,
+		epoch:  phase0.Epoch(uint64(state.Slot) / config.SlotsPerEpoch),
+	}
+	e.activationExitChurnLimit = e.calcActivationExitChurnLimit()
+
+	return e, nil
+}
+
+// ActivationExitChurnLimit returns the maximum balance that can exit per epoch.
+func (e *Estimator) ActivationExitChurnLimit() phase0.Gwei {
+	return e.activationExitChurnLimit
+}
+
+// ExitEpochForBalance returns the epoch at which an exit of the given balance
+// initiated now would take effect.
+func (e *Estimator) ExitEpochForBalance(exitBalance phase0.Gwei) phase0.Epoch {
+	// Based on compute_exit_epoch_and_update_churn.
+	earliestExitEpoch := e.epoch + 1 + e.config.MaxSeedLookahead
+	if e.state.EarliestExitEpoch > earliestExitEpoch {
+		earliestExitEpoch = e.state.EarliestExitEpoch
+	}
+
+	perEpochChurn := e.activationExitChurnLimit
+	exitBalanceToConsume := e.state.ExitBalanceToConsume
+	if e.state.EarliestExitEpoch < earliestExitEpoch {
+		// New epoch for exits.
+		exitBalanceToConsume = perEpochChurn
+	}
+
+	if exitBalance > exitBalanceToConsume {
+		balanceToProcess := exitBalance - exitBalanceToConsume
+		earliestExitEpoch += phase0.Epoch((balanceToProcess-1)/perEpochChurn + 1)
+	}
+
+	return earliestExitEpoch
+}
+
+// Exit returns the estimated exit of the given validator.
+func (e *Estimator) Exit(index phase0.ValidatorIndex) (*ExitEstimate, error) {
+	validator, err := e.validator(index)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.ExitEpoch != phase0.FarFutureEpoch {
+		return &ExitEstimate{
+			ExitEpoch:         validator.ExitEpoch,
+			WithdrawableEpoch: validator.WithdrawableEpoch,
+			Scheduled:         true,
+		}, nil
+	}
+	if validator.ActivationEpoch > e.epoch {
+		return nil, fmt.Errorf("validator %d is not active", index)
+	}
+
+	exitEpoch := e.ExitEpochForBalance(validator.EffectiveBalance)
+
+	return &ExitEstimate{
+		ExitEpoch:         exitEpoch,
+		WithdrawableEpoch: exitEpoch + e.config.MinValidatorWithdrawabilityDelay,
+	}, nil
+}
+
+// PartialWithdrawals returns the estimated processing of the pending partial
+// withdrawals for the given validator.
+func (e *Estimator) PartialWithdrawals(index phase0.ValidatorIndex) ([]*PartialWithdrawalEstimate, error) {
+	if _, err := e.validator(index); err != nil {
+		return nil, err
+	}
+
+	// Pending partial withdrawals are processed in order, a limited number
+	// per block, stopping at the first that is not yet withdrawable.
+	res := make([]*PartialWithdrawalEstimate, 0)
+	slot := e.state.Slot + 1
+	processedInSlot := uint64(0)
+	for _, withdrawal := range e.state.PendingPartialWithdrawals {
+		withdrawableSlot := phase0.Slot(uint64(withdrawal.WithdrawableEpoch) * e.config.SlotsPerEpoch)
+		if withdrawableSlot > slot {
+			slot = withdrawableSlot
+			processedInSlot = 0
+		}
+		if processedInSlot == e.config.MaxPendingPartialsPerWithdrawalsSweep {
+			slot++
+			processedInSlot = 0
+		}
+		processedInSlot++
+
+		if withdrawal.ValidatorIndex == index {
+			res = append(res, &PartialWithdrawalEstimate{
+				Amount:            withdrawal.Amount,
+				WithdrawableEpoch: withdrawal.WithdrawableEpoch,
+				Slot:              slot,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// SweepSlots returns the estimated number of slots until the withdrawal
+// sweep next reaches the given validator, where 1 is the next slot.
+func (e *Estimator) SweepSlots(index phase0.ValidatorIndex) (uint64, error) {
+	if _, err := e.validator(index); err != nil {
+		return 0, err
+	}
+
+	validators := uint64(len(e.state.Validators))
+	next := uint64(e.state.NextWithdrawalValidatorIndex) % validators
+	distance := (uint64(index) + validators - next) % validators
+
+	// Count the withdrawals that will be made before the sweep reaches the validator.
+	withdrawals := uint64(0)
+	for i := uint64(0); i < distance; i++ {
+		if e.isWithdrawable(phase0.ValidatorIndex((next + i) % validators)) {
+			withdrawals++
+		}
+	}
+
+	// The sweep is limited by both the number of validators it can consider
+	// and the number of withdrawals it can make in each slot.
+	slotsByValidators := distance/e.config.MaxValidatorsPerWithdrawalsSweep + 1
+	slotsByWithdrawals := withdrawals/e.config.MaxWithdrawalsPerPayload + 1
+
+	return max(slotsByValidators, slotsByWithdrawals), nil
+}
+
+func (e *Estimator) validator(index phase0.ValidatorIndex) (*phase0.Validator, error) {
+	if uint64(index) >= uint64(len(e.state.Validators)) {
+		return nil, fmt.Errorf("validator %d not found", index)
+	}
+	validator := e.state.Validators[index]
+	if validator == nil {
+		return nil, fmt.Errorf("validator %d not found", index)
+	}
+
+	return validator, nil
+}
+
+// calcActivationExitChurnLimit is based on get_activation_exit_churn_limit.
+func (e *Estimator) calcActivationExitChurnLimit() phase0.Gwei {
+	totalActiveBalance := phase0.Gwei(0)
+	for _, validator := range e.state.Validators {
+		if validator != nil && validator.ActivationEpoch <= e.epoch && e.epoch < validator.ExitEpoch {
+			totalActiveBalance += validator.EffectiveBalance
+		}
+	}
+	totalActiveBalance = max(totalActiveBalance, e.config.EffectiveBalanceIncrement)
+
+	churn := max(e.config.MinPerEpochChurnLimit, totalActiveBalance/phase0.Gwei(e.config.ChurnLimitQuotient))
+	churn -= churn % e.config.EffectiveBalanceIncrement
+
+	return min(e.config.MaxPerEpochActivationExitChurnLimit, churn)
+}
+
+// isWithdrawable returns true if the validator is fully or partially
+// withdrawable by the sweep.
+func (e *Estimator) isWithdrawable(index phase0.ValidatorIndex) bool {
+	validator := e.state.Validators[index]
+	if validator == nil || len(validator.WithdrawalCredentials) == 0 {
+		return false
+	}
+	balance := e.state.Balances[index]
+
+	var maxEffectiveBalance phase0.Gwei
+	switch validator.WithdrawalCredentials[0] {
+	case 0x01:
+		maxEffectiveBalance = e.config.MinActivationBalance
+	case 0x02:
+		maxEffectiveBalance = e.config.MaxEffectiveBalance
+	default:
+		// No execution withdrawal credentials.
+		return false
+	}
+
+	if validator.WithdrawableEpoch <= e.epoch && balance > 0 {
+		return true
+	}
+
+	return validator.EffectiveBalance == maxEffectiveBalance && balance > maxEffectiveBalance
+}
@@ -0,0 +1,307 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exitestimator_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/exitestimator"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpec() map[string]any {
+	return map[string]any{
+		"SLOTS_PER_EPOCH":                            uint64(32),
+		"MAX_SEED_LOOKAHEAD":                         uint64(4),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY":        uint64(256),
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":          uint64(128000000000),
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT":  uint64(256000000000),
+		"CHURN_LIMIT_QUOTIENT":                       uint64(65536),
+		"EFFECTIVE_BALANCE_INCREMENT":                uint64(1000000000),
+		"MIN_ACTIVATION_BALANCE":                     uint64(32000000000),
+		"MAX_EFFECTIVE_BALANCE_ELECTRA":              uint64(2048000000000),
+		"MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP": uint64(8),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP":       uint64(16384),
+		"MAX_WITHDRAWALS_PER_PAYLOAD":                uint64(16),
+	}
+}
+
+func testConfig(t *testing.T) *exitestimator.Config {
+	t.Helper()
+
+	config, err := exitestimator.ConfigFromSpec(testSpec())
+	require.NoError(t, err)
+
+	return config
+}
+
+// fixtureState creates a state at epoch 100 with the given number of active
+// 32 ETH validators with 0x01 withdrawal credentials.
+func fixtureState(t *testing.T, validators uint64) *exitestimator.State {
+	t.Helper()
+
+	beaconState, err := testfixtures.State(spec.DataVersionElectra,
+		testfixtures.WithSlot(3200),
+		testfixtures.WithValidators(validators),
+	)
+	require.NoError(t, err)
+	state, err := exitestimator.StateFromBeaconState(beaconState)
+	require.NoError(t, err)
+
+	return state
+}
+
+func TestConfigFromSpec(t *testing.T) {
+	config, err := exitestimator.ConfigFromSpec(testSpec())
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), config.SlotsPerEpoch)
+	require.Equal(t, phase0.Gwei(256000000000), config.MaxPerEpochActivationExitChurnLimit)
+
+	missing := testSpec()
+	delete(missing, "CHURN_LIMIT_QUOTIENT")
+	_, err = exitestimator.ConfigFromSpec(missing)
+	require.EqualError(t, err, "CHURN_LIMIT_QUOTIENT not found in spec")
+
+	wrongType := testSpec()
+	wrongType["SLOTS_PER_EPOCH"] = "32"
+	_, err = exitestimator.ConfigFromSpec(wrongType)
+	require.EqualError(t, err, "SLOTS_PER_EPOCH of unexpected type")
+
+	zero := testSpec()
+	zero["MAX_WITHDRAWALS_PER_PAYLOAD"] = uint64(0)
+	_, err = exitestimator.ConfigFromSpec(zero)
+	require.EqualError(t, err, "MAX_WITHDRAWALS_PER_PAYLOAD cannot be 0")
+}
+
+func TestNew(t *testing.T) {
+	_, err := exitestimator.New(nil, fixtureState(t, 1))
+	require.EqualError(t, err, "no config supplied")
+
+	_, err = exitestimator.New(testConfig(t), nil)
+	require.EqualError(t, err, "no state supplied")
+
+	state := fixtureState(t, 2)
+	state.Balances = state.Balances[:1]
+	_, err = exitestimator.New(testConfig(t), state)
+	require.EqualError(t, err, "validators and balances differ in length")
+}
+
+func TestActivationExitChurnLimit(t *testing.T) {
+	// Small validator set uses the minimum churn.
+	estimator, err := exitestimator.New(testConfig(t), fixtureState(t, 10))
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(128000000000), estimator.ActivationExitChurnLimit())
+
+	// Large validator set is capped at the maximum churn.
+	estimator, err = exitestimator.New(testConfig(t), fixtureState(t, 1000000))
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(256000000000), estimator.ActivationExitChurnLimit())
+}
+
+func TestExitEpochForBalance(t *testing.T) {
+	tests := []struct {
+		name                 string
+		earliestExitEpoch    phase0.Epoch
+		exitBalanceToConsume phase0.Gwei
+		balance              phase0.Gwei
+		expected             phase0.Epoch
+	}{
+		{
+			name:     "EmptyQueue",
+			balance:  32000000000,
+			expected: 105,
+		},
+		{
+			name:     "EmptyQueueFullChurn",
+			balance:  128000000000,
+			expected: 105,
+		},
+		{
+			name:     "EmptyQueueOverChurn",
+			balance:  128000000001,
+			expected: 106,
+		},
+		{
+			name:     "EmptyQueueLargeBalance",
+			balance:  2048000000000,
+			expected: 120,
+		},
+		{
+			name:                 "QueueWithSpace",
+			earliestExitEpoch:    110,
+			exitBalanceToConsume: 32000000000,
+			balance:              32000000000,
+			expected:             110,
+		},
+		{
+			name:                 "QueueWithoutSpace",
+			earliestExitEpoch:    110,
+			exitBalanceToConsume: 0,
+			balance:              32000000000,
+			expected:             111,
+		},
+		{
+			name:                 "StaleQueue",
+			earliestExitEpoch:    50,
+			exitBalanceToConsume: 0,
+			balance:              32000000000,
+			expected:             105,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := fixtureState(t, 10)
+			state.EarliestExitEpoch = test.earliestExitEpoch
+			state.ExitBalanceToConsume = test.exitBalanceToConsume
+			estimator, err := exitestimator.New(testConfig(t), state)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, estimator.ExitEpochForBalance(test.balance))
+		})
+	}
+}
+
+func TestExit(t *testing.T) {
+	state := fixtureState(t, 10)
+	state.Validators[1].ExitEpoch = 150
+	state.Validators[1].WithdrawableEpoch = 406
+	state.Validators[2].ActivationEpoch = 200
+	state.Validators[3] = nil
+
+	estimator, err := exitestimator.New(testConfig(t), state)
+	require.NoError(t, err)
+
+	estimate, err := estimator.Exit(0)
+	require.NoError(t, err)
+	require.Equal(t, &exitestimator.ExitEstimate{ExitEpoch: 105, WithdrawableEpoch: 361}, estimate)
+
+	estimate, err = estimator.Exit(1)
+	require.NoError(t, err)
+	require.Equal(t, &exitestimator.ExitEstimate{ExitEpoch: 150, WithdrawableEpoch: 406, Scheduled: true}, estimate)
+
+	_, err = estimator.Exit(2)
+	require.EqualError(t, err, "validator 2 is not active")
+
+	_, err = estimator.Exit(3)
+	require.EqualError(t, err, "validator 3 not found")
+
+	_, err = estimator.Exit(10)
+	require.EqualError(t, err, "validator 10 not found")
+}
+
+func TestPartialWithdrawals(t *testing.T) {
+	state := fixtureState(t, 10)
+	// Ten withdrawals ahead of validator 1 that are withdrawable now, then
+	// one that is withdrawable later.
+	for i := 0; i < 10; i++ {
+		state.PendingPartialWithdrawals = append(state.PendingPartialWithdrawals, &electra.PendingPartialWithdrawal{
+			ValidatorIndex:    0,
+			Amount:            1000000000,
+			WithdrawableEpoch: 90,
+		})
+	}
+	state.PendingPartialWithdrawals = append(state.PendingPartialWithdrawals,
+		&electra.PendingPartialWithdrawal{
+			ValidatorIndex:    1,
+			Amount:            2000000000,
+			WithdrawableEpoch: 90,
+		},
+		&electra.PendingPartialWithdrawal{
+			ValidatorIndex:    1,
+			Amount:            3000000000,
+			WithdrawableEpoch: 110,
+		},
+	)
+
+	estimator, err := exitestimator.New(testConfig(t), state)
+	require.NoError(t, err)
+
+	estimates, err := estimator.PartialWithdrawals(1)
+	require.NoError(t, err)
+	require.Equal(t, []*exitestimator.PartialWithdrawalEstimate{
+		{Amount: 2000000000, WithdrawableEpoch: 90, Slot: 3202},
+		{Amount: 3000000000, WithdrawableEpoch: 110, Slot: 3520},
+	}, estimates)
+
+	estimates, err = estimator.PartialWithdrawals(2)
+	require.NoError(t, err)
+	require.Empty(t, estimates)
+}
+
+func TestSweepSlots(t *testing.T) {
+	state := fixtureState(t, 100)
+	state.NextWithdrawalValidatorIndex = 90
+	// Validators 95 to 99 and 0 to 29 are partially withdrawable.
+	for i := 95; i < 130; i++ {
+		state.Balances[i%100] = 33000000000
+	}
+
+	estimator, err := exitestimator.New(testConfig(t), state)
+	require.NoError(t, err)
+
+	// Next validator to sweep.
+	slots, err := estimator.SweepSlots(90)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), slots)
+
+	// Reached after 15 withdrawals.
+	slots, err = estimator.SweepSlots(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), slots)
+
+	// Reached after 16 withdrawals, so in the following slot.
+	slots, err = estimator.SweepSlots(11)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), slots)
+
+	// Reached after 35 withdrawals.
+	slots, err = estimator.SweepSlots(89)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), slots)
+}
+
+func TestStateFromBeaconState(t *testing.T) {
+	_, err := exitestimator.StateFromBeaconState(nil)
+	require.EqualError(t, err, "no beacon state supplied")
+
+	_, err = exitestimator.StateFromBeaconState(&spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+		Deneb:   nil,
+	})
+	require.Error(t, err)
+
+	state, err := exitestimator.StateFromBeaconState(&spec.VersionedBeaconState{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.BeaconState{
+			Slot:                         3200,
+			Validators:                   []*phase0.Validator{{}},
+			Balances:                     []phase0.Gwei{32000000000},
+			EarliestExitEpoch:            110,
+			ExitBalanceToConsume:         1000000000,
+			NextWithdrawalValidatorIndex: 5,
+			PendingPartialWithdrawals:    []*electra.PendingPartialWithdrawal{{ValidatorIndex: 0}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(3200), state.Slot)
+	require.Len(t, state.Validators, 1)
+	require.Equal(t, phase0.Epoch(110), state.EarliestExitEpoch)
+	require.Equal(t, phase0.Gwei(1000000000), state.ExitBalanceToConsume)
+	require.Equal(t, phase0.ValidatorIndex(5), state.NextWithdrawalValidatorIndex)
+	require.Len(t, state.PendingPartialWithdrawals, 1)
+}
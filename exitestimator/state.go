@@ -0,0 +1,67 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exitestimator
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// State contains the beacon state values used for estimation.
+type State struct {
+	Slot                         phase0.Slot
+	Validators                   []*phase0.Validator
+	Balances                     []phase0.Gwei
+	EarliestExitEpoch            phase0.Epoch
+	ExitBalanceToConsume         phase0.Gwei
+	PendingPartialWithdrawals    []*electra.PendingPartialWithdrawal
+	NextWithdrawalValidatorIndex phase0.ValidatorIndex
+}
+
+// StateFromBeaconState creates a state from a beacon state.  The beacon
+// state must be from electra or later.
+func StateFromBeaconState(beaconState *spec.VersionedBeaconState) (*State, error) {
+	if beaconState == nil {
+		return nil, errors.New("no beacon state supplied")
+	}
+
+	var err error
+	state := &State{}
+	if state.Slot, err = beaconState.Slot(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain slot"), err)
+	}
+	if state.Validators, err = beaconState.Validators(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain validators"), err)
+	}
+	if state.Balances, err = beaconState.ValidatorBalances(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain balances"), err)
+	}
+	if state.EarliestExitEpoch, err = beaconState.EarliestExitEpoch(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain earliest exit epoch"), err)
+	}
+	if state.ExitBalanceToConsume, err = beaconState.ExitBalanceToConsume(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain exit balance to consume"), err)
+	}
+	if state.PendingPartialWithdrawals, err = beaconState.PendingPartialWithdrawals(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain pending partial withdrawals"), err)
+	}
+	if state.NextWithdrawalValidatorIndex, err = beaconState.NextWithdrawalValidatorIndex(); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain next withdrawal validator index"), err)
+	}
+
+	return state, nil
+}
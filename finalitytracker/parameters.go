@@ -0,0 +1,106 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finalitytracker
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel         zerolog.Level
+	finalityProvider consensusclient.FinalityProvider
+	eventsProvider   consensusclient.EventsProvider
+	pollInterval     time.Duration
+	clock            clock.Clock
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithFinalityProvider sets the finality provider.
+func WithFinalityProvider(provider consensusclient.FinalityProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalityProvider = provider
+	})
+}
+
+// WithEventsProvider sets the events provider.  If supplied, finality is
+// updated as soon as finalized checkpoint events are received.
+func WithEventsProvider(provider consensusclient.EventsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsProvider = provider
+	})
+}
+
+// WithPollInterval sets the interval at which finality is polled, regardless
+// of whether events are received.
+func WithPollInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pollInterval = interval
+	})
+}
+
+// WithClock sets the clock used to schedule polls.  Defaults to the system clock.
+func WithClock(clock clock.Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		clock:        clock.System(),
+		logLevel:     zerolog.GlobalLevel(),
+		pollInterval: time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.finalityProvider == nil {
+		return nil, errors.New("no finality provider specified")
+	}
+	if parameters.pollInterval <= 0 {
+		return nil, errors.New("no poll interval specified")
+	}
+
+	if parameters.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+
+	return &parameters, nil
+}
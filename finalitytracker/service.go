@@ -0,0 +1,183 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package finalitytracker tracks the justified and finalized checkpoints of
+// the chain, allowing callers to wait for a given epoch to be finalized.
+package finalitytracker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service tracks the finality of the chain.
+type Service struct {
+	log              zerolog.Logger
+	finalityProvider consensusclient.FinalityProvider
+	pollInterval     time.Duration
+	clock            clock.Clock
+
+	mu        sync.RWMutex
+	justified *phase0.Checkpoint
+	finalized *phase0.Checkpoint
+	// updated is closed and replaced whenever the checkpoints change.
+	updated chan struct{}
+}
+
+// New creates a new finality tracker.  The tracker runs until the supplied
+// context is canceled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "finalitytracker").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:              log,
+		finalityProvider: parameters.finalityProvider,
+		pollInterval:     parameters.pollInterval,
+		clock:            parameters.clock,
+		updated:          make(chan struct{}),
+	}
+
+	if err := s.update(ctx); err != nil {
+		return nil, errors.Join(errors.New("failed to obtain initial finality"), err)
+	}
+
+	if parameters.eventsProvider != nil {
+		if err := parameters.eventsProvider.Events(ctx, &api.EventsOpts{
+			Topics: []string{"finalized_checkpoint"},
+			FinalizedCheckpointHandler: func(ctx context.Context, _ *apiv1.FinalizedCheckpointEvent) {
+				// The event does not contain the justified checkpoint, so fetch full finality.
+				if err := s.update(ctx); err != nil {
+					s.log.Debug().Err(err).Msg("Failed to update finality on event")
+				}
+			},
+		}); err != nil {
+			return nil, errors.Join(errors.New("failed to subscribe to finalized checkpoint events"), err)
+		}
+	}
+
+	go s.poll(ctx)
+
+	return s, nil
+}
+
+// Justified returns the current justified checkpoint.
+func (s *Service) Justified() *phase0.Checkpoint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.justified
+}
+
+// Finalized returns the current finalized checkpoint.
+func (s *Service) Finalized() *phase0.Checkpoint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.finalized
+}
+
+// WaitForFinalized waits until the given epoch has been finalized, returning
+// the finalized checkpoint at that time.
+func (s *Service) WaitForFinalized(ctx context.Context, epoch phase0.Epoch) (*phase0.Checkpoint, error) {
+	for {
+		s.mu.RLock()
+		finalized := s.finalized
+		updated := s.updated
+		s.mu.RUnlock()
+
+		if finalized != nil && finalized.Epoch >= epoch {
+			return finalized, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-updated:
+		}
+	}
+}
+
+// poll periodically updates finality.
+func (s *Service) poll(ctx context.Context) {
+	ticker := s.clock.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.Trace().Msg("Context done; stopping")
+
+			return
+		case <-ticker.C():
+			if err := s.update(ctx); err != nil {
+				s.log.Debug().Err(err).Msg("Failed to update finality")
+			}
+		}
+	}
+}
+
+// update fetches the current finality and updates the tracked checkpoints
+// if they have advanced.
+func (s *Service) update(ctx context.Context) error {
+	response, err := s.finalityProvider.Finality(ctx, &api.FinalityOpts{
+		State: "head",
+	})
+	if err != nil {
+		return err
+	}
+	if response.Data == nil || response.Data.Justified == nil || response.Data.Finalized == nil {
+		return errors.New("finality incomplete")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	if s.justified == nil || response.Data.Justified.Epoch > s.justified.Epoch {
+		s.justified = response.Data.Justified
+		changed = true
+	}
+	if s.finalized == nil || response.Data.Finalized.Epoch > s.finalized.Epoch {
+		s.finalized = response.Data.Finalized
+		changed = true
+	}
+
+	if changed {
+		s.log.Trace().
+			Uint64("justified_epoch", uint64(s.justified.Epoch)).
+			Uint64("finalized_epoch", uint64(s.finalized.Epoch)).
+			Msg("Finality updated")
+		close(s.updated)
+		s.updated = make(chan struct{})
+	}
+
+	return nil
+}
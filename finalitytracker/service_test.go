@@ -0,0 +1,200 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package finalitytracker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/finalitytracker"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	failingClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	failingClient.FinalityFunc = func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		return nil, errors.New("mock error")
+	}
+
+	tests := []struct {
+		name   string
+		params []finalitytracker.Parameter
+		err    string
+	}{
+		{
+			name: "FinalityProviderMissing",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters\nno finality provider specified",
+		},
+		{
+			name: "PollIntervalZero",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithLogLevel(zerolog.Disabled),
+				finalitytracker.WithFinalityProvider(client),
+				finalitytracker.WithPollInterval(0),
+			},
+			err: "problem with parameters\nno poll interval specified",
+		},
+		{
+			name: "FinalityFails",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithLogLevel(zerolog.Disabled),
+				finalitytracker.WithFinalityProvider(failingClient),
+			},
+			err: "failed to obtain initial finality\nmock error",
+		},
+		{
+			name: "Good",
+			params: []finalitytracker.Parameter{
+				finalitytracker.WithLogLevel(zerolog.Disabled),
+				finalitytracker.WithFinalityProvider(client),
+				finalitytracker.WithEventsProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := finalitytracker.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Epoch(6), s.Finalized().Epoch)
+				require.Equal(t, phase0.Epoch(7), s.Justified().Epoch)
+			}
+		})
+	}
+}
+
+func TestWaitForFinalized(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var finalizedEpoch atomic.Uint64
+	finalizedEpoch.Store(10)
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.FinalityFunc = func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		epoch := phase0.Epoch(finalizedEpoch.Load())
+
+		return &api.Response[*apiv1.Finality]{
+			Data: &apiv1.Finality{
+				Finalized: &phase0.Checkpoint{Epoch: epoch},
+				Justified: &phase0.Checkpoint{Epoch: epoch + 1},
+			},
+		}, nil
+	}
+	var handler api.FinalizedCheckpointEventHandlerFunc
+	client.EventsFunc = func(_ context.Context, opts *api.EventsOpts) error {
+		handler = opts.FinalizedCheckpointHandler
+
+		return nil
+	}
+
+	s, err := finalitytracker.New(ctx,
+		finalitytracker.WithLogLevel(zerolog.Disabled),
+		finalitytracker.WithFinalityProvider(client),
+		finalitytracker.WithEventsProvider(client),
+		finalitytracker.WithPollInterval(time.Hour),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+
+	// Already finalized.
+	checkpoint, err := s.WaitForFinalized(ctx, 9)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(10), checkpoint.Epoch)
+
+	// Not yet finalized; times out.
+	shortCtx, shortCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer shortCancel()
+	_, err = s.WaitForFinalized(shortCtx, 12)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Finalized by event.
+	done := make(chan *phase0.Checkpoint)
+	go func() {
+		checkpoint, err := s.WaitForFinalized(ctx, 12)
+		if err == nil {
+			done <- checkpoint
+		}
+	}()
+	finalizedEpoch.Store(11)
+	handler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 11})
+	require.Equal(t, phase0.Epoch(11), s.Finalized().Epoch)
+	finalizedEpoch.Store(12)
+	handler(ctx, &apiv1.FinalizedCheckpointEvent{Epoch: 12})
+
+	select {
+	case checkpoint := <-done:
+		require.Equal(t, phase0.Epoch(12), checkpoint.Epoch)
+		require.Equal(t, phase0.Epoch(13), s.Justified().Epoch)
+	case <-time.After(time.Second):
+		require.Fail(t, "timed out waiting for finality")
+	}
+}
+
+func TestPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var finalizedEpoch atomic.Uint64
+	finalizedEpoch.Store(10)
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.FinalityFunc = func(context.Context, *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+		epoch := phase0.Epoch(finalizedEpoch.Load())
+
+		return &api.Response[*apiv1.Finality]{
+			Data: &apiv1.Finality{
+				Finalized: &phase0.Checkpoint{Epoch: epoch},
+				Justified: &phase0.Checkpoint{Epoch: epoch + 1},
+			},
+		}, nil
+	}
+
+	s, err := finalitytracker.New(ctx,
+		finalitytracker.WithLogLevel(zerolog.Disabled),
+		finalitytracker.WithFinalityProvider(client),
+		finalitytracker.WithPollInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	finalizedEpoch.Store(11)
+	waitCtx, waitCancel := context.WithTimeout(ctx, time.Second)
+	defer waitCancel()
+	checkpoint, err := s.WaitForFinalized(waitCtx, 11)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(11), checkpoint.Epoch)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graffiti provides a type for block graffiti, with helpers to
+// build and parse the graffiti conventions used by clients.
+package graffiti
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Graffiti is the graffiti of a block.
+type Graffiti [phase0.GraffitiLength]byte
+
+// New creates graffiti from a string, truncating it if required.
+func New(s string) Graffiti {
+	var res Graffiti
+	copy(res[:], Truncate(s, phase0.GraffitiLength))
+
+	return res
+}
+
+// Truncate truncates a string to at most the given number of bytes without
+// splitting a multi-byte UTF-8 character.
+func Truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+
+	end := 0
+	for end < len(s) {
+		_, size := utf8.DecodeRuneInString(s[end:])
+		if end+size > length {
+			break
+		}
+		end += size
+	}
+
+	return s[:end]
+}
+
+// String returns the graffiti as a string, without trailing zero bytes.
+// Invalid UTF-8 sequences are replaced with the Unicode replacement
+// character.
+func (g Graffiti) String() string {
+	return strings.ToValidUTF8(string(bytes.TrimRight(g[:], "\x00")), "�")
+}
+
+// IsEmpty returns true if the graffiti is all zero bytes.
+func (g Graffiti) IsEmpty() bool {
+	return g == Graffiti{}
+}
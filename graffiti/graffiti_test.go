@@ -0,0 +1,202 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graffiti_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/graffiti"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "Short",
+			input:    "hello",
+			expected: "hello",
+		},
+		{
+			name:     "Exact",
+			input:    "0123456789abcdef0123456789abcdef",
+			expected: "0123456789abcdef0123456789abcdef",
+		},
+		{
+			name:     "Long",
+			input:    "0123456789abcdef0123456789abcdefXYZ",
+			expected: "0123456789abcdef0123456789abcdef",
+		},
+		{
+			name:     "MultiByteBoundary",
+			input:    "0123456789abcdef0123456789abcd€",
+			expected: "0123456789abcdef0123456789abcd",
+		},
+		{
+			name:     "MultiByteFits",
+			input:    "0123456789abcdef0123456789abc€",
+			expected: "0123456789abcdef0123456789abc€",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := graffiti.New(test.input)
+			require.Equal(t, test.expected, g.String())
+			require.Equal(t, test.input == "", g.IsEmpty())
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	require.Equal(t, "a�b", graffiti.Graffiti{'a', 0xff, 'b'}.String())
+}
+
+func TestWithClientVersions(t *testing.T) {
+	execution := graffiti.ClientVersion{Code: "GE", Commit: "ABCDEF12"}
+	consensus := graffiti.ClientVersion{Code: "LH", Commit: "9876"}
+
+	tests := []struct {
+		name      string
+		execution graffiti.ClientVersion
+		user      string
+		expected  string
+	}{
+		{
+			name:      "NoUser",
+			execution: execution,
+			expected:  "GEabcdLH9876",
+		},
+		{
+			name:      "Full",
+			execution: execution,
+			user:      "0123456789abcdefghi",
+			expected:  "GEabcdLH9876 0123456789abcdefghi",
+		},
+		{
+			name:      "Short",
+			execution: execution,
+			user:      "0123456789abcdefghij",
+			expected:  "GEabLH98 0123456789abcdefghij",
+		},
+		{
+			name:      "CodesOnly",
+			execution: execution,
+			user:      "0123456789abcdefghijklmnop",
+			expected:  "GELH 0123456789abcdefghijklmnop",
+		},
+		{
+			name:      "UserOnly",
+			execution: execution,
+			user:      "0123456789abcdefghijklmnopqr",
+			expected:  "0123456789abcdefghijklmnopqr",
+		},
+		{
+			name:      "ShortCommit",
+			execution: graffiti.ClientVersion{Code: "GE", Commit: "a"},
+			expected:  "GEaLH9876",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, graffiti.WithClientVersions(test.execution, consensus, test.user).String())
+		})
+	}
+}
+
+func TestParseWatermark(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *graffiti.Watermark
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:  "User",
+			input: "hello world",
+		},
+		{
+			name:  "UnknownClient",
+			input: "XXabcdLH9876",
+		},
+		{
+			name:  "MismatchedCommits",
+			input: "GEabcdLH98",
+		},
+		{
+			name:  "ClientVersionFull",
+			input: "GEabcdLH9876 hello",
+			expected: &graffiti.Watermark{
+				Format:          graffiti.FormatClientVersion,
+				ExecutionClient: "GE",
+				ExecutionCommit: "abcd",
+				ConsensusClient: "LH",
+				ConsensusCommit: "9876",
+				User:            "hello",
+			},
+		},
+		{
+			name:  "ClientVersionCodesOnly",
+			input: "NMTK",
+			expected: &graffiti.Watermark{
+				Format:          graffiti.FormatClientVersion,
+				ExecutionClient: "NM",
+				ConsensusClient: "TK",
+			},
+		},
+		{
+			name:  "RocketPool",
+			input: "RP-GL v1.13.1 (hello)",
+			expected: &graffiti.Watermark{
+				Format:          graffiti.FormatRocketPool,
+				ExecutionClient: "G",
+				ConsensusClient: "L",
+				Version:         "1.13.1",
+				User:            "hello",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			watermark, found := graffiti.ParseWatermark(graffiti.New(test.input))
+			require.Equal(t, test.expected != nil, found)
+			require.Equal(t, test.expected, watermark)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	g := graffiti.WithClientVersions(
+		graffiti.ClientVersion{Code: "RH", Commit: "0011"},
+		graffiti.ClientVersion{Code: "PM", Commit: "2233"},
+		"my validator",
+	)
+	watermark, found := graffiti.ParseWatermark(g)
+	require.True(t, found)
+	require.Equal(t, "reth", graffiti.ClientName(watermark.ExecutionClient))
+	require.Equal(t, "prysm", graffiti.ClientName(watermark.ConsensusClient))
+	require.Equal(t, "my validator", watermark.User)
+}
@@ -0,0 +1,144 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graffiti
+
+import (
+	"regexp"
+	"strings"
+)
+
+// clientNames are the names of clients by their two-letter code, as
+// defined for ClientVersionV1 in the execution API specification.
+var clientNames = map[string]string{
+	"BU": "besu",
+	"EJ": "ethereumJS",
+	"EG": "erigon",
+	"GE": "go-ethereum",
+	"GR": "grandine",
+	"LH": "lighthouse",
+	"LS": "lodestar",
+	"NM": "nethermind",
+	"NB": "nimbus",
+	"TE": "trin-execution",
+	"TK": "teku",
+	"PM": "prysm",
+	"RH": "reth",
+}
+
+// ClientName returns the name of the client with the given two-letter
+// code, or an empty string if the code is not known.
+func ClientName(code string) string {
+	return clientNames[code]
+}
+
+// ClientVersion is the version of a client.
+type ClientVersion struct {
+	// Code is the two-letter code of the client.
+	Code string
+	// Commit is the hex commit of the client; only the leading characters
+	// are used.
+	Commit string
+}
+
+// WithClientVersions creates graffiti containing the versions of the
+// execution and consensus clients followed by the user graffiti.  The most
+// detailed version information that leaves room for the user graffiti is
+// used: four commit characters per client, then two, then none.  If the
+// user graffiti is too long for any version information it is used alone.
+func WithClientVersions(execution ClientVersion, consensus ClientVersion, user string) Graffiti {
+	for _, commitLength := range []int{4, 2, 0} {
+		watermark := execution.Code + prefix(execution.Commit, commitLength) +
+			consensus.Code + prefix(consensus.Commit, commitLength)
+		switch {
+		case user == "":
+			return New(watermark)
+		case len(watermark)+1+len(user) <= len(Graffiti{}):
+			return New(watermark + " " + user)
+		}
+	}
+
+	return New(user)
+}
+
+// prefix returns up to the given number of leading characters of a commit.
+func prefix(commit string, length int) string {
+	return strings.ToLower(commit[:min(length, len(commit))])
+}
+
+// Format is the format of a graffiti watermark.
+type Format string
+
+const (
+	// FormatClientVersion is client version information as created by
+	// WithClientVersions.
+	FormatClientVersion Format = "client_version"
+	// FormatRocketPool is the Rocket Pool smartnode watermark, for
+	// example "RP-GL v1.13.1 (user graffiti)".
+	FormatRocketPool Format = "rocket_pool"
+)
+
+// Watermark is information about the software that proposed a block, as
+// encoded in its graffiti.
+type Watermark struct {
+	Format Format
+	// ExecutionClient is the code of the execution client.
+	ExecutionClient string
+	// ExecutionCommit is the commit prefix of the execution client, if present.
+	ExecutionCommit string
+	// ConsensusClient is the code of the consensus client.
+	ConsensusClient string
+	// ConsensusCommit is the commit prefix of the consensus client, if present.
+	ConsensusCommit string
+	// Version is the version of the software, if present.
+	Version string
+	// User is the user graffiti, if present.
+	User string
+}
+
+var (
+	clientVersionRegex = regexp.MustCompile(`^([A-Z]{2})((?:[0-9a-f]{2}){0,2})([A-Z]{2})((?:[0-9a-f]{2}){0,2})(?: (.*))?$`)
+	rocketPoolRegex    = regexp.MustCompile(`^RP-([A-Z])([A-Z]) v?([0-9A-Za-z.\-]+)(?: \((.*)\))?$`)
+)
+
+// ParseWatermark parses a watermark from graffiti, returning false if the
+// graffiti does not contain a known watermark.
+func ParseWatermark(g Graffiti) (*Watermark, bool) {
+	s := g.String()
+
+	if matches := rocketPoolRegex.FindStringSubmatch(s); matches != nil {
+		return &Watermark{
+			Format:          FormatRocketPool,
+			ExecutionClient: matches[1],
+			ConsensusClient: matches[2],
+			Version:         matches[3],
+			User:            matches[4],
+		}, true
+	}
+
+	if matches := clientVersionRegex.FindStringSubmatch(s); matches != nil &&
+		len(matches[2]) == len(matches[4]) &&
+		ClientName(matches[1]) != "" &&
+		ClientName(matches[3]) != "" {
+		return &Watermark{
+			Format:          FormatClientVersion,
+			ExecutionClient: matches[1],
+			ExecutionCommit: matches[2],
+			ConsensusClient: matches[3],
+			ConsensusCommit: matches[4],
+			User:            matches[5],
+		}, true
+	}
+
+	return nil, false
+}
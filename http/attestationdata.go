@@ -38,6 +38,18 @@ func (s *Service) AttestationData(ctx context.Context,
 		return nil, client.ErrNoOptions
 	}
 
+	return hedged(ctx, s.clock, opts.HedgeAfter, func(ctx context.Context) (*api.Response[*phase0.AttestationData], error) {
+		return s.attestationData(ctx, opts)
+	})
+}
+
+// attestationData fetches and verifies attestation data.
+func (s *Service) attestationData(ctx context.Context,
+	opts *api.AttestationDataOpts,
+) (
+	*api.Response[*phase0.AttestationData],
+	error,
+) {
 	endpoint := "/eth/v1/validator/attestation_data"
 	query := fmt.Sprintf("slot=%d&committee_index=%d", opts.Slot, opts.CommitteeIndex)
 	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
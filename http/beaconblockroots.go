@@ -0,0 +1,108 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultBeaconBlockRootsConcurrency is the default maximum number of
+// concurrent requests made when fetching multiple block roots.
+const defaultBeaconBlockRootsConcurrency = 16
+
+// BeaconBlockRoots fetches the roots of multiple blocks given a set of options.
+// The returned map is keyed by the supplied block ID, and contains the root,
+// canonical status and header of each block.  Blocks that are not found are
+// omitted from the map.
+func (s *Service) BeaconBlockRoots(ctx context.Context,
+	opts *api.BeaconBlockRootsOpts,
+) (
+	*api.Response[map[string]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if len(opts.Blocks) == 0 {
+		return nil, errors.Join(errors.New("no blocks specified"), client.ErrInvalidOptions)
+	}
+	for _, block := range opts.Blocks {
+		if block == "" {
+			return nil, errors.Join(errors.New("empty block specified"), client.ErrInvalidOptions)
+		}
+	}
+	if opts.Concurrency < 0 {
+		return nil, errors.Join(errors.New("concurrency cannot be negative"), client.ErrInvalidOptions)
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultBeaconBlockRootsConcurrency
+	}
+
+	data := make(map[string]*apiv1.BeaconBlockHeader, len(opts.Blocks))
+	var dataMu sync.Mutex
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+	for _, block := range opts.Blocks {
+		if groupCtx.Err() != nil {
+			break
+		}
+		block := block
+		group.Go(func() error {
+			response, err := s.BeaconBlockHeader(groupCtx, &api.BeaconBlockHeaderOpts{
+				Common: opts.Common,
+				Block:  block,
+			})
+			if err != nil {
+				var apiErr *api.Error
+				if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+					// Block not found; omit it from the results.
+					return nil
+				}
+
+				return errors.Join(fmt.Errorf("failed to obtain root for block %s", block), err)
+			}
+
+			dataMu.Lock()
+			data[block] = response.Data
+			dataMu.Unlock()
+
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &api.Response[map[string]*apiv1.BeaconBlockHeader]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestBeaconBlockRoots(t *testing.T) {
+	ctx := context.Background()
+
+	var inFlight atomic.Int32
+	var maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := maxInFlight.Load()
+			if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		slot, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/headers/"), 10, 64)
+		switch {
+		case err != nil:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"invalid block id"}`))
+		case slot%3 == 0:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"not found"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"execution_optimistic":false,"finalized":true,"data":{"root":"0x%064x","canonical":%t,"header":{"message":{"slot":"%d","proposer_index":"1","parent_root":"0x0000000000000000000000000000000000000000000000000000000000000000","state_root":"0x0000000000000000000000000000000000000000000000000000000000000000","body_root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}}`, slot, slot%2 == 1, slot)
+		}
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	_, err = s.BeaconBlockRoots(ctx, nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+	_, err = s.BeaconBlockRoots(ctx, &api.BeaconBlockRootsOpts{})
+	require.EqualError(t, err, "no blocks specified\ninvalid options")
+	_, err = s.BeaconBlockRoots(ctx, &api.BeaconBlockRootsOpts{Blocks: []string{"1", ""}})
+	require.EqualError(t, err, "empty block specified\ninvalid options")
+	_, err = s.BeaconBlockRoots(ctx, &api.BeaconBlockRootsOpts{Blocks: []string{"1"}, Concurrency: -1})
+	require.EqualError(t, err, "concurrency cannot be negative\ninvalid options")
+
+	blocks := make([]string, 0, 30)
+	for i := 1; i <= 30; i++ {
+		blocks = append(blocks, strconv.Itoa(i))
+	}
+	response, err := s.BeaconBlockRoots(ctx, &api.BeaconBlockRootsOpts{
+		Blocks:      blocks,
+		Concurrency: 4,
+	})
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxInFlight.Load(), int32(4))
+	require.Greater(t, maxInFlight.Load(), int32(1))
+	// Blocks at every third slot are not found.
+	require.Len(t, response.Data, 20)
+	for slot := uint64(1); slot <= 30; slot++ {
+		header, exists := response.Data[strconv.FormatUint(slot, 10)]
+		if slot%3 == 0 {
+			require.False(t, exists)
+
+			continue
+		}
+		require.True(t, exists)
+		require.Equal(t, byte(slot), header.Root[31])
+		require.Equal(t, slot%2 == 1, header.Canonical)
+		require.Equal(t, slot, uint64(header.Header.Message.Slot))
+	}
+
+	// Errors other than not found are returned.
+	_, err = s.BeaconBlockRoots(ctx, &api.BeaconBlockRootsOpts{Blocks: []string{"1", "bad"}})
+	require.ErrorContains(t, err, "failed to obtain root for block bad")
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -42,12 +43,18 @@ func (s *Service) BeaconCommittees(ctx context.Context,
 	}
 
 	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/committees", opts.State)
-	query := ""
+	queryItems := make([]string, 0)
 	if opts.Epoch != nil {
-		query = fmt.Sprintf("epoch=%d", *opts.Epoch)
+		queryItems = append(queryItems, fmt.Sprintf("epoch=%d", *opts.Epoch))
+	}
+	if opts.Slot != nil {
+		queryItems = append(queryItems, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+	if opts.Index != nil {
+		queryItems = append(queryItems, fmt.Sprintf("index=%d", *opts.Index))
 	}
 
-	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, false)
+	httpResponse, err := s.get(ctx, endpoint, strings.Join(queryItems, "&"), &opts.Common, false)
 	if err != nil {
 		return nil, err
 	}
@@ -29,7 +29,6 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	dynssz "github.com/pk910/dynamic-ssz"
 )
 
 // BeaconState fetches a beacon state.
@@ -55,14 +54,26 @@ func (s *Service) BeaconState(ctx context.Context,
 		return nil, err
 	}
 
+	var response *api.Response[*spec.VersionedBeaconState]
 	switch httpResponse.contentType {
 	case ContentTypeSSZ:
-		return s.beaconStateFromSSZ(ctx, httpResponse)
+		response, err = s.beaconStateFromSSZ(ctx, httpResponse)
 	case ContentTypeJSON:
-		return s.beaconStateFromJSON(httpResponse)
+		response, err = s.beaconStateFromJSON(httpResponse)
 	default:
 		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.VerifyRoot {
+		if err := verifyRoot(opts.State, response.Data.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
 }
 
 func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*api.Response[*spec.VersionedBeaconState], error) {
@@ -73,21 +84,15 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		Metadata: metadataFromHeaders(res.headers),
 	}
 
-	var dynSSZ *dynssz.DynSsz
-	if s.customSpecSupport {
-		specs, err := s.Spec(ctx, &api.SpecOpts{})
-		if err != nil {
-			return nil, errors.Join(errors.New("failed to request specs"), err)
-		}
-
-		dynSSZ = dynssz.NewDynSsz(specs.Data)
+	dynSSZ, err := s.dynamicSSZ(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var err error
 	switch res.consensusVersion {
 	case spec.DataVersionPhase0:
 		response.Data.Phase0 = &phase0.BeaconState{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Phase0, res.body)
 		} else {
 			err = response.Data.Phase0.UnmarshalSSZ(res.body)
@@ -97,7 +102,7 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		}
 	case spec.DataVersionAltair:
 		response.Data.Altair = &altair.BeaconState{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Altair, res.body)
 		} else {
 			err = response.Data.Altair.UnmarshalSSZ(res.body)
@@ -107,7 +112,7 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		}
 	case spec.DataVersionBellatrix:
 		response.Data.Bellatrix = &bellatrix.BeaconState{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Bellatrix, res.body)
 		} else {
 			err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
@@ -117,7 +122,7 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		}
 	case spec.DataVersionCapella:
 		response.Data.Capella = &capella.BeaconState{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Capella, res.body)
 		} else {
 			err = response.Data.Capella.UnmarshalSSZ(res.body)
@@ -127,7 +132,7 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		}
 	case spec.DataVersionDeneb:
 		response.Data.Deneb = &deneb.BeaconState{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Deneb, res.body)
 		} else {
 			err = response.Data.Deneb.UnmarshalSSZ(res.body)
@@ -137,7 +142,7 @@ func (s *Service) beaconStateFromSSZ(ctx context.Context, res *httpResponse) (*a
 		}
 	case spec.DataVersionElectra:
 		response.Data.Electra = &electra.BeaconState{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Electra, res.body)
 		} else {
 			err = response.Data.Electra.UnmarshalSSZ(res.body)
@@ -0,0 +1,176 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// BlindedBlock fetches a signed blinded beacon block given a block ID.
+// Blocks prior to bellatrix do not have a blinded form, and result in an error.
+func (s *Service) BlindedBlock(ctx context.Context,
+	opts *api.BlindedBlockOpts,
+) (
+	*api.Response[*api.VersionedSignedBlindedBeaconBlock],
+	error,
+) {
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/blinded_blocks/%s", opts.Block)
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *api.Response[*api.VersionedSignedBlindedBeaconBlock]
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		response, err = s.blindedBlockFromSSZ(ctx, httpResponse)
+	case ContentTypeJSON:
+		response, err = s.blindedBlockFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.VerifyRoot {
+		if err := verifyRoot(opts.Block, response.Data.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Service) blindedBlockFromSSZ(ctx context.Context,
+	res *httpResponse,
+) (
+	*api.Response[*api.VersionedSignedBlindedBeaconBlock],
+	error,
+) {
+	response := &api.Response[*api.VersionedSignedBlindedBeaconBlock]{
+		Data: &api.VersionedSignedBlindedBeaconBlock{
+			Version: res.consensusVersion,
+		},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+
+	dynSSZ, err := s.dynamicSSZ(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		if dynSSZ != nil {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Bellatrix, res.body)
+		} else {
+			err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+		}
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode bellatrix signed blinded beacon block"), err)
+		}
+	case spec.DataVersionCapella:
+		response.Data.Capella = &apiv1capella.SignedBlindedBeaconBlock{}
+		if dynSSZ != nil {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Capella, res.body)
+		} else {
+			err = response.Data.Capella.UnmarshalSSZ(res.body)
+		}
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode capella signed blinded beacon block"), err)
+		}
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{}
+		if dynSSZ != nil {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Deneb, res.body)
+		} else {
+			err = response.Data.Deneb.UnmarshalSSZ(res.body)
+		}
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode deneb signed blinded beacon block"), err)
+		}
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1electra.SignedBlindedBeaconBlock{}
+		if dynSSZ != nil {
+			err = dynSSZ.UnmarshalSSZ(response.Data.Electra, res.body)
+		} else {
+			err = response.Data.Electra.UnmarshalSSZ(res.body)
+		}
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decode electra signed blinded beacon block"), err)
+		}
+	default:
+		return nil, fmt.Errorf("unhandled blinded block version %s", res.consensusVersion)
+	}
+
+	return response, nil
+}
+
+func (*Service) blindedBlockFromJSON(res *httpResponse) (*api.Response[*api.VersionedSignedBlindedBeaconBlock], error) {
+	response := &api.Response[*api.VersionedSignedBlindedBeaconBlock]{
+		Data: &api.VersionedSignedBlindedBeaconBlock{
+			Version: res.consensusVersion,
+		},
+	}
+
+	var err error
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&apiv1bellatrix.SignedBlindedBeaconBlock{},
+		)
+	case spec.DataVersionCapella:
+		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&apiv1capella.SignedBlindedBeaconBlock{},
+		)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&apiv1deneb.SignedBlindedBeaconBlock{},
+		)
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body),
+			&apiv1electra.SignedBlindedBeaconBlock{},
+		)
+	default:
+		return nil, fmt.Errorf("unhandled blinded block version %s", res.consensusVersion)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,139 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestBlindedBlock(t *testing.T) {
+	ctx := context.Background()
+
+	block := &apiv1capella.SignedBlindedBeaconBlock{
+		Message: &apiv1capella.BlindedBeaconBlock{
+			Slot: 1,
+			Body: &apiv1capella.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: make([]byte, 64),
+				},
+				ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+			},
+		},
+	}
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	// Round trip through SSZ to populate empty lists for JSON.
+	decodedBlock := &apiv1capella.SignedBlindedBeaconBlock{}
+	require.NoError(t, decodedBlock.UnmarshalSSZ(blockSSZ))
+	blockJSON, err := json.Marshal(decodedBlock)
+	require.NoError(t, err)
+	root, err := block.Message.HashTreeRoot()
+	require.NoError(t, err)
+
+	version := "capella"
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/blinded_blocks/") {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		path = r.URL.Path
+		w.Header().Set("Eth-Consensus-Version", version)
+		if strings.HasPrefix(r.Header.Get("Accept"), "application/octet-stream") {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(blockSSZ)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"version":%q,"execution_optimistic":false,"finalized":true,"data":%s}`, version, blockJSON)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	// Options are required.
+	_, err = s.BlindedBlock(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+	_, err = s.BlindedBlock(ctx, &api.BlindedBlockOpts{})
+	require.EqualError(t, err, "no block specified\ninvalid options")
+
+	// SSZ by default.
+	response, err := s.BlindedBlock(ctx, &api.BlindedBlockOpts{
+		Block:      fmt.Sprintf("%#x", root),
+		VerifyRoot: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("/eth/v1/beacon/blinded_blocks/%#x", root), path)
+	require.Equal(t, spec.DataVersionCapella, response.Data.Version)
+	responseRoot, err := response.Data.Root()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(root), responseRoot)
+
+	// JSON if enforced.
+	s.enforceJSON = true
+	response, err = s.BlindedBlock(ctx, &api.BlindedBlockOpts{Block: "head"})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionCapella, response.Data.Version)
+	responseRoot, err = response.Data.Root()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(root), responseRoot)
+
+	// Root mismatch.
+	_, err = s.BlindedBlock(ctx, &api.BlindedBlockOpts{
+		Block:      fmt.Sprintf("%#x", phase0.Root{0x01}),
+		VerifyRoot: true,
+	})
+	require.ErrorIs(t, err, client.ErrInconsistentResult)
+
+	// Blocks prior to bellatrix have no blinded form.
+	version = "phase0"
+	_, err = s.BlindedBlock(ctx, &api.BlindedBlockOpts{Block: "1"})
+	require.EqualError(t, err, "unhandled blinded block version phase0")
+}
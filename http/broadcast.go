@@ -0,0 +1,199 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Submission is a submission that is encoded once and can then be sent to
+// multiple beacon nodes.  The body is encoded in each content type the first
+// time that it is required, and the encoding reused for later submissions.
+type Submission struct {
+	name     string
+	endpoint string
+	query    string
+	headers  map[string]string
+	common   api.CommonOpts
+	encode   func(s *Service, contentType ContentType) ([]byte, error)
+
+	mu     sync.Mutex
+	bodies map[ContentType][]byte
+}
+
+// NewProposalSubmission creates a submission for a proposal.
+func NewProposalSubmission(opts *api.SubmitProposalOpts) (*Submission, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Proposal == nil {
+		return nil, errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
+	}
+
+	query := ""
+	if opts.BroadcastValidation != nil {
+		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	}
+
+	return &Submission{
+		name:     "proposal",
+		endpoint: "/eth/v2/beacon/blocks",
+		query:    query,
+		headers: map[string]string{
+			"Eth-Consensus-Version": strings.ToLower(opts.Proposal.Version.String()),
+		},
+		common: opts.Common,
+		encode: func(s *Service, contentType ContentType) ([]byte, error) {
+			if contentType == ContentTypeJSON {
+				return s.submitProposalJSON(context.Background(), opts.Proposal)
+			}
+
+			return s.submitProposalSSZ(context.Background(), opts.Proposal)
+		},
+		bodies: make(map[ContentType][]byte),
+	}, nil
+}
+
+// NewBlindedProposalSubmission creates a submission for a blinded proposal.
+func NewBlindedProposalSubmission(opts *api.SubmitBlindedProposalOpts) (*Submission, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Proposal == nil {
+		return nil, errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
+	}
+
+	query := ""
+	if opts.BroadcastValidation != nil {
+		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	}
+
+	return &Submission{
+		name:     "blinded proposal",
+		endpoint: "/eth/v2/beacon/blinded_blocks",
+		query:    query,
+		headers: map[string]string{
+			"Eth-Consensus-Version": strings.ToLower(opts.Proposal.Version.String()),
+		},
+		common: opts.Common,
+		encode: func(s *Service, contentType ContentType) ([]byte, error) {
+			if contentType == ContentTypeJSON {
+				return s.submitBlindedProposalJSON(context.Background(), opts.Proposal)
+			}
+
+			return s.submitBlindedProposalSSZ(context.Background(), opts.Proposal)
+		},
+		bodies: make(map[ContentType][]byte),
+	}, nil
+}
+
+// body returns the body of the submission in the content type used by the
+// service, encoding it if this has not already been done.
+func (m *Submission) body(s *Service) ([]byte, ContentType, error) {
+	contentType := ContentTypeSSZ
+	if s.enforceJSON {
+		contentType = ContentTypeJSON
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if body, exists := m.bodies[contentType]; exists {
+		return body, contentType, nil
+	}
+	body, err := m.encode(s, contentType)
+	if err != nil {
+		return nil, ContentTypeUnknown, err
+	}
+	m.bodies[contentType] = body
+
+	return body, contentType, nil
+}
+
+// SubmitEncoded sends a submission to the beacon node.
+func (s *Service) SubmitEncoded(ctx context.Context, submission *Submission) error {
+	if err := s.assertIsSynced(ctx); err != nil {
+		return err
+	}
+	if submission == nil {
+		return errors.Join(errors.New("no submission supplied"), client.ErrInvalidOptions)
+	}
+
+	body, contentType, err := submission.body(s)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.post(ctx, submission.endpoint, submission.query, &submission.common, bytes.NewReader(body), contentType, submission.headers)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to submit %s", submission.name), err)
+	}
+
+	return nil
+}
+
+// BroadcastResult is the outcome of sending a submission to a beacon node.
+type BroadcastResult struct {
+	// Name is the name of the client.
+	Name string
+	// Address is the address of the client.
+	Address string
+	// Duration is the time taken by the submission.
+	Duration time.Duration
+	// Err is the error returned by the submission, if any.
+	Err error
+}
+
+// Broadcast sends a submission to multiple beacon nodes concurrently,
+// returning the outcome for each in the order the clients were supplied.
+// The submission is encoded once for each content type required, rather
+// than once per client.  Clients that are not http clients are reported as
+// failing.
+func Broadcast(ctx context.Context, submission *Submission, clients []client.Service) []*BroadcastResult {
+	results := make([]*BroadcastResult, len(clients))
+
+	var wg sync.WaitGroup
+	for i, consensusClient := range clients {
+		results[i] = &BroadcastResult{
+			Name:    consensusClient.Name(),
+			Address: consensusClient.Address(),
+		}
+		httpClient, isHTTPClient := consensusClient.(*Service)
+		if !isHTTPClient {
+			results[i].Err = errors.New("client does not support encoded submissions")
+
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *BroadcastResult) {
+			defer wg.Done()
+			started := time.Now()
+			result.Err = httpClient.SubmitEncoded(ctx, submission)
+			result.Duration = time.Since(started)
+		}(results[i])
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,117 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingServer records the content type and body of each request.
+type recordingServer struct {
+	mu           sync.Mutex
+	contentTypes []string
+	bodies       [][]byte
+	status       int
+}
+
+func (r *recordingServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.contentTypes = append(r.contentTypes, req.Header.Get("Content-Type"))
+	r.bodies = append(r.bodies, body)
+	r.mu.Unlock()
+	w.WriteHeader(r.status)
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := context.Background()
+
+	block, err := testfixtures.Block(spec.DataVersionCapella)
+	require.NoError(t, err)
+	submission, err := NewProposalSubmission(&api.SubmitProposalOpts{
+		Proposal: &api.VersionedSignedProposal{
+			Version: spec.DataVersionCapella,
+			Capella: block.Capella,
+		},
+	})
+	require.NoError(t, err)
+
+	recorders := []*recordingServer{
+		{status: http.StatusOK},
+		{status: http.StatusOK},
+		{status: http.StatusOK},
+		{status: http.StatusInternalServerError},
+	}
+	clients := make([]client.Service, 0, len(recorders)+1)
+	for i, recorder := range recorders {
+		server := httptest.NewServer(recorder)
+		defer server.Close()
+		s := newClosableService(t, server)
+		// One of the clients only accepts JSON.
+		s.enforceJSON = i == 2
+		clients = append(clients, s)
+	}
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	clients = append(clients, mockClient)
+
+	results := Broadcast(ctx, submission, clients)
+	require.Len(t, results, 5)
+	require.NoError(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	require.NoError(t, results[2].Err)
+	require.ErrorContains(t, results[3].Err, "failed to submit proposal")
+	require.EqualError(t, results[4].Err, "client does not support encoded submissions")
+	require.Equal(t, mockClient.Name(), results[4].Name)
+
+	// The body was encoded once for each content type.
+	require.Len(t, submission.bodies, 2)
+	expectedSSZ, err := block.Capella.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expectedSSZ, submission.bodies[ContentTypeSSZ])
+
+	for i, recorder := range recorders {
+		require.Len(t, recorder.bodies, 1)
+		if i == 2 {
+			require.Equal(t, "application/json", recorder.contentTypes[0])
+			require.JSONEq(t, string(submission.bodies[ContentTypeJSON]), string(recorder.bodies[0]))
+		} else {
+			require.Equal(t, "application/octet-stream", recorder.contentTypes[0])
+			require.Equal(t, expectedSSZ, recorder.bodies[0])
+		}
+	}
+}
+
+func TestNewSubmission(t *testing.T) {
+	_, err := NewProposalSubmission(nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+	_, err = NewProposalSubmission(&api.SubmitProposalOpts{})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+	_, err = NewBlindedProposalSubmission(nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+	_, err = NewBlindedProposalSubmission(&api.SubmitBlindedProposalOpts{})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+}
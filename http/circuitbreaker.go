@@ -0,0 +1,199 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+)
+
+// ErrCircuitOpen is returned when a request is refused because the circuit
+// breaker for its endpoint class is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed is the state in which requests are permitted.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen is the state in which requests are refused.
+	CircuitOpen
+	// CircuitHalfOpen is the state in which a single probe request is
+	// permitted to find out if the beacon node has recovered.
+	CircuitHalfOpen
+)
+
+var circuitStateStrings = [...]string{
+	"closed",
+	"open",
+	"half-open",
+}
+
+// String returns a string representation of the state.
+func (c CircuitState) String() string {
+	if int(c) < 0 || int(c) >= len(circuitStateStrings) {
+		return "unknown"
+	}
+
+	return circuitStateStrings[c]
+}
+
+// CircuitStateChangeFunc is a function called when the circuit breaker for
+// an endpoint class changes state.
+type CircuitStateChangeFunc func(ctx context.Context, s *Service, class string, previous CircuitState, current CircuitState)
+
+// circuitBreakerConfig is the configuration for a circuit breaker.
+type circuitBreakerConfig struct {
+	failures     int
+	openDuration time.Duration
+}
+
+// circuitBreaker tracks consecutive failures of requests to a class of
+// endpoints, refusing requests for a period once a threshold is reached.
+type circuitBreaker struct {
+	mu           sync.Mutex
+	failures     int
+	openDuration time.Duration
+	state        CircuitState
+	consecutive  int
+	openedAt     time.Time
+	probing      bool
+	clock        clock.Clock
+}
+
+// newCircuitBreaker creates a new circuit breaker.
+func newCircuitBreaker(config *circuitBreakerConfig, clock clock.Clock) *circuitBreaker {
+	return &circuitBreaker{
+		failures:     config.failures,
+		openDuration: config.openDuration,
+		clock:        clock,
+	}
+}
+
+// allow returns true if a request is permitted, along with the state of the
+// breaker before and after the call.
+func (b *circuitBreaker) allow() (bool, CircuitState, CircuitState) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	previous := b.state
+	if b.state == CircuitOpen && b.clock.Now().Sub(b.openedAt) >= b.openDuration {
+		b.state = CircuitHalfOpen
+	}
+
+	switch b.state {
+	case CircuitClosed:
+		return true, previous, b.state
+	case CircuitHalfOpen:
+		if b.probing {
+			return false, previous, b.state
+		}
+		b.probing = true
+
+		return true, previous, b.state
+	default:
+		return false, previous, b.state
+	}
+}
+
+// record records the result of a permitted request, returning the state of
+// the breaker before and after the call.
+func (b *circuitBreaker) record(success bool) (CircuitState, CircuitState) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	previous := b.state
+	b.probing = false
+	if success {
+		b.consecutive = 0
+		b.state = CircuitClosed
+
+		return previous, b.state
+	}
+
+	b.consecutive++
+	if b.state == CircuitHalfOpen || b.consecutive >= b.failures {
+		b.state = CircuitOpen
+		b.openedAt = b.clock.Now()
+	}
+
+	return previous, b.state
+}
+
+// release releases a permitted request without recording a result, for
+// example if the request was canceled by the caller.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// do sends a request, subject to the circuit breaker for the endpoint class.
+// Errors sending the request and server error responses are failures;
+// requests abandoned because the caller's context is done are not counted.
+func (s *Service) do(ctx context.Context, endpoint string, req *http.Request) (*http.Response, error) {
+	class := endpointClass(endpoint)
+	breaker, exists := s.circuitBreakers[class]
+	if !exists {
+		return s.client.Do(req)
+	}
+
+	allowed, previous, current := breaker.allow()
+	s.notifyCircuitStateChange(ctx, class, previous, current)
+	if !allowed {
+		return nil, ErrCircuitOpen
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil && ctx.Err() != nil {
+		breaker.release()
+
+		return resp, err
+	}
+	previous, current = breaker.record(err == nil && resp.StatusCode < http.StatusInternalServerError)
+	s.notifyCircuitStateChange(ctx, class, previous, current)
+
+	return resp, err
+}
+
+// notifyCircuitStateChange logs and calls the hook for a change of state of
+// a circuit breaker.
+func (s *Service) notifyCircuitStateChange(ctx context.Context, class string, previous CircuitState, current CircuitState) {
+	if previous == current {
+		return
+	}
+
+	s.log.Debug().Str("class", class).Stringer("previous", previous).Stringer("current", current).Msg("Circuit breaker state changed")
+	if s.hooks.OnCircuitStateChange != nil {
+		go s.hooks.OnCircuitStateChange(ctx, s, class, previous, current)
+	}
+}
+
+// checkCircuitBreaker checks that a circuit breaker configuration is valid.
+func checkCircuitBreaker(config *circuitBreakerConfig) error {
+	if config.failures < 1 {
+		return errors.New("failures must be at least 1")
+	}
+	if config.openDuration <= 0 {
+		return errors.New("open duration must be positive")
+	}
+
+	return nil
+}
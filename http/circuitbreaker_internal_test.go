@@ -0,0 +1,166 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	virtualClock := clock.NewVirtual(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	breaker := newCircuitBreaker(&circuitBreakerConfig{failures: 2, openDuration: 50 * time.Millisecond}, virtualClock)
+
+	// Failures below the threshold leave the breaker closed.
+	allowed, _, _ := breaker.allow()
+	require.True(t, allowed)
+	_, current := breaker.record(false)
+	require.Equal(t, CircuitClosed, current)
+
+	// A success resets the count.
+	allowed, _, _ = breaker.allow()
+	require.True(t, allowed)
+	breaker.record(true)
+	allowed, _, _ = breaker.allow()
+	require.True(t, allowed)
+	_, current = breaker.record(false)
+	require.Equal(t, CircuitClosed, current)
+
+	// Reaching the threshold opens the breaker.
+	allowed, _, _ = breaker.allow()
+	require.True(t, allowed)
+	previous, current := breaker.record(false)
+	require.Equal(t, CircuitClosed, previous)
+	require.Equal(t, CircuitOpen, current)
+	allowed, _, current = breaker.allow()
+	require.False(t, allowed)
+	require.Equal(t, CircuitOpen, current)
+
+	// After the open duration a single probe is permitted.
+	virtualClock.Advance(60 * time.Millisecond)
+	allowed, previous, current = breaker.allow()
+	require.True(t, allowed)
+	require.Equal(t, CircuitOpen, previous)
+	require.Equal(t, CircuitHalfOpen, current)
+	allowed, _, _ = breaker.allow()
+	require.False(t, allowed)
+
+	// A failed probe reopens the breaker.
+	_, current = breaker.record(false)
+	require.Equal(t, CircuitOpen, current)
+	allowed, _, _ = breaker.allow()
+	require.False(t, allowed)
+
+	// A released probe permits another.
+	virtualClock.Advance(60 * time.Millisecond)
+	allowed, _, _ = breaker.allow()
+	require.True(t, allowed)
+	breaker.release()
+	allowed, _, _ = breaker.allow()
+	require.True(t, allowed)
+
+	// A successful probe closes the breaker.
+	previous, current = breaker.record(true)
+	require.Equal(t, CircuitHalfOpen, previous)
+	require.Equal(t, CircuitClosed, current)
+}
+
+func TestCircuitBreakerDo(t *testing.T) {
+	ctx := context.Background()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	virtualClock := clock.NewVirtual(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	changes := make(chan CircuitState, 10)
+	s := &Service{
+		log:    zerolog.Nop(),
+		client: srv.Client(),
+		hooks: &Hooks{
+			OnCircuitStateChange: func(_ context.Context, _ *Service, class string, _ CircuitState, current CircuitState) {
+				require.Equal(t, "validator", class)
+				changes <- current
+			},
+		},
+		circuitBreakers: map[string]*circuitBreaker{
+			"validator": newCircuitBreaker(&circuitBreakerConfig{failures: 2, openDuration: 50 * time.Millisecond}, virtualClock),
+		},
+	}
+
+	call := func(endpoint string) (int, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+endpoint, nil)
+		require.NoError(t, err)
+		resp, err := s.do(ctx, endpoint, req)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+
+		return resp.StatusCode, nil
+	}
+
+	// Server errors open the breaker for the class.
+	for i := 0; i < 2; i++ {
+		status, err := call("/eth/v1/validator/duties/proposer/1")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, status)
+	}
+	require.Equal(t, CircuitOpen, <-changes)
+	_, err := call("/eth/v1/validator/duties/proposer/1")
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	// Other classes are unaffected.
+	status, err := call("/eth/v1/beacon/genesis")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+
+	// Client errors are not failures, so a probe closes the breaker.
+	failing.Store(false)
+	virtualClock.Advance(60 * time.Millisecond)
+	status, err = call("/eth/v1/validator/duties/proposer/1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, status)
+	// Hooks are called asynchronously, so may arrive in any order.
+	require.ElementsMatch(t, []CircuitState{CircuitHalfOpen, CircuitClosed}, []CircuitState{<-changes, <-changes})
+}
+
+func TestCheckCircuitBreaker(t *testing.T) {
+	require.EqualError(t, checkCircuitBreaker(&circuitBreakerConfig{failures: 0, openDuration: time.Second}), "failures must be at least 1")
+	require.EqualError(t, checkCircuitBreaker(&circuitBreakerConfig{failures: 1}), "open duration must be positive")
+	require.NoError(t, checkCircuitBreaker(&circuitBreakerConfig{failures: 1, openDuration: time.Second}))
+}
+
+func TestCircuitStateString(t *testing.T) {
+	require.Equal(t, "closed", CircuitClosed.String())
+	require.Equal(t, "open", CircuitOpen.String())
+	require.Equal(t, "half-open", CircuitHalfOpen.String())
+	require.Equal(t, "unknown", CircuitState(-1).String())
+}
@@ -0,0 +1,118 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// Close shuts down the service.  New requests are rejected with
+// client.ErrClosed, and event streams and background processes are stopped.
+// In-flight requests are given until the supplied context is done to
+// complete, after which they are canceled.  Idle connections are closed once
+// all requests have finished.
+//
+// Close may be called more than once; calls after the first return
+// immediately.
+func (s *Service) Close(ctx context.Context) error {
+	s.closeMu.Lock()
+	if s.closed {
+		s.closeMu.Unlock()
+
+		return nil
+	}
+	s.closed = true
+	s.closeMu.Unlock()
+
+	s.log.Trace().Msg("Closing service")
+	if s.stop != nil {
+		s.stop()
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		s.background.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		s.log.Debug().Msg("Drain period over; canceling in-flight requests")
+		if s.abort != nil {
+			s.abort()
+		}
+		<-drained
+		err = errors.Join(errors.New("in-flight requests canceled"), ctx.Err())
+	}
+
+	s.client.CloseIdleConnections()
+	s.log.Trace().Msg("Service closed")
+
+	return err
+}
+
+// isClosed returns true if the service has been closed.
+func (s *Service) isClosed() bool {
+	s.closeMu.RLock()
+	defer s.closeMu.RUnlock()
+
+	return s.closed
+}
+
+// acquire registers an in-flight request.  It returns a context that is
+// canceled if the service is closed and the drain period passes before the
+// request completes, and a function to call when the request completes.
+func (s *Service) acquire(ctx context.Context) (context.Context, func(), error) {
+	return s.register(ctx, &s.inflight, s.abortCtx)
+}
+
+// register registers an operation with the given wait group, returning a
+// context that is canceled when either the supplied context or done is
+// canceled, and a function to call when the operation completes.
+func (s *Service) register(ctx context.Context,
+	wg *sync.WaitGroup,
+	done context.Context,
+) (
+	context.Context,
+	func(),
+	error,
+) {
+	s.closeMu.RLock()
+	defer s.closeMu.RUnlock()
+
+	if s.closed {
+		return nil, nil, client.ErrClosed
+	}
+	wg.Add(1)
+
+	if done == nil {
+		return ctx, wg.Done, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(done, cancel)
+
+	return ctx, func() {
+		stop()
+		cancel()
+		wg.Done()
+	}, nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func newClosableService(t *testing.T, server *httptest.Server) *Service {
+	t.Helper()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          5 * time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+	s.stopCtx, s.stop = context.WithCancel(context.Background())
+	s.abortCtx, s.abort = context.WithCancel(context.Background())
+
+	return s
+}
+
+func TestCloseDrains(t *testing.T) {
+	ctx := context.Background()
+
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+	s := newClosableService(t, server)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.get(ctx, "/eth/v1/test", "", &api.CommonOpts{}, false)
+		errCh <- err
+	}()
+	<-started
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.Close(closeCtx))
+	// The in-flight request was allowed to complete.
+	require.NoError(t, <-errCh)
+
+	// New requests are rejected.
+	_, err := s.get(ctx, "/eth/v1/test", "", &api.CommonOpts{}, false)
+	require.ErrorIs(t, err, client.ErrClosed)
+
+	// Closing again has no effect.
+	require.NoError(t, s.Close(ctx))
+}
+
+func TestCloseCancels(t *testing.T) {
+	ctx := context.Background()
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-finished:
+		}
+	}))
+	defer server.Close()
+	defer close(finished)
+	s := newClosableService(t, server)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.post(ctx, "/eth/v1/test", "", &api.CommonOpts{}, nil, ContentTypeJSON, map[string]string{})
+		errCh <- err
+	}()
+	<-started
+
+	closeCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.ErrorContains(t, s.Close(closeCtx), "in-flight requests canceled")
+	// The in-flight request was canceled.
+	require.ErrorIs(t, <-errCh, context.Canceled)
+}
+
+func TestCloseStopsBackground(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+	s := newClosableService(t, server)
+
+	streamCtx, release, err := s.register(ctx, &s.background, s.stopCtx)
+	require.NoError(t, err)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		defer release()
+		<-streamCtx.Done()
+	}()
+
+	require.NoError(t, s.Close(ctx))
+	<-stopped
+
+	_, _, err = s.register(ctx, &s.background, s.stopCtx)
+	require.ErrorIs(t, err, client.ErrClosed)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+// conditionalEndpoints are endpoints whose responses rarely change.  Responses
+// from these endpoints are retained along with their entity tag, allowing
+// later requests to be made conditional on the response having changed.
+var conditionalEndpoints = map[string]bool{
+	"/eth/v1/beacon/genesis":          true,
+	"/eth/v1/config/deposit_contract": true,
+	"/eth/v1/config/fork_schedule":    true,
+	"/eth/v1/config/spec":             true,
+}
+
+// conditionalResponse is a response retained for conditional requests.
+type conditionalResponse struct {
+	etag string
+	res  *httpResponse
+}
+
+// conditionalResponse returns the retained response for the given URL, if
+// the endpoint supports conditional requests and a response is available.
+func (s *Service) conditionalResponse(endpoint string, callURL string) *conditionalResponse {
+	if !conditionalEndpoints[endpoint] {
+		return nil
+	}
+
+	s.conditionalResponsesMu.RLock()
+	defer s.conditionalResponsesMu.RUnlock()
+
+	return s.conditionalResponses[callURL]
+}
+
+// storeConditionalResponse retains the response for the given URL if the
+// endpoint supports conditional requests and the response has an entity tag.
+func (s *Service) storeConditionalResponse(endpoint string, callURL string, etag string, res *httpResponse) {
+	if !conditionalEndpoints[endpoint] {
+		return
+	}
+
+	s.conditionalResponsesMu.Lock()
+	defer s.conditionalResponsesMu.Unlock()
+
+	if etag == "" {
+		// Without an entity tag the response cannot be revalidated.
+		delete(s.conditionalResponses, callURL)
+
+		return
+	}
+	if s.conditionalResponses == nil {
+		s.conditionalResponses = make(map[string]*conditionalResponse)
+	}
+	// Store a copy, so that later changes to the response by the caller
+	// are not reflected in the retained response.
+	retained := *res
+	s.conditionalResponses[callURL] = &conditionalResponse{
+		etag: etag,
+		res:  &retained,
+	}
+}
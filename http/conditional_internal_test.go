@@ -0,0 +1,143 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestConditionalRequests(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		etag         string
+		conditionals []string
+		fullRequests int
+	}{
+		{
+			name:         "ETag",
+			etag:         `"abc"`,
+			conditionals: []string{"", `"abc"`, `"abc"`},
+			fullRequests: 1,
+		},
+		{
+			name:         "NoETag",
+			conditionals: []string{"", "", ""},
+			fullRequests: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conditionals := make([]string, 0)
+			fullRequests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/config/spec", r.URL.Path)
+				conditionals = append(conditionals, r.Header.Get("If-None-Match"))
+				if test.etag != "" && r.Header.Get("If-None-Match") == test.etag {
+					w.WriteHeader(http.StatusNotModified)
+
+					return
+				}
+				fullRequests++
+				if test.etag != "" {
+					w.Header().Set("ETag", test.etag)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32"}}`))
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          server.URL,
+				client:           server.Client(),
+				timeout:          time.Second,
+				pingSem:          semaphore.NewWeighted(1),
+				hooks:            &Hooks{},
+				connectionActive: true,
+				connectionSynced: true,
+			}
+
+			for i := 0; i < 3; i++ {
+				s.clearStaticValues()
+				response, err := s.Spec(ctx, &api.SpecOpts{})
+				require.NoError(t, err)
+				require.Equal(t, uint64(32), response.Data["SLOTS_PER_EPOCH"])
+			}
+			require.Equal(t, test.conditionals, conditionals)
+			require.Equal(t, test.fullRequests, fullRequests)
+		})
+	}
+}
+
+func TestConditionalConsensusVersionOverride(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32"}}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	// The first call forces a version.
+	res, err := s.get(ctx, "/eth/v1/config/spec", "", &api.CommonOpts{ConsensusVersion: spec.DataVersionDeneb}, false)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionDeneb, res.consensusVersion)
+
+	// The second call receives the retained response, without the first call's override.
+	res, err = s.get(ctx, "/eth/v1/config/spec", "", &api.CommonOpts{}, false)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionUnknown, res.consensusVersion)
+
+	// A third call applies its own override to the retained response.
+	res, err = s.get(ctx, "/eth/v1/config/spec", "", &api.CommonOpts{ConsensusVersion: spec.DataVersionElectra}, false)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, res.consensusVersion)
+}
@@ -0,0 +1,169 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Config is a serializable configuration for the service, for use when
+// configuration is held in a file or the environment.  Fields that are not
+// set leave the corresponding parameter at its default.  Parameters that
+// cannot be serialized, such as the monitor or HTTP client, are passed to New
+// alongside those returned by Parameters.
+type Config struct {
+	// Address is the address of the beacon node.
+	Address string `json:"address"`
+	// Timeout is the timeout for requests, for example "5s".
+	Timeout string `json:"timeout,omitempty"`
+	// Retries is the number of times that a failed GET request is retried.
+	Retries int `json:"retries,omitempty"`
+	// LogLevel is the log level, for example "debug".
+	LogLevel string `json:"log_level,omitempty"`
+	// UserAgent is the user agent sent with requests.
+	UserAgent string `json:"user_agent,omitempty"`
+	// ExtraHeaders are additional headers sent with requests.
+	ExtraHeaders map[string]string `json:"extra_headers,omitempty"`
+	// EnforceJSON is true if SSZ is never requested.
+	EnforceJSON bool `json:"enforce_json,omitempty"`
+	// AllowDelayedStart is true if the service starts before the node is available.
+	AllowDelayedStart bool `json:"allow_delayed_start,omitempty"`
+	// CustomSpecSupport is true if non-mainnet specifications are supported.
+	CustomSpecSupport bool `json:"custom_spec_support,omitempty"`
+	// IndexChunkSize is the number of validator indices sent in a single request.
+	IndexChunkSize int `json:"index_chunk_size,omitempty"`
+	// PubKeyChunkSize is the number of validator public keys sent in a single request.
+	PubKeyChunkSize int `json:"pubkey_chunk_size,omitempty"`
+	// MaxResponseSize is the maximum size of a response body, in bytes.
+	MaxResponseSize int64 `json:"max_response_size,omitempty"`
+}
+
+// ConfigFromEnv creates a configuration from environment variables.  The
+// name of the variable for each field is the prefix followed by its JSON
+// name in upper case, for example ETH2_ADDRESS for a prefix of "ETH2_".
+// Extra headers are supplied as comma-separated key=value pairs.
+func ConfigFromEnv(prefix string) (*Config, error) {
+	config := &Config{}
+
+	val := reflect.ValueOf(config).Elem()
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		envName := prefix + strings.ToUpper(name)
+		value, exists := os.LookupEnv(envName)
+		if !exists {
+			continue
+		}
+		if err := setConfigField(val.Field(i), value); err != nil {
+			return nil, errors.Join(fmt.Errorf("invalid value for %s", envName), err)
+		}
+	}
+
+	return config, nil
+}
+
+func setConfigField(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(parsed)
+	case reflect.Map:
+		headers := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			if pair == "" {
+				continue
+			}
+			k, v, found := strings.Cut(pair, "=")
+			if !found {
+				return fmt.Errorf("invalid pair %q", pair)
+			}
+			headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+		field.Set(reflect.ValueOf(headers))
+	default:
+		return fmt.Errorf("unsupported kind %v", field.Kind())
+	}
+
+	return nil
+}
+
+// Parameters returns the parameters for the configuration, to pass to New.
+func (c *Config) Parameters() ([]Parameter, error) {
+	params := []Parameter{
+		WithAddress(c.Address),
+	}
+
+	if c.Timeout != "" {
+		timeout, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, errors.Join(errors.New("invalid timeout"), err)
+		}
+		params = append(params, WithTimeout(timeout))
+	}
+	if c.Retries != 0 {
+		params = append(params, WithRetries(c.Retries))
+	}
+	if c.LogLevel != "" {
+		logLevel, err := zerolog.ParseLevel(strings.ToLower(c.LogLevel))
+		if err != nil {
+			return nil, errors.Join(errors.New("invalid log level"), err)
+		}
+		params = append(params, WithLogLevel(logLevel))
+	}
+	if c.UserAgent != "" {
+		params = append(params, WithUserAgent(c.UserAgent))
+	}
+	if len(c.ExtraHeaders) > 0 {
+		params = append(params, WithExtraHeaders(c.ExtraHeaders))
+	}
+	if c.EnforceJSON {
+		params = append(params, WithEnforceJSON(true))
+	}
+	if c.AllowDelayedStart {
+		params = append(params, WithAllowDelayedStart(true))
+	}
+	if c.CustomSpecSupport {
+		params = append(params, WithCustomSpecSupport(true))
+	}
+	if c.IndexChunkSize != 0 {
+		params = append(params, WithIndexChunkSize(c.IndexChunkSize))
+	}
+	if c.PubKeyChunkSize != 0 {
+		params = append(params, WithPubKeyChunkSize(c.PubKeyChunkSize))
+	}
+	if c.MaxResponseSize != 0 {
+		params = append(params, WithMaxResponseSize(c.MaxResponseSize))
+	}
+
+	return params, nil
+}
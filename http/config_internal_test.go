@@ -0,0 +1,77 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigParameters(t *testing.T) {
+	var config Config
+	require.NoError(t, json.Unmarshal([]byte(`{"address":"http://localhost:5052","timeout":"5s","retries":3,"log_level":"debug","extra_headers":{"X-Test":"1"},"enforce_json":true,"index_chunk_size":100}`), &config))
+
+	params, err := config.Parameters()
+	require.NoError(t, err)
+	parameters, err := parseAndCheckParameters(params...)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:5052", parameters.address)
+	require.Equal(t, 5*time.Second, parameters.timeout)
+	require.Equal(t, 3, parameters.retries)
+	require.Equal(t, zerolog.DebugLevel, parameters.logLevel)
+	require.Equal(t, map[string]string{"X-Test": "1"}, parameters.extraHeaders)
+	require.True(t, parameters.enforceJSON)
+	require.Equal(t, 100, parameters.indexChunkSize)
+	// Unset fields keep their defaults.
+	require.Equal(t, defaultUserAgent, parameters.userAgent)
+	require.Equal(t, -1, parameters.pubKeyChunkSize)
+
+	_, err = (&Config{Address: "http://localhost:5052", Timeout: "bad"}).Parameters()
+	require.ErrorContains(t, err, "invalid timeout")
+	_, err = (&Config{Address: "http://localhost:5052", LogLevel: "bad"}).Parameters()
+	require.ErrorContains(t, err, "invalid log level")
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("TEST_ADDRESS", "http://localhost:5052")
+	t.Setenv("TEST_TIMEOUT", "10s")
+	t.Setenv("TEST_RETRIES", "2")
+	t.Setenv("TEST_EXTRA_HEADERS", "X-A=1, X-B=2")
+	t.Setenv("TEST_ALLOW_DELAYED_START", "true")
+	t.Setenv("TEST_MAX_RESPONSE_SIZE", "1024")
+
+	config, err := ConfigFromEnv("TEST_")
+	require.NoError(t, err)
+	require.Equal(t, &Config{
+		Address:           "http://localhost:5052",
+		Timeout:           "10s",
+		Retries:           2,
+		ExtraHeaders:      map[string]string{"X-A": "1", "X-B": "2"},
+		AllowDelayedStart: true,
+		MaxResponseSize:   1024,
+	}, config)
+
+	t.Setenv("TEST_RETRIES", "many")
+	_, err = ConfigFromEnv("TEST_")
+	require.ErrorContains(t, err, "invalid value for TEST_RETRIES")
+
+	t.Setenv("TEST_RETRIES", "2")
+	t.Setenv("TEST_EXTRA_HEADERS", "X-A")
+	_, err = ConfigFromEnv("TEST_")
+	require.ErrorContains(t, err, "invalid value for TEST_EXTRA_HEADERS")
+}
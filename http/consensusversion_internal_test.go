@@ -0,0 +1,111 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestConsensusVersionOverride(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		header   string
+		override spec.DataVersion
+		expected spec.DataVersion
+		err      string
+	}{
+		{
+			name:     "NoOverride",
+			header:   "deneb",
+			expected: spec.DataVersionDeneb,
+		},
+		{
+			name:     "OverrideMatches",
+			header:   "electra",
+			override: spec.DataVersionElectra,
+			expected: spec.DataVersionElectra,
+		},
+		{
+			name:     "OverrideMismatch",
+			header:   "deneb",
+			override: spec.DataVersionElectra,
+			expected: spec.DataVersionElectra,
+		},
+		{
+			name:   "UnknownVersion",
+			header: "unreleased",
+			err:    "failed to parse consensus version\nfailed to parse consensus version\nunrecognised data version \"unreleased\"",
+		},
+		{
+			name:     "UnknownVersionOverride",
+			header:   "unreleased",
+			override: spec.DataVersionElectra,
+			expected: spec.DataVersionElectra,
+		},
+		{
+			name:     "NoHeaderOverride",
+			override: spec.DataVersionDeneb,
+			expected: spec.DataVersionDeneb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if test.header != "" {
+					w.Header().Set("Eth-Consensus-Version", test.header)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          server.URL,
+				client:           server.Client(),
+				timeout:          time.Second,
+				pingSem:          semaphore.NewWeighted(1),
+				hooks:            &Hooks{},
+				connectionActive: true,
+				connectionSynced: true,
+			}
+
+			res, err := s.get(ctx, "/eth/v2/beacon/blocks/head", "", &api.CommonOpts{ConsensusVersion: test.override}, false)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.consensusVersion)
+		})
+	}
+}
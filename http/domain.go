@@ -17,20 +17,99 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
+// maxCachedDomains is the maximum number of domains held in the cache.  The
+// cache is emptied when it reaches this size, which bounds its memory usage
+// for long-running processes that sign across many epochs.
+const maxCachedDomains = 1024
+
+// domainKey is the key for the domain cache.
+type domainKey struct {
+	domainType phase0.DomainType
+	epoch      phase0.Epoch
+}
+
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	key := domainKey{domainType: domainType, epoch: epoch}
+	s.domainsMutex.RLock()
+	domain, exists := s.domains[key]
+	s.domainsMutex.RUnlock()
+	if exists {
+		return domain, nil
+	}
+
 	// Obtain the fork for the epoch.
 	fork, err := s.forkAtEpoch(ctx, epoch)
 	if err != nil {
 		return phase0.Domain{}, errors.Join(errors.New("failed to obtain fork"), err)
 	}
 
-	return s.calculateDomain(ctx, domainType, epoch, fork)
+	domain, err = s.calculateDomain(ctx, domainType, epoch, fork)
+	if err != nil {
+		return phase0.Domain{}, err
+	}
+
+	s.domainsMutex.Lock()
+	if s.domains == nil || len(s.domains) >= maxCachedDomains {
+		s.domains = make(map[domainKey]phase0.Domain)
+	}
+	s.domains[key] = domain
+	s.domainsMutex.Unlock()
+
+	return domain, nil
+}
+
+// DomainsForEpoch provides the domains for the given domain types at the
+// given epoch.  If no domain types are supplied, domains are provided for
+// all domain types in the chain specification.  The domains are cached, so
+// this can be used to precompute the domains for an epoch ahead of signing.
+func (s *Service) DomainsForEpoch(ctx context.Context,
+	epoch phase0.Epoch,
+	domainTypes ...phase0.DomainType,
+) (
+	map[phase0.DomainType]phase0.Domain,
+	error,
+) {
+	if len(domainTypes) == 0 {
+		response, err := s.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain spec"), err)
+		}
+		domainTypes = specDomainTypes(response.Data)
+	}
+
+	domains := make(map[phase0.DomainType]phase0.Domain, len(domainTypes))
+	for _, domainType := range domainTypes {
+		domain, err := s.Domain(ctx, domainType, epoch)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain domain for %#x", domainType), err)
+		}
+		domains[domainType] = domain
+	}
+
+	return domains, nil
+}
+
+// specDomainTypes returns the domain types in the chain specification.
+func specDomainTypes(spec map[string]any) []phase0.DomainType {
+	domainTypes := make([]phase0.DomainType, 0)
+	for k, v := range spec {
+		if !strings.HasPrefix(k, "DOMAIN_") {
+			continue
+		}
+		if domainType, isDomainType := v.(phase0.DomainType); isDomainType {
+			domainTypes = append(domainTypes, domainType)
+		}
+	}
+
+	return domainTypes
 }
 
 // GenesisDomain returns the domain for the given domain type at genesis.
@@ -0,0 +1,125 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func expectedDomain(t *testing.T, domainType phase0.DomainType, version phase0.Version, gvr phase0.Root) phase0.Domain {
+	t.Helper()
+
+	root, err := (&phase0.ForkData{CurrentVersion: version, GenesisValidatorsRoot: gvr}).HashTreeRoot()
+	require.NoError(t, err)
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], root[:])
+
+	return domain
+}
+
+func TestDomainCache(t *testing.T) {
+	ctx := context.Background()
+
+	gvr := phase0.Root{0x01}
+	proposerDomainType := phase0.DomainType{0x00, 0x00, 0x00, 0x00}
+	randaoDomainType := phase0.DomainType{0x02, 0x00, 0x00, 0x00}
+	s := &Service{
+		log:              zerolog.Nop(),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+		genesis: &apiv1.Genesis{
+			GenesisValidatorsRoot: gvr,
+		},
+		spec: map[string]any{
+			"DOMAIN_BEACON_PROPOSER": proposerDomainType,
+			"DOMAIN_RANDAO":          randaoDomainType,
+			"SLOTS_PER_EPOCH":        uint64(32),
+		},
+		forkSchedule: []*phase0.Fork{
+			{PreviousVersion: phase0.Version{0x00}, CurrentVersion: phase0.Version{0x00}, Epoch: 0},
+			{PreviousVersion: phase0.Version{0x00}, CurrentVersion: phase0.Version{0x01}, Epoch: 10},
+		},
+	}
+
+	domain, err := s.Domain(ctx, proposerDomainType, 5)
+	require.NoError(t, err)
+	require.Equal(t, expectedDomain(t, proposerDomainType, phase0.Version{0x00}, gvr), domain)
+	domain, err = s.Domain(ctx, proposerDomainType, 10)
+	require.NoError(t, err)
+	require.Equal(t, expectedDomain(t, proposerDomainType, phase0.Version{0x01}, gvr), domain)
+	require.Len(t, s.domains, 2)
+
+	// Domains for all types in the spec.
+	domains, err := s.DomainsForEpoch(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, map[phase0.DomainType]phase0.Domain{
+		proposerDomainType: expectedDomain(t, proposerDomainType, phase0.Version{0x01}, gvr),
+		randaoDomainType:   expectedDomain(t, randaoDomainType, phase0.Version{0x01}, gvr),
+	}, domains)
+	require.Len(t, s.domains, 3)
+
+	// Domains for specific types.
+	domains, err = s.DomainsForEpoch(ctx, 5, randaoDomainType)
+	require.NoError(t, err)
+	require.Equal(t, map[phase0.DomainType]phase0.Domain{
+		randaoDomainType: expectedDomain(t, randaoDomainType, phase0.Version{0x00}, gvr),
+	}, domains)
+	require.Len(t, s.domains, 4)
+
+	// Cached domains are returned without reference to the fork schedule.
+	s.forkSchedule = []*phase0.Fork{
+		{PreviousVersion: phase0.Version{0x02}, CurrentVersion: phase0.Version{0x02}, Epoch: 0},
+	}
+	domain, err = s.Domain(ctx, proposerDomainType, 5)
+	require.NoError(t, err)
+	require.Equal(t, expectedDomain(t, proposerDomainType, phase0.Version{0x00}, gvr), domain)
+
+	// Clearing static values clears the cache.
+	s.clearStaticValues()
+	require.Nil(t, s.domains)
+}
+
+func TestDomainCacheBounded(t *testing.T) {
+	ctx := context.Background()
+
+	s := &Service{
+		log:              zerolog.Nop(),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+		genesis:          &apiv1.Genesis{},
+		forkSchedule: []*phase0.Fork{
+			{PreviousVersion: phase0.Version{0x00}, CurrentVersion: phase0.Version{0x00}, Epoch: 0},
+		},
+	}
+
+	for epoch := phase0.Epoch(0); epoch < maxCachedDomains; epoch++ {
+		_, err := s.Domain(ctx, phase0.DomainType{}, epoch)
+		require.NoError(t, err)
+	}
+	require.Len(t, s.domains, maxCachedDomains)
+
+	_, err := s.Domain(ctx, phase0.DomainType{}, maxCachedDomains)
+	require.NoError(t, err)
+	require.Len(t, s.domains, 1)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	dynssz "github.com/pk910/dynamic-ssz"
+)
+
+// dynamicSSZ returns a dynamic SSZ codec if one is required to decode data
+// from the beacon node, or nil if the static codec can be used.
+//
+// A dynamic codec is always used if custom spec support is enabled.  Otherwise
+// it is used only if the limits in the beacon node's spec, for example those
+// of the minimal preset, differ from the mainnet limits assumed by the static
+// codec.
+func (s *Service) dynamicSSZ(ctx context.Context) (*dynssz.DynSsz, error) {
+	specs, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		if s.customSpecSupport {
+			return nil, errors.Join(errors.New("failed to request specs"), err)
+		}
+		// Assume mainnet limits.
+		s.log.Debug().Err(err).Msg("Failed to obtain spec; using static SSZ")
+
+		return nil, nil
+	}
+
+	if !s.customSpecSupport {
+		limits, err := spec.LimitsFromSpec(specs.Data)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain limits from spec"), err)
+		}
+		if limits.StaticSSZCompatible() {
+			return nil, nil
+		}
+	}
+
+	return dynssz.NewDynSsz(specs.Data), nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestDynamicSSZ(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		spec              string
+		customSpecSupport bool
+		dynamic           bool
+		err               string
+	}{
+		{
+			name:    "Mainnet",
+			spec:    `{"data":{"PRESET_BASE":"mainnet","SLOTS_PER_EPOCH":"32","MAX_BLOB_COMMITMENTS_PER_BLOCK":"4096"}}`,
+			dynamic: false,
+		},
+		{
+			name:    "Minimal",
+			spec:    `{"data":{"PRESET_BASE":"minimal","SLOTS_PER_EPOCH":"8"}}`,
+			dynamic: true,
+		},
+		{
+			name:    "MainnetOverridden",
+			spec:    `{"data":{"PRESET_BASE":"mainnet","MAX_BLOB_COMMITMENTS_PER_BLOCK":"32"}}`,
+			dynamic: true,
+		},
+		{
+			name:              "CustomSpecSupport",
+			spec:              `{"data":{"PRESET_BASE":"mainnet"}}`,
+			customSpecSupport: true,
+			dynamic:           true,
+		},
+		{
+			name:    "SpecUnavailable",
+			dynamic: false,
+		},
+		{
+			name:              "SpecUnavailableCustomSpecSupport",
+			customSpecSupport: true,
+			err:               "failed to request specs",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if test.spec == "" {
+					w.WriteHeader(http.StatusInternalServerError)
+
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.spec))
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:               zerolog.Nop(),
+				base:              base,
+				address:           server.URL,
+				client:            server.Client(),
+				timeout:           time.Second,
+				pingSem:           semaphore.NewWeighted(1),
+				hooks:             &Hooks{},
+				connectionActive:  true,
+				connectionSynced:  true,
+				customSpecSupport: test.customSpecSupport,
+			}
+
+			dynSSZ, err := s.dynamicSSZ(ctx)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.dynamic, dynSSZ != nil)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/r3labs/sse/v2"
+	"github.com/rs/zerolog"
+)
+
+// defaultEventBufferSize is the default number of events that can be queued
+// awaiting a worker.
+const defaultEventBufferSize = 256
+
+// eventDispatcher sends events from the event stream to handlers.
+type eventDispatcher struct {
+	ctx    context.Context
+	opts   *api.EventsOpts
+	handle func(ctx context.Context, msg *sse.Event, opts *api.EventsOpts)
+	queue  chan *sse.Event
+}
+
+// checkEventDispatchOpts checks the dispatch options for events.
+func checkEventDispatchOpts(opts *api.EventsOpts) error {
+	if opts.Workers < 0 {
+		return errors.Join(errors.New("workers cannot be negative"), client.ErrInvalidOptions)
+	}
+	if opts.BufferSize < 0 {
+		return errors.Join(errors.New("buffer size cannot be negative"), client.ErrInvalidOptions)
+	}
+	if opts.HandlerTimeout < 0 {
+		return errors.Join(errors.New("handler timeout cannot be negative"), client.ErrInvalidOptions)
+	}
+	switch opts.OverflowPolicy {
+	case api.EventOverflowBlock, api.EventOverflowDropOldest:
+	case api.EventOverflowError:
+		if opts.OverflowHandler == nil {
+			return errors.Join(errors.New("no overflow handler specified"), client.ErrInvalidOptions)
+		}
+	default:
+		return errors.Join(errors.New("unknown overflow policy"), client.ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+// newEventDispatcher creates a dispatcher, starting its workers if required.
+// Workers stop when the context is done.
+func newEventDispatcher(ctx context.Context,
+	opts *api.EventsOpts,
+	handle func(ctx context.Context, msg *sse.Event, opts *api.EventsOpts),
+) *eventDispatcher {
+	d := &eventDispatcher{
+		ctx:    ctx,
+		opts:   opts,
+		handle: handle,
+	}
+
+	if opts.Workers > 0 {
+		bufferSize := opts.BufferSize
+		if bufferSize == 0 {
+			bufferSize = defaultEventBufferSize
+		}
+		d.queue = make(chan *sse.Event, bufferSize)
+		for i := 0; i < opts.Workers; i++ {
+			go d.work()
+		}
+	}
+
+	return d
+}
+
+// dispatch sends an event to the handlers, either directly or via the
+// workers according to the options.
+func (d *eventDispatcher) dispatch(msg *sse.Event) {
+	if d.queue == nil {
+		d.handleWithTimeout(msg)
+
+		return
+	}
+
+	switch d.opts.OverflowPolicy {
+	case api.EventOverflowDropOldest:
+		for {
+			select {
+			case d.queue <- msg:
+				return
+			default:
+			}
+			// Buffer is full; drop the oldest event, if it has not already
+			// been picked up by a worker, and try again.
+			select {
+			case oldest := <-d.queue:
+				d.overflow(oldest)
+			default:
+			}
+		}
+	case api.EventOverflowError:
+		select {
+		case d.queue <- msg:
+		default:
+			d.overflow(msg)
+		}
+	default:
+		select {
+		case d.queue <- msg:
+		case <-d.ctx.Done():
+		}
+	}
+}
+
+// work sends queued events to the handlers until the context is done.
+func (d *eventDispatcher) work() {
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case msg := <-d.queue:
+			if d.ctx.Err() != nil {
+				// Do not start handling events once the stream has stopped.
+				return
+			}
+			d.handleWithTimeout(msg)
+		}
+	}
+}
+
+// handleWithTimeout sends an event to the handlers, applying the handler
+// timeout if one is set.
+func (d *eventDispatcher) handleWithTimeout(msg *sse.Event) {
+	ctx := d.ctx
+	if d.opts.HandlerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.opts.HandlerTimeout)
+		defer cancel()
+	}
+
+	d.handle(ctx, msg, d.opts)
+}
+
+// overflow reports an event dropped due to the buffer being full.
+func (d *eventDispatcher) overflow(msg *sse.Event) {
+	zerolog.Ctx(d.ctx).Debug().Str("topic", string(msg.Event)).Msg("Event buffer full; dropping event")
+	if d.opts.OverflowHandler != nil {
+		d.opts.OverflowHandler(d.ctx, string(msg.Event), msg.Data)
+	}
+}
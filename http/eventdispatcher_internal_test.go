@@ -0,0 +1,232 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/r3labs/sse/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEventDispatchOpts(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *api.EventsOpts
+		err  string
+	}{
+		{
+			name: "Default",
+			opts: &api.EventsOpts{},
+		},
+		{
+			name: "WorkersNegative",
+			opts: &api.EventsOpts{Workers: -1},
+			err:  "workers cannot be negative\ninvalid options",
+		},
+		{
+			name: "BufferSizeNegative",
+			opts: &api.EventsOpts{Workers: 1, BufferSize: -1},
+			err:  "buffer size cannot be negative\ninvalid options",
+		},
+		{
+			name: "HandlerTimeoutNegative",
+			opts: &api.EventsOpts{HandlerTimeout: -time.Second},
+			err:  "handler timeout cannot be negative\ninvalid options",
+		},
+		{
+			name: "OverflowHandlerMissing",
+			opts: &api.EventsOpts{Workers: 1, OverflowPolicy: api.EventOverflowError},
+			err:  "no overflow handler specified\ninvalid options",
+		},
+		{
+			name: "OverflowPolicyUnknown",
+			opts: &api.EventsOpts{Workers: 1, OverflowPolicy: 99},
+			err:  "unknown overflow policy\ninvalid options",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkEventDispatchOpts(test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// blockingHandler records events, blocking until released.
+type blockingHandler struct {
+	mu      sync.Mutex
+	release chan struct{}
+	started chan string
+	handled []string
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{
+		release: make(chan struct{}),
+		started: make(chan string, 16),
+	}
+}
+
+func (h *blockingHandler) handle(ctx context.Context, msg *sse.Event, _ *api.EventsOpts) {
+	h.started <- string(msg.ID)
+	select {
+	case <-h.release:
+	case <-ctx.Done():
+	}
+	h.mu.Lock()
+	h.handled = append(h.handled, string(msg.ID))
+	h.mu.Unlock()
+}
+
+func (h *blockingHandler) handledIDs() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string{}, h.handled...)
+}
+
+func testEvent(i int) *sse.Event {
+	return &sse.Event{
+		ID:    []byte(fmt.Sprintf("%d", i)),
+		Event: []byte("head"),
+	}
+}
+
+func TestEventDispatcherDropOldest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newBlockingHandler()
+	var dropped []string
+	d := newEventDispatcher(ctx, &api.EventsOpts{
+		Workers:        1,
+		BufferSize:     2,
+		OverflowPolicy: api.EventOverflowDropOldest,
+		OverflowHandler: func(_ context.Context, topic string, _ []byte) {
+			dropped = append(dropped, topic)
+		},
+	}, handler.handle)
+
+	// First event is picked up by the worker, which then blocks.
+	d.dispatch(testEvent(0))
+	require.Equal(t, "0", <-handler.started)
+
+	// Further events fill the buffer, then displace the oldest queued events.
+	for i := 1; i <= 4; i++ {
+		d.dispatch(testEvent(i))
+	}
+	require.Equal(t, []string{"head", "head"}, dropped)
+
+	close(handler.release)
+	require.Eventually(t, func() bool {
+		return len(handler.handledIDs()) == 3
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []string{"0", "3", "4"}, handler.handledIDs())
+}
+
+func TestEventDispatcherError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newBlockingHandler()
+	overflowed := make([]string, 0)
+	d := newEventDispatcher(ctx, &api.EventsOpts{
+		Workers:        1,
+		BufferSize:     1,
+		OverflowPolicy: api.EventOverflowError,
+		OverflowHandler: func(_ context.Context, _ string, data []byte) {
+			overflowed = append(overflowed, string(data))
+		},
+	}, handler.handle)
+
+	d.dispatch(testEvent(0))
+	require.Equal(t, "0", <-handler.started)
+	d.dispatch(testEvent(1))
+	msg := testEvent(2)
+	msg.Data = []byte("overflow")
+	d.dispatch(msg)
+	require.Equal(t, []string{"overflow"}, overflowed)
+
+	close(handler.release)
+	require.Eventually(t, func() bool {
+		return len(handler.handledIDs()) == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []string{"0", "1"}, handler.handledIDs())
+}
+
+func TestEventDispatcherBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	handler := newBlockingHandler()
+	d := newEventDispatcher(ctx, &api.EventsOpts{
+		Workers:    1,
+		BufferSize: 1,
+	}, handler.handle)
+
+	d.dispatch(testEvent(0))
+	require.Equal(t, "0", <-handler.started)
+	d.dispatch(testEvent(1))
+
+	// The buffer is full, so dispatch blocks until the context is canceled.
+	dispatched := make(chan struct{})
+	go func() {
+		d.dispatch(testEvent(2))
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+		require.Fail(t, "dispatch did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	select {
+	case <-dispatched:
+	case <-time.After(time.Second):
+		require.Fail(t, "dispatch did not unblock on cancel")
+	}
+
+	// Queued events are not handled once the context is canceled.
+	require.Eventually(t, func() bool {
+		return len(handler.handledIDs()) == 1
+	}, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, []string{"0"}, handler.handledIDs())
+}
+
+func TestEventDispatcherHandlerTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newBlockingHandler()
+	d := newEventDispatcher(ctx, &api.EventsOpts{
+		HandlerTimeout: 10 * time.Millisecond,
+	}, handler.handle)
+
+	// Synchronous dispatch returns once the handler's context times out.
+	start := time.Now()
+	d.dispatch(testEvent(0))
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, []string{"0"}, handler.handledIDs())
+}
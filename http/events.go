@@ -18,7 +18,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 	"strings"
@@ -48,13 +47,16 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 		return errors.Join(errors.New("no topics supplied"), client.ErrInvalidOptions)
 	}
 
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Logger()
+	reqID := requestID(&opts.Common)
+	log := s.log.With().Str("id", reqID).Str("address", s.address).Logger()
 	ctx = log.WithContext(ctx)
 
 	if err := s.checkEventsOpts(opts); err != nil {
 		return err
 	}
+	if err := checkEventDispatchOpts(opts); err != nil {
+		return err
+	}
 
 	endpoint := "/eth/v1/events"
 	query := "topics=" + strings.Join(opts.Topics, "&topics=")
@@ -66,7 +68,12 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 		sseClient.Headers[k] = v
 	}
 	if _, exists := sseClient.Headers["User-Agent"]; !exists {
-		sseClient.Headers["User-Agent"] = defaultUserAgent
+		sseClient.Headers["User-Agent"] = s.userAgent
+	}
+	if s.requestIDHeader != "" {
+		if _, exists := sseClient.Headers[s.requestIDHeader]; !exists {
+			sseClient.Headers[s.requestIDHeader] = reqID
+		}
 	}
 	sseClient.Headers["Accept"] = "text/event-stream"
 	sseClient.Connection.Transport = &http.Transport{
@@ -76,14 +83,20 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 		}).Dial,
 	}
 
+	// The stream is stopped if the service is closed.
+	ctx, release, err := s.register(ctx, &s.background, s.stopCtx)
+	if err != nil {
+		return err
+	}
+
+	dispatcher := newEventDispatcher(ctx, opts, s.handleEvent)
 	go func() {
+		defer release()
 		for {
 			select {
 			case <-time.After(time.Second):
 				log.Trace().Msg("Connecting to events stream")
-				if err := sseClient.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, opts)
-				}); err != nil {
+				if err := sseClient.SubscribeRawWithContext(ctx, dispatcher.dispatch); err != nil {
 					log.Error().Err(err).Msg("Failed to subscribe to event stream")
 				}
 				log.Trace().Msg("Events stream disconnected")
@@ -99,6 +112,11 @@ func (s *Service) Events(ctx context.Context, opts *api.EventsOpts) error {
 }
 
 func (s *Service) checkEventsOpts(opts *api.EventsOpts) error {
+	if opts.RawHandler != nil {
+		// All events are sent to the raw handler, so any topic is acceptable.
+		return nil
+	}
+
 	// Ensure we support the requested topic(s), and have a handler for each.
 	for _, topic := range opts.Topics {
 		if _, exists := apiv1.SupportedEventTopics[topic]; !exists {
@@ -172,6 +190,14 @@ func (s *Service) handleEvent(ctx context.Context,
 		return
 	}
 
+	if opts.RawHandler != nil && len(msg.Event) > 0 {
+		opts.RawHandler(ctx, string(msg.Event), msg.Data)
+		if opts.Handler == nil && s.checkEventSpecificHandler(opts, string(msg.Event)) != nil {
+			// No decoded handler for this event.
+			return
+		}
+	}
+
 	switch string(msg.Event) {
 	case "attestation":
 		s.handleAttestationEvent(ctx, msg, opts)
@@ -184,3 +184,54 @@ func TestEventHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestRawEvents(t *testing.T) {
+	ctx := zerolog.Nop().WithContext(context.Background())
+	s := &Service{
+		log: zerolog.Nop(),
+	}
+
+	type rawEvent struct {
+		topic string
+		data  string
+	}
+	var rawEvents []rawEvent
+	rawHandler := func(_ context.Context, topic string, data []byte) {
+		rawEvents = append(rawEvents, rawEvent{topic: topic, data: string(data)})
+	}
+	var heads []*apiv1.HeadEvent
+	headHandler := func(_ context.Context, event *apiv1.HeadEvent) {
+		heads = append(heads, event)
+	}
+
+	// Unknown topics are rejected without a raw handler.
+	require.EqualError(t, s.checkEventsOpts(&api.EventsOpts{
+		Topics:      []string{"head", "custom_topic"},
+		HeadHandler: headHandler,
+	}), "unsupported event topic custom_topic")
+	require.EqualError(t, s.checkEventsOpts(&api.EventsOpts{
+		Topics: []string{"head"},
+	}), "no handler for head event")
+
+	opts := &api.EventsOpts{
+		Topics:      []string{"head", "block", "custom_topic"},
+		RawHandler:  rawHandler,
+		HeadHandler: headHandler,
+	}
+	require.NoError(t, s.checkEventsOpts(opts))
+
+	headData := `{"slot":"4095940","block":"0x73d83c5f925716c9bd2d1e9c339fb99b0ec4addef3e93f6f35d4c5f1de7ae092","state":"0xead0e6eb4004576546864f10cfa4aeac31afbf96abc405a86c00cbda8f3e8ed0","epoch_transition":false,"previous_duty_dependent_root":"0xeca94cc9180212a2cff2659289cc7e6f2df08a645120e35e25d09c2ddc7db5f1","current_duty_dependent_root":"0xdda286c4a096fc8ec0d6ba9e14e688cbb046bfb33462fdf94953e75d0cea0074","execution_optimistic":false}`
+	s.handleEvent(ctx, &sse.Event{Event: []byte("head"), Data: []byte(headData)}, opts)
+	s.handleEvent(ctx, &sse.Event{Event: []byte("block"), Data: []byte("not JSON")}, opts)
+	s.handleEvent(ctx, &sse.Event{Event: []byte("custom_topic"), Data: []byte(`{"value":"1"}`)}, opts)
+	// Keepalive.
+	s.handleEvent(ctx, &sse.Event{}, opts)
+
+	require.Equal(t, []rawEvent{
+		{topic: "head", data: headData},
+		{topic: "block", data: "not JSON"},
+		{topic: "custom_topic", data: `{"value":"1"}`},
+	}, rawEvents)
+	require.Len(t, heads, 1)
+	require.Equal(t, uint64(4095940), uint64(heads[0].Slot))
+}
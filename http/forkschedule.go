@@ -16,6 +16,9 @@ package http
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -68,6 +71,12 @@ func (s *Service) ForkSchedule(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if s.overridesForkSchedule() {
+		data, err = s.forkScheduleFromSpec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
 	s.forkSchedule = data
 
 	return &api.Response[[]*phase0.Fork]{
@@ -75,3 +84,70 @@ func (s *Service) ForkSchedule(ctx context.Context,
 		Metadata: metadata,
 	}, nil
 }
+
+// forkNames are the names of forks in the spec, in the order in which they
+// occur.
+var forkNames = []string{
+	"GENESIS",
+	"ALTAIR",
+	"BELLATRIX",
+	"CAPELLA",
+	"DENEB",
+	"ELECTRA",
+}
+
+// overridesForkSchedule returns true if the spec overrides change fork
+// versions or epochs, in which case the fork schedule returned by the beacon
+// node is not used.
+func (s *Service) overridesForkSchedule() bool {
+	for k := range s.specOverrides {
+		if strings.HasSuffix(k, "_FORK_VERSION") || strings.HasSuffix(k, "_FORK_EPOCH") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forkScheduleFromSpec builds the fork schedule from the fork versions and
+// epochs in the spec.
+func (s *Service) forkScheduleFromSpec(ctx context.Context) ([]*phase0.Fork, error) {
+	response, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	forkSchedule := make([]*phase0.Fork, 0, len(forkNames))
+	for _, name := range forkNames {
+		version, exists := response.Data[name+"_FORK_VERSION"].(phase0.Version)
+		if !exists {
+			if name == "GENESIS" {
+				return nil, errors.New("GENESIS_FORK_VERSION not found in spec")
+			}
+
+			continue
+		}
+
+		var epoch phase0.Epoch
+		if name != "GENESIS" {
+			tmp, exists := response.Data[name+"_FORK_EPOCH"].(uint64)
+			if !exists {
+				return nil, fmt.Errorf("%s_FORK_EPOCH not found in spec", name)
+			}
+			epoch = phase0.Epoch(tmp)
+		}
+
+		previousVersion := version
+		if len(forkSchedule) > 0 {
+			previousVersion = forkSchedule[len(forkSchedule)-1].CurrentVersion
+		}
+
+		forkSchedule = append(forkSchedule, &phase0.Fork{
+			PreviousVersion: previousVersion,
+			CurrentVersion:  version,
+			Epoch:           epoch,
+		})
+	}
+
+	return forkSchedule, nil
+}
@@ -64,8 +64,22 @@ func (s *Service) Genesis(ctx context.Context,
 	}
 
 	// Up to us to fetch the information.
+	genesis, err := s.fetchGenesis(ctx, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+	s.genesis = genesis
+
+	return &api.Response[*apiv1.Genesis]{
+		Data:     s.genesis,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// fetchGenesis fetches the genesis information from the node, bypassing the cache.
+func (s *Service) fetchGenesis(ctx context.Context, opts *api.CommonOpts) (*apiv1.Genesis, error) {
 	endpoint := "/eth/v1/beacon/genesis"
-	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, false)
+	httpResponse, err := s.get(ctx, endpoint, "", opts, false)
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to request genesis"), err)
 	}
@@ -74,10 +88,9 @@ func (s *Service) Genesis(ctx context.Context,
 	if err := json.NewDecoder(bytes.NewReader(httpResponse.body)).Decode(&resp); err != nil {
 		return nil, errors.Join(errors.New("failed to parse genesis"), err)
 	}
-	s.genesis = resp.Data
+	if resp.Data == nil {
+		return nil, errors.New("genesis not returned")
+	}
 
-	return &api.Response[*apiv1.Genesis]{
-		Data:     s.genesis,
-		Metadata: make(map[string]any),
-	}, nil
+	return resp.Data, nil
 }
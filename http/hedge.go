@@ -0,0 +1,81 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+)
+
+// hedgedResult is the result of a hedged call.
+type hedgedResult[T any] struct {
+	data T
+	err  error
+}
+
+// hedged calls fn and, if it has not returned after the given delay, calls
+// it again in parallel, returning the first successful result.  If the first
+// call fails before the delay has passed its error is returned immediately.
+// Calls that are still outstanding once a result is returned are canceled.
+// A delay of 0 calls fn once.  The delay is measured by the given clock.
+func hedged[T any](ctx context.Context,
+	clk clock.Clock,
+	delay time.Duration,
+	fn func(ctx context.Context) (T, error),
+) (
+	T,
+	error,
+) {
+	if delay <= 0 {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that outstanding calls do not block once we have returned.
+	results := make(chan *hedgedResult[T], 2)
+	call := func() {
+		data, err := fn(ctx)
+		results <- &hedgedResult[T]{data: data, err: err}
+	}
+
+	go call()
+	outstanding := 1
+	timer := clk.NewTimer(delay)
+	defer timer.Stop()
+
+	var errs []error
+	for {
+		select {
+		case <-timer.C():
+			go call()
+			outstanding++
+		case result := <-results:
+			outstanding--
+			if result.err == nil {
+				return result.data, nil
+			}
+			errs = append(errs, result.err)
+			if outstanding == 0 {
+				var res T
+
+				return res, errors.Join(errs...)
+			}
+		}
+	}
+}
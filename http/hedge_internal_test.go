@@ -0,0 +1,181 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedged(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		delay time.Duration
+		// fn is passed the number of the call, starting at 1.
+		fn    func(ctx context.Context, call int32) (int32, error)
+		res   int32
+		err   string
+		calls int32
+	}{
+		{
+			name:  "NoDelay",
+			delay: 0,
+			fn: func(_ context.Context, call int32) (int32, error) {
+				time.Sleep(20 * time.Millisecond)
+
+				return call, nil
+			},
+			res:   1,
+			calls: 1,
+		},
+		{
+			name:  "FirstFast",
+			delay: 50 * time.Millisecond,
+			fn: func(_ context.Context, call int32) (int32, error) {
+				return call, nil
+			},
+			res:   1,
+			calls: 1,
+		},
+		{
+			name:  "SecondWins",
+			delay: 10 * time.Millisecond,
+			fn: func(ctx context.Context, call int32) (int32, error) {
+				if call == 1 {
+					<-ctx.Done()
+
+					return 0, ctx.Err()
+				}
+
+				return call, nil
+			},
+			res:   2,
+			calls: 2,
+		},
+		{
+			name:  "FirstWinsAfterHedge",
+			delay: 10 * time.Millisecond,
+			fn: func(ctx context.Context, call int32) (int32, error) {
+				if call == 1 {
+					time.Sleep(20 * time.Millisecond)
+
+					return call, nil
+				}
+				<-ctx.Done()
+
+				return 0, ctx.Err()
+			},
+			res:   1,
+			calls: 2,
+		},
+		{
+			name:  "InvalidFirstResponse",
+			delay: 10 * time.Millisecond,
+			fn: func(_ context.Context, call int32) (int32, error) {
+				if call == 1 {
+					time.Sleep(20 * time.Millisecond)
+
+					return 0, errors.New("invalid")
+				}
+				time.Sleep(20 * time.Millisecond)
+
+				return call, nil
+			},
+			res:   2,
+			calls: 2,
+		},
+		{
+			name:  "FirstFailsEarly",
+			delay: 50 * time.Millisecond,
+			fn: func(_ context.Context, _ int32) (int32, error) {
+				return 0, errors.New("failed")
+			},
+			err:   "failed",
+			calls: 1,
+		},
+		{
+			name:  "BothFail",
+			delay: 10 * time.Millisecond,
+			fn: func(_ context.Context, call int32) (int32, error) {
+				time.Sleep(20 * time.Millisecond)
+				if call == 1 {
+					return 0, errors.New("first failed")
+				}
+
+				return 0, errors.New("second failed")
+			},
+			err:   "first failed\nsecond failed",
+			calls: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls atomic.Int32
+			res, err := hedged(ctx, clock.System(), test.delay, func(ctx context.Context) (int32, error) {
+				return test.fn(ctx, calls.Add(1))
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+			require.Equal(t, test.calls, calls.Load())
+		})
+	}
+}
+
+func TestHedgedVirtualClock(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewVirtual(time.Unix(1606824023, 0))
+
+	var calls atomic.Int32
+	results := make(chan *hedgedResult[int32], 1)
+	go func() {
+		res, err := hedged(ctx, clk, time.Second, func(ctx context.Context) (int32, error) {
+			call := calls.Add(1)
+			if call == 1 {
+				<-ctx.Done()
+
+				return 0, ctx.Err()
+			}
+
+			return call, nil
+		})
+		results <- &hedgedResult[int32]{data: res, err: err}
+	}()
+
+	// The hedged call is only made once the clock reaches the delay.
+	require.NoError(t, clk.WaitForWaiters(ctx, 1))
+	select {
+	case <-results:
+		require.Fail(t, "hedged call returned before the delay")
+	default:
+	}
+	clk.Advance(time.Second)
+
+	result := <-results
+	require.NoError(t, result.err)
+	require.Equal(t, int32(2), result.data)
+	require.Equal(t, int32(2), calls.Load())
+}
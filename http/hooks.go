@@ -24,4 +24,7 @@ type Hooks struct {
 	OnInactive HookFunc
 	OnSynced   HookFunc
 	OnDesynced HookFunc
+	// OnCircuitStateChange is called when the circuit breaker for an
+	// endpoint class changes state.
+	OnCircuitStateChange CircuitStateChangeFunc
 }
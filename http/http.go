@@ -16,11 +16,12 @@ package http
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"strings"
@@ -52,8 +53,15 @@ func (s *Service) post(ctx context.Context,
 	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "post")
 	defer span.End()
 
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	// Requests are canceled if the service is closed before they complete.
+	reqCtx, release, err := s.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	reqID := requestID(opts)
+	log := s.log.With().Str("id", reqID).Str("address", s.address).Str("endpoint", endpoint).Logger()
 	if e := log.Trace(); e.Enabled() {
 		switch contentType {
 		case ContentTypeJSON:
@@ -69,21 +77,33 @@ func (s *Service) post(ctx context.Context,
 		}
 	}
 
-	callURL := urlForCall(s.base, endpoint, query)
+	base, err := s.baseForCall(opts)
+	if err != nil {
+		return nil, err
+	}
+	callURL := urlForCall(base, endpoint, query)
 	log.Trace().Str("url", callURL.String()).Msg("URL to POST")
-	span.SetAttributes(attribute.String("url", callURL.String()))
+	span.SetAttributes(attribute.String("url", callURL.String()), attribute.String("request_id", reqID))
 
 	timeout := s.timeout
 	if opts.Timeout != 0 {
 		timeout = opts.Timeout
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	opCtx, cancel := context.WithTimeout(reqCtx, timeout)
 	defer cancel()
+	if err := s.throttle(opCtx, endpoint); err != nil {
+		span.SetStatus(codes.Error, "Rate limited")
+
+		return nil, errors.Join(errors.New("failed to obtain permission from rate limiter"), err)
+	}
 	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, callURL.String(), body)
 	if err != nil {
 		return nil, errors.Join(errors.New("failed to create POST request"), err)
 	}
+	if opts.Host != "" {
+		req.Host = opts.Host
+	}
 
 	s.addExtraHeaders(req)
 	req.Header.Set("Content-Type", contentType.MediaType())
@@ -92,17 +112,19 @@ func (s *Service) post(ctx context.Context,
 	for k, v := range headers {
 		req.Header.Set(k, v)
 	}
-	if req.Header.Get("User-Agent") == "" {
-		req.Header.Set("User-Agent", defaultUserAgent)
-	}
+	s.addRequestHeaders(req, reqID)
 
-	resp, err := s.client.Do(req)
+	resp, err := s.doCompressed(ctx, endpoint, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, context.Canceled):
 			// We don't consider context canceled to be a potential connection issue, as the user canceled the context.
 		case errors.Is(err, context.DeadlineExceeded):
 			// We don't consider context deadline exceeded to be a potential connection issue, as the user selected the deadline.
+		case errors.Is(err, ErrCircuitOpen):
+			// We don't consider an open circuit to be a potential connection issue, as no request was made.
+		case opts.Address != "":
+			// We don't consider failures against an overridden address to be a potential connection issue for our node.
 		default:
 			// We consider other errors to be potential connection issues.
 			go s.CheckConnectionState(ctx)
@@ -121,7 +143,7 @@ func (s *Service) post(ctx context.Context,
 	}
 	populateHeaders(res, resp)
 
-	res.body, err = io.ReadAll(resp.Body)
+	res.body, err = s.readBody(resp, opts, endpoint)
 	if err != nil {
 		switch {
 		case errors.Is(err, context.Canceled):
@@ -176,6 +198,7 @@ func (s *Service) post(ctx context.Context,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
 			Data:       res.body,
+			RequestID:  reqID,
 		}
 	}
 
@@ -207,6 +230,30 @@ func (s *Service) addExtraHeaders(req *http.Request) {
 	}
 }
 
+// addRequestHeaders adds the user agent and request ID headers to the request,
+// unless they have already been set.
+func (s *Service) addRequestHeaders(req *http.Request, reqID string) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", s.userAgent)
+	}
+	if s.requestIDHeader != "" && req.Header.Get(s.requestIDHeader) == "" {
+		req.Header.Set(s.requestIDHeader, reqID)
+	}
+}
+
+// requestID returns the request ID for a call, generating one if not supplied.
+func requestID(opts *api.CommonOpts) string {
+	if opts != nil && opts.RequestID != "" {
+		return opts.RequestID
+	}
+
+	id := make([]byte, 8)
+	// crypto/rand.Read never returns an error.
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
 // responseMetadata returns metadata related to responses.
 type responseMetadata struct {
 	Version spec.DataVersion `json:"version"`
@@ -220,10 +267,26 @@ type httpResponse struct {
 	body             []byte
 }
 
-// get sends an HTTP get request and returns the response.
+// get sends an HTTP get request and returns the response, retrying
+// transient failures if configured.
+func (s *Service) get(ctx context.Context,
+	endpoint string,
+	query string,
+	opts *api.CommonOpts,
+	supportsSSZ bool,
+) (
+	*httpResponse,
+	error,
+) {
+	return retried(ctx, s.log, s.clock, s.retries, func(ctx context.Context) (*httpResponse, error) {
+		return s.getOnce(ctx, endpoint, query, opts, supportsSSZ)
+	})
+}
+
+// getOnce sends a single HTTP get request and returns the response.
 //
 //nolint:revive
-func (s *Service) get(ctx context.Context,
+func (s *Service) getOnce(ctx context.Context,
 	endpoint string,
 	query string,
 	opts *api.CommonOpts,
@@ -235,29 +298,49 @@ func (s *Service) get(ctx context.Context,
 	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "get")
 	defer span.End()
 
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	// Requests are canceled if the service is closed before they complete.
+	reqCtx, release, err := s.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	reqID := requestID(opts)
+	log := s.log.With().Str("id", reqID).Str("address", s.address).Str("endpoint", endpoint).Logger()
 	log.Trace().Msg("GET request")
 
-	callURL := urlForCall(s.base, endpoint, query)
+	base, err := s.baseForCall(opts)
+	if err != nil {
+		return nil, err
+	}
+	callURL := urlForCall(base, endpoint, query)
 	log.Trace().Str("url", callURL.String()).Msg("URL to GET")
-	span.SetAttributes(attribute.String("url", callURL.String()))
+	span.SetAttributes(attribute.String("url", callURL.String()), attribute.String("request_id", reqID))
 
 	timeout := s.timeout
 	if opts.Timeout != 0 {
 		timeout = opts.Timeout
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	opCtx, cancel := context.WithTimeout(reqCtx, timeout)
 	defer cancel()
+	if err := s.throttle(opCtx, endpoint); err != nil {
+		span.SetStatus(codes.Error, "Rate limited")
+
+		return nil, errors.Join(errors.New("failed to obtain permission from rate limiter"), err)
+	}
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, callURL.String(), nil)
 	if err != nil {
 		span.SetStatus(codes.Error, "Failed to create request")
 
 		return nil, errors.Join(errors.New("failed to create GET request"), err)
 	}
+	if opts.Host != "" {
+		req.Host = opts.Host
+	}
 
 	s.addExtraHeaders(req)
+	s.addRequestHeaders(req, reqID)
 	if s.enforceJSON || !supportsSSZ {
 		// JSON only.
 		req.Header.Set("Accept", "application/json")
@@ -265,14 +348,22 @@ func (s *Service) get(ctx context.Context,
 		// Prefer SSZ, JSON if not.
 		req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
 	}
+	cached := s.conditionalResponse(endpoint, callURL.String())
+	if cached != nil {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
 
-	resp, err := s.client.Do(req)
+	resp, err := s.do(ctx, endpoint, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, context.Canceled):
 			// We don't consider context canceled to be a potential connection issue, as the user canceled the context.
 		case errors.Is(err, context.DeadlineExceeded):
 			// We don't consider context deadline exceeded to be a potential connection issue, as the user selected the deadline.
+		case errors.Is(err, ErrCircuitOpen):
+			// We don't consider an open circuit to be a potential connection issue, as no request was made.
+		case opts.Address != "":
+			// We don't consider failures against an overridden address to be a potential connection issue for our node.
 		case strings.HasSuffix(callURL.String(), "/node/syncing"):
 			// Special case; if we have called the syncing endpoint and it failed then we don't check the connection status, as
 			// that calls the syncing endpoint itself and so we find ourselves in an endless loop.
@@ -298,7 +389,7 @@ func (s *Service) get(ctx context.Context,
 	// require the calling function to be aware that it needs to close the body
 	// once it is done with it.  To avoid that complexity, we read here and store the
 	// body as a byte array.
-	res.body, err = io.ReadAll(resp.Body)
+	res.body, err = s.readBody(resp, opts, endpoint)
 	if err != nil {
 		switch {
 		case errors.Is(err, context.Canceled):
@@ -315,6 +406,17 @@ func (s *Service) get(ctx context.Context,
 		return nil, errors.Join(errors.New("failed to read GET response"), err)
 	}
 
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		// Response has not changed since we last received it.
+		span.AddEvent("Received not modified response")
+		log.Trace().Msg("Endpoint returned not modified; using retained response")
+		s.monitorGetComplete(ctx, callURL.Path, "succeeded")
+		retained := *cached.res
+		overrideConsensusVersion(&retained, opts, log, span)
+
+		return &retained, nil
+	}
+
 	if resp.StatusCode == http.StatusNoContent {
 		// Nothing returned.  This is not considered an error.
 		span.AddEvent("Received empty response")
@@ -346,6 +448,7 @@ func (s *Service) get(ctx context.Context,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
 			Data:       res.body,
+			RequestID:  reqID,
 		}
 	}
 
@@ -357,14 +460,38 @@ func (s *Service) get(ctx context.Context,
 	}
 
 	if err := populateConsensusVersion(res, resp); err != nil {
-		return nil, errors.Join(errors.New("failed to parse consensus version"), err)
+		if opts.ConsensusVersion == spec.DataVersionUnknown {
+			return nil, errors.Join(errors.New("failed to parse consensus version"), err)
+		}
+		log.Warn().Err(err).Stringer("override", opts.ConsensusVersion).Msg("Failed to parse consensus version; using override")
+		res.consensusVersion = spec.DataVersionUnknown
 	}
 
+	// The response is retained before the per-call override is applied, so
+	// that the override does not leak to later calls.
+	s.storeConditionalResponse(endpoint, callURL.String(), resp.Header.Get("ETag"), res)
+	overrideConsensusVersion(res, opts, log, span)
 	s.monitorGetComplete(ctx, callURL.Path, "succeeded")
 
 	return res, nil
 }
 
+// overrideConsensusVersion applies the consensus version override of the
+// call options, if any, to the response.
+func overrideConsensusVersion(res *httpResponse, opts *api.CommonOpts, log zerolog.Logger, span trace.Span) {
+	if opts.ConsensusVersion == spec.DataVersionUnknown || opts.ConsensusVersion == res.consensusVersion {
+		return
+	}
+	if res.consensusVersion != spec.DataVersionUnknown {
+		log.Warn().
+			Stringer("reported", res.consensusVersion).
+			Stringer("override", opts.ConsensusVersion).
+			Msg("Consensus version mismatch; using override")
+		span.AddEvent("Overrode consensus version")
+	}
+	res.consensusVersion = opts.ConsensusVersion
+}
+
 func populateConsensusVersion(res *httpResponse, resp *http.Response) error {
 	res.consensusVersion = spec.DataVersionUnknown
 	respConsensusVersions, exists := resp.Header["Eth-Consensus-Version"]
@@ -435,6 +562,21 @@ func metadataFromHeaders(headers map[string]string) map[string]any {
 	return metadata
 }
 
+// baseForCall returns the base URL for a call, taking into account any
+// address override in the options.
+func (s *Service) baseForCall(opts *api.CommonOpts) (*url.URL, error) {
+	if opts == nil || opts.Address == "" {
+		return s.base, nil
+	}
+
+	base, _, err := parseAddress(opts.Address)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid address override"), err)
+	}
+
+	return base, nil
+}
+
 // urlForCall patches together a URL for a call.
 func urlForCall(base *url.URL,
 	endpoint string,
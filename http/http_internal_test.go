@@ -14,9 +14,16 @@
 package http
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
@@ -148,3 +155,137 @@ func TestURLForCall(t *testing.T) {
 		})
 	}
 }
+
+func TestAddRequestHeaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		userAgent       string
+		requestIDHeader string
+		headers         map[string]string
+		reqID           string
+		expected        map[string]string
+	}{
+		{
+			name:            "Defaults",
+			userAgent:       defaultUserAgent,
+			requestIDHeader: "X-Request-ID",
+			reqID:           "abc",
+			expected: map[string]string{
+				"User-Agent":   defaultUserAgent,
+				"X-Request-Id": "abc",
+			},
+		},
+		{
+			name:            "CustomUserAgent",
+			userAgent:       "custom/1.0",
+			requestIDHeader: "X-Request-ID",
+			reqID:           "abc",
+			expected: map[string]string{
+				"User-Agent":   "custom/1.0",
+				"X-Request-Id": "abc",
+			},
+		},
+		{
+			name:            "ExistingHeaders",
+			userAgent:       "custom/1.0",
+			requestIDHeader: "X-Request-ID",
+			headers: map[string]string{
+				"User-Agent":   "existing/1.0",
+				"X-Request-ID": "def",
+			},
+			reqID: "abc",
+			expected: map[string]string{
+				"User-Agent":   "existing/1.0",
+				"X-Request-Id": "def",
+			},
+		},
+		{
+			name:      "NoRequestID",
+			userAgent: defaultUserAgent,
+			reqID:     "abc",
+			expected: map[string]string{
+				"User-Agent": defaultUserAgent,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				userAgent:       test.userAgent,
+				requestIDHeader: test.requestIDHeader,
+			}
+			req, err := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+			require.NoError(t, err)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			s.addRequestHeaders(req, test.reqID)
+			require.Len(t, req.Header, len(test.expected))
+			for k, v := range test.expected {
+				require.Equal(t, v, req.Header.Get(k))
+			}
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	require.Equal(t, "supplied", requestID(&api.CommonOpts{RequestID: "supplied"}))
+
+	generated1 := requestID(&api.CommonOpts{})
+	require.Len(t, generated1, 16)
+	generated2 := requestID(nil)
+	require.Len(t, generated2, 16)
+	require.NotEqual(t, generated1, generated2)
+}
+
+func TestCallOverrides(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(name string, hosts *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*hosts = append(*hosts, r.Host)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":"` + name + `"}`))
+		}
+	}
+	localHosts := make([]string, 0)
+	local := httptest.NewServer(handler("local", &localHosts))
+	defer local.Close()
+	archiveHosts := make([]string, 0)
+	archive := httptest.NewServer(handler("archive", &archiveHosts))
+	defer archive.Close()
+
+	base, err := url.Parse(local.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: local.URL,
+		client:  http.DefaultClient,
+		timeout: time.Second,
+	}
+
+	// No overrides.
+	res, err := s.get(ctx, "/eth/v1/test", "", &api.CommonOpts{}, false)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"local"}`, string(res.body))
+
+	// Address override.
+	res, err = s.get(ctx, "/eth/v1/test", "", &api.CommonOpts{Address: archive.URL}, false)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"archive"}`, string(res.body))
+	res, err = s.post(ctx, "/eth/v1/test", "", &api.CommonOpts{Address: archive.URL}, strings.NewReader("{}"), ContentTypeJSON, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"data":"archive"}`, string(res.body))
+
+	// Host override.
+	_, err = s.get(ctx, "/eth/v1/test", "", &api.CommonOpts{Host: "beacon.example.com"}, false)
+	require.NoError(t, err)
+	require.Equal(t, "beacon.example.com", localHosts[len(localHosts)-1])
+	require.Len(t, archiveHosts, 2)
+
+	// Invalid address override.
+	_, err = s.get(ctx, "/eth/v1/test", "", &api.CommonOpts{Address: "http://[::1"}, false)
+	require.ErrorContains(t, err, "invalid address override")
+}
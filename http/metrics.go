@@ -23,8 +23,9 @@ import (
 )
 
 var (
-	requestsMetric *prometheus.CounterVec
-	stateMetric    *prometheus.GaugeVec
+	requestsMetric  *prometheus.CounterVec
+	stateMetric     *prometheus.GaugeVec
+	throttledMetric *prometheus.CounterVec
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -64,6 +65,16 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return errors.Join(errors.New("failed to register state"), err)
 	}
 
+	throttledMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http",
+		Name:      "throttled_requests_total",
+		Help:      "Number of requests delayed by rate limiting",
+	}, []string{"server", "class"})
+	if err := prometheus.Register(throttledMetric); err != nil {
+		return errors.Join(errors.New("failed to register throttled_requests_total"), err)
+	}
+
 	return nil
 }
 
@@ -83,6 +94,14 @@ func (s *Service) monitorPostComplete(_ context.Context, endpoint string, result
 	requestsMetric.WithLabelValues(s.address, "POST", reduceEndpoint(endpoint), result).Inc()
 }
 
+func (s *Service) monitorThrottled(class string) {
+	if throttledMetric == nil {
+		return
+	}
+
+	throttledMetric.WithLabelValues(s.address, class).Inc()
+}
+
 type templateReplacement struct {
 	pattern     *regexp.Regexp
 	replacement []byte
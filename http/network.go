@@ -0,0 +1,85 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// checkNetwork checks that the node is on the expected network, if one has
+// been specified.  Values are fetched directly from the node rather than
+// from the cache, as the node may have changed.
+func (s *Service) checkNetwork(ctx context.Context) error {
+	if s.expectedGVR == nil && s.expectedChainID == nil {
+		return nil
+	}
+
+	err := s.confirmNetwork(ctx)
+
+	s.connectionMu.Lock()
+	if errors.Is(err, client.ErrNetworkMismatch) {
+		s.networkErr = err
+	} else {
+		s.networkErr = nil
+	}
+	s.connectionMu.Unlock()
+
+	return err
+}
+
+func (s *Service) confirmNetwork(ctx context.Context) error {
+	if s.expectedGVR != nil {
+		genesis, err := s.fetchGenesis(ctx, &api.CommonOpts{})
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain genesis to confirm network"), err)
+		}
+		if genesis.GenesisValidatorsRoot != *s.expectedGVR {
+			return errors.Join(fmt.Errorf("genesis validators root %#x does not match expected %#x",
+				genesis.GenesisValidatorsRoot, *s.expectedGVR), client.ErrNetworkMismatch)
+		}
+	}
+
+	if s.expectedChainID != nil {
+		httpResponse, err := s.get(ctx, "/eth/v1/config/deposit_contract", "", &api.CommonOpts{}, false)
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain deposit contract to confirm network"), err)
+		}
+		depositContract, _, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), apiv1.DepositContract{})
+		if err != nil {
+			return errors.Join(errors.New("failed to parse deposit contract to confirm network"), err)
+		}
+		if depositContract.ChainID != *s.expectedChainID {
+			return errors.Join(fmt.Errorf("deposit chain ID %d does not match expected %d",
+				depositContract.ChainID, *s.expectedChainID), client.ErrNetworkMismatch)
+		}
+	}
+
+	return nil
+}
+
+// networkError returns the error from the most recent network check, if the
+// node was found to be on the wrong network.
+func (s *Service) networkError() error {
+	s.connectionMu.RLock()
+	defer s.connectionMu.RUnlock()
+
+	return s.networkErr
+}
@@ -0,0 +1,133 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// networkTestServer returns a server that mimics a beacon node on the network
+// with the given genesis validators root and deposit chain ID.
+func networkTestServer(t *testing.T, gvr string, chainID string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/node/syncing":
+			_, _ = w.Write([]byte(`{"data":{"head_slot":"100","sync_distance":"0","is_syncing":false,"is_optimistic":false,"el_offline":false}}`))
+		case "/eth/v1/node/version":
+			_, _ = w.Write([]byte(`{"data":{"version":"test/v1.0.0"}}`))
+		case "/eth/v1/beacon/genesis":
+			_, _ = w.Write([]byte(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"` + gvr + `","genesis_fork_version":"0x00000000"}}`))
+		case "/eth/v1/config/deposit_contract":
+			_, _ = w.Write([]byte(`{"data":{"chain_id":"` + chainID + `","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestNetworkMismatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mainnetGVR := "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+	expectedGVR := phase0.Root{
+		0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+		0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+	}
+
+	tests := []struct {
+		name    string
+		gvr     string
+		chainID string
+		params  []Parameter
+		err     string
+	}{
+		{
+			name:    "NoExpectations",
+			gvr:     "0x0000000000000000000000000000000000000000000000000000000000000000",
+			chainID: "5",
+		},
+		{
+			name:    "Match",
+			gvr:     mainnetGVR,
+			chainID: "1",
+			params: []Parameter{
+				WithExpectedGenesisValidatorsRoot(expectedGVR),
+				WithExpectedDepositChainID(1),
+			},
+		},
+		{
+			name:    "GenesisValidatorsRootMismatch",
+			gvr:     "0x0100000000000000000000000000000000000000000000000000000000000000",
+			chainID: "1",
+			params: []Parameter{
+				WithExpectedGenesisValidatorsRoot(expectedGVR),
+			},
+			err: "genesis validators root 0x0100000000000000000000000000000000000000000000000000000000000000 does not match expected 0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95\nnetwork mismatch",
+		},
+		{
+			name:    "ChainIDMismatch",
+			gvr:     mainnetGVR,
+			chainID: "17000",
+			params: []Parameter{
+				WithExpectedGenesisValidatorsRoot(expectedGVR),
+				WithExpectedDepositChainID(1),
+			},
+			err: "deposit chain ID 17000 does not match expected 1\nnetwork mismatch",
+		},
+		{
+			name:    "MismatchDelayedStart",
+			gvr:     mainnetGVR,
+			chainID: "17000",
+			params: []Parameter{
+				WithExpectedDepositChainID(1),
+				WithAllowDelayedStart(true),
+			},
+			err: "deposit chain ID 17000 does not match expected 1\nnetwork mismatch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := networkTestServer(t, test.gvr, test.chainID)
+			defer server.Close()
+
+			params := append([]Parameter{
+				WithAddress(server.URL),
+				WithTimeout(time.Second),
+				WithLogLevel(zerolog.Disabled),
+			}, test.params...)
+			service, err := New(ctx, params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, client.ErrNetworkMismatch)
+			} else {
+				require.NoError(t, err)
+				require.True(t, service.(*Service).IsActive())
+			}
+		})
+	}
+}
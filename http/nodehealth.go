@@ -0,0 +1,58 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	// Carry this out without a connection check, as it is used to find out
+	// the state of the node.
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	endpoint := "/eth/v1/node/health"
+	httpResponse, err := s.get(ctx, endpoint, "", &opts.Common, false)
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusServiceUnavailable {
+			// The node is reporting its health rather than failing.
+			return &api.Response[apiv1.NodeHealth]{
+				Data:     apiv1.NodeHealthNotInitialized,
+				Metadata: make(map[string]any),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	return &api.Response[apiv1.NodeHealth]{
+		Data:     apiv1.NodeHealthFromStatusCode(httpResponse.statusCode),
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeHealth(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		expected   apiv1.NodeHealth
+		err        string
+	}{
+		{
+			name:       "Ready",
+			statusCode: http.StatusOK,
+			expected:   apiv1.NodeHealthReady,
+		},
+		{
+			name:       "Syncing",
+			statusCode: http.StatusPartialContent,
+			expected:   apiv1.NodeHealthSyncing,
+		},
+		{
+			name:       "NotInitialized",
+			statusCode: http.StatusServiceUnavailable,
+			expected:   apiv1.NodeHealthNotInitialized,
+		},
+		{
+			name:       "BadRequest",
+			statusCode: http.StatusBadRequest,
+			err:        "GET failed with status 400: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/node/health", r.URL.Path)
+				w.WriteHeader(test.statusCode)
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:     zerolog.Nop(),
+				base:    base,
+				address: server.URL,
+				client:  server.Client(),
+				timeout: time.Second,
+			}
+
+			_, err = s.NodeHealth(ctx, nil)
+			require.EqualError(t, err, "no options specified")
+
+			response, err := s.NodeHealth(ctx, &api.NodeHealthOpts{})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, response.Data)
+			}
+		})
+	}
+}
@@ -15,14 +15,19 @@ package http
 
 import (
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/clock"
 	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
+	logger             *slog.Logger
 	logLevel           zerolog.Level
 	monitor            metrics.Service
 	address            string
@@ -30,12 +35,26 @@ type parameters struct {
 	indexChunkSize     int
 	pubKeyChunkSize    int
 	extraHeaders       map[string]string
+	userAgent          string
+	requestIDHeader    string
 	enforceJSON        bool
 	allowDelayedStart  bool
 	hooks              *Hooks
 	reducedMemoryUsage bool
 	customSpecSupport  bool
+	specOverrides      map[string]string
+	maxResponseSize    int64
+	expectedGVR        *phase0.Root
+	expectedChainID    *uint64
 	client             *http.Client
+	staticValuesPeriod time.Duration
+	rateLimit          *rateLimit
+	endpointRateLimits map[string]*rateLimit
+	circuitBreakers    map[string]*circuitBreakerConfig
+	compressThreshold  int64
+	compressEncodings  []string
+	retries            int
+	clock              clock.Clock
 }
 
 // Parameter is the interface for service parameters.
@@ -49,6 +68,15 @@ func (f parameterFunc) apply(p *parameters) {
 	f(p)
 }
 
+// WithLogger sets a structured logger to which the module's log output is
+// sent, in place of the default zerolog logger.  The log level set by
+// WithLogLevel continues to apply.
+func WithLogger(logger *slog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+	})
+}
+
 // WithLogLevel sets the log level for the module.
 func WithLogLevel(logLevel zerolog.Level) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -98,6 +126,25 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithUserAgent sets the User-Agent header sent with each HTTP request.
+// A User-Agent supplied in extra headers takes precedence over this value.
+func WithUserAgent(userAgent string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.userAgent = userAgent
+	})
+}
+
+// WithRequestIDHeader sets the name of the header used to send the request ID with each HTTP request.
+// Request IDs are generated for each call unless supplied in the call's common options, and are
+// returned in errors and traces to allow correlation with server-side logs.
+// If the name is empty then request IDs are not sent to the server.
+// Defaults to "X-Request-ID".
+func WithRequestIDHeader(header string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requestIDHeader = header
+	})
+}
+
 // WithEnforceJSON forces all requests and responses to be in JSON, not sending or requesting SSZ.
 func WithEnforceJSON(enforceJSON bool) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -129,6 +176,8 @@ func WithReducedMemoryUsage(reducedMemoryUsage bool) Parameter {
 
 // WithCustomSpecSupport switches from the built in static SSZ library to a new dynamic SSZ library, which is able to handle
 // non-mainnet presets.
+// The dynamic SSZ library is used regardless of this setting if the beacon node's spec has limits that differ from mainnet,
+// for example on minimal preset devnets.  This setting forces its use even when the limits match mainnet.
 // Dynamic SSZ en-/decoding is much slower than the static one, so this should only be used if required.
 func WithCustomSpecSupport(customSpecSupport bool) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -136,6 +185,53 @@ func WithCustomSpecSupport(customSpecSupport bool) Parameter {
 	})
 }
 
+// WithSpecOverrides sets values that replace those in the spec returned by the
+// beacon node, for example to set SECONDS_PER_SLOT to "5" for Gnosis chain or
+// custom fork versions for a private testnet.  Values are supplied in the same
+// form as the beacon node's spec endpoint.  Overrides apply to all users of the
+// spec, including slot timing, the fork schedule and SSZ limits.
+func WithSpecOverrides(overrides map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specOverrides = overrides
+	})
+}
+
+// WithMaxResponseSize sets the maximum size in bytes of a response body from
+// the beacon node, after any decompression.  Responses over this size fail
+// with an api.ResponseTooLargeError rather than being read in to memory.  The
+// limit can be changed for individual calls with CommonOpts.MaxResponseSize.
+// As the limit applies after decompression it also guards against small
+// compressed responses that expand to exhaust memory.  A value of 0, the
+// default, places no limit on responses, compressed or otherwise; note that
+// beacon states can be hundreds of megabytes in size.
+func WithMaxResponseSize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseSize = size
+	})
+}
+
+// WithExpectedGenesisValidatorsRoot sets the genesis validators root of the
+// network to which the beacon node is expected to belong.  The node's genesis
+// validators root is checked each time the node becomes active, and the node
+// is treated as inactive if it does not match.  If the node does not match
+// when the service is created then the service is not created, regardless of
+// WithAllowDelayedStart.
+func WithExpectedGenesisValidatorsRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.expectedGVR = &root
+	})
+}
+
+// WithExpectedDepositChainID sets the chain ID of the deposit contract of the
+// network to which the beacon node is expected to belong.  It is checked in
+// the same way as the genesis validators root set by
+// WithExpectedGenesisValidatorsRoot.
+func WithExpectedDepositChainID(chainID uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.expectedChainID = &chainID
+	})
+}
+
 // WithHTTPClient provides a custom HTTP client for communication with the HTTP server.
 // If not supplied then a standard HTTP client is used.
 func WithHTTPClient(client *http.Client) Parameter {
@@ -144,16 +240,118 @@ func WithHTTPClient(client *http.Client) Parameter {
 	})
 }
 
+// WithStaticValuesRefreshInterval sets the interval after which values that
+// are static for the lifetime of a beacon node, such as genesis and spec, are
+// refetched.  Refetches are conditional on the values having changed if the
+// beacon node supplies entity tags.  A value of 0 disables periodic refetching.
+func WithStaticValuesRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.staticValuesPeriod = interval
+	})
+}
+
+// WithRateLimit sets the maximum average number of requests per second made
+// to the beacon node, allowing bursts of up to the given number of requests.
+// Requests over the limit are queued until they are permitted or their
+// context is canceled.
+func WithRateLimit(rate float64, burst int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rateLimit = &rateLimit{
+			rate:  rate,
+			burst: burst,
+		}
+	})
+}
+
+// WithEndpointClassRateLimit sets the maximum average number of requests per
+// second made to a class of endpoints, allowing bursts of up to the given
+// number of requests.  The class is the namespace of the endpoint, for
+// example "beacon", "debug", "node" or "validator".  This applies in addition
+// to any limit set by WithRateLimit.
+func WithEndpointClassRateLimit(class string, rate float64, burst int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.endpointRateLimits[class] = &rateLimit{
+			rate:  rate,
+			burst: burst,
+		}
+	})
+}
+
+// WithEndpointClassCircuitBreaker sets a circuit breaker for a class of
+// endpoints.  The breaker opens after the given number of consecutive
+// failures, refusing requests to the class with ErrCircuitOpen.  Once the open
+// duration has passed a single probe request is permitted; if it succeeds the
+// breaker closes, otherwise it opens again.  Changes of state are passed to
+// the OnCircuitStateChange hook.
+func WithEndpointClassCircuitBreaker(class string, failures int, openDuration time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.circuitBreakers[class] = &circuitBreakerConfig{
+			failures:     failures,
+			openDuration: openDuration,
+		}
+	})
+}
+
+// WithRequestCompression compresses request bodies of at least the given
+// number of bytes, such as large batches of validator registrations or
+// attestations.  Bodies are only compressed once the node is known to
+// support an encoding, either because it has advertised the encoding in the
+// Accept-Encoding header of a response or because it was supplied with
+// WithRequestCompressionEncodings.  Bodies are compressed with gzip, or
+// deflate if that is the only supported encoding.  If the node rejects an
+// encoding with 415 Unsupported Media Type then the request is resent with
+// the next encoding or uncompressed, and the rejected encoding is not used
+// again.  A value of 0, the default, disables request compression.
+func WithRequestCompression(threshold int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.compressThreshold = threshold
+	})
+}
+
+// WithRequestCompressionEncodings sets the encodings that the node is known
+// to accept for request bodies, allowing request compression to start
+// without waiting for the node to advertise them.
+func WithRequestCompressionEncodings(encodings []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.compressEncodings = encodings
+	})
+}
+
+// WithRetries sets the number of times that a GET request is retried if it
+// fails with an error that may be transient, such as a connection failure or
+// a 5xx or 429 status code.  Retries back off exponentially, and stop early if
+// the request's context is done.  Defaults to 0, which disables retries.
+func WithRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retries = retries
+	})
+}
+
+// WithClock sets the clock used by rate limiters, circuit breakers and
+// retry backoffs.
+// Defaults to the system clock.
+func WithClock(clock clock.Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:          zerolog.GlobalLevel(),
-		timeout:           2 * time.Second,
-		indexChunkSize:    -1,
-		pubKeyChunkSize:   -1,
-		extraHeaders:      make(map[string]string),
-		allowDelayedStart: false,
-		hooks:             &Hooks{},
+		logLevel:           zerolog.GlobalLevel(),
+		timeout:            2 * time.Second,
+		indexChunkSize:     -1,
+		pubKeyChunkSize:    -1,
+		extraHeaders:       make(map[string]string),
+		userAgent:          defaultUserAgent,
+		requestIDHeader:    "X-Request-ID",
+		allowDelayedStart:  false,
+		hooks:              &Hooks{},
+		staticValuesPeriod: 5 * time.Minute,
+		endpointRateLimits: make(map[string]*rateLimit),
+		circuitBreakers:    make(map[string]*circuitBreakerConfig),
+		clock:              clock.System(),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -173,9 +371,42 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.userAgent == "" {
+		return nil, errors.New("no user agent specified")
+	}
 	if parameters.hooks == nil {
 		return nil, errors.New("no hooks specified")
 	}
+	if parameters.maxResponseSize < 0 {
+		return nil, errors.New("max response size cannot be negative")
+	}
+	if parameters.compressThreshold < 0 {
+		return nil, errors.New("request compression threshold cannot be negative")
+	}
+	if parameters.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+	if parameters.retries < 0 {
+		return nil, errors.New("retries cannot be negative")
+	}
+	if parameters.staticValuesPeriod < 0 {
+		return nil, errors.New("static values refresh interval cannot be negative")
+	}
+	if parameters.rateLimit != nil {
+		if err := checkRateLimit(parameters.rateLimit); err != nil {
+			return nil, errors.Join(errors.New("invalid rate limit"), err)
+		}
+	}
+	for class, limit := range parameters.endpointRateLimits {
+		if err := checkRateLimit(limit); err != nil {
+			return nil, errors.Join(fmt.Errorf("invalid rate limit for endpoint class %s", class), err)
+		}
+	}
+	for class, config := range parameters.circuitBreakers {
+		if err := checkCircuitBreaker(config); err != nil {
+			return nil, errors.Join(fmt.Errorf("invalid circuit breaker for endpoint class %s", class), err)
+		}
+	}
 
 	return &parameters, nil
 }
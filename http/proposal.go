@@ -33,7 +33,6 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	dynssz "github.com/pk910/dynamic-ssz"
 	"go.opentelemetry.io/otel"
 )
 
@@ -57,24 +56,61 @@ func (s *Service) Proposal(ctx context.Context,
 		return nil, errors.Join(errors.New("no slot specified"), client.ErrInvalidOptions)
 	}
 
-	endpoint := fmt.Sprintf("/eth/v3/validator/blocks/%d", opts.Slot)
-	query := fmt.Sprintf("randao_reveal=%#x&graffiti=%#x", opts.RandaoReveal, opts.Graffiti)
+	if opts.SkipRandaoVerification && !opts.RandaoReveal.IsInfinity() {
+		return nil, errors.Join(
+			errors.New("randao reveal must be point at infinity if skip randao verification is set"),
+			client.ErrInvalidOptions,
+		)
+	}
 
-	if opts.SkipRandaoVerification {
-		if !opts.RandaoReveal.IsInfinity() {
+	fetch := proposalFetcher(s.proposal)
+	if opts.HedgeAfter > 0 {
+		fetch = func(ctx context.Context,
+			opts *api.ProposalOpts,
+			builderBoostFactor uint64,
+		) (
+			*api.Response[*api.VersionedProposal],
+			error,
+		) {
+			return hedged(ctx, s.clock, opts.HedgeAfter, func(ctx context.Context) (*api.Response[*api.VersionedProposal], error) {
+				return s.proposal(ctx, opts, builderBoostFactor)
+			})
+		}
+	}
+
+	if opts.FallbackPolicy != api.ProposalFallbackPolicyNone {
+		if opts.BuilderBoostFactor != nil {
 			return nil, errors.Join(
-				errors.New("randao reveal must be point at infinity if skip randao verification is set"),
+				errors.New("builder boost factor cannot be specified with a fallback policy"),
 				client.ErrInvalidOptions,
 			)
 		}
-		query = fmt.Sprintf("%s&skip_randao_verification", query)
+
+		return proposalWithFallbackPolicy(ctx, opts, fetch)
 	}
 
-	if opts.BuilderBoostFactor == nil {
-		query += "&builder_boost_factor=100"
-	} else {
-		query = fmt.Sprintf("%s&builder_boost_factor=%d", query, *opts.BuilderBoostFactor)
+	builderBoostFactor := uint64(100)
+	if opts.BuilderBoostFactor != nil {
+		builderBoostFactor = *opts.BuilderBoostFactor
+	}
+
+	return fetch(ctx, opts, builderBoostFactor)
+}
+
+// proposal fetches a potential beacon block for signing with the given builder boost factor.
+func (s *Service) proposal(ctx context.Context,
+	opts *api.ProposalOpts,
+	builderBoostFactor uint64,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+) {
+	endpoint := fmt.Sprintf("/eth/v3/validator/blocks/%d", opts.Slot)
+	query := fmt.Sprintf("randao_reveal=%#x&graffiti=%#x", opts.RandaoReveal, opts.Graffiti)
+	if opts.SkipRandaoVerification {
+		query = fmt.Sprintf("%s&skip_randao_verification", query)
 	}
+	query = fmt.Sprintf("%s&builder_boost_factor=%d", query, builderBoostFactor)
 
 	httpResponse, err := s.get(ctx, endpoint, query, &opts.Common, true)
 	if err != nil {
@@ -144,28 +180,22 @@ func (s *Service) beaconBlockProposalFromSSZ(ctx context.Context,
 		return nil, err
 	}
 
-	var dynSSZ *dynssz.DynSsz
-	if s.customSpecSupport {
-		specs, err := s.Spec(ctx, &api.SpecOpts{})
-		if err != nil {
-			return nil, errors.Join(errors.New("failed to request specs"), err)
-		}
-
-		dynSSZ = dynssz.NewDynSsz(specs.Data)
+	dynSSZ, err := s.dynamicSSZ(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var err error
 	switch res.consensusVersion {
 	case spec.DataVersionPhase0:
 		response.Data.Phase0 = &phase0.BeaconBlock{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Phase0, res.body)
 		} else {
 			err = response.Data.Phase0.UnmarshalSSZ(res.body)
 		}
 	case spec.DataVersionAltair:
 		response.Data.Altair = &altair.BeaconBlock{}
-		if s.customSpecSupport {
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Altair, res.body)
 		} else {
 			err = response.Data.Altair.UnmarshalSSZ(res.body)
@@ -173,14 +203,14 @@ func (s *Service) beaconBlockProposalFromSSZ(ctx context.Context,
 	case spec.DataVersionBellatrix:
 		if response.Data.Blinded {
 			response.Data.BellatrixBlinded = &apiv1bellatrix.BlindedBeaconBlock{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.BellatrixBlinded, res.body)
 			} else {
 				err = response.Data.BellatrixBlinded.UnmarshalSSZ(res.body)
 			}
 		} else {
 			response.Data.Bellatrix = &bellatrix.BeaconBlock{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.Bellatrix, res.body)
 			} else {
 				err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
@@ -189,14 +219,14 @@ func (s *Service) beaconBlockProposalFromSSZ(ctx context.Context,
 	case spec.DataVersionCapella:
 		if response.Data.Blinded {
 			response.Data.CapellaBlinded = &apiv1capella.BlindedBeaconBlock{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.CapellaBlinded, res.body)
 			} else {
 				err = response.Data.CapellaBlinded.UnmarshalSSZ(res.body)
 			}
 		} else {
 			response.Data.Capella = &capella.BeaconBlock{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.Capella, res.body)
 			} else {
 				err = response.Data.Capella.UnmarshalSSZ(res.body)
@@ -205,14 +235,14 @@ func (s *Service) beaconBlockProposalFromSSZ(ctx context.Context,
 	case spec.DataVersionDeneb:
 		if response.Data.Blinded {
 			response.Data.DenebBlinded = &apiv1deneb.BlindedBeaconBlock{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.DenebBlinded, res.body)
 			} else {
 				err = response.Data.DenebBlinded.UnmarshalSSZ(res.body)
 			}
 		} else {
 			response.Data.Deneb = &apiv1deneb.BlockContents{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.Deneb, res.body)
 			} else {
 				err = response.Data.Deneb.UnmarshalSSZ(res.body)
@@ -221,14 +251,14 @@ func (s *Service) beaconBlockProposalFromSSZ(ctx context.Context,
 	case spec.DataVersionElectra:
 		if response.Data.Blinded {
 			response.Data.ElectraBlinded = &apiv1electra.BlindedBeaconBlock{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.ElectraBlinded, res.body)
 			} else {
 				err = response.Data.ElectraBlinded.UnmarshalSSZ(res.body)
 			}
 		} else {
 			response.Data.Electra = &apiv1electra.BlockContents{}
-			if s.customSpecSupport {
+			if dynSSZ != nil {
 				err = dynSSZ.UnmarshalSSZ(response.Data.Electra, res.body)
 			} else {
 				err = response.Data.Electra.UnmarshalSSZ(res.body)
@@ -342,17 +372,17 @@ func (*Service) populateProposalDataFromHeaders(response *api.Response[*api.Vers
 		case strings.EqualFold(k, "Eth-Execution-Payload-Blinded"):
 			response.Data.Blinded = strings.EqualFold(v, "true")
 		case strings.EqualFold(k, "Eth-Execution-Payload-Value"):
-			var success bool
-			response.Data.ExecutionValue, success = new(big.Int).SetString(v, 10)
-			if !success {
-				return fmt.Errorf("proposal header Eth-Execution-Payload-Value %s not a valid integer", v)
+			value, err := phase0.WeiFromString(v)
+			if err != nil {
+				return errors.Join(fmt.Errorf("proposal header Eth-Execution-Payload-Value %s not a valid value", v), err)
 			}
+			response.Data.ExecutionValue = value.Big()
 		case strings.EqualFold(k, "Eth-Consensus-Block-Value"):
-			var success bool
-			response.Data.ConsensusValue, success = new(big.Int).SetString(v, 10)
-			if !success {
-				return fmt.Errorf("proposal header Eth-Consensus-Block-Value %s not a valid integer", v)
+			value, err := phase0.WeiFromString(v)
+			if err != nil {
+				return errors.Join(fmt.Errorf("proposal header Eth-Consensus-Block-Value %s not a valid value", v), err)
 			}
+			response.Data.ConsensusValue = value.Big()
 		}
 	}
 
@@ -0,0 +1,110 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+const (
+	// alwaysBuilderBoostFactor is the builder boost factor requesting that
+	// a builder payload is used whenever one is available.
+	alwaysBuilderBoostFactor = uint64(math.MaxUint64)
+	// neverBuilderBoostFactor is the builder boost factor requesting that a
+	// locally-produced payload is always used.
+	neverBuilderBoostFactor = uint64(0)
+)
+
+type proposalFetcher func(ctx context.Context,
+	opts *api.ProposalOpts,
+	builderBoostFactor uint64,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+)
+
+// proposalWithFallbackPolicy obtains a proposal that meets the fallback policy in the options.
+func proposalWithFallbackPolicy(ctx context.Context,
+	opts *api.ProposalOpts,
+	fetch proposalFetcher,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+) {
+	switch opts.FallbackPolicy {
+	case api.ProposalFallbackPolicyBuilderOnly:
+		response, err := fetch(ctx, opts, alwaysBuilderBoostFactor)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Data.Blinded {
+			return nil, errors.Join(errors.New("beacon node did not provide a builder payload"), client.ErrProposalPolicyUnsatisfied)
+		}
+
+		return response, nil
+	case api.ProposalFallbackPolicyLocalOnly:
+		return localProposal(ctx, opts, fetch)
+	case api.ProposalFallbackPolicyPreferBuilderAboveValue:
+		if opts.MinBuilderValue == nil || opts.MinBuilderValue.Sign() < 0 {
+			return nil, errors.Join(errors.New("no valid minimum builder value specified"), client.ErrInvalidOptions)
+		}
+
+		response, err := fetch(ctx, opts, alwaysBuilderBoostFactor)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Data.Blinded {
+			// No builder payload available, so the local payload is the only option.
+			return response, nil
+		}
+		value := response.Data.ExecutionValue
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		if value.Cmp(opts.MinBuilderValue) >= 0 {
+			return response, nil
+		}
+
+		// Builder payload is below the minimum value; fetch a local payload instead.
+		return localProposal(ctx, opts, fetch)
+	default:
+		return nil, errors.Join(fmt.Errorf("unsupported fallback policy %v", opts.FallbackPolicy), client.ErrInvalidOptions)
+	}
+}
+
+// localProposal obtains a proposal with a locally-produced payload.
+func localProposal(ctx context.Context,
+	opts *api.ProposalOpts,
+	fetch proposalFetcher,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+) {
+	response, err := fetch(ctx, opts, neverBuilderBoostFactor)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Blinded {
+		return nil, errors.Join(errors.New("beacon node did not provide a local payload"), client.ErrProposalPolicyUnsatisfied)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,188 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+// testProposalFetcher returns a builder payload with the given value if one
+// is available and the builder boost factor is not 0, otherwise a local payload.
+func testProposalFetcher(builderAvailable bool, builderValue int64, alwaysBlinded bool, boostFactors *[]uint64) proposalFetcher {
+	return func(_ context.Context, _ *api.ProposalOpts, builderBoostFactor uint64) (*api.Response[*api.VersionedProposal], error) {
+		*boostFactors = append(*boostFactors, builderBoostFactor)
+		if alwaysBlinded || (builderAvailable && builderBoostFactor != 0) {
+			return &api.Response[*api.VersionedProposal]{
+				Data: &api.VersionedProposal{
+					Blinded:        true,
+					ExecutionValue: big.NewInt(builderValue),
+				},
+			}, nil
+		}
+
+		return &api.Response[*api.VersionedProposal]{
+			Data: &api.VersionedProposal{
+				ExecutionValue: big.NewInt(1),
+			},
+		}, nil
+	}
+}
+
+func TestProposalWithFallbackPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name             string
+		opts             *api.ProposalOpts
+		builderAvailable bool
+		builderValue     int64
+		alwaysBlinded    bool
+		fetchErr         bool
+		blinded          bool
+		boostFactors     []uint64
+		err              error
+	}{
+		{
+			name: "UnknownPolicy",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicy(99),
+			},
+			boostFactors: []uint64{},
+			err:          client.ErrInvalidOptions,
+		},
+		{
+			name: "BuilderOnly",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicyBuilderOnly,
+			},
+			builderAvailable: true,
+			blinded:          true,
+			boostFactors:     []uint64{alwaysBuilderBoostFactor},
+		},
+		{
+			name: "BuilderOnlyUnavailable",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicyBuilderOnly,
+			},
+			boostFactors: []uint64{alwaysBuilderBoostFactor},
+			err:          client.ErrProposalPolicyUnsatisfied,
+		},
+		{
+			name: "BuilderOnlyFetchError",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicyBuilderOnly,
+			},
+			fetchErr:     true,
+			boostFactors: []uint64{alwaysBuilderBoostFactor},
+		},
+		{
+			name: "LocalOnly",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicyLocalOnly,
+			},
+			builderAvailable: true,
+			boostFactors:     []uint64{neverBuilderBoostFactor},
+		},
+		{
+			name: "LocalOnlyIgnored",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicyLocalOnly,
+			},
+			alwaysBlinded: true,
+			boostFactors:  []uint64{neverBuilderBoostFactor},
+			err:           client.ErrProposalPolicyUnsatisfied,
+		},
+		{
+			name: "PreferBuilderMinValueMissing",
+			opts: &api.ProposalOpts{
+				FallbackPolicy: api.ProposalFallbackPolicyPreferBuilderAboveValue,
+			},
+			boostFactors: []uint64{},
+			err:          client.ErrInvalidOptions,
+		},
+		{
+			name: "PreferBuilderAboveValue",
+			opts: &api.ProposalOpts{
+				FallbackPolicy:  api.ProposalFallbackPolicyPreferBuilderAboveValue,
+				MinBuilderValue: big.NewInt(1000),
+			},
+			builderAvailable: true,
+			builderValue:     1000,
+			blinded:          true,
+			boostFactors:     []uint64{alwaysBuilderBoostFactor},
+		},
+		{
+			name: "PreferBuilderBelowValue",
+			opts: &api.ProposalOpts{
+				FallbackPolicy:  api.ProposalFallbackPolicyPreferBuilderAboveValue,
+				MinBuilderValue: big.NewInt(1000),
+			},
+			builderAvailable: true,
+			builderValue:     999,
+			boostFactors:     []uint64{alwaysBuilderBoostFactor, neverBuilderBoostFactor},
+		},
+		{
+			name: "PreferBuilderUnavailable",
+			opts: &api.ProposalOpts{
+				FallbackPolicy:  api.ProposalFallbackPolicyPreferBuilderAboveValue,
+				MinBuilderValue: big.NewInt(1000),
+			},
+			boostFactors: []uint64{alwaysBuilderBoostFactor},
+		},
+		{
+			name: "PreferBuilderBelowValueLocalUnavailable",
+			opts: &api.ProposalOpts{
+				FallbackPolicy:  api.ProposalFallbackPolicyPreferBuilderAboveValue,
+				MinBuilderValue: big.NewInt(1000),
+			},
+			alwaysBlinded: true,
+			builderValue:  999,
+			boostFactors:  []uint64{alwaysBuilderBoostFactor, neverBuilderBoostFactor},
+			err:           client.ErrProposalPolicyUnsatisfied,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			boostFactors := make([]uint64, 0)
+			fetch := testProposalFetcher(test.builderAvailable, test.builderValue, test.alwaysBlinded, &boostFactors)
+			if test.fetchErr {
+				fetch = func(_ context.Context, _ *api.ProposalOpts, builderBoostFactor uint64) (*api.Response[*api.VersionedProposal], error) {
+					boostFactors = append(boostFactors, builderBoostFactor)
+
+					return nil, errors.New("fetch failed")
+				}
+			}
+
+			response, err := proposalWithFallbackPolicy(ctx, test.opts, fetch)
+			require.Equal(t, test.boostFactors, boostFactors)
+			switch {
+			case test.fetchErr:
+				require.EqualError(t, err, "fetch failed")
+			case test.err != nil:
+				require.ErrorIs(t, err, test.err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, test.blinded, response.Data.Blinded)
+			}
+		})
+	}
+}
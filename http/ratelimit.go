@@ -0,0 +1,135 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+)
+
+// rateLimit is the configuration for a rate limiter.
+type rateLimit struct {
+	rate  float64
+	burst int
+}
+
+// rateLimiter is a token bucket rate limiter.  Callers that cannot obtain a
+// token immediately are queued in order of arrival.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	clock  clock.Clock
+}
+
+// newRateLimiter creates a new rate limiter that allows rate requests per
+// second on average, with bursts of up to burst requests.
+func newRateLimiter(limit *rateLimit, clock clock.Clock) *rateLimiter {
+	return &rateLimiter{
+		rate:   limit.rate,
+		burst:  float64(limit.burst),
+		tokens: float64(limit.burst),
+		last:   clock.Now(),
+		clock:  clock,
+	}
+}
+
+// wait waits until a request is permitted, returning true if it had to wait.
+func (l *rateLimiter) wait(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	now := l.clock.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+
+		return false, nil
+	}
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	timer := l.clock.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// Return our token so that it can be used by others.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return true, ctx.Err()
+	case <-timer.C():
+		return true, nil
+	}
+}
+
+// endpointClass returns the class of an endpoint, for example "validator"
+// for /eth/v1/validator/duties/proposer/1.
+func endpointClass(endpoint string) string {
+	parts := strings.SplitN(strings.TrimPrefix(endpoint, "/"), "/", 4)
+	if len(parts) < 3 {
+		return ""
+	}
+
+	return parts[2]
+}
+
+// throttle waits until the rate limiters permit a request to the endpoint.
+func (s *Service) throttle(ctx context.Context, endpoint string) error {
+	if s.rateLimiter != nil {
+		waited, err := s.rateLimiter.wait(ctx)
+		if waited {
+			s.monitorThrottled("global")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	class := endpointClass(endpoint)
+	if limiter, exists := s.endpointClassRateLimiters[class]; exists {
+		waited, err := limiter.wait(ctx)
+		if waited {
+			s.monitorThrottled(class)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRateLimit checks that a rate limit is valid.
+func checkRateLimit(limit *rateLimit) error {
+	if limit.rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if limit.burst < 1 {
+		return errors.New("burst must be at least 1")
+	}
+
+	return nil
+}
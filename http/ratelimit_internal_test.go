@@ -0,0 +1,90 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointClass(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		class    string
+	}{
+		{endpoint: "", class: ""},
+		{endpoint: "/eth/v1", class: ""},
+		{endpoint: "/eth/v1/node/syncing", class: "node"},
+		{endpoint: "/eth/v1/validator/duties/proposer/1", class: "validator"},
+		{endpoint: "/eth/v2/debug/beacon/states/head", class: "debug"},
+		{endpoint: "/eth/v1/beacon/genesis", class: "beacon"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			require.Equal(t, test.class, endpointClass(test.endpoint))
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	virtualClock := clock.NewVirtual(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	limiter := newRateLimiter(&rateLimit{rate: 20, burst: 2}, virtualClock)
+
+	// Burst is permitted immediately.
+	for i := 0; i < 2; i++ {
+		waited, err := limiter.wait(ctx)
+		require.NoError(t, err)
+		require.False(t, waited)
+	}
+
+	// Next request waits for a token, which is available after 50ms.
+	done := make(chan error)
+	go func() {
+		waited, err := limiter.wait(ctx)
+		if err == nil && !waited {
+			err = errors.New("did not wait")
+		}
+		done <- err
+	}()
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 1))
+	virtualClock.Advance(40 * time.Millisecond)
+	select {
+	case err := <-done:
+		require.FailNow(t, "wait returned early", err)
+	default:
+	}
+	virtualClock.Advance(10 * time.Millisecond)
+	require.NoError(t, <-done)
+
+	// Canceled context returns immediately.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	waited, err := limiter.wait(canceledCtx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, waited)
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	require.EqualError(t, checkRateLimit(&rateLimit{rate: 0, burst: 1}), "rate must be positive")
+	require.EqualError(t, checkRateLimit(&rateLimit{rate: 1, burst: 0}), "burst must be at least 1")
+	require.NoError(t, checkRateLimit(&rateLimit{rate: 0.5, burst: 1}))
+}
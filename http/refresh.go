@@ -0,0 +1,81 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Refresh discards values that are cached for the lifetime of a beacon node,
+// such as genesis, spec and fork schedule, and refetches them.  This allows
+// long-running processes to pick up changes if the node is known to have been
+// reconfigured.
+func (s *Service) Refresh(ctx context.Context) error {
+	if err := s.assertIsActive(ctx); err != nil {
+		return err
+	}
+
+	s.clearStaticValues()
+
+	if _, err := s.Genesis(ctx, &api.GenesisOpts{}); err != nil {
+		return errors.Join(errors.New("failed to refresh genesis"), err)
+	}
+	if _, err := s.Spec(ctx, &api.SpecOpts{}); err != nil {
+		return errors.Join(errors.New("failed to refresh spec"), err)
+	}
+	if _, err := s.ForkSchedule(ctx, &api.ForkScheduleOpts{}); err != nil {
+		return errors.Join(errors.New("failed to refresh fork schedule"), err)
+	}
+	if _, err := s.DepositContract(ctx, &api.DepositContractOpts{}); err != nil {
+		return errors.Join(errors.New("failed to refresh deposit contract"), err)
+	}
+	if err := s.checkDVT(ctx); err != nil {
+		return errors.Join(errors.New("failed to refresh node version"), err)
+	}
+
+	return nil
+}
+
+// checkStaticValues confirms that the node is still on the chain for which
+// static values have been cached, clearing them if not.
+func (s *Service) checkStaticValues(ctx context.Context) {
+	s.genesisMutex.RLock()
+	genesis := s.genesis
+	s.genesisMutex.RUnlock()
+	if genesis == nil {
+		// Nothing cached, so nothing to check.
+		return
+	}
+
+	current, err := s.fetchGenesis(ctx, &api.CommonOpts{})
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain genesis to check static values")
+
+		return
+	}
+
+	if current.GenesisValidatorsRoot == genesis.GenesisValidatorsRoot &&
+		current.GenesisTime.Equal(genesis.GenesisTime) {
+		return
+	}
+
+	s.log.Warn().
+		Stringer("old_genesis_validators_root", genesis.GenesisValidatorsRoot).
+		Stringer("new_genesis_validators_root", current.GenesisValidatorsRoot).
+		Msg("Node genesis has changed; clearing cached values")
+	s.clearStaticValues()
+}
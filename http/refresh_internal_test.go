@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestCheckStaticValues(t *testing.T) {
+	ctx := context.Background()
+
+	var genesisRoot atomic.Value
+	genesisRoot.Store("0x0000000000000000000000000000000000000000000000000000000000000001")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/beacon/genesis" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":{"genesis_time":"1606824023","genesis_validators_root":"%s","genesis_fork_version":"0x00000000"}}`,
+			genesisRoot.Load())
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+	}
+
+	// Nothing cached, so nothing to check.
+	s.checkStaticValues(ctx)
+	require.Nil(t, s.genesis)
+
+	genesis, err := s.Genesis(ctx, &api.GenesisOpts{})
+	require.NoError(t, err)
+	s.spec = map[string]any{"SLOTS_PER_EPOCH": uint64(32)}
+
+	// Unchanged genesis retains cached values.
+	s.checkStaticValues(ctx)
+	require.Equal(t, genesis.Data, s.genesis)
+	require.NotNil(t, s.spec)
+
+	// Changed genesis clears cached values.
+	genesisRoot.Store("0x0000000000000000000000000000000000000000000000000000000000000002")
+	s.checkStaticValues(ctx)
+	require.Nil(t, s.genesis)
+	require.Nil(t, s.spec)
+}
@@ -0,0 +1,206 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// requestEncodings are the encodings that can be used to compress request
+// bodies, in order of preference.
+var requestEncodings = []string{"gzip", "deflate"}
+
+// requestCompressor tracks the encodings that can be used to compress
+// request bodies sent to the beacon node.
+type requestCompressor struct {
+	threshold int64
+
+	mu sync.RWMutex
+	// advertised are the encodings the node is known to support, either
+	// from configuration or because the node has advertised them.
+	advertised map[string]bool
+	// rejected are the encodings the node has rejected.
+	rejected map[string]bool
+}
+
+// newRequestCompressor creates a compressor for request bodies of at least
+// the given size.  Encodings are known to be supported if they are supplied
+// here, otherwise once the node advertises them.
+func newRequestCompressor(threshold int64, encodings []string) *requestCompressor {
+	c := &requestCompressor{
+		threshold: threshold,
+		rejected:  make(map[string]bool),
+	}
+	if len(encodings) > 0 {
+		c.advertised = make(map[string]bool, len(encodings))
+		for _, encoding := range encodings {
+			c.advertised[strings.ToLower(encoding)] = true
+		}
+	}
+
+	return c
+}
+
+// encoding returns the encoding to use for a request body of the given size,
+// or an empty string if the body should not be compressed.  Bodies are not
+// compressed until the node is known to support an encoding.
+func (c *requestCompressor) encoding(size int64) string {
+	if size < c.threshold {
+		return ""
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, encoding := range requestEncodings {
+		if c.rejected[encoding] {
+			continue
+		}
+		if !c.advertised[encoding] {
+			continue
+		}
+
+		return encoding
+	}
+
+	return ""
+}
+
+// advertise records the encodings the node advertised in a response, if any.
+func (c *requestCompressor) advertise(acceptEncoding string) {
+	if acceptEncoding == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.advertised = parseAcceptEncoding(acceptEncoding)
+}
+
+// reject records that the node rejected an encoding, along with the
+// encodings it advertised in the rejection, if any.
+func (c *requestCompressor) reject(encoding string, acceptEncoding string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rejected[encoding] = true
+	if acceptEncoding != "" {
+		c.advertised = parseAcceptEncoding(acceptEncoding)
+	}
+}
+
+// parseAcceptEncoding parses the encodings in an Accept-Encoding header,
+// ignoring quality values.
+func parseAcceptEncoding(header string) map[string]bool {
+	encodings := make(map[string]bool)
+	for _, part := range strings.Split(header, ",") {
+		encoding, _, _ := strings.Cut(part, ";")
+		encoding = strings.ToLower(strings.TrimSpace(encoding))
+		if encoding != "" {
+			encodings[encoding] = true
+		}
+	}
+
+	return encodings
+}
+
+// compress compresses data with the given encoding.
+func compress(encoding string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch encoding {
+	case "gzip":
+		writer = gzip.NewWriter(&buf)
+	case "deflate":
+		writer = zlib.NewWriter(&buf)
+	default:
+		return nil, fmt.Errorf("unsupported encoding %s", encoding)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, errors.Join(errors.New("failed to compress data"), err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Join(errors.New("failed to compress data"), err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// doCompressed sends a request, compressing its body if request compression
+// is enabled and the node is known to support an encoding that it has not
+// rejected.  If the node rejects the encoding then the request is sent again
+// with another encoding, or uncompressed.
+func (s *Service) doCompressed(ctx context.Context, endpoint string, req *http.Request) (*http.Response, error) {
+	if s.requestCompressor == nil || req.GetBody == nil {
+		return s.do(ctx, endpoint, req)
+	}
+	encoding := s.requestCompressor.encoding(req.ContentLength)
+	if encoding == "" {
+		resp, err := s.do(ctx, endpoint, req)
+		if err != nil {
+			return nil, err
+		}
+		s.requestCompressor.advertise(resp.Header.Get("Accept-Encoding"))
+
+		return resp, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain request body"), err)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read request body"), err)
+	}
+	compressed, err := compress(encoding, data)
+	if err != nil {
+		return nil, err
+	}
+
+	compressedReq := req.Clone(req.Context())
+	compressedReq.Body = io.NopCloser(bytes.NewReader(compressed))
+	compressedReq.ContentLength = int64(len(compressed))
+	compressedReq.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	compressedReq.Header.Set("Content-Encoding", encoding)
+
+	resp, err := s.do(ctx, endpoint, compressedReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		s.requestCompressor.advertise(resp.Header.Get("Accept-Encoding"))
+
+		return resp, nil
+	}
+
+	// The node does not accept this encoding; try again without it.
+	resp.Body.Close()
+	s.log.Debug().Str("encoding", encoding).Msg("Node rejected request encoding")
+	s.requestCompressor.reject(encoding, resp.Header.Get("Accept-Encoding"))
+	retryReq := req.Clone(req.Context())
+	retryReq.Body = io.NopCloser(bytes.NewReader(data))
+
+	return s.doCompressed(ctx, endpoint, retryReq)
+}
@@ -0,0 +1,182 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestRequestCompression(t *testing.T) {
+	ctx := context.Background()
+
+	largeBody := `{"data":"` + strings.Repeat("a", 1024) + `"}`
+	smallBody := `{"data":"a"}`
+
+	tests := []struct {
+		name string
+		// known are the encodings configured as supported by the node.
+		known []string
+		// accepted are the encodings the server accepts.
+		accepted map[string]bool
+		// advertise is the Accept-Encoding header sent with responses.
+		advertise string
+		bodies    []string
+		encodings []string
+	}{
+		{
+			name:      "Small",
+			accepted:  map[string]bool{"gzip": true},
+			advertise: "gzip",
+			bodies:    []string{smallBody, smallBody},
+			encodings: []string{"", ""},
+		},
+		{
+			name:      "NotAdvertised",
+			accepted:  map[string]bool{"gzip": true},
+			bodies:    []string{largeBody, largeBody},
+			encodings: []string{"", ""},
+		},
+		{
+			name:      "Gzip",
+			accepted:  map[string]bool{"gzip": true},
+			advertise: "gzip, deflate",
+			bodies:    []string{largeBody, largeBody},
+			encodings: []string{"", "gzip"},
+		},
+		{
+			name:      "Deflate",
+			accepted:  map[string]bool{"deflate": true},
+			advertise: "deflate",
+			bodies:    []string{largeBody, largeBody},
+			encodings: []string{"", "deflate"},
+		},
+		{
+			name:      "Known",
+			known:     []string{"Deflate"},
+			accepted:  map[string]bool{"deflate": true},
+			bodies:    []string{largeBody, largeBody},
+			encodings: []string{"deflate", "deflate"},
+		},
+		{
+			name:      "Rejected",
+			accepted:  map[string]bool{"deflate": true},
+			advertise: "gzip, deflate",
+			bodies:    []string{largeBody, largeBody, largeBody},
+			encodings: []string{"", "gzip", "deflate", "deflate"},
+		},
+		{
+			name:      "RejectedAdvertisedNone",
+			known:     []string{"gzip"},
+			accepted:  map[string]bool{"deflate": true},
+			advertise: "identity",
+			bodies:    []string{largeBody, largeBody},
+			encodings: []string{"gzip", "", ""},
+		},
+		{
+			name:      "Unsupported",
+			accepted:  map[string]bool{},
+			advertise: "gzip, deflate",
+			bodies:    []string{largeBody, largeBody, largeBody},
+			encodings: []string{"", "gzip", "deflate", "", ""},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			encodings := make([]string, 0)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				encoding := r.Header.Get("Content-Encoding")
+				mu.Lock()
+				encodings = append(encodings, encoding)
+				mu.Unlock()
+
+				if test.advertise != "" {
+					w.Header().Set("Accept-Encoding", test.advertise)
+				}
+				var reader io.Reader = r.Body
+				switch {
+				case encoding == "":
+				case !test.accepted[encoding]:
+					w.WriteHeader(http.StatusUnsupportedMediaType)
+
+					return
+				case encoding == "gzip":
+					gzipReader, err := gzip.NewReader(r.Body)
+					require.NoError(t, err)
+					reader = gzipReader
+				case encoding == "deflate":
+					zlibReader, err := zlib.NewReader(r.Body)
+					require.NoError(t, err)
+					reader = zlibReader
+				}
+				data, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(data)
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:               zerolog.Nop(),
+				base:              base,
+				address:           server.URL,
+				client:            server.Client(),
+				timeout:           time.Second,
+				pingSem:           semaphore.NewWeighted(1),
+				hooks:             &Hooks{},
+				connectionActive:  true,
+				connectionSynced:  true,
+				requestCompressor: newRequestCompressor(512, test.known),
+			}
+
+			for _, body := range test.bodies {
+				resp, err := s.post(ctx,
+					"/eth/v1/test",
+					"",
+					&api.CommonOpts{},
+					bytes.NewReader([]byte(body)),
+					ContentTypeJSON,
+					map[string]string{},
+				)
+				require.NoError(t, err)
+				require.Equal(t, body, string(resp.body))
+			}
+			require.Equal(t, test.encodings, encodings)
+		})
+	}
+}
+
+func TestParseAcceptEncoding(t *testing.T) {
+	require.Equal(t, map[string]bool{"gzip": true, "deflate": true}, parseAcceptEncoding("gzip;q=1.0, Deflate"))
+	require.Equal(t, map[string]bool{}, parseAcceptEncoding(" , "))
+}
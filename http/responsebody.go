@@ -0,0 +1,76 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// readBody reads the body of a response, enforcing the maximum response size
+// and decompressing the body if required.  The limit applies to the body
+// after decompression, so also protects against small compressed responses
+// that expand to exhaust memory.
+func (s *Service) readBody(resp *http.Response, opts *api.CommonOpts, endpoint string) ([]byte, error) {
+	limit := s.maxResponseSize
+	if opts != nil && opts.MaxResponseSize > 0 {
+		limit = opts.MaxResponseSize
+	}
+
+	// The HTTP client transparently decompresses responses if it requested
+	// compression itself; otherwise we decompress here.
+	compressed := resp.Uncompressed
+	var body io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		reader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to decompress response"), err)
+		}
+		defer reader.Close()
+		body = reader
+		compressed = true
+	}
+
+	if !compressed && limit > 0 && resp.ContentLength > limit {
+		// No need to read the body to know that it is too large.
+		return nil, &api.ResponseTooLargeError{
+			Endpoint: endpoint,
+			Limit:    limit,
+		}
+	}
+
+	if limit == 0 {
+		return io.ReadAll(body)
+	}
+
+	// Read one byte over the limit to detect bodies that exceed it.
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, &api.ResponseTooLargeError{
+			Endpoint:   endpoint,
+			Limit:      limit,
+			Compressed: compressed,
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestResponseSizeLimits(t *testing.T) {
+	ctx := context.Background()
+
+	body := []byte(`{"data":{"value":"` + strings.Repeat("a", 1000) + `"}}`)
+	var compressedBody bytes.Buffer
+	writer := gzip.NewWriter(&compressedBody)
+	_, err := writer.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/compressed" {
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write(compressedBody.Bytes())
+
+			return
+		}
+		if r.URL.Path == "/chunked" {
+			// Flushing before writing the body prevents a content length being sent.
+			w.(http.Flusher).Flush()
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	tests := []struct {
+		name            string
+		endpoint        string
+		maxResponseSize int64
+		opts            *api.CommonOpts
+		err             string
+	}{
+		{
+			name:     "Unlimited",
+			endpoint: "/plain",
+			opts:     &api.CommonOpts{},
+		},
+		{
+			name:            "WithinLimit",
+			endpoint:        "/plain",
+			maxResponseSize: int64(len(body)),
+			opts:            &api.CommonOpts{},
+		},
+		{
+			name:            "OverLimit",
+			endpoint:        "/plain",
+			maxResponseSize: 1000,
+			opts:            &api.CommonOpts{},
+			err:             "response from /plain exceeds maximum size of 1000 bytes",
+		},
+		{
+			name:            "OverLimitChunked",
+			endpoint:        "/chunked",
+			maxResponseSize: 100,
+			opts:            &api.CommonOpts{},
+			err:             "response from /chunked exceeds maximum size of 100 bytes",
+		},
+		{
+			name:            "CallOverride",
+			endpoint:        "/plain",
+			maxResponseSize: 100,
+			opts:            &api.CommonOpts{MaxResponseSize: int64(len(body))},
+		},
+		{
+			name:     "CallLimit",
+			endpoint: "/plain",
+			opts:     &api.CommonOpts{MaxResponseSize: 100},
+			err:      "response from /plain exceeds maximum size of 100 bytes",
+		},
+		{
+			name:     "Compressed",
+			endpoint: "/compressed",
+			opts:     &api.CommonOpts{},
+		},
+		{
+			name:            "CompressedOverLimit",
+			endpoint:        "/compressed",
+			maxResponseSize: 100,
+			opts:            &api.CommonOpts{},
+			err:             "decompressed response from /compressed exceeds maximum size of 100 bytes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s.maxResponseSize = test.maxResponseSize
+			res, err := s.get(ctx, test.endpoint, "", test.opts, false)
+			if test.err != "" {
+				require.Error(t, err)
+				var tooLargeErr *api.ResponseTooLargeError
+				require.True(t, errors.As(err, &tooLargeErr))
+				require.EqualError(t, tooLargeErr, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, body, res.body)
+			}
+		})
+	}
+}
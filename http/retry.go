@@ -0,0 +1,84 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/rs/zerolog"
+)
+
+// retryBackoff is the delay before the first retry; each subsequent retry
+// waits twice as long as the previous one.
+var retryBackoff = 100 * time.Millisecond
+
+// retried calls fn, calling it again up to the given number of times if it
+// fails with an error that may be transient.  Backoffs are timed with the
+// given clock.
+func retried[T any](ctx context.Context,
+	log zerolog.Logger,
+	clock clock.Clock,
+	retries int,
+	fn func(ctx context.Context) (T, error),
+) (
+	T,
+	error,
+) {
+	backoff := retryBackoff
+	for attempt := 0; ; attempt++ {
+		data, err := fn(ctx)
+		if err == nil || attempt >= retries || !retryable(err) {
+			return data, err
+		}
+
+		log.Debug().Err(err).Int("attempt", attempt+1).Dur("backoff", backoff).Msg("Request failed; retrying")
+		timer := clock.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return data, err
+		case <-timer.C():
+		}
+		backoff *= 2
+	}
+}
+
+// retryable returns true if the error may be transient, so that repeating the
+// request could succeed.
+func retryable(err error) bool {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.Is(err, ErrCircuitOpen):
+		return false
+	}
+
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError ||
+			apiErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	// Errors from the transport, such as a refused connection.
+	var urlErr *url.Error
+
+	return errors.As(err, &urlErr)
+}
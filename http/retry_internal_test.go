@@ -0,0 +1,172 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetried(t *testing.T) {
+	retryBackoff = time.Millisecond
+	defer func() { retryBackoff = 100 * time.Millisecond }()
+
+	transportErr := errors.Join(errors.New("failed to call GET endpoint"), &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")})
+	serverErr := &api.Error{Method: http.MethodGet, StatusCode: http.StatusServiceUnavailable}
+	clientErr := &api.Error{Method: http.MethodGet, StatusCode: http.StatusNotFound}
+
+	tests := []struct {
+		name    string
+		retries int
+		// errs are the errors returned by successive calls; calls after the
+		// last error succeed.
+		errs  []error
+		err   string
+		calls int
+	}{
+		{
+			name:  "Success",
+			calls: 1,
+		},
+		{
+			name:  "NoRetries",
+			errs:  []error{transportErr},
+			err:   transportErr.Error(),
+			calls: 1,
+		},
+		{
+			name:    "TransportRecovered",
+			retries: 2,
+			errs:    []error{transportErr, transportErr},
+			calls:   3,
+		},
+		{
+			name:    "ServerErrorRecovered",
+			retries: 2,
+			errs:    []error{serverErr},
+			calls:   2,
+		},
+		{
+			name:    "RetriesExhausted",
+			retries: 2,
+			errs:    []error{serverErr, serverErr, serverErr},
+			err:     serverErr.Error(),
+			calls:   3,
+		},
+		{
+			name:    "ClientError",
+			retries: 2,
+			errs:    []error{clientErr},
+			err:     clientErr.Error(),
+			calls:   1,
+		},
+		{
+			name:    "CircuitOpen",
+			retries: 2,
+			errs:    []error{ErrCircuitOpen},
+			err:     ErrCircuitOpen.Error(),
+			calls:   1,
+		},
+		{
+			name:    "Canceled",
+			retries: 2,
+			errs:    []error{context.Canceled},
+			err:     context.Canceled.Error(),
+			calls:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			res, err := retried(context.Background(), zerolog.Nop(), clock.System(), test.retries, func(_ context.Context) (int, error) {
+				calls++
+				if calls <= len(test.errs) {
+					return 0, test.errs[calls-1]
+				}
+
+				return calls, nil
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.calls, res)
+			}
+			require.Equal(t, test.calls, calls)
+		})
+	}
+}
+
+func TestRetriedBackoff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	virtualClock := clock.NewVirtual(time.Unix(1700000000, 0))
+	serverErr := &api.Error{Method: http.MethodGet, StatusCode: http.StatusBadGateway}
+
+	var calls atomic.Int32
+	done := make(chan error, 1)
+	go func() {
+		_, err := retried(ctx, zerolog.Nop(), virtualClock, 2, func(_ context.Context) (int, error) {
+			if calls.Add(1) <= 2 {
+				return 0, serverErr
+			}
+
+			return 0, nil
+		})
+		done <- err
+	}()
+
+	// First backoff.
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 1))
+	require.Equal(t, int32(1), calls.Load())
+	virtualClock.Advance(retryBackoff - time.Millisecond)
+	require.Equal(t, int32(1), calls.Load())
+	virtualClock.Advance(time.Millisecond)
+
+	// Second backoff is twice the first.
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 1))
+	require.Equal(t, int32(2), calls.Load())
+	virtualClock.Advance(2*retryBackoff - time.Millisecond)
+	require.Equal(t, int32(2), calls.Load())
+	virtualClock.Advance(time.Millisecond)
+
+	require.NoError(t, <-done)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetriedContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	_, err := retried(ctx, zerolog.Nop(), clock.NewVirtual(time.Unix(1700000000, 0)), 5, func(_ context.Context) (int, error) {
+		calls++
+
+		return 0, &api.Error{Method: http.MethodGet, StatusCode: http.StatusBadGateway}
+	})
+	require.EqualError(t, err, "GET failed with status 502")
+	require.Equal(t, 1, calls)
+}
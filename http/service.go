@@ -28,6 +28,8 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/logging"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -57,10 +59,16 @@ type Service struct {
 	nodeVersion          string
 	nodeVersionMutex     sync.RWMutex
 
+	// Domains calculated from the above, keyed by domain type and epoch.
+	domains      map[domainKey]phase0.Domain
+	domainsMutex sync.RWMutex
+
 	// User-specified chunk sizes.
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
 	extraHeaders        map[string]string
+	userAgent           string
+	requestIDHeader     string
 
 	// Connection support.
 	hooks *Hooks
@@ -74,6 +82,45 @@ type Service struct {
 	connectedToDVTMiddleware bool
 	reducedMemoryUsage       bool
 	customSpecSupport        bool
+	specOverrides            map[string]string
+	maxResponseSize          int64
+	expectedGVR              *phase0.Root
+	expectedChainID          *uint64
+	networkErr               error
+	staticValuesPeriod       time.Duration
+	retries                  int
+	clock                    clock.Clock
+
+	// Responses retained for conditional requests, keyed by URL.
+	conditionalResponsesMu sync.RWMutex
+	conditionalResponses   map[string]*conditionalResponse
+
+	// Node capabilities, detected on use.
+	capabilitiesMu                   sync.RWMutex
+	validatorStatesFilterUnsupported bool
+	aggregateAndProofsV2Unsupported  bool
+
+	// Rate limiting.
+	rateLimiter               *rateLimiter
+	endpointClassRateLimiters map[string]*rateLimiter
+
+	// Circuit breakers, keyed by endpoint class.
+	circuitBreakers map[string]*circuitBreaker
+
+	// Request compression; nil if disabled.
+	requestCompressor *requestCompressor
+
+	// Shutdown support.
+	closeMu    sync.RWMutex
+	closed     bool
+	inflight   sync.WaitGroup
+	background sync.WaitGroup
+	// stopCtx is canceled to stop event streams and background processes.
+	stopCtx context.Context
+	stop    context.CancelFunc
+	// abortCtx is canceled to cancel in-flight requests.
+	abortCtx context.Context
+	abort    context.CancelFunc
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -85,9 +132,16 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 
 	// Set logging.
 	log := zerologger.With().Str("service", "client").Str("impl", "http").Logger()
+	if parameters.logger != nil {
+		log = logging.FromSlog(parameters.logger).With().Str("service", "client").Str("impl", "http").Logger()
+	}
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
+	if parameters.logger != nil {
+		// Ensure that logging from background processes also uses the supplied logger.
+		ctx = log.WithContext(ctx)
+	}
 
 	if parameters.monitor != nil {
 		if err := registerMetrics(ctx, parameters.monitor); err != nil {
@@ -126,19 +180,55 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 		userIndexChunkSize:  parameters.indexChunkSize,
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
 		extraHeaders:        parameters.extraHeaders,
+		userAgent:           parameters.userAgent,
+		requestIDHeader:     parameters.requestIDHeader,
 		enforceJSON:         parameters.enforceJSON,
 		pingSem:             semaphore.NewWeighted(1),
 		hooks:               parameters.hooks,
 		reducedMemoryUsage:  parameters.reducedMemoryUsage,
 		customSpecSupport:   parameters.customSpecSupport,
+		specOverrides:       parameters.specOverrides,
+		maxResponseSize:     parameters.maxResponseSize,
+		expectedGVR:         parameters.expectedGVR,
+		expectedChainID:     parameters.expectedChainID,
+		staticValuesPeriod:  parameters.staticValuesPeriod,
+		retries:             parameters.retries,
+		clock:               parameters.clock,
+	}
+
+	// Background processes and event streams stop when the service is
+	// closed, as well as when the supplied context is done.
+	ctx, s.stop = context.WithCancel(ctx)
+	s.stopCtx = ctx
+	s.abortCtx, s.abort = context.WithCancel(context.Background())
+
+	if parameters.rateLimit != nil {
+		s.rateLimiter = newRateLimiter(parameters.rateLimit, parameters.clock)
+	}
+	s.endpointClassRateLimiters = make(map[string]*rateLimiter, len(parameters.endpointRateLimits))
+	for class, limit := range parameters.endpointRateLimits {
+		s.endpointClassRateLimiters[class] = newRateLimiter(limit, parameters.clock)
+	}
+	if parameters.compressThreshold > 0 {
+		s.requestCompressor = newRequestCompressor(parameters.compressThreshold, parameters.compressEncodings)
+	}
+	s.circuitBreakers = make(map[string]*circuitBreaker, len(parameters.circuitBreakers))
+	for class, config := range parameters.circuitBreakers {
+		s.circuitBreakers[class] = newCircuitBreaker(config, parameters.clock)
 	}
 
 	// Ping the client to see if it is ready to serve requests.
 	s.CheckConnectionState(ctx)
 	active := s.IsActive()
 
-	if !active && !parameters.allowDelayedStart {
-		return nil, client.ErrNotActive
+	if !active {
+		if err := s.networkError(); err != nil {
+			// Never start against the wrong network.
+			return nil, err
+		}
+		if !parameters.allowDelayedStart {
+			return nil, client.ErrNotActive
+		}
 	}
 
 	// Periodically refetch static values in case of client update.
@@ -164,13 +254,15 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 
 // periodicUpdateConnectionState periodically pings the client to update its active and synced status.
 func (s *Service) periodicUpdateConnectionState(ctx context.Context) {
+	s.background.Add(1)
 	go func(s *Service, ctx context.Context) {
+		defer s.background.Done()
 		// Refresh every 30 seconds.
-		refreshTicker := time.NewTicker(30 * time.Second)
+		refreshTicker := s.clock.NewTicker(30 * time.Second)
 		defer refreshTicker.Stop()
 		for {
 			select {
-			case <-refreshTicker.C:
+			case <-refreshTicker.C():
 				s.CheckConnectionState(ctx)
 			case <-ctx.Done():
 				return
@@ -182,13 +274,19 @@ func (s *Service) periodicUpdateConnectionState(ctx context.Context) {
 // periodicClearStaticValues periodically sets static values to nil so they are
 // refetched the next time they are required.
 func (s *Service) periodicClearStaticValues(ctx context.Context) {
+	if s.staticValuesPeriod == 0 {
+		// Periodic refresh disabled.
+		return
+	}
+
+	s.background.Add(1)
 	go func(s *Service, ctx context.Context) {
-		// Refresh every 5 minutes.
-		refreshTicker := time.NewTicker(5 * time.Minute)
+		defer s.background.Done()
+		refreshTicker := s.clock.NewTicker(s.staticValuesPeriod)
 		defer refreshTicker.Stop()
 		for {
 			select {
-			case <-refreshTicker.C:
+			case <-refreshTicker.C():
 				s.clearStaticValues()
 			case <-ctx.Done():
 				return
@@ -197,8 +295,8 @@ func (s *Service) periodicClearStaticValues(ctx context.Context) {
 	}(s, ctx)
 }
 
-// clearStaticValues periodically sets static values to nil so they are
-// refetched the next time they are required.
+// clearStaticValues sets static values to nil so they are refetched the
+// next time they are required.
 func (s *Service) clearStaticValues() {
 	s.genesisMutex.Lock()
 	s.genesis = nil
@@ -215,6 +313,14 @@ func (s *Service) clearStaticValues() {
 	s.nodeVersionMutex.Lock()
 	s.nodeVersion = ""
 	s.nodeVersionMutex.Unlock()
+	s.domainsMutex.Lock()
+	s.domains = nil
+	s.domainsMutex.Unlock()
+	// Capabilities are detected again, as the node may have been upgraded.
+	s.capabilitiesMu.Lock()
+	s.validatorStatesFilterUnsupported = false
+	s.aggregateAndProofsV2Unsupported = false
+	s.capabilitiesMu.Unlock()
 }
 
 // checkDVT checks if connected to DVT middleware and sets
@@ -245,13 +351,18 @@ func (s *Service) Address() string {
 }
 
 // close closes the service, freeing up resources.
-func (*Service) close() {
+func (s *Service) close() {
+	s.client.CloseIdleConnections()
 }
 
 // CheckConnectionState checks the connection state for the client, potentially updating
 // its activation and sync states.
 // This will call hooks supplied when creating the client if the state changes.
 func (s *Service) CheckConnectionState(ctx context.Context) {
+	if s.isClosed() {
+		// Connection state is meaningless once the service is closed.
+		return
+	}
 	log := zerolog.Ctx(ctx)
 
 	s.connectionMu.Lock()
@@ -288,6 +399,14 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 			log.Error().Err(err).Msg("Failed to check DVT connection on client activation; returning to inactive")
 			active = false
 		}
+
+		// Check that the node is on the expected network.
+		if active {
+			if err := s.checkNetwork(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to confirm network on client activation; returning to inactive")
+				active = false
+			}
+		}
 	}
 
 	if wasActive && !active {
@@ -0,0 +1,52 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodicClearStaticValues(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := clock.NewVirtual(time.Unix(1606824023, 0))
+	s := &Service{
+		clock:              clk,
+		staticValuesPeriod: time.Hour,
+		nodeVersion:        "test",
+	}
+	nodeVersion := func() string {
+		s.nodeVersionMutex.RLock()
+		defer s.nodeVersionMutex.RUnlock()
+
+		return s.nodeVersion
+	}
+
+	s.periodicClearStaticValues(ctx)
+	require.NoError(t, clk.WaitForWaiters(ctx, 1))
+	require.Equal(t, "test", nodeVersion())
+
+	// Values are cleared once the clock reaches the refresh interval.
+	clk.Advance(time.Hour)
+	require.Eventually(t, func() bool { return nodeVersion() == "" }, time.Second, time.Millisecond)
+
+	cancel()
+	s.background.Wait()
+}
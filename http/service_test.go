@@ -89,6 +89,42 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters\nno hooks specified",
 		},
+		{
+			name: "StaticValuesRefreshIntervalNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithStaticValuesRefreshInterval(-1 * time.Second),
+			},
+			err: "problem with parameters\nstatic values refresh interval cannot be negative",
+		},
+		{
+			name: "MaxResponseSizeNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxResponseSize(-1),
+			},
+			err: "problem with parameters\nmax response size cannot be negative",
+		},
+		{
+			name: "RateLimitInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithRateLimit(0, 1),
+			},
+			err: "problem with parameters\ninvalid rate limit\nrate must be positive",
+		},
+		{
+			name: "EndpointClassRateLimitInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithEndpointClassRateLimit("debug", 1, 0),
+			},
+			err: "problem with parameters\ninvalid rate limit for endpoint class debug\nburst must be at least 1",
+		},
 		{
 			name: "Good",
 			parameters: []v1.Parameter{
@@ -146,6 +182,7 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
+	assert.Implements(t, (*client.StaticValuesRefresher)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeDutiesProvider)(nil), s)
@@ -158,6 +195,13 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
 
+	assert.Implements(t, (*client.PendingDepositProvider)(nil), s)
+	assert.Implements(t, (*client.PendingPartialWithdrawalsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingConsolidationsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconHeadsProvider)(nil), s)
+	assert.Implements(t, (*client.ForkSupporter)(nil), s)
+	assert.Implements(t, (*client.ElectraAttestationSubmitter)(nil), s)
 	// Non-standard extensions.
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
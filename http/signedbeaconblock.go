@@ -23,13 +23,13 @@ import (
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/pools"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	dynssz "github.com/pk910/dynamic-ssz"
 )
 
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
@@ -68,6 +68,14 @@ func (s *Service) SignedBeaconBlock(ctx context.Context,
 		return nil, err
 	}
 
+	if opts.VerifyRoot {
+		if err := verifyRoot(opts.Block, response.Data.Root); err != nil {
+			response.Release()
+
+			return nil, err
+		}
+	}
+
 	return response, nil
 }
 
@@ -84,76 +92,94 @@ func (s *Service) signedBeaconBlockFromSSZ(ctx context.Context,
 		Metadata: metadataFromHeaders(res.headers),
 	}
 
-	var dynSSZ *dynssz.DynSsz
-	if s.customSpecSupport {
-		specs, err := s.Spec(ctx, &api.SpecOpts{})
-		if err != nil {
-			return nil, errors.Join(errors.New("failed to request specs"), err)
-		}
-
-		dynSSZ = dynssz.NewDynSsz(specs.Data)
+	dynSSZ, err := s.dynamicSSZ(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var err error
 	switch res.consensusVersion {
 	case spec.DataVersionPhase0:
-		response.Data.Phase0 = &phase0.SignedBeaconBlock{}
-		if s.customSpecSupport {
+		block := pools.Phase0SignedBeaconBlocks.Get()
+		response.OnRelease(func() { pools.Phase0SignedBeaconBlocks.Put(block) })
+		response.Data.Phase0 = block
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Phase0, res.body)
 		} else {
 			err = response.Data.Phase0.UnmarshalSSZ(res.body)
 		}
 		if err != nil {
+			response.Release()
+
 			return nil, errors.Join(errors.New("failed to decode phase0 signed beacon block"), err)
 		}
 	case spec.DataVersionAltair:
-		response.Data.Altair = &altair.SignedBeaconBlock{}
-		if s.customSpecSupport {
+		block := pools.AltairSignedBeaconBlocks.Get()
+		response.OnRelease(func() { pools.AltairSignedBeaconBlocks.Put(block) })
+		response.Data.Altair = block
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Altair, res.body)
 		} else {
 			err = response.Data.Altair.UnmarshalSSZ(res.body)
 		}
 		if err != nil {
+			response.Release()
+
 			return nil, errors.Join(errors.New("failed to decode altair signed beacon block"), err)
 		}
 	case spec.DataVersionBellatrix:
-		response.Data.Bellatrix = &bellatrix.SignedBeaconBlock{}
-		if s.customSpecSupport {
+		block := pools.BellatrixSignedBeaconBlocks.Get()
+		response.OnRelease(func() { pools.BellatrixSignedBeaconBlocks.Put(block) })
+		response.Data.Bellatrix = block
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Bellatrix, res.body)
 		} else {
 			err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
 		}
 		if err != nil {
+			response.Release()
+
 			return nil, errors.Join(errors.New("failed to decode bellatrix signed beacon block"), err)
 		}
 	case spec.DataVersionCapella:
-		response.Data.Capella = &capella.SignedBeaconBlock{}
-		if s.customSpecSupport {
+		block := pools.CapellaSignedBeaconBlocks.Get()
+		response.OnRelease(func() { pools.CapellaSignedBeaconBlocks.Put(block) })
+		response.Data.Capella = block
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Capella, res.body)
 		} else {
 			err = response.Data.Capella.UnmarshalSSZ(res.body)
 		}
 		if err != nil {
+			response.Release()
+
 			return nil, errors.Join(errors.New("failed to decode capella signed beacon block"), err)
 		}
 	case spec.DataVersionDeneb:
-		response.Data.Deneb = &deneb.SignedBeaconBlock{}
-		if s.customSpecSupport {
+		block := pools.DenebSignedBeaconBlocks.Get()
+		response.OnRelease(func() { pools.DenebSignedBeaconBlocks.Put(block) })
+		response.Data.Deneb = block
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Deneb, res.body)
 		} else {
 			err = response.Data.Deneb.UnmarshalSSZ(res.body)
 		}
 		if err != nil {
+			response.Release()
+
 			return nil, errors.Join(errors.New("failed to decode deneb signed block contents"), err)
 		}
 	case spec.DataVersionElectra:
-		response.Data.Electra = &electra.SignedBeaconBlock{}
-		if s.customSpecSupport {
+		block := pools.ElectraSignedBeaconBlocks.Get()
+		response.OnRelease(func() { pools.ElectraSignedBeaconBlocks.Put(block) })
+		response.Data.Electra = block
+		if dynSSZ != nil {
 			err = dynSSZ.UnmarshalSSZ(response.Data.Electra, res.body)
 		} else {
 			err = response.Data.Electra.UnmarshalSSZ(res.body)
 		}
 		if err != nil {
+			response.Release()
+
 			return nil, errors.Join(errors.New("failed to decode electra signed block contents"), err)
 		}
 	default:
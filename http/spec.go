@@ -73,6 +73,11 @@ func (s *Service) Spec(ctx context.Context,
 		return nil, err
 	}
 
+	// Apply user-supplied overrides before decoding.
+	for k, v := range s.specOverrides {
+		data[k] = v
+	}
+
 	config := make(map[string]any)
 	for k, v := range data {
 		// Handle domains.
@@ -0,0 +1,135 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestSpecOverrides(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		overrides     map[string]string
+		slotDuration  time.Duration
+		slotsPerEpoch uint64
+		forkSchedule  []*phase0.Fork
+		dynamic       bool
+		err           string
+	}{
+		{
+			name:          "None",
+			slotDuration:  12 * time.Second,
+			slotsPerEpoch: 32,
+			forkSchedule: []*phase0.Fork{
+				{PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x00, 0x00, 0x00, 0x00}, Epoch: 0},
+				{PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00}, CurrentVersion: phase0.Version{0x01, 0x00, 0x00, 0x00}, Epoch: 10},
+			},
+		},
+		{
+			name: "Gnosis",
+			overrides: map[string]string{
+				"PRESET_BASE":                    "gnosis",
+				"SECONDS_PER_SLOT":               "5",
+				"SLOTS_PER_EPOCH":                "16",
+				"MAX_WITHDRAWALS_PER_PAYLOAD":    "8",
+				"GENESIS_FORK_VERSION":           "0x00000064",
+				"ALTAIR_FORK_VERSION":            "0x01000064",
+				"ALTAIR_FORK_EPOCH":              "512",
+				"MAX_BLOB_COMMITMENTS_PER_BLOCK": "4096",
+			},
+			slotDuration:  5 * time.Second,
+			slotsPerEpoch: 16,
+			forkSchedule: []*phase0.Fork{
+				{PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x64}, CurrentVersion: phase0.Version{0x00, 0x00, 0x00, 0x64}, Epoch: 0},
+				{PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x64}, CurrentVersion: phase0.Version{0x01, 0x00, 0x00, 0x64}, Epoch: 512},
+			},
+			dynamic: true,
+		},
+		{
+			name: "ForkEpochMissing",
+			overrides: map[string]string{
+				"BELLATRIX_FORK_VERSION": "0x02000000",
+			},
+			slotDuration:  12 * time.Second,
+			slotsPerEpoch: 32,
+			err:           "BELLATRIX_FORK_EPOCH not found in spec",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/eth/v1/config/spec":
+					_, _ = w.Write([]byte(`{"data":{"PRESET_BASE":"mainnet","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","GENESIS_FORK_VERSION":"0x00000000","ALTAIR_FORK_VERSION":"0x01000000","ALTAIR_FORK_EPOCH":"10"}}`))
+				case "/eth/v1/config/fork_schedule":
+					_, _ = w.Write([]byte(`{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"},{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"10"}]}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			base, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          server.URL,
+				client:           server.Client(),
+				timeout:          time.Second,
+				pingSem:          semaphore.NewWeighted(1),
+				hooks:            &Hooks{},
+				connectionActive: true,
+				connectionSynced: true,
+				specOverrides:    test.overrides,
+			}
+
+			slotDuration, err := s.SlotDuration(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.slotDuration, slotDuration)
+
+			slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.slotsPerEpoch, slotsPerEpoch)
+
+			forkSchedule, err := s.ForkSchedule(ctx, &api.ForkScheduleOpts{})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.forkSchedule, forkSchedule.Data)
+
+			dynSSZ, err := s.dynamicSSZ(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.dynamic, dynSSZ != nil)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
 	"strings"
 
 	client "github.com/attestantio/go-eth2-client"
@@ -47,11 +48,16 @@ func (s *Service) SubmitAggregateAttestations(ctx context.Context, opts *api.Sub
 		return errors.Join(errors.New("failed to marshal JSON"), err)
 	}
 
+	version := aggregateAndProofs[0].Version
+	if version < spec.DataVersionElectra && !s.supportsAggregateAndProofsV2() {
+		return s.submitAggregateAttestationsV1(ctx, opts, specJSON)
+	}
+
 	endpoint := "/eth/v2/validator/aggregate_and_proofs"
 	query := ""
 
 	headers := make(map[string]string)
-	headers["Eth-Consensus-Version"] = strings.ToLower(aggregateAndProofs[0].Version.String())
+	headers["Eth-Consensus-Version"] = strings.ToLower(version.String())
 	if _, err = s.post(ctx,
 		endpoint,
 		query,
@@ -60,12 +66,62 @@ func (s *Service) SubmitAggregateAttestations(ctx context.Context, opts *api.Sub
 		ContentTypeJSON,
 		headers,
 	); err != nil {
-		return errors.Join(errors.New("failed to submit versioned aggregate and proofs"), err)
+		var apiErr *api.Error
+		if version >= spec.DataVersionElectra || !errors.As(err, &apiErr) || !endpointUnsupported(apiErr.StatusCode) {
+			return errors.Join(errors.New("failed to submit versioned aggregate and proofs"), err)
+		}
+
+		// The node does not support the v2 endpoint; fall back to v1, which
+		// can carry aggregates prior to electra.
+		s.log.Debug().Err(err).Msg("Node does not support v2 aggregate and proofs endpoint; using v1")
+		s.capabilitiesMu.Lock()
+		s.aggregateAndProofsV2Unsupported = true
+		s.capabilitiesMu.Unlock()
+
+		return s.submitAggregateAttestationsV1(ctx, opts, specJSON)
 	}
 
 	return nil
 }
 
+// submitAggregateAttestationsV1 submits pre-electra aggregate and proofs to
+// the unversioned endpoint.
+func (s *Service) submitAggregateAttestationsV1(ctx context.Context,
+	opts *api.SubmitAggregateAttestationsOpts,
+	specJSON []byte,
+) error {
+	endpoint := "/eth/v1/validator/aggregate_and_proofs"
+	query := ""
+
+	if _, err := s.post(ctx,
+		endpoint,
+		query,
+		&opts.Common,
+		bytes.NewReader(specJSON),
+		ContentTypeJSON,
+		map[string]string{},
+	); err != nil {
+		return errors.Join(errors.New("failed to submit aggregate and proofs"), err)
+	}
+
+	return nil
+}
+
+// supportsAggregateAndProofsV2 returns false if the node has been found not
+// to support the v2 aggregate and proofs endpoint.
+func (s *Service) supportsAggregateAndProofsV2() bool {
+	s.capabilitiesMu.RLock()
+	defer s.capabilitiesMu.RUnlock()
+
+	return !s.aggregateAndProofsV2Unsupported
+}
+
+// endpointUnsupported returns true if the status code shows that the node
+// does not provide an endpoint.
+func endpointUnsupported(statusCode int) bool {
+	return statusCode == http.StatusNotFound || statusCode == http.StatusMethodNotAllowed
+}
+
 func createUnversionedAggregates(aggregateAndProofs []*spec.VersionedSignedAggregateAndProof) ([]any, error) {
 	var version spec.DataVersion
 	var unversionedAggregates []any
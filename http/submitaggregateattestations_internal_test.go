@@ -0,0 +1,109 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestSubmitAggregateAttestationsVersionSelection(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path+" "+r.Header.Get("Eth-Consensus-Version"))
+		mu.Unlock()
+
+		if r.URL.Path == "/eth/v2/validator/aggregate_and_proofs" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"not found"}`))
+
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	denebOpts := &api.SubmitAggregateAttestationsOpts{
+		SignedAggregateAndProofs: []*spec.VersionedSignedAggregateAndProof{
+			{
+				Version: spec.DataVersionDeneb,
+				Deneb:   &phase0.SignedAggregateAndProof{},
+			},
+		},
+	}
+	electraOpts := &api.SubmitAggregateAttestationsOpts{
+		SignedAggregateAndProofs: []*spec.VersionedSignedAggregateAndProof{
+			{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.SignedAggregateAndProof{},
+			},
+		},
+	}
+
+	// First pre-electra submission falls back to v1.
+	require.NoError(t, s.SubmitAggregateAttestations(ctx, denebOpts))
+	require.Equal(t, []string{
+		"/eth/v2/validator/aggregate_and_proofs deneb",
+		"/eth/v1/validator/aggregate_and_proofs ",
+	}, requests)
+	require.False(t, s.supportsAggregateAndProofsV2())
+
+	// Subsequent pre-electra submissions go straight to v1.
+	require.NoError(t, s.SubmitAggregateAttestations(ctx, denebOpts))
+	require.Len(t, requests, 3)
+	require.Equal(t, "/eth/v1/validator/aggregate_and_proofs ", requests[2])
+
+	// Electra submissions always use v2, and do not fall back.
+	require.ErrorContains(t, s.SubmitAggregateAttestations(ctx, electraOpts), "failed to submit versioned aggregate and proofs")
+	require.Len(t, requests, 4)
+	require.Equal(t, "/eth/v2/validator/aggregate_and_proofs electra", requests[3])
+
+	// Clearing static values, as happens on refresh, detects support again.
+	s.clearStaticValues()
+	require.True(t, s.supportsAggregateAndProofsV2())
+	require.NoError(t, s.SubmitAggregateAttestations(ctx, denebOpts))
+	require.Len(t, requests, 6)
+	require.Equal(t, "/eth/v2/validator/aggregate_and_proofs deneb", requests[4])
+}
@@ -38,42 +38,109 @@ func (s *Service) SubmitBlindedProposal(ctx context.Context,
 	if opts.Proposal == nil {
 		return errors.Join(errors.New("no proposal supplied"), client.ErrInvalidOptions)
 	}
+	if err := opts.Proposal.AssertPresent(); err != nil {
+		return errors.Join(errors.New("invalid proposal"), err, client.ErrInvalidOptions)
+	}
+
+	body, contentType, err := s.submitBlindedProposalData(ctx, opts.Proposal)
+	if err != nil {
+		return err
+	}
+
+	endpoint := "/eth/v2/beacon/blinded_blocks"
+	query := ""
+	if opts.BroadcastValidation != nil {
+		query = "broadcast_validation=" + opts.BroadcastValidation.String()
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
+	_, err = s.post(ctx, endpoint, query, &opts.Common, bytes.NewBuffer(body), contentType, headers)
+	if err != nil {
+		return errors.Join(errors.New("failed to submit blinded proposal"), err)
+	}
+
+	return nil
+}
+
+func (s *Service) submitBlindedProposalData(ctx context.Context,
+	proposal *api.VersionedSignedBlindedProposal,
+) (
+	[]byte,
+	ContentType,
+	error,
+) {
+	var body []byte
+	var contentType ContentType
+	var err error
+
+	if s.enforceJSON {
+		contentType = ContentTypeJSON
+		body, err = s.submitBlindedProposalJSON(ctx, proposal)
+	} else {
+		contentType = ContentTypeSSZ
+		body, err = s.submitBlindedProposalSSZ(ctx, proposal)
+	}
 
+	if err != nil {
+		return nil, ContentTypeUnknown, err
+	}
+
+	return body, contentType, nil
+}
+
+func (*Service) submitBlindedProposalJSON(_ context.Context,
+	proposal *api.VersionedSignedBlindedProposal,
+) (
+	[]byte,
+	error,
+) {
 	var specJSON []byte
 	var err error
 
-	switch opts.Proposal.Version {
-	case spec.DataVersionPhase0:
-		err = errors.New("blinded phase0 proposals not supported")
-	case spec.DataVersionAltair:
-		err = errors.New("blinded altair proposals not supported")
+	switch proposal.Version {
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(opts.Proposal.Bellatrix)
+		specJSON, err = json.Marshal(proposal.Bellatrix)
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(opts.Proposal.Capella)
+		specJSON, err = json.Marshal(proposal.Capella)
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(opts.Proposal.Deneb)
+		specJSON, err = json.Marshal(proposal.Deneb)
 	case spec.DataVersionElectra:
-		specJSON, err = json.Marshal(opts.Proposal.Electra)
+		specJSON, err = json.Marshal(proposal.Electra)
 	default:
 		err = errors.New("unknown proposal version")
 	}
 	if err != nil {
-		return errors.Join(errors.New("failed to marshal JSON"), err)
+		return nil, errors.Join(errors.New("failed to marshal JSON"), err)
 	}
 
-	endpoint := "/eth/v2/beacon/blinded_blocks"
-	query := ""
-	if opts.BroadcastValidation != nil {
-		query = "broadcast_validation=" + opts.BroadcastValidation.String()
-	}
+	return specJSON, nil
+}
 
-	headers := make(map[string]string)
-	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
-	_, err = s.post(ctx, endpoint, query, &opts.Common, bytes.NewBuffer(specJSON), ContentTypeJSON, headers)
+func (*Service) submitBlindedProposalSSZ(_ context.Context,
+	proposal *api.VersionedSignedBlindedProposal,
+) (
+	[]byte,
+	error,
+) {
+	var specSSZ []byte
+	var err error
+
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		specSSZ, err = proposal.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		specSSZ, err = proposal.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		specSSZ, err = proposal.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		specSSZ, err = proposal.Electra.MarshalSSZ()
+	default:
+		err = errors.New("unknown proposal version")
+	}
 	if err != nil {
-		return errors.Join(errors.New("failed to submit blinded proposal"), err)
+		return nil, errors.Join(errors.New("failed to marshal SSZ"), err)
 	}
 
-	return nil
+	return specSSZ, nil
 }
@@ -0,0 +1,134 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv2 "github.com/attestantio/go-eth2-client/api/v2"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func TestSubmitBlindedProposalEncoding(t *testing.T) {
+	ctx := context.Background()
+
+	type request struct {
+		path        string
+		query       string
+		contentType string
+		version     string
+		body        []byte
+	}
+	var received *request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = &request{
+			path:        r.URL.Path,
+			query:       r.URL.RawQuery,
+			contentType: r.Header.Get("Content-Type"),
+			version:     r.Header.Get("Eth-Consensus-Version"),
+			body:        body,
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+		connectionSynced: true,
+	}
+
+	block := &apiv1capella.SignedBlindedBeaconBlock{
+		Message: &apiv1capella.BlindedBeaconBlock{
+			Slot: 1,
+			Body: &apiv1capella.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: make([]byte, 64),
+				},
+				ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+			},
+		},
+	}
+	expectedSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	broadcastValidation := apiv2.BroadcastValidationConsensus
+	opts := &api.SubmitBlindedProposalOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version: spec.DataVersionCapella,
+			Capella: block,
+		},
+		BroadcastValidation: &broadcastValidation,
+	}
+
+	// SSZ by default.
+	require.NoError(t, s.SubmitBlindedProposal(ctx, opts))
+	require.Equal(t, "/eth/v2/beacon/blinded_blocks", received.path)
+	require.Equal(t, "broadcast_validation=consensus", received.query)
+	require.Equal(t, "application/octet-stream", received.contentType)
+	require.Equal(t, "capella", received.version)
+	require.Equal(t, expectedSSZ, received.body)
+
+	// JSON if enforced.
+	s.enforceJSON = true
+	require.NoError(t, s.SubmitBlindedProposal(ctx, opts))
+	require.Equal(t, "application/json", received.contentType)
+	require.Equal(t, "capella", received.version)
+	require.Contains(t, string(received.body), `"slot":"1"`)
+
+	// Missing proposal data.
+	err = s.SubmitBlindedProposal(ctx, &api.SubmitBlindedProposalOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version: spec.DataVersionDeneb,
+		},
+	})
+	require.True(t, errors.Is(err, client.ErrInvalidOptions))
+
+	// Unsupported version.
+	err = s.SubmitBlindedProposal(ctx, &api.SubmitBlindedProposalOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version: spec.DataVersionAltair,
+		},
+	})
+	require.True(t, errors.Is(err, client.ErrInvalidOptions))
+}
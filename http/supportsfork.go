@@ -0,0 +1,43 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SupportsFork returns true if the beacon node supports the given fork, as
+// shown by the fork being present in its spec.  A fork is supported once the
+// node knows of it, regardless of whether it has been reached.
+func (s *Service) SupportsFork(ctx context.Context, version spec.DataVersion) (bool, error) {
+	switch {
+	case version == spec.DataVersionUnknown:
+		return false, errors.New("unknown fork")
+	case version == spec.DataVersionPhase0:
+		return true, nil
+	}
+
+	response, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return false, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+	_, exists := response.Data[strings.ToUpper(version.String())+"_FORK_EPOCH"]
+
+	return exists, nil
+}
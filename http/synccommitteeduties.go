@@ -22,6 +22,7 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // SyncCommitteeDuties obtains sync committee duties.
@@ -41,46 +42,121 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context,
 		return nil, errors.Join(errors.New("no validator indices specified"), client.ErrInvalidOptions)
 	}
 
+	duties, metadata, err := s.syncCommitteeDutiesForEpoch(ctx, &opts.Common, opts.Epoch, opts.Indices)
+	if err != nil {
+		return nil, err
+	}
+
+	epochsPerPeriod, err := s.epochsPerSyncCommitteePeriod(ctx)
+	if err != nil {
+		if opts.ToEpoch > opts.Epoch {
+			return nil, err
+		}
+
+		// Not required, so return the duties without period information.
+		return &api.Response[[]*apiv1.SyncCommitteeDuty]{
+			Metadata: metadata,
+			Data:     duties,
+		}, nil
+	}
+
+	fromEpoch := opts.Epoch - opts.Epoch%epochsPerPeriod
+	untilEpoch := fromEpoch + epochsPerPeriod
+	setSyncCommitteeDutiesPeriod(duties, fromEpoch, untilEpoch)
+
+	if opts.ToEpoch >= untilEpoch+epochsPerPeriod {
+		return nil, errors.Join(errors.New("to epoch beyond next sync committee period"), client.ErrInvalidOptions)
+	}
+	if opts.ToEpoch >= untilEpoch {
+		// Crosses a period boundary, so also fetch the duties for the next period.
+		nextDuties, _, err := s.syncCommitteeDutiesForEpoch(ctx, &opts.Common, untilEpoch, opts.Indices)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain sync committee duties for next period"), err)
+		}
+		setSyncCommitteeDutiesPeriod(nextDuties, untilEpoch, untilEpoch+epochsPerPeriod)
+		duties = append(duties, nextDuties...)
+	}
+
+	return &api.Response[[]*apiv1.SyncCommitteeDuty]{
+		Metadata: metadata,
+		Data:     duties,
+	}, nil
+}
+
+// syncCommitteeDutiesForEpoch obtains the sync committee duties for the period containing the given epoch.
+func (s *Service) syncCommitteeDutiesForEpoch(ctx context.Context,
+	commonOpts *api.CommonOpts,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*apiv1.SyncCommitteeDuty,
+	map[string]any,
+	error,
+) {
 	var reqBodyReader bytes.Buffer
 	if _, err := reqBodyReader.WriteString(`[`); err != nil {
-		return nil, errors.Join(errors.New("failed to write validator index array start"), err)
+		return nil, nil, errors.Join(errors.New("failed to write validator index array start"), err)
 	}
-	for i := range opts.Indices {
-		if _, err := reqBodyReader.WriteString(fmt.Sprintf(`"%d"`, opts.Indices[i])); err != nil {
-			return nil, errors.Join(errors.New("failed to write index"), err)
+	for i := range indices {
+		if _, err := reqBodyReader.WriteString(fmt.Sprintf(`"%d"`, indices[i])); err != nil {
+			return nil, nil, errors.Join(errors.New("failed to write index"), err)
 		}
-		if i != len(opts.Indices)-1 {
+		if i != len(indices)-1 {
 			if _, err := reqBodyReader.WriteString(`,`); err != nil {
-				return nil, errors.Join(errors.New("failed to write separator"), err)
+				return nil, nil, errors.Join(errors.New("failed to write separator"), err)
 			}
 		}
 	}
 	if _, err := reqBodyReader.WriteString(`]`); err != nil {
-		return nil, errors.Join(errors.New("failed to write end of validator index array"), err)
+		return nil, nil, errors.Join(errors.New("failed to write end of validator index array"), err)
 	}
 
-	endpoint := fmt.Sprintf("/eth/v1/validator/duties/sync/%d", opts.Epoch)
+	endpoint := fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch)
 	query := ""
 
 	httpResponse, err := s.post(ctx,
 		endpoint,
 		query,
-		&api.CommonOpts{},
+		commonOpts,
 		&reqBodyReader,
 		ContentTypeJSON,
 		map[string]string{},
 	)
 	if err != nil {
-		return nil, errors.Join(errors.New("failed to request sync committee duties"), err)
+		return nil, nil, errors.Join(errors.New("failed to request sync committee duties"), err)
 	}
 
 	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.SyncCommitteeDuty{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &api.Response[[]*apiv1.SyncCommitteeDuty]{
-		Metadata: metadata,
-		Data:     data,
-	}, nil
+	return data, metadata, nil
+}
+
+// epochsPerSyncCommitteePeriod obtains the number of epochs in a sync committee period.
+func (s *Service) epochsPerSyncCommitteePeriod(ctx context.Context) (phase0.Epoch, error) {
+	specResponse, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return 0, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	tmp, exists := specResponse.Data["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"]
+	if !exists {
+		return 0, errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD not found in spec")
+	}
+	epochsPerPeriod, isUint := tmp.(uint64)
+	if !isUint || epochsPerPeriod == 0 {
+		return 0, errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD of unexpected value")
+	}
+
+	return phase0.Epoch(epochsPerPeriod), nil
+}
+
+// setSyncCommitteeDutiesPeriod sets the period information for the duties.
+func setSyncCommitteeDutiesPeriod(duties []*apiv1.SyncCommitteeDuty, fromEpoch phase0.Epoch, untilEpoch phase0.Epoch) {
+	for _, duty := range duties {
+		duty.FromEpoch = fromEpoch
+		duty.UntilEpoch = untilEpoch
+	}
 }
@@ -79,3 +79,46 @@ func TestSyncCommitteeDuties(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncCommitteeDutiesNextPeriod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	genesisResponse, err := service.(client.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+	require.NoError(t, err)
+	slotDuration, err := service.(client.SlotDurationProvider).SlotDuration(ctx)
+	require.NoError(t, err)
+	slotsPerEpoch, err := service.(client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	specResponse, err := service.(client.SpecProvider).Spec(ctx, &api.SpecOpts{})
+	require.NoError(t, err)
+	epochsPerPeriod, isUint := specResponse.Data["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"].(uint64)
+	require.True(t, isUint)
+
+	epoch := phase0.Epoch(uint64(time.Since(genesisResponse.Data.GenesisTime).Seconds()) / (uint64(slotDuration.Seconds()) * slotsPerEpoch))
+	untilEpoch := epoch - epoch%phase0.Epoch(epochsPerPeriod) + phase0.Epoch(epochsPerPeriod)
+
+	response, err := service.(client.SyncCommitteeDutiesProvider).SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+		Epoch:   epoch,
+		ToEpoch: untilEpoch,
+		Indices: []phase0.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, response)
+	for _, duty := range response.Data {
+		require.True(t, duty.UntilEpoch == untilEpoch || duty.UntilEpoch == untilEpoch+phase0.Epoch(epochsPerPeriod))
+	}
+
+	_, err = service.(client.SyncCommitteeDutiesProvider).SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+		Epoch:   epoch,
+		ToEpoch: untilEpoch + phase0.Epoch(epochsPerPeriod),
+		Indices: []phase0.ValidatorIndex{0},
+	})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+}
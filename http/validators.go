@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
@@ -52,19 +53,19 @@ func (s *Service) Validators(ctx context.Context,
 	if opts.State == "" {
 		return nil, errors.Join(errors.New("no state specified"), client.ErrInvalidOptions)
 	}
+	validatorStates, err := validatorStatesFilter(opts.ValidatorStates)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid validator states"), err, client.ErrInvalidOptions)
+	}
 	span.SetAttributes(attribute.Int("validators", len(opts.Indices)+len(opts.PubKeys)))
 
 	if len(opts.Indices) == 0 && len(opts.PubKeys) == 0 {
 		// Request is for all validators; fetch from state.
-		return s.validatorsFromState(ctx, opts)
+		return s.validatorsFromState(ctx, opts, validatorStates)
 	}
 
-	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", opts.State)
-	query := ""
-
 	body := &validatorsBody{
-		IDs:      make([]string, 0),
-		Statuses: make([]string, 0),
+		IDs: make([]string, 0),
 	}
 	for i := range opts.Indices {
 		body.IDs = append(body.IDs, fmt.Sprintf("%d", opts.Indices[i]))
@@ -72,18 +73,38 @@ func (s *Service) Validators(ctx context.Context,
 	for i := range opts.PubKeys {
 		body.IDs = append(body.IDs, opts.PubKeys[i].String())
 	}
-	for i := range opts.ValidatorStates {
-		body.Statuses = append(body.Statuses, opts.ValidatorStates[i].String())
+	if len(validatorStates) > 0 && s.supportsValidatorStatesFilter() {
+		body.Statuses = make([]string, 0, len(validatorStates))
+		for _, validatorState := range validatorStates {
+			body.Statuses = append(body.Statuses, validatorState.String())
+		}
 	}
 
-	reqData, err := json.Marshal(body)
+	httpResponse, err := s.postValidators(ctx, opts, body)
 	if err != nil {
-		return nil, errors.Join(errors.New("failed to marshal request data"), err)
-	}
+		var apiErr *api.Error
+		if len(body.Statuses) == 0 || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+			return nil, errors.Join(errors.New("failed to request validators"), err)
+		}
 
-	httpResponse, err := s.post(ctx, endpoint, query, &opts.Common, bytes.NewReader(reqData), ContentTypeJSON, map[string]string{})
-	if err != nil {
-		return nil, errors.Join(errors.New("failed to request validators"), err)
+		// The node rejected the request, possibly because of the status
+		// filter; retry without it and filter locally.  The filter is only
+		// marked as unsupported if the node said that it was the cause, as
+		// the rejection could also be due to the validator IDs.
+		if rejectsStatuses(apiErr) {
+			s.log.Debug().Err(err).Msg("Node does not support validator status filter; filtering locally")
+			s.capabilitiesMu.Lock()
+			s.validatorStatesFilterUnsupported = true
+			s.capabilitiesMu.Unlock()
+		} else {
+			s.log.Debug().Err(err).Msg("Node rejected request with validator status filter; retrying without")
+		}
+
+		body.Statuses = nil
+		httpResponse, err = s.postValidators(ctx, opts, body)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to request validators"), err)
+		}
 	}
 
 	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.Validator{})
@@ -91,9 +112,16 @@ func (s *Service) Validators(ctx context.Context,
 		return nil, err
 	}
 
-	// Data is returned as an array but we want it as a map.
+	// Data is returned as an array but we want it as a map.  The status
+	// filter is applied here as well, as the node may not have applied it.
+	filter := validatorStatesMap(validatorStates)
 	mapData := make(map[phase0.ValidatorIndex]*apiv1.Validator)
 	for _, validator := range data {
+		if len(filter) > 0 {
+			if _, exists := filter[validator.Status]; !exists {
+				continue
+			}
+		}
 		mapData[validator.Index] = validator
 	}
 
@@ -103,11 +131,70 @@ func (s *Service) Validators(ctx context.Context,
 	}, nil
 }
 
+// rejectsStatuses returns true if the error from the node refers to the
+// statuses field of the request.
+func rejectsStatuses(apiErr *api.Error) bool {
+	return bytes.Contains(bytes.ToLower(apiErr.Data), []byte("statuses"))
+}
+
+// postValidators posts the validators request.
+func (s *Service) postValidators(ctx context.Context,
+	opts *api.ValidatorsOpts,
+	body *validatorsBody,
+) (
+	*httpResponse,
+	error,
+) {
+	reqData, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to marshal request data"), err)
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", opts.State)
+	query := ""
+
+	return s.post(ctx, endpoint, query, &opts.Common, bytes.NewReader(reqData), ContentTypeJSON, map[string]string{})
+}
+
+// supportsValidatorStatesFilter returns false if the node has been found to
+// reject validator status filters.
+func (s *Service) supportsValidatorStatesFilter() bool {
+	s.capabilitiesMu.RLock()
+	defer s.capabilitiesMu.RUnlock()
+
+	return !s.validatorStatesFilterUnsupported
+}
+
+// validatorStatesFilter checks the supplied validator states, returning them
+// with duplicates removed.
+func validatorStatesFilter(validatorStates []apiv1.ValidatorState) ([]apiv1.ValidatorState, error) {
+	if len(validatorStates) == 0 {
+		return nil, nil
+	}
+
+	statuses := make([]string, 0, len(validatorStates))
+	for _, validatorState := range validatorStates {
+		statuses = append(statuses, validatorState.String())
+	}
+
+	return apiv1.ValidatorStatesFromStrings(statuses)
+}
+
+func validatorStatesMap(validatorStates []apiv1.ValidatorState) map[apiv1.ValidatorState]struct{} {
+	res := make(map[apiv1.ValidatorState]struct{}, len(validatorStates))
+	for _, validatorState := range validatorStates {
+		res[validatorState] = struct{}{}
+	}
+
+	return res
+}
+
 // validatorsFromState fetches all validators from state.
 // This is more efficient than fetching the validators endpoint, as validators uses JSON only,
 // whereas state can be provided using SSZ.
 func (s *Service) validatorsFromState(ctx context.Context,
 	opts *api.ValidatorsOpts,
+	validatorStates []apiv1.ValidatorState,
 ) (
 	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
 	error,
@@ -148,18 +235,15 @@ func (s *Service) validatorsFromState(ctx context.Context,
 		return nil, err
 	}
 
-	validatorStates := make(map[apiv1.ValidatorState]struct{})
-	for _, validatorState := range opts.ValidatorStates {
-		validatorStates[validatorState] = struct{}{}
-	}
+	filter := validatorStatesMap(validatorStates)
 
 	res := make(map[phase0.ValidatorIndex]*apiv1.Validator, len(validators))
 	for i, validator := range validators {
 		index := phase0.ValidatorIndex(i)
 
 		state := apiv1.ValidatorToState(validator, &balances[i], epoch, farFutureEpoch)
-		if len(validatorStates) > 0 {
-			if _, exists := validatorStates[state]; !exists {
+		if len(filter) > 0 {
+			if _, exists := filter[state]; !exists {
 				// We want specific states, and this isn't one of them.  Ignore.
 				continue
 			}
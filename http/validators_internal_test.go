@@ -0,0 +1,169 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+const validatorsResponse = `{"execution_optimistic":false,"finalized":false,"data":[` +
+	`{"index":"1","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x00fad2a6bfb0e7f1f0f45460944fbd8dfa7f37da06a4d13b3983cc90bb46963b","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}},` +
+	`{"index":"2","balance":"32000000000","status":"pending_queued","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"18446744073709551615","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}` +
+	`]}`
+
+func TestValidatorsStatesFilterFallback(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	requestedStatuses := make([][]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := &validatorsBody{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+		mu.Lock()
+		requestedStatuses = append(requestedStatuses, body.Statuses)
+		mu.Unlock()
+
+		if len(body.Statuses) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"unknown field statuses"}`))
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(validatorsResponse))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+	}
+
+	opts := &api.ValidatorsOpts{
+		State:           "head",
+		Indices:         []phase0.ValidatorIndex{1, 2},
+		ValidatorStates: []apiv1.ValidatorState{apiv1.ValidatorStatePendingQueued, apiv1.ValidatorStatePendingInitialized},
+	}
+
+	// First request is rejected by the node, and retried without the filter.
+	response, err := s.Validators(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Contains(t, response.Data, phase0.ValidatorIndex(2))
+	require.Equal(t, [][]string{{"pending_queued", "pending_initialized"}, nil}, requestedStatuses)
+	require.False(t, s.supportsValidatorStatesFilter())
+
+	// Subsequent requests do not send the filter.
+	response, err = s.Validators(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Len(t, requestedStatuses, 3)
+	require.Nil(t, requestedStatuses[2])
+
+	// No filter returns all validators.
+	response, err = s.Validators(ctx, &api.ValidatorsOpts{
+		State:   "head",
+		Indices: []phase0.ValidatorIndex{1, 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+}
+
+func TestValidatorsStatesFilterOtherRejection(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	requestedStatuses := make([][]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := &validatorsBody{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+		mu.Lock()
+		requestedStatuses = append(requestedStatuses, body.Statuses)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400,"message":"invalid validator ID"}`))
+	}))
+	defer server.Close()
+
+	base, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:              zerolog.Nop(),
+		base:             base,
+		address:          server.URL,
+		client:           server.Client(),
+		timeout:          time.Second,
+		pingSem:          semaphore.NewWeighted(1),
+		hooks:            &Hooks{},
+		connectionActive: true,
+	}
+
+	// The request is retried once without the filter, but the filter is not
+	// marked as unsupported as the node did not reject it.
+	_, err = s.Validators(ctx, &api.ValidatorsOpts{
+		State:           "head",
+		Indices:         []phase0.ValidatorIndex{1},
+		ValidatorStates: []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing},
+	})
+	require.Error(t, err)
+	require.Equal(t, [][]string{{"active_ongoing"}, nil}, requestedStatuses)
+	require.True(t, s.supportsValidatorStatesFilter())
+}
+
+func TestValidatorsStatesFilterInvalid(t *testing.T) {
+	s := &Service{
+		log:              zerolog.Nop(),
+		connectionActive: true,
+	}
+
+	_, err := s.Validators(context.Background(), &api.ValidatorsOpts{
+		State:           "head",
+		Indices:         []phase0.ValidatorIndex{1},
+		ValidatorStates: []apiv1.ValidatorState{apiv1.ValidatorStateUnknown},
+	})
+	require.True(t, errors.Is(err, client.ErrInvalidOptions))
+}
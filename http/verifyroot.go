@@ -0,0 +1,61 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// rootFromID returns the root if the supplied block or state ID is a root.
+func rootFromID(id string) (phase0.Root, bool) {
+	if !strings.HasPrefix(id, "0x") || len(id) != 2+2*phase0.RootLength {
+		return phase0.Root{}, false
+	}
+
+	data, err := hex.DecodeString(id[2:])
+	if err != nil {
+		return phase0.Root{}, false
+	}
+
+	return phase0.Root(data), true
+}
+
+// verifyRoot confirms that the root of returned data matches the requested
+// ID, if the ID is a root.
+func verifyRoot(id string, rootFunc func() (phase0.Root, error)) error {
+	requested, isRoot := rootFromID(id)
+	if !isRoot {
+		// Nothing to verify.
+		return nil
+	}
+
+	returned, err := rootFunc()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain root of returned data"), err)
+	}
+	if returned != requested {
+		return errors.Join(&api.RootMismatchError{
+			Requested: requested,
+			Returned:  returned,
+		}, client.ErrInconsistentResult)
+	}
+
+	return nil
+}
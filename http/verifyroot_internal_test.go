@@ -0,0 +1,88 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyRoot(t *testing.T) {
+	root := phase0.Root{0x01, 0x02}
+	rootID := "0x0102000000000000000000000000000000000000000000000000000000000000"
+
+	tests := []struct {
+		name     string
+		id       string
+		rootFunc func() (phase0.Root, error)
+		err      string
+		mismatch bool
+	}{
+		{
+			name:     "Head",
+			id:       "head",
+			rootFunc: func() (phase0.Root, error) { return phase0.Root{}, nil },
+		},
+		{
+			name:     "Slot",
+			id:       "12345",
+			rootFunc: func() (phase0.Root, error) { return phase0.Root{}, nil },
+		},
+		{
+			name:     "InvalidHex",
+			id:       "0xzz02000000000000000000000000000000000000000000000000000000000000",
+			rootFunc: func() (phase0.Root, error) { return phase0.Root{}, nil },
+		},
+		{
+			name:     "RootFuncError",
+			id:       rootID,
+			rootFunc: func() (phase0.Root, error) { return phase0.Root{}, errors.New("no data") },
+			err:      "failed to obtain root of returned data\nno data",
+		},
+		{
+			name:     "Mismatch",
+			id:       rootID,
+			rootFunc: func() (phase0.Root, error) { return phase0.Root{0x03}, nil },
+			err:      "returned data has root 0x0300000000000000000000000000000000000000000000000000000000000000; requested 0x0102000000000000000000000000000000000000000000000000000000000000\ninconsistent result",
+			mismatch: true,
+		},
+		{
+			name:     "Match",
+			id:       rootID,
+			rootFunc: func() (phase0.Root, error) { return root, nil },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verifyRoot(test.id, test.rootFunc)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			if test.mismatch {
+				var mismatchErr *api.RootMismatchError
+				require.ErrorAs(t, err, &mismatchErr)
+				require.Equal(t, root, mismatchErr.Requested)
+				require.ErrorIs(t, err, client.ErrInconsistentResult)
+			}
+		})
+	}
+}
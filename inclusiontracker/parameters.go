@@ -0,0 +1,116 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inclusiontracker
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                   zerolog.Level
+	chainTime                  *chaintime.Service
+	signedBeaconBlockProvider  consensusclient.SignedBeaconBlockProvider
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	beaconCommitteesProvider   consensusclient.BeaconCommitteesProvider
+	maxInclusionDistance       uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the provider from which blocks are scanned for attestations.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithBeaconBlockHeadersProvider sets the provider from which canonical block roots are obtained.
+func WithBeaconBlockHeadersProvider(provider consensusclient.BeaconBlockHeadersProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconBlockHeadersProvider = provider
+	})
+}
+
+// WithBeaconCommitteesProvider sets the provider from which committee membership is obtained.
+func WithBeaconCommitteesProvider(provider consensusclient.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithMaxInclusionDistance sets the number of slots after an attestation's
+// slot within which it must be included before it is reported as missed.
+// Defaults to the number of slots in an epoch.
+func WithMaxInclusionDistance(distance uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxInclusionDistance = distance
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider specified")
+	}
+	if parameters.beaconBlockHeadersProvider == nil {
+		return nil, errors.New("no beacon block headers provider specified")
+	}
+	if parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("no beacon committees provider specified")
+	}
+	if parameters.maxInclusionDistance == 0 {
+		parameters.maxInclusionDistance = parameters.chainTime.SlotsPerEpoch()
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,435 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inclusiontracker tracks the inclusion of submitted attestations in
+// subsequent blocks, reporting when and how well each validator's attestation
+// was included.
+package inclusiontracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Submission is an attestation submitted by one or more validators.
+type Submission struct {
+	// Data is the data of the attestation.
+	Data *phase0.AttestationData
+	// ValidatorIndices are the indices of the validators that attested.
+	ValidatorIndices []phase0.ValidatorIndex
+}
+
+// Result is the inclusion result for a validator's attestation.
+type Result struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Slot is the slot of the attestation.
+	Slot phase0.Slot
+	// Included is true if the attestation was included in a block within
+	// the maximum inclusion distance.
+	Included bool
+	// InclusionSlot is the slot of the first block in which the attestation
+	// was included.
+	InclusionSlot phase0.Slot
+	// InclusionDistance is the number of slots between the attestation slot
+	// and the inclusion slot.
+	InclusionDistance uint64
+	// HeadCorrect is true if the attestation voted for the canonical head.
+	HeadCorrect bool
+	// TargetCorrect is true if the attestation voted for the canonical target.
+	TargetCorrect bool
+	// SourceCorrect is true if the attestation voted for the correct source.
+	// Attestations with an incorrect source cannot be included, so this is
+	// true if the attestation was included.
+	SourceCorrect bool
+}
+
+type pendingAttestation struct {
+	data       *phase0.AttestationData
+	validators map[phase0.ValidatorIndex]struct{}
+}
+
+// votes contains the correctness of an attestation's votes.
+type votes struct {
+	head   bool
+	target bool
+}
+
+// Service tracks attestation inclusion.
+type Service struct {
+	log                        zerolog.Logger
+	chainTime                  *chaintime.Service
+	signedBeaconBlockProvider  consensusclient.SignedBeaconBlockProvider
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	beaconCommitteesProvider   consensusclient.BeaconCommitteesProvider
+	maxInclusionDistance       uint64
+
+	mu      sync.Mutex
+	pending map[phase0.Root]*pendingAttestation
+
+	// processMu serializes slot processing and guards the caches below.
+	processMu  sync.Mutex
+	committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex
+	roots      map[phase0.Slot]phase0.Root
+}
+
+// New creates a new attestation inclusion tracker.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "inclusiontracker").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                        log,
+		chainTime:                  parameters.chainTime,
+		signedBeaconBlockProvider:  parameters.signedBeaconBlockProvider,
+		beaconBlockHeadersProvider: parameters.beaconBlockHeadersProvider,
+		beaconCommitteesProvider:   parameters.beaconCommitteesProvider,
+		maxInclusionDistance:       parameters.maxInclusionDistance,
+		pending:                    make(map[phase0.Root]*pendingAttestation),
+		committees:                 make(map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex),
+		roots:                      make(map[phase0.Slot]phase0.Root),
+	}, nil
+}
+
+// Track starts tracking the inclusion of a submitted attestation.
+func (s *Service) Track(submission *Submission) error {
+	if submission == nil {
+		return errors.New("no submission supplied")
+	}
+	if submission.Data == nil {
+		return errors.New("no attestation data supplied")
+	}
+	if submission.Data.Source == nil || submission.Data.Target == nil {
+		return errors.New("attestation data missing checkpoints")
+	}
+	if len(submission.ValidatorIndices) == 0 {
+		return errors.New("no validator indices supplied")
+	}
+
+	dataRoot, err := submission.Data.HashTreeRoot()
+	if err != nil {
+		return errors.Join(errors.New("failed to calculate attestation data root"), err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending, exists := s.pending[dataRoot]
+	if !exists {
+		pending = &pendingAttestation{
+			data:       submission.Data,
+			validators: make(map[phase0.ValidatorIndex]struct{}, len(submission.ValidatorIndices)),
+		}
+		s.pending[dataRoot] = pending
+	}
+	for _, index := range submission.ValidatorIndices {
+		pending.validators[index] = struct{}{}
+	}
+
+	return nil
+}
+
+// Tracked returns the number of validators whose attestations are awaiting inclusion.
+func (s *Service) Tracked() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tracked := 0
+	for _, pending := range s.pending {
+		tracked += len(pending.validators)
+	}
+
+	return tracked
+}
+
+// ProcessSlot scans the block at the given slot for tracked attestations,
+// returning results for validators whose attestations were included in the
+// block, along with results for validators whose attestations can no longer
+// be included.  Slots should be processed in order once the block for the
+// slot, if any, is available.
+func (s *Service) ProcessSlot(ctx context.Context, slot phase0.Slot) ([]*Result, error) {
+	s.processMu.Lock()
+	defer s.processMu.Unlock()
+
+	// Find the attesters of tracked attestations in the block.
+	included, err := s.includedAttesters(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find tracked attestations that have passed their inclusion window.
+	expired := make(map[phase0.Root]*phase0.AttestationData)
+	s.mu.Lock()
+	for dataRoot, pending := range s.pending {
+		if uint64(pending.data.Slot)+s.maxInclusionDistance <= uint64(slot) {
+			expired[dataRoot] = pending.data
+		}
+	}
+	s.mu.Unlock()
+
+	// Obtain the correctness of the votes before altering state, so that a
+	// failure can be retried.
+	attestationVotes := make(map[phase0.Root]*votes, len(included)+len(expired))
+	for dataRoot, attestation := range included {
+		if attestationVotes[dataRoot], err = s.votes(ctx, attestation.data); err != nil {
+			return nil, err
+		}
+	}
+	for dataRoot, data := range expired {
+		if attestationVotes[dataRoot], err = s.votes(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*Result, 0)
+	s.mu.Lock()
+	for dataRoot, attestation := range included {
+		pending, exists := s.pending[dataRoot]
+		if !exists {
+			continue
+		}
+		for _, index := range attestation.validators {
+			if _, exists := pending.validators[index]; !exists {
+				continue
+			}
+			delete(pending.validators, index)
+			results = append(results, &Result{
+				ValidatorIndex:    index,
+				Slot:              pending.data.Slot,
+				Included:          true,
+				InclusionSlot:     slot,
+				InclusionDistance: uint64(slot - pending.data.Slot),
+				HeadCorrect:       attestationVotes[dataRoot].head,
+				TargetCorrect:     attestationVotes[dataRoot].target,
+				SourceCorrect:     true,
+			})
+		}
+		if len(pending.validators) == 0 {
+			delete(s.pending, dataRoot)
+		}
+	}
+	for dataRoot := range expired {
+		pending, exists := s.pending[dataRoot]
+		if !exists {
+			continue
+		}
+		for index := range pending.validators {
+			results = append(results, &Result{
+				ValidatorIndex: index,
+				Slot:           pending.data.Slot,
+				HeadCorrect:    attestationVotes[dataRoot].head,
+				TargetCorrect:  attestationVotes[dataRoot].target,
+			})
+		}
+		delete(s.pending, dataRoot)
+	}
+	s.mu.Unlock()
+
+	s.prune(slot)
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Slot != results[j].Slot {
+			return results[i].Slot < results[j].Slot
+		}
+
+		return results[i].ValidatorIndex < results[j].ValidatorIndex
+	})
+
+	return results, nil
+}
+
+type includedAttestation struct {
+	data       *phase0.AttestationData
+	validators []phase0.ValidatorIndex
+}
+
+// includedAttesters returns the attesters of tracked attestations included in
+// the block at the given slot, keyed by attestation data root.
+func (s *Service) includedAttesters(ctx context.Context, slot phase0.Slot) (map[phase0.Root]*includedAttestation, error) {
+	res := make(map[phase0.Root]*includedAttestation)
+
+	block, err := consensusclient.SlotBlock(ctx, s.signedBeaconBlockProvider, slot)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return res, nil
+	}
+
+	attestations, err := block.Attestations()
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to obtain attestations for slot %d", slot), err)
+	}
+
+	for _, attestation := range attestations {
+		data, err := attestation.Data()
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain attestation data for slot %d", slot), err)
+		}
+		dataRoot, err := data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to calculate attestation data root"), err)
+		}
+
+		s.mu.Lock()
+		_, tracked := s.pending[dataRoot]
+		s.mu.Unlock()
+		if !tracked {
+			continue
+		}
+
+		committees, err := s.slotCommittees(ctx, data.Slot)
+		if err != nil {
+			return nil, err
+		}
+		validators, err := attestation.AttestingIndices(committees)
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain attesting indices"), err)
+		}
+		if _, exists := res[dataRoot]; !exists {
+			res[dataRoot] = &includedAttestation{
+				data: data,
+			}
+		}
+		res[dataRoot].validators = append(res[dataRoot].validators, validators...)
+	}
+
+	return res, nil
+}
+
+// slotCommittees returns the committees for the given slot.
+func (s *Service) slotCommittees(ctx context.Context, slot phase0.Slot) (map[phase0.CommitteeIndex][]phase0.ValidatorIndex, error) {
+	if committees, exists := s.committees[slot]; exists {
+		return committees, nil
+	}
+
+	epoch := s.chainTime.SlotToEpoch(slot)
+	response, err := s.beaconCommitteesProvider.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: "head",
+		Epoch: &epoch,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to obtain beacon committees for epoch %d", epoch), err)
+	}
+	for _, committee := range response.Data {
+		if _, exists := s.committees[committee.Slot]; !exists {
+			s.committees[committee.Slot] = make(map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+		}
+		s.committees[committee.Slot][committee.Index] = committee.Validators
+	}
+
+	committees, exists := s.committees[slot]
+	if !exists {
+		return nil, fmt.Errorf("no committees for slot %d", slot)
+	}
+
+	return committees, nil
+}
+
+// votes returns the correctness of the votes in the attestation data.
+func (s *Service) votes(ctx context.Context, data *phase0.AttestationData) (*votes, error) {
+	headRoot, err := s.canonicalRoot(ctx, data.Slot)
+	if err != nil {
+		return nil, err
+	}
+	targetRoot, err := s.canonicalRoot(ctx, s.chainTime.EpochStartSlot(data.Target.Epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	return &votes{
+		head:   data.BeaconBlockRoot == headRoot,
+		target: data.Target.Root == targetRoot,
+	}, nil
+}
+
+// canonicalRoot returns the root of the canonical block at or before the given slot.
+func (s *Service) canonicalRoot(ctx context.Context, slot phase0.Slot) (phase0.Root, error) {
+	var root phase0.Root
+	blockSlot := slot
+	for {
+		if cachedRoot, exists := s.roots[blockSlot]; exists {
+			root = cachedRoot
+
+			break
+		}
+
+		response, err := s.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+			Block: strconv.FormatUint(uint64(blockSlot), 10),
+		})
+		if err == nil && response.Data != nil && response.Data.Canonical {
+			root = response.Data.Root
+
+			break
+		}
+		if err != nil {
+			var apiErr *api.Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+				return phase0.Root{}, errors.Join(fmt.Errorf("failed to obtain block header for slot %d", blockSlot), err)
+			}
+		}
+
+		if blockSlot == 0 {
+			return phase0.Root{}, fmt.Errorf("no canonical block at or before slot %d", slot)
+		}
+		blockSlot--
+	}
+
+	for ; blockSlot <= slot; blockSlot++ {
+		s.roots[blockSlot] = root
+	}
+
+	return root, nil
+}
+
+// prune removes cached data that is no longer required.
+func (s *Service) prune(slot phase0.Slot) {
+	// Tracked attestations may be for slots up to the inclusion distance
+	// ago, and their targets up to an epoch before that.
+	retain := s.maxInclusionDistance + 2*s.chainTime.SlotsPerEpoch()
+	if uint64(slot) <= retain {
+		return
+	}
+	minSlot := slot - phase0.Slot(retain)
+
+	for cachedSlot := range s.committees {
+		if cachedSlot < minSlot {
+			delete(s.committees, cachedSlot)
+		}
+	}
+	for cachedSlot := range s.roots {
+		if cachedSlot < minSlot {
+			delete(s.roots, cachedSlot)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inclusiontracker_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/inclusiontracker"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// headersClient creates a test client with a canonical block header at every
+// slot except 6, and the supplied blocks.
+func headersClient(t *testing.T, blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now(), nil)
+	require.NoError(t, err)
+	testfixtures.SetBeaconCommittees(client)
+	testfixtures.SetBlocks(client, blocks)
+	client.BeaconBlockHeaderFunc = func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+		slot, err := strconv.ParseUint(opts.Block, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if slot == 6 {
+			return nil, testfixtures.ErrNotFound
+		}
+
+		return &api.Response[*apiv1.BeaconBlockHeader]{
+			Data: &apiv1.BeaconBlockHeader{
+				Root:      phase0.Root{byte(slot)},
+				Canonical: true,
+			},
+		}, nil
+	}
+
+	return client
+}
+
+func newService(t *testing.T, client *mock.Service) *inclusiontracker.Service {
+	t.Helper()
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	s, err := inclusiontracker.New(context.Background(),
+		inclusiontracker.WithLogLevel(zerolog.Disabled),
+		inclusiontracker.WithChainTime(chainTime),
+		inclusiontracker.WithSignedBeaconBlockProvider(client),
+		inclusiontracker.WithBeaconBlockHeadersProvider(client),
+		inclusiontracker.WithBeaconCommitteesProvider(client),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+func attestationData(slot phase0.Slot, index phase0.CommitteeIndex, head phase0.Root) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            slot,
+		Index:           index,
+		BeaconBlockRoot: head,
+		Source:          &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{
+			Epoch: phase0.Epoch(slot / 4),
+			Root:  phase0.Root{byte(slot / 4 * 4)},
+		},
+	}
+}
+
+func bits(length uint64, set ...uint64) bitfield.Bitlist {
+	res := bitfield.NewBitlist(length)
+	for _, bit := range set {
+		res.SetBitAt(bit, true)
+	}
+
+	return res
+}
+
+func phase0Block(slot phase0.Slot, attestations ...*phase0.Attestation) *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: slot,
+				Body: &phase0.BeaconBlockBody{
+					Attestations: attestations,
+				},
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	client := headersClient(t, nil)
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []inclusiontracker.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []inclusiontracker.Parameter{
+				inclusiontracker.WithLogLevel(zerolog.Disabled),
+				inclusiontracker.WithSignedBeaconBlockProvider(client),
+				inclusiontracker.WithBeaconBlockHeadersProvider(client),
+				inclusiontracker.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "SignedBeaconBlockProviderMissing",
+			params: []inclusiontracker.Parameter{
+				inclusiontracker.WithLogLevel(zerolog.Disabled),
+				inclusiontracker.WithChainTime(chainTime),
+				inclusiontracker.WithBeaconBlockHeadersProvider(client),
+				inclusiontracker.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno signed beacon block provider specified",
+		},
+		{
+			name: "BeaconBlockHeadersProviderMissing",
+			params: []inclusiontracker.Parameter{
+				inclusiontracker.WithLogLevel(zerolog.Disabled),
+				inclusiontracker.WithChainTime(chainTime),
+				inclusiontracker.WithSignedBeaconBlockProvider(client),
+				inclusiontracker.WithBeaconCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno beacon block headers provider specified",
+		},
+		{
+			name: "BeaconCommitteesProviderMissing",
+			params: []inclusiontracker.Parameter{
+				inclusiontracker.WithLogLevel(zerolog.Disabled),
+				inclusiontracker.WithChainTime(chainTime),
+				inclusiontracker.WithSignedBeaconBlockProvider(client),
+				inclusiontracker.WithBeaconBlockHeadersProvider(client),
+			},
+			err: "problem with parameters\nno beacon committees provider specified",
+		},
+		{
+			name: "Good",
+			params: []inclusiontracker.Parameter{
+				inclusiontracker.WithLogLevel(zerolog.Disabled),
+				inclusiontracker.WithChainTime(chainTime),
+				inclusiontracker.WithSignedBeaconBlockProvider(client),
+				inclusiontracker.WithBeaconBlockHeadersProvider(client),
+				inclusiontracker.WithBeaconCommitteesProvider(client),
+				inclusiontracker.WithMaxInclusionDistance(8),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := inclusiontracker.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTrack(t *testing.T) {
+	s := newService(t, headersClient(t, nil))
+
+	require.EqualError(t, s.Track(nil), "no submission supplied")
+	require.EqualError(t, s.Track(&inclusiontracker.Submission{}), "no attestation data supplied")
+	require.EqualError(t, s.Track(&inclusiontracker.Submission{
+		Data: &phase0.AttestationData{},
+	}), "attestation data missing checkpoints")
+	require.EqualError(t, s.Track(&inclusiontracker.Submission{
+		Data: attestationData(2, 0, phase0.Root{2}),
+	}), "no validator indices supplied")
+
+	require.NoError(t, s.Track(&inclusiontracker.Submission{
+		Data:             attestationData(2, 0, phase0.Root{2}),
+		ValidatorIndices: []phase0.ValidatorIndex{20, 21},
+	}))
+	require.NoError(t, s.Track(&inclusiontracker.Submission{
+		Data:             attestationData(2, 0, phase0.Root{2}),
+		ValidatorIndices: []phase0.ValidatorIndex{21, 22},
+	}))
+	require.Equal(t, 3, s.Tracked())
+}
+
+func TestProcessSlot(t *testing.T) {
+	goodData := attestationData(2, 0, phase0.Root{2})
+	badHeadData := attestationData(3, 1, phase0.Root{0xff})
+	blocks := map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+		3: phase0Block(3, &phase0.Attestation{
+			AggregationBits: bits(4, 0, 1),
+			Data:            goodData,
+		}),
+		4: phase0Block(4),
+		5: phase0Block(5,
+			&phase0.Attestation{
+				AggregationBits: bits(4, 1, 2),
+				Data:            goodData,
+			},
+			// Untracked attestation.
+			&phase0.Attestation{
+				AggregationBits: bits(4, 0),
+				Data:            attestationData(4, 0, phase0.Root{4}),
+			},
+		),
+	}
+	s := newService(t, headersClient(t, blocks))
+
+	require.NoError(t, s.Track(&inclusiontracker.Submission{
+		Data:             goodData,
+		ValidatorIndices: []phase0.ValidatorIndex{20, 21, 22},
+	}))
+	require.NoError(t, s.Track(&inclusiontracker.Submission{
+		Data:             badHeadData,
+		ValidatorIndices: []phase0.ValidatorIndex{34, 35},
+	}))
+
+	results, err := s.ProcessSlot(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, []*inclusiontracker.Result{
+		{ValidatorIndex: 20, Slot: 2, Included: true, InclusionSlot: 3, InclusionDistance: 1, HeadCorrect: true, TargetCorrect: true, SourceCorrect: true},
+		{ValidatorIndex: 21, Slot: 2, Included: true, InclusionSlot: 3, InclusionDistance: 1, HeadCorrect: true, TargetCorrect: true, SourceCorrect: true},
+	}, results)
+	require.Equal(t, 3, s.Tracked())
+
+	results, err = s.ProcessSlot(context.Background(), 4)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// Validator 21 is included again, which is ignored.
+	results, err = s.ProcessSlot(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, []*inclusiontracker.Result{
+		{ValidatorIndex: 22, Slot: 2, Included: true, InclusionSlot: 5, InclusionDistance: 3, HeadCorrect: true, TargetCorrect: true, SourceCorrect: true},
+	}, results)
+	require.Equal(t, 2, s.Tracked())
+
+	// Slot 6 has no block.
+	results, err = s.ProcessSlot(context.Background(), 6)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// Attestation for slot 3 is no longer includable.
+	results, err = s.ProcessSlot(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, []*inclusiontracker.Result{
+		{ValidatorIndex: 34, Slot: 3, TargetCorrect: true},
+		{ValidatorIndex: 35, Slot: 3, TargetCorrect: true},
+	}, results)
+	require.Equal(t, 0, s.Tracked())
+}
+
+func TestProcessSlotElectra(t *testing.T) {
+	data := attestationData(9, 0, phase0.Root{8})
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(0, true)
+	committeeBits.SetBitAt(1, true)
+	blocks := map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+		10: {
+			Version: spec.DataVersionElectra,
+			Electra: &electra.SignedBeaconBlock{
+				Message: &electra.BeaconBlock{
+					Slot: 10,
+					Body: &electra.BeaconBlockBody{
+						Attestations: []*electra.Attestation{
+							{
+								// Validators 93 from committee 0 and 94 from committee 1.
+								AggregationBits: bits(8, 3, 4),
+								Data:            data,
+								CommitteeBits:   committeeBits,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	s := newService(t, headersClient(t, blocks))
+
+	require.NoError(t, s.Track(&inclusiontracker.Submission{
+		Data:             data,
+		ValidatorIndices: []phase0.ValidatorIndex{93, 94, 95},
+	}))
+
+	results, err := s.ProcessSlot(context.Background(), 10)
+	require.NoError(t, err)
+	// The head vote is for the block at slot 8, but slot 9 has a block.
+	require.Equal(t, []*inclusiontracker.Result{
+		{ValidatorIndex: 93, Slot: 9, Included: true, InclusionSlot: 10, InclusionDistance: 1, TargetCorrect: true, SourceCorrect: true},
+		{ValidatorIndex: 94, Slot: 9, Included: true, InclusionSlot: 10, InclusionDistance: 1, TargetCorrect: true, SourceCorrect: true},
+	}, results)
+	require.Equal(t, 1, s.Tracked())
+}
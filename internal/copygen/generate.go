@@ -0,0 +1,384 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	modulePath  = "github.com/attestantio/go-eth2-client"
+	uint256Path = "github.com/holiman/uint256"
+	outputFile  = "copy.go"
+)
+
+// generate generates the copy files for the given root containers, keyed by
+// their path relative to the module root.
+func generate(root string, roots []reflect.Type) (map[string][]byte, error) {
+	packages, err := containers(roots)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(packages))
+	for pkgPath, types := range packages {
+		dir := strings.TrimPrefix(strings.TrimPrefix(pkgPath, modulePath), "/")
+		receivers, err := receiverNames(filepath.Join(root, dir))
+		if err != nil {
+			return nil, err
+		}
+		data, err := generateFile(pkgPath, types, receivers)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to generate %s", pkgPath), err)
+		}
+		files[filepath.Join(dir, outputFile)] = data
+	}
+
+	return files, nil
+}
+
+// containers returns the roots and all module containers reachable from
+// them, grouped by package and sorted by name.
+func containers(roots []reflect.Type) (map[string][]reflect.Type, error) {
+	seen := make(map[reflect.Type]bool)
+	queue := make([]reflect.Type, 0, len(roots))
+	for _, t := range roots {
+		if t.Kind() != reflect.Struct || !strings.HasPrefix(t.PkgPath(), modulePath) {
+			return nil, fmt.Errorf("%s is not a container in this module", t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			queue = append(queue, t)
+		}
+	}
+
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				return nil, fmt.Errorf("%s.%s: unexported fields are not supported", t, field.Name)
+			}
+			if elem := containerElem(field.Type); elem != nil && !seen[elem] {
+				seen[elem] = true
+				queue = append(queue, elem)
+			}
+		}
+	}
+
+	packages := make(map[string][]reflect.Type)
+	for t := range seen {
+		packages[t.PkgPath()] = append(packages[t.PkgPath()], t)
+	}
+	for _, types := range packages {
+		sort.Slice(types, func(i, j int) bool { return types[i].Name() < types[j].Name() })
+	}
+
+	return packages, nil
+}
+
+// containerElem returns the container referenced by a pointer or a slice of
+// pointers, or nil if the type does not reference a container.
+func containerElem(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if isContainerPtr(t) {
+		return t.Elem()
+	}
+
+	return nil
+}
+
+func isContainerPtr(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer &&
+		t.Elem().Kind() == reflect.Struct &&
+		strings.HasPrefix(t.Elem().PkgPath(), modulePath)
+}
+
+func isUint256Ptr(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer &&
+		t.Elem().PkgPath() == uint256Path &&
+		t.Elem().Name() == "Int"
+}
+
+func isByteSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// isValue returns true if the type can be copied by assignment and compared
+// with ==.
+func isValue(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.String:
+		return true
+	case reflect.Array:
+		return isValue(t.Elem())
+	default:
+		return false
+	}
+}
+
+// receiverNames returns the receiver names used by existing methods in the
+// package directory, so that generated methods match them.
+func receiverNames(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	receivers := make(map[string]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == outputFile {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			fn, isFunc := decl.(*ast.FuncDecl)
+			if !isFunc || fn.Recv == nil || len(fn.Recv.List) != 1 || len(fn.Recv.List[0].Names) != 1 {
+				continue
+			}
+			recvType := fn.Recv.List[0].Type
+			if star, isStar := recvType.(*ast.StarExpr); isStar {
+				recvType = star.X
+			}
+			ident, isIdent := recvType.(*ast.Ident)
+			if !isIdent {
+				continue
+			}
+			if _, exists := receivers[ident.Name]; !exists {
+				receivers[ident.Name] = fn.Recv.List[0].Names[0].Name
+			}
+		}
+	}
+
+	return receivers, nil
+}
+
+type fileGenerator struct {
+	pkgPath string
+	imports map[string]bool
+	buf     bytes.Buffer
+}
+
+func generateFile(pkgPath string, types []reflect.Type, receivers map[string]string) ([]byte, error) {
+	g := &fileGenerator{
+		pkgPath: pkgPath,
+		imports: make(map[string]bool),
+	}
+
+	for _, t := range types {
+		recv, exists := receivers[t.Name()]
+		if !exists {
+			recv = strings.ToLower(t.Name()[:1])
+		}
+		if err := g.generateCopy(t, recv); err != nil {
+			return nil, err
+		}
+		if err := g.generateEqual(t, recv); err != nil {
+			return nil, err
+		}
+	}
+
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by copygen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", path.Base(pkgPath))
+	if len(imports) > 0 {
+		out.WriteString("import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		out.WriteString(")\n")
+	}
+	out.Write(g.buf.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+// typeExpr returns the expression for a type in the generated package.
+func (g *fileGenerator) typeExpr(t reflect.Type) string {
+	if t.Name() != "" {
+		switch t.PkgPath() {
+		case "":
+			if t.Kind() == reflect.Uint8 {
+				return "byte"
+			}
+
+			return t.Name()
+		case g.pkgPath:
+			return t.Name()
+		default:
+			g.imports[t.PkgPath()] = true
+
+			return t.String()
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + g.typeExpr(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeExpr(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), g.typeExpr(t.Elem()))
+	default:
+		return t.String()
+	}
+}
+
+func (g *fileGenerator) generateCopy(t reflect.Type, r string) error {
+	name := t.Name()
+	fmt.Fprintf(&g.buf, "\n// Copy returns a deep copy of the %s.\n", words(name))
+	fmt.Fprintf(&g.buf, "func (%s *%s) Copy() *%s {\n", r, name, name)
+	fmt.Fprintf(&g.buf, "if %s == nil {\nreturn nil\n}\n\n", r)
+
+	idx := indexName(r)
+	fmt.Fprintf(&g.buf, "res := &%s{\n", name)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if isValue(field.Type) {
+			fmt.Fprintf(&g.buf, "%s: %s.%s,\n", field.Name, r, field.Name)
+		}
+	}
+	g.buf.WriteString("}\n")
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		src := fmt.Sprintf("%s.%s", r, field.Name)
+		dst := "res." + field.Name
+		switch ft := field.Type; {
+		case isValue(ft):
+			// Copied above.
+		case isContainerPtr(ft):
+			fmt.Fprintf(&g.buf, "%s = %s.Copy()\n", dst, src)
+		case isUint256Ptr(ft):
+			g.imports[uint256Path] = true
+			fmt.Fprintf(&g.buf, "if %s != nil {\n%s = new(uint256.Int).Set(%s)\n}\n", src, dst, src)
+		case isByteSlice(ft), ft.Kind() == reflect.Slice && isValue(ft.Elem()):
+			fmt.Fprintf(&g.buf, "if %s != nil {\n%s = make(%s, len(%s))\ncopy(%s, %s)\n}\n",
+				src, dst, g.typeExpr(ft), src, dst, src)
+		case ft.Kind() == reflect.Slice && isContainerPtr(ft.Elem()):
+			g.buf.WriteString(strings.ReplaceAll(fmt.Sprintf("if %s != nil {\n%s = make(%s, len(%s))\nfor $i := range %s {\n%s[$i] = %s[$i].Copy()\n}\n}\n",
+				src, dst, g.typeExpr(ft), src, src, dst, src), "$i", idx))
+		case ft.Kind() == reflect.Slice && isByteSlice(ft.Elem()):
+			g.buf.WriteString(strings.ReplaceAll(fmt.Sprintf("if %s != nil {\n%s = make(%s, len(%s))\nfor $i := range %s {\nif %s[$i] != nil {\n%s[$i] = make(%s, len(%s[$i]))\ncopy(%s[$i], %s[$i])\n}\n}\n}\n",
+				src, dst, g.typeExpr(ft), src, src, src, dst, g.typeExpr(ft.Elem()), src, dst, src), "$i", idx))
+		default:
+			return fmt.Errorf("%s.%s: unsupported type %s", name, field.Name, ft)
+		}
+	}
+	g.buf.WriteString("\nreturn res\n}\n")
+
+	return nil
+}
+
+func (g *fileGenerator) generateEqual(t reflect.Type, r string) error {
+	name := t.Name()
+	fmt.Fprintf(&g.buf, "\n// Equal returns true if the %s is equal to other.\n", words(name))
+	fmt.Fprintf(&g.buf, "func (%s *%s) Equal(other *%s) bool {\n", r, name, name)
+	fmt.Fprintf(&g.buf, "if %s == nil || other == nil {\nreturn %s == other\n}\n", r, r)
+
+	idx := indexName(r)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		a := fmt.Sprintf("%s.%s", r, field.Name)
+		b := "other." + field.Name
+		switch ft := field.Type; {
+		case isValue(ft):
+			fmt.Fprintf(&g.buf, "if %s != %s {\nreturn false\n}\n", a, b)
+		case isContainerPtr(ft):
+			fmt.Fprintf(&g.buf, "if !%s.Equal(%s) {\nreturn false\n}\n", a, b)
+		case isUint256Ptr(ft):
+			fmt.Fprintf(&g.buf, "if (%s == nil) != (%s == nil) || (%s != nil && !%s.Eq(%s)) {\nreturn false\n}\n", a, b, a, a, b)
+		case isByteSlice(ft):
+			g.imports["bytes"] = true
+			fmt.Fprintf(&g.buf, "if !bytes.Equal(%s, %s) {\nreturn false\n}\n", a, b)
+		case ft.Kind() == reflect.Slice && isValue(ft.Elem()):
+			g.buf.WriteString(strings.ReplaceAll(fmt.Sprintf("if len(%s) != len(%s) {\nreturn false\n}\nfor $i := range %s {\nif %s[$i] != %s[$i] {\nreturn false\n}\n}\n", a, b, a, a, b), "$i", idx))
+		case ft.Kind() == reflect.Slice && isContainerPtr(ft.Elem()):
+			g.buf.WriteString(strings.ReplaceAll(fmt.Sprintf("if len(%s) != len(%s) {\nreturn false\n}\nfor $i := range %s {\nif !%s[$i].Equal(%s[$i]) {\nreturn false\n}\n}\n", a, b, a, a, b), "$i", idx))
+		case ft.Kind() == reflect.Slice && isByteSlice(ft.Elem()):
+			g.imports["bytes"] = true
+			g.buf.WriteString(strings.ReplaceAll(fmt.Sprintf("if len(%s) != len(%s) {\nreturn false\n}\nfor $i := range %s {\nif !bytes.Equal(%s[$i], %s[$i]) {\nreturn false\n}\n}\n", a, b, a, a, b), "$i", idx))
+		default:
+			return fmt.Errorf("%s.%s: unsupported type %s", name, field.Name, ft)
+		}
+	}
+	g.buf.WriteString("\nreturn true\n}\n")
+
+	return nil
+}
+
+// indexName returns the name of the index variable for loops, which must
+// not clash with the receiver.
+func indexName(recv string) string {
+	if recv == "i" {
+		return "j"
+	}
+
+	return "i"
+}
+
+// words splits a type name in to lower-case words, keeping acronyms together,
+// for example "SignedBLSToExecutionChange" becomes "signed BLS to execution change".
+func words(name string) string {
+	res := make([]string, 0)
+	start := 0
+	for i := 1; i < len(name); i++ {
+		upper := name[i] >= 'A' && name[i] <= 'Z'
+		prevUpper := name[i-1] >= 'A' && name[i-1] <= 'Z'
+		nextLower := i+1 < len(name) && name[i+1] >= 'a' && name[i+1] <= 'z'
+		if upper && (!prevUpper || nextLower) {
+			res = append(res, name[start:i])
+			start = i
+		}
+	}
+	res = append(res, name[start:])
+
+	for i := range res {
+		if strings.ToUpper(res[i]) != res[i] {
+			res[i] = strings.ToLower(res[i])
+		}
+	}
+
+	return strings.Join(res, " ")
+}
@@ -0,0 +1,57 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedUpToDate ensures that the generated files match the containers.
+func TestGeneratedUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+	files, err := generate(root, roots)
+	require.NoError(t, err)
+
+	for name, data := range files {
+		existing, err := os.ReadFile(filepath.Join(root, name))
+		require.NoError(t, err)
+		require.Equal(t, string(data), string(existing), "%s is out of date; run go generate in spec", name)
+	}
+}
+
+func TestContainers(t *testing.T) {
+	_, err := containers([]reflect.Type{reflect.TypeOf(phase0.Root{})})
+	require.EqualError(t, err, "phase0.Root is not a container in this module")
+
+	packages, err := containers([]reflect.Type{reflect.TypeOf(phase0.AttesterSlashing{})})
+	require.NoError(t, err)
+	require.Len(t, packages, 1)
+	names := make([]string, 0)
+	for _, t := range packages["github.com/attestantio/go-eth2-client/spec/phase0"] {
+		names = append(names, t.Name())
+	}
+	require.Equal(t, []string{"AttestationData", "AttesterSlashing", "Checkpoint", "IndexedAttestation"}, names)
+}
+
+func TestWords(t *testing.T) {
+	require.Equal(t, "beacon block", words("BeaconBlock"))
+	require.Equal(t, "signed BLS to execution change", words("SignedBLSToExecutionChange"))
+	require.Equal(t, "ETH1 data", words("ETH1Data"))
+}
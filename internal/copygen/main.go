@@ -0,0 +1,57 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main generates Copy() and Equal() methods for spec containers.
+//
+// Usage:
+//
+//	copygen -root ..
+//
+// The containers for which methods are generated are listed in roots; any
+// containers that they reference within this module are generated as well,
+// so that copies are deep.  The methods for each package are written to
+// copy.go in the package's directory, relative to the module root.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	root := flag.String("root", ".", "path to the root of the module")
+	flag.Parse()
+
+	if err := run(*root); err != nil {
+		fmt.Fprintf(os.Stderr, "copygen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(root string) error {
+	files, err := generate(root, roots)
+	if err != nil {
+		return err
+	}
+
+	for name, data := range files {
+		//nolint:gosec
+		if err := os.WriteFile(filepath.Join(root, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// roots are the containers for which methods are generated.
+var roots = []reflect.Type{
+	reflect.TypeOf(phase0.SignedBeaconBlock{}),
+	reflect.TypeOf(phase0.Attestation{}),
+	reflect.TypeOf(altair.SignedBeaconBlock{}),
+	reflect.TypeOf(bellatrix.SignedBeaconBlock{}),
+	reflect.TypeOf(bellatrix.ExecutionPayloadHeader{}),
+	reflect.TypeOf(capella.SignedBeaconBlock{}),
+	reflect.TypeOf(capella.ExecutionPayloadHeader{}),
+	reflect.TypeOf(deneb.SignedBeaconBlock{}),
+	reflect.TypeOf(deneb.ExecutionPayloadHeader{}),
+	reflect.TypeOf(electra.SignedBeaconBlock{}),
+	reflect.TypeOf(electra.Attestation{}),
+	reflect.TypeOf(electra.SingleAttestation{}),
+	reflect.TypeOf(apiv1bellatrix.SignedBlindedBeaconBlock{}),
+	reflect.TypeOf(apiv1capella.SignedBlindedBeaconBlock{}),
+	reflect.TypeOf(apiv1deneb.SignedBlindedBeaconBlock{}),
+	reflect.TypeOf(apiv1deneb.SignedBlockContents{}),
+	reflect.TypeOf(apiv1deneb.BlockContents{}),
+	reflect.TypeOf(apiv1electra.SignedBlindedBeaconBlock{}),
+	reflect.TypeOf(apiv1electra.SignedBlockContents{}),
+	reflect.TypeOf(apiv1electra.BlockContents{}),
+}
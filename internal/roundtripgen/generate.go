@@ -0,0 +1,124 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// modulePath is the import path of the module.
+const modulePath = "github.com/attestantio/go-eth2-client"
+
+// pkg is a package whose containers are included.
+type pkg struct {
+	// dir is the directory of the package, relative to the module root.
+	dir string
+	// alias is the name by which the package is imported.
+	alias string
+}
+
+// packages are the packages whose containers are included.
+var packages = []pkg{
+	{dir: "api/v1", alias: "apiv1"},
+	{dir: "api/v1/bellatrix", alias: "apiv1bellatrix"},
+	{dir: "api/v1/capella", alias: "apiv1capella"},
+	{dir: "api/v1/deneb", alias: "apiv1deneb"},
+	{dir: "api/v1/electra", alias: "apiv1electra"},
+	{dir: "spec/altair", alias: "altair"},
+	{dir: "spec/bellatrix", alias: "bellatrix"},
+	{dir: "spec/capella", alias: "capella"},
+	{dir: "spec/deneb", alias: "deneb"},
+	{dir: "spec/electra", alias: "electra"},
+	{dir: "spec/phase0", alias: "phase0"},
+}
+
+// generate generates the list of containers.
+func generate(root string) ([]byte, error) {
+	var out bytes.Buffer
+	out.WriteString("// Code generated by roundtripgen. DO NOT EDIT.\n\n")
+	out.WriteString("package spec_test\n\n")
+	out.WriteString("import (\n")
+	for _, p := range packages {
+		if p.alias == path.Base(p.dir) {
+			fmt.Fprintf(&out, "\t%q\n", modulePath+"/"+p.dir)
+		} else {
+			fmt.Fprintf(&out, "\t%s %q\n", p.alias, modulePath+"/"+p.dir)
+		}
+	}
+	out.WriteString(")\n\n")
+	out.WriteString("// roundTripContainers are the containers checked by the round-trip tests.\n")
+	out.WriteString("var roundTripContainers = []struct {\n\tname string\n\tobj  func() any\n}{\n")
+	for _, p := range packages {
+		names, err := containers(filepath.Join(root, p.dir))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			fmt.Fprintf(&out, "\t{name: %q, obj: func() any { return &%s.%s{} }},\n", p.alias+"."+name, p.alias, name)
+		}
+	}
+	out.WriteString("}\n")
+
+	return format.Source(out.Bytes())
+}
+
+// containers returns the sorted names of the types in the package directory
+// that have a MarshalSSZ method.
+func containers(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	names := make([]string, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			fn, isFunc := decl.(*ast.FuncDecl)
+			if !isFunc || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Name.Name != "MarshalSSZ" {
+				continue
+			}
+			star, isStar := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !isStar {
+				continue
+			}
+			ident, isIdent := star.X.(*ast.Ident)
+			if !isIdent {
+				continue
+			}
+			names = append(names, ident.Name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,42 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedUpToDate ensures that the generated file matches the containers.
+func TestGeneratedUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+	data, err := generate(root)
+	require.NoError(t, err)
+
+	existing, err := os.ReadFile(filepath.Join(root, outputFile))
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(existing), "%s is out of date; run go generate in spec", outputFile)
+}
+
+func TestContainers(t *testing.T) {
+	names, err := containers(filepath.Join("..", "..", "api", "v1"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"SignedValidatorRegistration", "ValidatorRegistration"}, names)
+
+	_, err = containers(filepath.Join("..", "..", "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main generates the list of SSZ containers exercised by the codec
+// round-trip tests.
+//
+// Usage:
+//
+//	roundtripgen -root ..
+//
+// Every type in the packages listed in packages that has a MarshalSSZ method
+// is included.  The list is written to spec/roundtrip_containers_test.go,
+// relative to the module root.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// outputFile is the path of the generated file, relative to the module root.
+const outputFile = "spec/roundtrip_containers_test.go"
+
+func main() {
+	root := flag.String("root", ".", "path to the root of the module")
+	flag.Parse()
+
+	if err := run(*root); err != nil {
+		fmt.Fprintf(os.Stderr, "roundtripgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(root string) error {
+	data, err := generate(root)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec
+	return os.WriteFile(filepath.Join(root, outputFile), data, 0o644)
+}
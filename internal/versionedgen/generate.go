@@ -0,0 +1,234 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const modulePath = "github.com/attestantio/go-eth2-client"
+
+// packagePaths maps package names used in type definitions to their import paths.
+var packagePaths = map[string]string{
+	"spec":      modulePath + "/spec",
+	"phase0":    modulePath + "/spec/phase0",
+	"altair":    modulePath + "/spec/altair",
+	"bellatrix": modulePath + "/spec/bellatrix",
+	"capella":   modulePath + "/spec/capella",
+	"deneb":     modulePath + "/spec/deneb",
+	"electra":   modulePath + "/spec/electra",
+	"apiv1":     modulePath + "/api/v1",
+}
+
+var packageRefRegex = regexp.MustCompile(`([a-z][a-z0-9]*)\.[A-Z]`)
+
+var fileTemplate = template.Must(template.New("file").Funcs(template.FuncMap{
+	"lower": strings.ToLower,
+	"words": words,
+}).Parse(`// Code generated by versionedgen. DO NOT EDIT.
+// Source: {{ .Source }}
+
+package {{ .Package }}
+
+import (
+{{- range .Imports }}
+{{- if . }}
+	"{{ . }}"
+{{- else }}
+{{ end }}
+{{- end }}
+)
+
+{{ $t := . -}}
+// {{ .Type.Name }} contains a versioned {{ .Type.Description }}.
+type {{ .Type.Name }} struct {
+	Version {{ .VersionPrefix }}DataVersion
+{{- range .Present }}
+	{{ . }} *{{ index $t.Type.Forks . }}
+{{- end }}
+}
+
+// IsEmpty returns true if there is no {{ .Type.Description }}.
+func (v *{{ .Type.Name }}) IsEmpty() bool {
+	return {{ range $i, $fork := .Present }}{{ if $i }} && {{ end }}v.{{ $fork }} == nil{{ end }}
+}
+{{ range $a := .Type.Accessors }}
+// {{ $a.Name }} returns {{ $a.Doc }}.
+func (v *{{ $t.Type.Name }}) {{ $a.Name }}() ({{ $a.Type }}, error) {
+	switch v.Version {
+{{- if $t.Absent }}
+	case {{ range $i, $fork := $t.Absent }}{{ if $i }}, {{ end }}{{ $t.VersionPrefix }}DataVersion{{ $fork }}{{ end }}:
+		return {{ $a.Zero }}, errors.Join(errors.New("{{ $t.Type.Description }} does not provide {{ words $a.Name }}"), {{ $t.VersionPrefix }}ErrNotAvailableInFork)
+{{- end }}
+{{- range $fork := $t.Present }}
+	case {{ $t.VersionPrefix }}DataVersion{{ $fork }}:
+		if v.{{ $fork }} == nil {
+			return {{ $a.Zero }}, errors.New("no {{ lower $fork }} {{ $t.Type.Description }}")
+		}
+
+		return v.{{ $fork }}.{{ $a.Path }}, nil
+{{- end }}
+	default:
+		return {{ $a.Zero }}, errors.New("unknown version for {{ $t.Type.Description }}")
+	}
+}
+{{ end }}
+{{- if .Type.HashTreeRoot }}
+// HashTreeRoot returns the hash tree root of the {{ .Type.Description }}.
+func (v *{{ .Type.Name }}) HashTreeRoot() ([32]byte, error) {
+	switch v.Version {
+{{- range $fork := .Present }}
+	case {{ $t.VersionPrefix }}DataVersion{{ $fork }}:
+		if v.{{ $fork }} == nil {
+			return [32]byte{}, errors.New("no {{ lower $fork }} {{ $t.Type.Description }}")
+		}
+
+		return v.{{ $fork }}.HashTreeRoot()
+{{- end }}
+	default:
+		return [32]byte{}, errors.New("unknown version for {{ .Type.Description }}")
+	}
+}
+{{ end }}
+// String returns a string version of the structure.
+func (v *{{ .Type.Name }}) String() string {
+	switch v.Version {
+{{- range $fork := .Present }}
+	case {{ $t.VersionPrefix }}DataVersion{{ $fork }}:
+		if v.{{ $fork }} == nil {
+			return ""
+		}
+
+		return v.{{ $fork }}.String()
+{{- end }}
+	default:
+		return "unknown version"
+	}
+}
+`))
+
+// fileData is the data supplied to the file template.
+type fileData struct {
+	Source        string
+	Package       string
+	Imports       []string
+	VersionPrefix string
+	Type          *versionedType
+	Present       []string
+	Absent        []string
+}
+
+// generate generates the source for each type in the table, returning a map
+// of file name to formatted source.
+func generate(t *table, source string) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(t.Types))
+	for _, vt := range t.Types {
+		data, err := generateType(t, vt, source)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to generate %s", vt.Name), err)
+		}
+		files[vt.File] = data
+	}
+
+	return files, nil
+}
+
+func generateType(t *table, vt *versionedType, source string) ([]byte, error) {
+	fd := &fileData{
+		Source:  source,
+		Package: t.Package,
+		Type:    vt,
+		Present: t.presentForks(vt),
+		Absent:  t.absentForks(vt),
+	}
+	if t.Package != "spec" {
+		fd.VersionPrefix = "spec."
+	}
+
+	imports, err := typeImports(t, vt, fd)
+	if err != nil {
+		return nil, err
+	}
+	fd.Imports = imports
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, fd); err != nil {
+		return nil, errors.Join(errors.New("failed to execute template"), err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to format source"), err)
+	}
+
+	return formatted, nil
+}
+
+// typeImports returns the import paths required by the generated type, with
+// standard library imports first.
+func typeImports(t *table, vt *versionedType, fd *fileData) ([]string, error) {
+	refs := make([]string, 0)
+	for _, typ := range vt.Forks {
+		refs = append(refs, typ)
+	}
+	for _, a := range vt.Accessors {
+		refs = append(refs, a.Type, a.Zero)
+	}
+	if fd.VersionPrefix != "" {
+		refs = append(refs, "spec.DataVersion")
+	}
+
+	paths := make(map[string]bool)
+	if len(vt.Accessors) > 0 || vt.HashTreeRoot {
+		paths["errors"] = true
+	}
+	for _, ref := range refs {
+		for _, match := range packageRefRegex.FindAllStringSubmatch(ref, -1) {
+			if match[1] == t.Package {
+				continue
+			}
+			path, exists := packagePaths[match[1]]
+			if !exists {
+				return nil, fmt.Errorf("unknown package %s", match[1])
+			}
+			paths[path] = true
+		}
+	}
+
+	std := make([]string, 0)
+	nonStd := make([]string, 0)
+	for path := range paths {
+		if strings.Contains(path, ".") {
+			nonStd = append(nonStd, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(nonStd)
+
+	// An empty entry separates standard library imports from the rest.
+	if len(std) > 0 && len(nonStd) > 0 {
+		std = append(std, "")
+	}
+
+	return append(std, nonStd...), nil
+}
@@ -0,0 +1,145 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedUpToDate ensures that the generated files match the table.
+func TestGeneratedUpToDate(t *testing.T) {
+	tablePath := filepath.Join("..", "..", "spec", "versioned.json")
+	table, err := loadTable(tablePath)
+	require.NoError(t, err)
+
+	files, err := generate(table, filepath.Base(tablePath))
+	require.NoError(t, err)
+	require.Len(t, files, len(table.Types))
+
+	for name, data := range files {
+		existing, err := os.ReadFile(filepath.Join(filepath.Dir(tablePath), name))
+		require.NoError(t, err)
+		require.Equal(t, string(data), string(existing), "%s is out of date; run go generate in spec", name)
+	}
+}
+
+func TestTableCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		table *table
+		err   string
+	}{
+		{
+			name:  "PackageMissing",
+			table: &table{Forks: []string{"Phase0"}},
+			err:   "no package specified",
+		},
+		{
+			name:  "ForksMissing",
+			table: &table{Package: "spec"},
+			err:   "no forks specified",
+		},
+		{
+			name: "TypeForksMissing",
+			table: &table{
+				Package: "spec",
+				Forks:   []string{"Phase0"},
+				Types: []*versionedType{
+					{Name: "VersionedFoo", File: "versionedfoo.go", Description: "foo"},
+				},
+			},
+			err: "type VersionedFoo has no forks",
+		},
+		{
+			name: "TypeForkUnknown",
+			table: &table{
+				Package: "spec",
+				Forks:   []string{"Phase0"},
+				Types: []*versionedType{
+					{Name: "VersionedFoo", File: "versionedfoo.go", Description: "foo", Forks: map[string]string{"Altair": "altair.Foo"}},
+				},
+			},
+			err: "type VersionedFoo has unknown fork Altair",
+		},
+		{
+			name: "TypeDuplicate",
+			table: &table{
+				Package: "spec",
+				Forks:   []string{"Phase0"},
+				Types: []*versionedType{
+					{Name: "VersionedFoo", File: "versionedfoo.go", Description: "foo", Forks: map[string]string{"Phase0": "phase0.Foo"}},
+					{Name: "VersionedFoo", File: "versionedfoo2.go", Description: "foo", Forks: map[string]string{"Phase0": "phase0.Foo"}},
+				},
+			},
+			err: "duplicate type VersionedFoo",
+		},
+		{
+			name: "AccessorZeroMissing",
+			table: &table{
+				Package: "spec",
+				Forks:   []string{"Phase0"},
+				Types: []*versionedType{
+					{
+						Name:        "VersionedFoo",
+						File:        "versionedfoo.go",
+						Description: "foo",
+						Forks:       map[string]string{"Phase0": "phase0.Foo"},
+						Accessors: []*accessor{
+							{Name: "Bar", Doc: "the bar", Type: "uint64", Path: "Bar"},
+						},
+					},
+				},
+			},
+			err: "accessor VersionedFoo.Bar missing zero value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.table.check()
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestGenerateOtherPackage(t *testing.T) {
+	tbl := &table{
+		Package: "api",
+		Forks:   []string{"Phase0", "Altair"},
+		Types: []*versionedType{
+			{
+				Name:        "VersionedFoo",
+				File:        "versionedfoo.go",
+				Description: "foo",
+				Forks:       map[string]string{"Altair": "altair.Foo"},
+				Accessors: []*accessor{
+					{Name: "BarBaz", Doc: "the bar baz", Type: "phase0.Slot", Zero: "0", Path: "Bar.Baz"},
+				},
+			},
+		},
+	}
+	require.NoError(t, tbl.check())
+
+	files, err := generate(tbl, "versioned.json")
+	require.NoError(t, err)
+	source := string(files["versionedfoo.go"])
+	require.Contains(t, source, "\"errors\"\n\n\t\"github.com/attestantio/go-eth2-client/spec\"")
+	require.Contains(t, source, "Version spec.DataVersion")
+	require.Contains(t, source, "case spec.DataVersionPhase0:\n\t\treturn 0, errors.Join(errors.New(\"foo does not provide bar baz\"), spec.ErrNotAvailableInFork)")
+	require.Contains(t, source, "return v.Altair.Bar.Baz, nil")
+}
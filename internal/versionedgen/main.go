@@ -0,0 +1,87 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main generates versioned container wrappers, such as
+// spec.VersionedAggregateAndProof, from a declarative table of forks and
+// the types used in each fork.
+//
+// Usage:
+//
+//	versionedgen -table versioned.json
+//
+// The table is a JSON file of the form:
+//
+//	{
+//	  "package": "spec",
+//	  "forks": ["Phase0", "Altair", ...],
+//	  "types": [
+//	    {
+//	      "name": "VersionedAggregateAndProof",
+//	      "file": "versionedaggregateandproof.go",
+//	      "description": "aggregate and proof",
+//	      "forks": {"Phase0": "phase0.AggregateAndProof", ...},
+//	      "hashTreeRoot": true,
+//	      "accessors": [
+//	        {
+//	          "name": "AggregatorIndex",
+//	          "doc": "the aggregator index of the aggregate",
+//	          "type": "phase0.ValidatorIndex",
+//	          "zero": "0",
+//	          "path": "AggregatorIndex"
+//	        }
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// Each type is written to its own file in the directory containing the table.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	tablePath := flag.String("table", "versioned.json", "path to the table of versioned types")
+	flag.Parse()
+
+	if err := run(*tablePath); err != nil {
+		fmt.Fprintf(os.Stderr, "versionedgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(tablePath string) error {
+	table, err := loadTable(tablePath)
+	if err != nil {
+		return err
+	}
+
+	files, err := generate(table, filepath.Base(tablePath))
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(tablePath)
+	for name, data := range files {
+		//nolint:gosec
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
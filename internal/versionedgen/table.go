@@ -0,0 +1,173 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// table is the declarative description of the versioned types to generate.
+type table struct {
+	// Package is the name of the package in which the types are generated.
+	Package string `json:"package"`
+	// Forks are the names of the forks, in order.
+	Forks []string `json:"forks"`
+	// Types are the versioned types to generate.
+	Types []*versionedType `json:"types"`
+}
+
+// versionedType is the description of a single versioned type.
+type versionedType struct {
+	// Name is the name of the versioned type.
+	Name string `json:"name"`
+	// File is the name of the file to which the type is written.
+	File string `json:"file"`
+	// Description is the human-readable description of the type.
+	Description string `json:"description"`
+	// Forks maps fork names to the type used for that fork.  Forks in
+	// which the type is not present are omitted.
+	Forks map[string]string `json:"forks"`
+	// HashTreeRoot is true if a HashTreeRoot function should be generated.
+	HashTreeRoot bool `json:"hashTreeRoot"`
+	// Accessors are the accessor functions to generate.
+	Accessors []*accessor `json:"accessors"`
+}
+
+// accessor is the description of an accessor function on a versioned type.
+type accessor struct {
+	// Name is the name of the accessor function.
+	Name string `json:"name"`
+	// Doc is the description of the returned value.
+	Doc string `json:"doc"`
+	// Type is the type returned by the accessor.
+	Type string `json:"type"`
+	// Zero is the zero value for the type returned by the accessor.
+	Zero string `json:"zero"`
+	// Path is the path to the value within each fork's type.
+	Path string `json:"path"`
+}
+
+func loadTable(path string) (*table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read table"), err)
+	}
+
+	var t table
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, errors.Join(errors.New("failed to parse table"), err)
+	}
+
+	if err := t.check(); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// check ensures that the table is internally consistent.
+func (t *table) check() error {
+	if t.Package == "" {
+		return errors.New("no package specified")
+	}
+	if len(t.Forks) == 0 {
+		return errors.New("no forks specified")
+	}
+
+	forks := make(map[string]bool, len(t.Forks))
+	for _, fork := range t.Forks {
+		forks[fork] = true
+	}
+
+	names := make(map[string]bool, len(t.Types))
+	for _, vt := range t.Types {
+		switch {
+		case vt.Name == "":
+			return errors.New("type missing name")
+		case names[vt.Name]:
+			return fmt.Errorf("duplicate type %s", vt.Name)
+		case vt.File == "":
+			return fmt.Errorf("type %s missing file", vt.Name)
+		case vt.Description == "":
+			return fmt.Errorf("type %s missing description", vt.Name)
+		case len(vt.Forks) == 0:
+			return fmt.Errorf("type %s has no forks", vt.Name)
+		}
+		names[vt.Name] = true
+
+		for fork := range vt.Forks {
+			if !forks[fork] {
+				return fmt.Errorf("type %s has unknown fork %s", vt.Name, fork)
+			}
+		}
+
+		for _, a := range vt.Accessors {
+			switch {
+			case a.Name == "":
+				return fmt.Errorf("type %s has accessor missing name", vt.Name)
+			case a.Type == "":
+				return fmt.Errorf("accessor %s.%s missing type", vt.Name, a.Name)
+			case a.Zero == "":
+				return fmt.Errorf("accessor %s.%s missing zero value", vt.Name, a.Name)
+			case a.Path == "":
+				return fmt.Errorf("accessor %s.%s missing path", vt.Name, a.Name)
+			case a.Doc == "":
+				return fmt.Errorf("accessor %s.%s missing doc", vt.Name, a.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// presentForks returns the forks in which the type is present, in order.
+func (t *table) presentForks(vt *versionedType) []string {
+	res := make([]string, 0, len(vt.Forks))
+	for _, fork := range t.Forks {
+		if _, exists := vt.Forks[fork]; exists {
+			res = append(res, fork)
+		}
+	}
+
+	return res
+}
+
+// absentForks returns the forks in which the type is not present, in order.
+func (t *table) absentForks(vt *versionedType) []string {
+	res := make([]string, 0)
+	for _, fork := range t.Forks {
+		if _, exists := vt.Forks[fork]; !exists {
+			res = append(res, fork)
+		}
+	}
+
+	return res
+}
+
+// words splits a camel-case name into lower-case words.
+func words(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			sb.WriteByte(' ')
+		}
+		sb.WriteRune(r)
+	}
+
+	return strings.ToLower(sb.String())
+}
@@ -0,0 +1,98 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterators
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttesterDuties returns an iterator over the attester duties of the given
+// validators for epochs from the start epoch up to but not including the end
+// epoch.  Duties for each epoch are fetched once those for the previous
+// epoch have been consumed.  If the duties for an epoch cannot be obtained
+// then the error is yielded and iteration stops.
+func AttesterDuties(ctx context.Context,
+	provider consensusclient.AttesterDutiesProvider,
+	startEpoch phase0.Epoch,
+	endEpoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) iter.Seq2[*apiv1.AttesterDuty, error] {
+	return epochDuties(startEpoch, endEpoch, func(epoch phase0.Epoch) ([]*apiv1.AttesterDuty, error) {
+		response, err := provider.AttesterDuties(ctx, &api.AttesterDutiesOpts{
+			Epoch:   epoch,
+			Indices: indices,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Data, nil
+	})
+}
+
+// ProposerDuties returns an iterator over the proposer duties for epochs
+// from the start epoch up to but not including the end epoch, optionally
+// restricted to the given validators.  Duties for each epoch are fetched
+// once those for the previous epoch have been consumed.  If the duties for
+// an epoch cannot be obtained then the error is yielded and iteration stops.
+func ProposerDuties(ctx context.Context,
+	provider consensusclient.ProposerDutiesProvider,
+	startEpoch phase0.Epoch,
+	endEpoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) iter.Seq2[*apiv1.ProposerDuty, error] {
+	return epochDuties(startEpoch, endEpoch, func(epoch phase0.Epoch) ([]*apiv1.ProposerDuty, error) {
+		response, err := provider.ProposerDuties(ctx, &api.ProposerDutiesOpts{
+			Epoch:   epoch,
+			Indices: indices,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Data, nil
+	})
+}
+
+// epochDuties returns an iterator over duties fetched an epoch at a time.
+func epochDuties[T any](startEpoch phase0.Epoch,
+	endEpoch phase0.Epoch,
+	fetch func(epoch phase0.Epoch) ([]T, error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for epoch := startEpoch; epoch < endEpoch; epoch++ {
+			duties, err := fetch(epoch)
+			if err != nil {
+				var empty T
+				yield(empty, fmt.Errorf("failed to obtain duties for epoch %d: %w", epoch, err))
+
+				return
+			}
+			for _, duty := range duties {
+				if !yield(duty, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iterators provides iterators over streams of data obtained from a
+// beacon node, as an alternative to handlers and channels.  Iteration stops
+// early if the caller breaks out of the loop or the context is canceled, in
+// which case any outstanding requests are canceled.
+package iterators
+
+import (
+	"context"
+	"iter"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// Events returns an iterator over events with the given topics.  Iteration
+// continues until the caller stops or the context is canceled.  If the
+// subscription cannot be started then its error is yielded and iteration
+// stops.
+func Events(ctx context.Context,
+	provider consensusclient.EventsProvider,
+	topics []string,
+) iter.Seq2[*apiv1.Event, error] {
+	return func(yield func(*apiv1.Event, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		events := make(chan *apiv1.Event)
+		err := provider.Events(ctx, &api.EventsOpts{
+			Topics: topics,
+			Handler: func(event *apiv1.Event) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+				}
+			},
+		})
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				if !yield(event, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterators
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// FinalizedCheckpoint is a finalized checkpoint enriched with the slot of
+// its block.
+type FinalizedCheckpoint struct {
+	// Epoch is the epoch of the checkpoint.
+	Epoch phase0.Epoch
+	// Root is the root of the checkpoint block.
+	Root phase0.Root
+	// State is the root of the checkpoint state.
+	State phase0.Root
+	// Slot is the slot of the checkpoint block.  This is earlier than the
+	// first slot of the epoch if that slot has no block.
+	Slot phase0.Slot
+}
+
+// FinalizedCheckpointEvents returns an iterator over finalized checkpoint
+// events.  Iteration continues until the caller stops or the context is
+// canceled.  If the subscription cannot be started then its error is yielded
+// and iteration stops.
+func FinalizedCheckpointEvents(ctx context.Context,
+	provider consensusclient.EventsProvider,
+) iter.Seq2[*apiv1.FinalizedCheckpointEvent, error] {
+	return func(yield func(*apiv1.FinalizedCheckpointEvent, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		events := make(chan *apiv1.FinalizedCheckpointEvent)
+		err := provider.Events(ctx, &api.EventsOpts{
+			Topics: []string{"finalized_checkpoint"},
+			FinalizedCheckpointHandler: func(ctx context.Context, event *apiv1.FinalizedCheckpointEvent) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+				}
+			},
+		})
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				if !yield(event, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FinalizedCheckpoints returns an iterator over finalized checkpoints, with
+// the block root of each checkpoint resolved to obtain its slot.  If the
+// block header for a checkpoint cannot be obtained then the error is yielded
+// and iteration continues with the next checkpoint.  Otherwise it behaves as
+// FinalizedCheckpointEvents.
+func FinalizedCheckpoints(ctx context.Context,
+	eventsProvider consensusclient.EventsProvider,
+	headersProvider consensusclient.BeaconBlockHeadersProvider,
+) iter.Seq2[*FinalizedCheckpoint, error] {
+	return func(yield func(*FinalizedCheckpoint, error) bool) {
+		for event, err := range FinalizedCheckpointEvents(ctx, eventsProvider) {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			checkpoint, err := finalizedCheckpoint(ctx, headersProvider, event)
+			if !yield(checkpoint, err) {
+				return
+			}
+		}
+	}
+}
+
+// finalizedCheckpoint resolves the block of a finalized checkpoint event.
+func finalizedCheckpoint(ctx context.Context,
+	headersProvider consensusclient.BeaconBlockHeadersProvider,
+	event *apiv1.FinalizedCheckpointEvent,
+) (
+	*FinalizedCheckpoint,
+	error,
+) {
+	response, err := headersProvider.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{
+		Block: fmt.Sprintf("%#x", event.Block),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain header for finalized block %#x: %w", event.Block, err)
+	}
+	if response.Data == nil || response.Data.Header == nil || response.Data.Header.Message == nil {
+		return nil, fmt.Errorf("no header for finalized block %#x", event.Block)
+	}
+
+	return &FinalizedCheckpoint{
+		Epoch: event.Epoch,
+		Root:  event.Block,
+		State: event.State,
+		Slot:  response.Data.Header.Message.Slot,
+	}, nil
+}
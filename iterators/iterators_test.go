@@ -0,0 +1,331 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterators_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/iterators"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := mock.New(context.Background())
+	require.NoError(t, err)
+	stopped := make(chan struct{})
+	client.EventsFunc = func(ctx context.Context, opts *api.EventsOpts) error {
+		if len(opts.Topics) == 0 {
+			return errors.New("no topics supplied")
+		}
+		go func() {
+			defer close(stopped)
+			for i := 0; ; i++ {
+				opts.Handler(&apiv1.Event{Topic: opts.Topics[0], Data: i})
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+			}
+		}()
+
+		return nil
+	}
+
+	// Early termination stops the subscription.
+	received := 0
+	for event, err := range iterators.Events(ctx, client, []string{"head"}) {
+		require.NoError(t, err)
+		require.Equal(t, "head", event.Topic)
+		require.Equal(t, received, event.Data)
+		received++
+		if received == 5 {
+			break
+		}
+	}
+	require.Equal(t, 5, received)
+	<-stopped
+
+	// Failure to subscribe.
+	received = 0
+	for _, err := range iterators.Events(ctx, client, nil) {
+		require.EqualError(t, err, "no topics supplied")
+		received++
+	}
+	require.Equal(t, 1, received)
+}
+
+func TestEventsContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := mock.New(context.Background())
+	require.NoError(t, err)
+	client.EventsFunc = func(_ context.Context, opts *api.EventsOpts) error {
+		go opts.Handler(&apiv1.Event{Topic: "head"})
+
+		return nil
+	}
+
+	received := 0
+	for _, err := range iterators.Events(ctx, client, []string{"head"}) {
+		require.NoError(t, err)
+		received++
+		cancel()
+	}
+	require.Equal(t, 1, received)
+}
+
+func TestValidators(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	calls := 0
+	client.ValidatorsFunc = func(_ context.Context, opts *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+		calls++
+		if opts.State != "head" {
+			return nil, errors.New("unknown state")
+		}
+		data := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+		for _, index := range opts.Indices {
+			if index < 25 {
+				data[index] = &apiv1.Validator{Index: index}
+			}
+		}
+
+		return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{Data: data}, nil
+	}
+
+	tests := []struct {
+		name     string
+		state    string
+		pageSize int
+		limit    int
+		expected int
+		calls    int
+		err      string
+	}{
+		{
+			name:     "All",
+			state:    "head",
+			pageSize: 10,
+			expected: 25,
+			calls:    3,
+		},
+		{
+			name:     "ExactPages",
+			state:    "head",
+			pageSize: 5,
+			expected: 25,
+			calls:    6,
+		},
+		{
+			name:     "EarlyTermination",
+			state:    "head",
+			pageSize: 10,
+			limit:    12,
+			expected: 12,
+			calls:    2,
+		},
+		{
+			name:     "PageSizeZero",
+			state:    "head",
+			pageSize: 0,
+			err:      "page size must be positive",
+		},
+		{
+			name:     "ProviderError",
+			state:    "unknown",
+			pageSize: 10,
+			err:      "unknown state",
+			calls:    1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls = 0
+			received := 0
+			for validator, err := range iterators.Validators(ctx, client, test.state, test.pageSize) {
+				if test.err != "" {
+					require.EqualError(t, err, test.err)
+
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, phase0.ValidatorIndex(received), validator.Index)
+				received++
+				if received == test.limit {
+					break
+				}
+			}
+			require.Equal(t, test.expected, received)
+			require.Equal(t, test.calls, calls)
+		})
+	}
+}
+
+func TestAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.AttesterDutiesFunc = func(_ context.Context, opts *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error) {
+		if opts.Epoch == 12 {
+			return nil, errors.New("unavailable")
+		}
+		data := make([]*apiv1.AttesterDuty, 0, len(opts.Indices))
+		for _, index := range opts.Indices {
+			data = append(data, &apiv1.AttesterDuty{
+				ValidatorIndex: index,
+				Slot:           phase0.Slot(uint64(opts.Epoch) * 32),
+			})
+		}
+
+		return &api.Response[[]*apiv1.AttesterDuty]{Data: data}, nil
+	}
+
+	indices := []phase0.ValidatorIndex{1, 2}
+	slots := make([]phase0.Slot, 0)
+	for duty, err := range iterators.AttesterDuties(ctx, client, 10, 12, indices) {
+		require.NoError(t, err)
+		slots = append(slots, duty.Slot)
+	}
+	require.Equal(t, []phase0.Slot{320, 320, 352, 352}, slots)
+
+	var iterErr error
+	for _, err := range iterators.AttesterDuties(ctx, client, 11, 14, indices) {
+		iterErr = err
+	}
+	require.EqualError(t, iterErr, "failed to obtain duties for epoch 12: unavailable")
+}
+
+func TestProposerDuties(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	epochs := make([]phase0.Epoch, 0)
+	client.ProposerDutiesFunc = func(_ context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+		epochs = append(epochs, opts.Epoch)
+		data := make([]*apiv1.ProposerDuty, 32)
+		for i := range data {
+			data[i] = &apiv1.ProposerDuty{Slot: phase0.Slot(uint64(opts.Epoch)*32 + uint64(i))}
+		}
+
+		return &api.Response[[]*apiv1.ProposerDuty]{Data: data}, nil
+	}
+
+	// Stopping within the first epoch does not fetch later epochs.
+	for duty, err := range iterators.ProposerDuties(ctx, client, 5, 10, nil) {
+		require.NoError(t, err)
+		if duty.Slot == 165 {
+			break
+		}
+	}
+	require.Equal(t, []phase0.Epoch{5}, epochs)
+}
+
+func TestFinalizedCheckpoints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := mock.New(context.Background())
+	require.NoError(t, err)
+	client.EventsFunc = func(ctx context.Context, opts *api.EventsOpts) error {
+		if len(opts.Topics) != 1 || opts.Topics[0] != "finalized_checkpoint" {
+			return errors.New("unexpected topics")
+		}
+		go func() {
+			for i := 1; ; i++ {
+				opts.FinalizedCheckpointHandler(ctx, &apiv1.FinalizedCheckpointEvent{
+					Block: phase0.Root{byte(i)},
+					State: phase0.Root{0x80, byte(i)},
+					Epoch: phase0.Epoch(i),
+				})
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+			}
+		}()
+
+		return nil
+	}
+	client.BeaconBlockHeaderFunc = func(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+		if opts.Block == "0x0200000000000000000000000000000000000000000000000000000000000000" {
+			return nil, errors.New("not available")
+		}
+		var root phase0.Root
+		if err := root.UnmarshalText([]byte(opts.Block)); err != nil {
+			return nil, err
+		}
+
+		return &api.Response[*apiv1.BeaconBlockHeader]{
+			Data: &apiv1.BeaconBlockHeader{
+				Root: root,
+				Header: &phase0.SignedBeaconBlockHeader{
+					Message: &phase0.BeaconBlockHeader{
+						// The first slot of the epoch has no block.
+						Slot: phase0.Slot(uint64(root[0])*32 - 1),
+					},
+				},
+			},
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	received := 0
+	for event, err := range iterators.FinalizedCheckpointEvents(ctx, client) {
+		require.NoError(t, err)
+		received++
+		require.Equal(t, phase0.Epoch(received), event.Epoch)
+		if received == 3 {
+			break
+		}
+	}
+	require.Equal(t, 3, received)
+
+	received = 0
+	for checkpoint, err := range iterators.FinalizedCheckpoints(ctx, client, client) {
+		received++
+		if received == 2 {
+			require.EqualError(t, err, "failed to obtain header for finalized block 0x0200000000000000000000000000000000000000000000000000000000000000: not available")
+
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, phase0.Epoch(received), checkpoint.Epoch)
+		require.Equal(t, phase0.Root{byte(received)}, checkpoint.Root)
+		require.Equal(t, phase0.Root{0x80, byte(received)}, checkpoint.State)
+		require.Equal(t, phase0.Slot(received*32-1), checkpoint.Slot)
+		if received == 3 {
+			break
+		}
+	}
+	require.Equal(t, 3, received)
+}
@@ -0,0 +1,83 @@
+//go:build go1.23
+
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterators
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"iter"
+	"slices"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Validators returns an iterator over the validators in the given state in
+// index order.  Validators are fetched in pages of the given number of
+// indices, with the next page only fetched once the previous page has been
+// consumed.  If a page cannot be obtained then its error is yielded and
+// iteration stops.
+func Validators(ctx context.Context,
+	provider consensusclient.ValidatorsProvider,
+	state string,
+	pageSize int,
+) iter.Seq2[*apiv1.Validator, error] {
+	return func(yield func(*apiv1.Validator, error) bool) {
+		if pageSize <= 0 {
+			yield(nil, errors.New("page size must be positive"))
+
+			return
+		}
+
+		for start := phase0.ValidatorIndex(0); ; start += phase0.ValidatorIndex(pageSize) {
+			indices := make([]phase0.ValidatorIndex, pageSize)
+			for i := range indices {
+				indices[i] = start + phase0.ValidatorIndex(i)
+			}
+
+			response, err := provider.Validators(ctx, &api.ValidatorsOpts{
+				State:   state,
+				Indices: indices,
+			})
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			validators := make([]*apiv1.Validator, 0, len(response.Data))
+			for _, validator := range response.Data {
+				validators = append(validators, validator)
+			}
+			slices.SortFunc(validators, func(a, b *apiv1.Validator) int {
+				return cmp.Compare(a.Index, b.Index)
+			})
+			for _, validator := range validators {
+				if !yield(validator, nil) {
+					return
+				}
+			}
+
+			if len(validators) < pageSize {
+				// Reached the end of the registry.
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kzg provides helpers to verify blobs against their KZG commitments
+// and proofs.
+//
+// Verification is carried out by an implementation of Verifier supplied by
+// the caller, so that this module does not depend on any particular KZG
+// library.  For example, a thin wrapper around the context provided by
+// github.com/crate-crypto/go-kzg-4844 satisfies the interface.
+package kzg
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// ErrVerificationFailed is returned when blobs do not verify against their
+// commitments and proofs.
+var ErrVerificationFailed = errors.New("KZG verification failed")
+
+// Verifier is the interface for a KZG verification backend.
+type Verifier interface {
+	// VerifyBlobKZGProof verifies a single blob against its commitment and proof.
+	VerifyBlobKZGProof(blob *deneb.Blob, commitment deneb.KZGCommitment, proof deneb.KZGProof) error
+
+	// VerifyBlobKZGProofBatch verifies multiple blobs against their commitments and proofs.
+	VerifyBlobKZGProofBatch(blobs []deneb.Blob, commitments []deneb.KZGCommitment, proofs []deneb.KZGProof) error
+}
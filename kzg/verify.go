@@ -0,0 +1,92 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// VerifyBlobs verifies blobs against their commitments and proofs.
+func VerifyBlobs(verifier Verifier,
+	blobs []deneb.Blob,
+	commitments []deneb.KZGCommitment,
+	proofs []deneb.KZGProof,
+) error {
+	if verifier == nil {
+		return errors.New("no verifier specified")
+	}
+	if len(blobs) != len(commitments) || len(blobs) != len(proofs) {
+		return fmt.Errorf("mismatched lengths: %d blobs, %d commitments, %d proofs", len(blobs), len(commitments), len(proofs))
+	}
+
+	switch len(blobs) {
+	case 0:
+		return nil
+	case 1:
+		if err := verifier.VerifyBlobKZGProof(&blobs[0], commitments[0], proofs[0]); err != nil {
+			return errors.Join(ErrVerificationFailed, err)
+		}
+	default:
+		if err := verifier.VerifyBlobKZGProofBatch(blobs, commitments, proofs); err != nil {
+			return errors.Join(ErrVerificationFailed, err)
+		}
+	}
+
+	return nil
+}
+
+// VerifyBlobSidecars verifies the blobs in blob sidecars against their
+// commitments and proofs.
+func VerifyBlobSidecars(verifier Verifier, sidecars []*deneb.BlobSidecar) error {
+	blobs := make([]deneb.Blob, len(sidecars))
+	commitments := make([]deneb.KZGCommitment, len(sidecars))
+	proofs := make([]deneb.KZGProof, len(sidecars))
+	for i, sidecar := range sidecars {
+		if sidecar == nil {
+			return fmt.Errorf("blob sidecar %d missing", i)
+		}
+		blobs[i] = sidecar.Blob
+		commitments[i] = sidecar.KZGCommitment
+		proofs[i] = sidecar.KZGProof
+	}
+
+	return VerifyBlobs(verifier, blobs, commitments, proofs)
+}
+
+// VerifyProposal verifies the blobs in a proposal against the commitments in
+// its block and the proofs supplied alongside it.
+func VerifyProposal(verifier Verifier, proposal *api.VersionedProposal) error {
+	if proposal == nil {
+		return errors.New("no proposal specified")
+	}
+
+	blobs, err := proposal.Blobs()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain blobs"), err)
+	}
+	commitments, err := proposal.BlobKZGCommitments()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain blob KZG commitments"), err)
+	}
+	proofs, err := proposal.KZGProofs()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain KZG proofs"), err)
+	}
+
+	return VerifyBlobs(verifier, blobs, commitments, proofs)
+}
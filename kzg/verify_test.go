@@ -0,0 +1,168 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/kzg"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/stretchr/testify/require"
+)
+
+// testVerifier accepts a blob if the first bytes of the blob, commitment and
+// proof match.
+type testVerifier struct {
+	batchCalls int
+}
+
+func (*testVerifier) VerifyBlobKZGProof(blob *deneb.Blob, commitment deneb.KZGCommitment, proof deneb.KZGProof) error {
+	if blob[0] != commitment[0] || blob[0] != proof[0] {
+		return errors.New("invalid proof")
+	}
+
+	return nil
+}
+
+func (v *testVerifier) VerifyBlobKZGProofBatch(blobs []deneb.Blob, commitments []deneb.KZGCommitment, proofs []deneb.KZGProof) error {
+	v.batchCalls++
+	for i := range blobs {
+		if err := v.VerifyBlobKZGProof(&blobs[i], commitments[i], proofs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestVerifyBlobs(t *testing.T) {
+	tests := []struct {
+		name        string
+		verifier    kzg.Verifier
+		blobs       []deneb.Blob
+		commitments []deneb.KZGCommitment
+		proofs      []deneb.KZGProof
+		err         string
+		failed      bool
+	}{
+		{
+			name: "VerifierMissing",
+			err:  "no verifier specified",
+		},
+		{
+			name:        "MismatchedLengths",
+			verifier:    &testVerifier{},
+			blobs:       []deneb.Blob{{0x01}},
+			commitments: []deneb.KZGCommitment{},
+			proofs:      []deneb.KZGProof{{0x01}},
+			err:         "mismatched lengths: 1 blobs, 0 commitments, 1 proofs",
+		},
+		{
+			name:     "Empty",
+			verifier: &testVerifier{},
+		},
+		{
+			name:        "Single",
+			verifier:    &testVerifier{},
+			blobs:       []deneb.Blob{{0x01}},
+			commitments: []deneb.KZGCommitment{{0x01}},
+			proofs:      []deneb.KZGProof{{0x01}},
+		},
+		{
+			name:        "SingleInvalid",
+			verifier:    &testVerifier{},
+			blobs:       []deneb.Blob{{0x01}},
+			commitments: []deneb.KZGCommitment{{0x02}},
+			proofs:      []deneb.KZGProof{{0x01}},
+			err:         "KZG verification failed\ninvalid proof",
+			failed:      true,
+		},
+		{
+			name:        "Batch",
+			verifier:    &testVerifier{},
+			blobs:       []deneb.Blob{{0x01}, {0x02}},
+			commitments: []deneb.KZGCommitment{{0x01}, {0x02}},
+			proofs:      []deneb.KZGProof{{0x01}, {0x02}},
+		},
+		{
+			name:        "BatchInvalid",
+			verifier:    &testVerifier{},
+			blobs:       []deneb.Blob{{0x01}, {0x02}},
+			commitments: []deneb.KZGCommitment{{0x01}, {0x02}},
+			proofs:      []deneb.KZGProof{{0x01}, {0x03}},
+			err:         "KZG verification failed\ninvalid proof",
+			failed:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := kzg.VerifyBlobs(test.verifier, test.blobs, test.commitments, test.proofs)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				if test.failed {
+					require.ErrorIs(t, err, kzg.ErrVerificationFailed)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyBlobSidecars(t *testing.T) {
+	verifier := &testVerifier{}
+
+	err := kzg.VerifyBlobSidecars(verifier, []*deneb.BlobSidecar{nil})
+	require.EqualError(t, err, "blob sidecar 0 missing")
+
+	sidecars := []*deneb.BlobSidecar{
+		{Blob: deneb.Blob{0x01}, KZGCommitment: deneb.KZGCommitment{0x01}, KZGProof: deneb.KZGProof{0x01}},
+		{Blob: deneb.Blob{0x02}, KZGCommitment: deneb.KZGCommitment{0x02}, KZGProof: deneb.KZGProof{0x02}},
+	}
+	require.NoError(t, kzg.VerifyBlobSidecars(verifier, sidecars))
+	require.Equal(t, 1, verifier.batchCalls)
+}
+
+func TestVerifyProposal(t *testing.T) {
+	verifier := &testVerifier{}
+
+	err := kzg.VerifyProposal(verifier, nil)
+	require.EqualError(t, err, "no proposal specified")
+
+	err = kzg.VerifyProposal(verifier, &api.VersionedProposal{Version: spec.DataVersionCapella})
+	require.ErrorIs(t, err, api.ErrUnsupportedVersion)
+
+	proposal := &api.VersionedProposal{
+		Version: spec.DataVersionDeneb,
+		Deneb: &apiv1deneb.BlockContents{
+			Block: &deneb.BeaconBlock{
+				Body: &deneb.BeaconBlockBody{
+					ExecutionPayload:   &deneb.ExecutionPayload{},
+					BlobKZGCommitments: []deneb.KZGCommitment{{0x01}},
+				},
+			},
+			KZGProofs: []deneb.KZGProof{{0x01}},
+			Blobs:     []deneb.Blob{{0x01}},
+		},
+	}
+	require.NoError(t, kzg.VerifyProposal(verifier, proposal))
+
+	proposal.Deneb.KZGProofs[0] = deneb.KZGProof{0x02}
+	require.ErrorIs(t, kzg.VerifyProposal(verifier, proposal), kzg.ErrVerificationFailed)
+}
@@ -0,0 +1,110 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logging provides adapters that allow the zerolog-based logging
+// used within this module to be sent to other logging libraries.
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sort"
+
+	"github.com/rs/zerolog"
+)
+
+// LevelTrace is the slog level to which zerolog trace messages are mapped.
+const LevelTrace = slog.LevelDebug - 4
+
+// zerologLevels are the zerolog levels, from most to least verbose.
+var zerologLevels = []zerolog.Level{
+	zerolog.TraceLevel,
+	zerolog.DebugLevel,
+	zerolog.InfoLevel,
+	zerolog.WarnLevel,
+	zerolog.ErrorLevel,
+}
+
+// FromSlog returns a zerolog logger that sends its output to the supplied
+// slog logger.  Structured fields are passed through as attributes.  The
+// level of the returned logger is set to the most verbose level enabled by
+// the slog logger, to avoid building messages that would be discarded.
+func FromSlog(logger *slog.Logger) zerolog.Logger {
+	level := zerolog.Disabled
+	for _, l := range zerologLevels {
+		if logger.Enabled(context.Background(), slogLevel(l)) {
+			level = l
+
+			break
+		}
+	}
+
+	return zerolog.New(&slogWriter{logger: logger}).Level(level)
+}
+
+// slogWriter is a zerolog level writer that sends output to a slog logger.
+type slogWriter struct {
+	logger *slog.Logger
+}
+
+// Write implements io.Writer.
+func (w *slogWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel implements zerolog.LevelWriter.
+func (w *slogWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	fields := make(map[string]any)
+	decoder := json.NewDecoder(bytes.NewReader(p))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return 0, err
+	}
+
+	msg, _ := fields[zerolog.MessageFieldName].(string)
+	delete(fields, zerolog.MessageFieldName)
+	delete(fields, zerolog.LevelFieldName)
+	delete(fields, zerolog.TimestampFieldName)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+
+	w.logger.LogAttrs(context.Background(), slogLevel(level), msg, attrs...)
+
+	return len(p), nil
+}
+
+// slogLevel maps a zerolog level to a slog level.
+func slogLevel(level zerolog.Level) slog.Level {
+	switch level {
+	case zerolog.TraceLevel:
+		return LevelTrace
+	case zerolog.DebugLevel:
+		return slog.LevelDebug
+	case zerolog.WarnLevel:
+		return slog.LevelWarn
+	case zerolog.ErrorLevel, zerolog.FatalLevel, zerolog.PanicLevel:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
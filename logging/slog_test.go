@@ -0,0 +1,97 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/logging"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSlog(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    slog.Level
+		expected zerolog.Level
+	}{
+		{
+			name:     "Trace",
+			level:    logging.LevelTrace,
+			expected: zerolog.TraceLevel,
+		},
+		{
+			name:     "Debug",
+			level:    slog.LevelDebug,
+			expected: zerolog.DebugLevel,
+		},
+		{
+			name:     "Info",
+			level:    slog.LevelInfo,
+			expected: zerolog.InfoLevel,
+		},
+		{
+			name:     "Error",
+			level:    slog.LevelError,
+			expected: zerolog.ErrorLevel,
+		},
+		{
+			name:     "Disabled",
+			level:    slog.LevelError + 1,
+			expected: zerolog.Disabled,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := slog.NewJSONHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: test.level})
+			log := logging.FromSlog(slog.New(handler))
+			require.Equal(t, test.expected, log.GetLevel())
+		})
+	}
+}
+
+func TestFromSlogOutput(t *testing.T) {
+	buf := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			// Remove the time to allow comparison.
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	})
+	log := logging.FromSlog(slog.New(handler)).With().Str("service", "test").Logger()
+
+	log.Trace().Msg("Not shown")
+	require.Empty(t, buf.String())
+
+	log.Warn().Uint64("slot", 12345).Bool("synced", true).Msg("Test message")
+	res := make(map[string]any)
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &res))
+	require.Equal(t, map[string]any{
+		"level":   "WARN",
+		"msg":     "Test message",
+		"service": "test",
+		"slot":    float64(12345),
+		"synced":  true,
+	}, res)
+}
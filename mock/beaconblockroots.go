@@ -0,0 +1,49 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconBlockRoots fetches the roots of multiple blocks given a set of options.
+func (s *Service) BeaconBlockRoots(ctx context.Context,
+	opts *api.BeaconBlockRootsOpts,
+) (
+	*api.Response[map[string]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if s.BeaconBlockRootsFunc != nil {
+		return s.BeaconBlockRootsFunc(ctx, opts)
+	}
+
+	data := make(map[string]*apiv1.BeaconBlockHeader, len(opts.Blocks))
+	for _, block := range opts.Blocks {
+		data[block] = &apiv1.BeaconBlockHeader{
+			Canonical: true,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{},
+			},
+		}
+	}
+
+	return &api.Response[map[string]*apiv1.BeaconBlockHeader]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
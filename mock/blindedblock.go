@@ -0,0 +1,53 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BlindedBlock fetches a signed blinded beacon block given a block ID.
+func (s *Service) BlindedBlock(ctx context.Context,
+	opts *api.BlindedBlockOpts,
+) (
+	*api.Response[*api.VersionedSignedBlindedBeaconBlock],
+	error,
+) {
+	if s.BlindedBlockFunc != nil {
+		return s.BlindedBlockFunc(ctx, opts)
+	}
+
+	return &api.Response[*api.VersionedSignedBlindedBeaconBlock]{
+		Data: &api.VersionedSignedBlindedBeaconBlock{
+			Version: spec.DataVersionBellatrix,
+			Bellatrix: &apiv1bellatrix.SignedBlindedBeaconBlock{
+				Message: &apiv1bellatrix.BlindedBeaconBlock{
+					Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+						ETH1Data:               &phase0.ETH1Data{},
+						SyncAggregate:          &altair.SyncAggregate{},
+						ExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{},
+					},
+				},
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
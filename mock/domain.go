@@ -16,6 +16,7 @@ package mock
 import (
 	"bytes"
 	"context"
+	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -33,6 +34,40 @@ func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoc
 	return s.calculateDomain(ctx, domainType, epoch, fork)
 }
 
+// DomainsForEpoch provides the domains for the given domain types at the
+// given epoch.  If no domain types are supplied, domains are provided for
+// all domain types in the chain specification.
+func (s *Service) DomainsForEpoch(ctx context.Context,
+	epoch phase0.Epoch,
+	domainTypes ...phase0.DomainType,
+) (
+	map[phase0.DomainType]phase0.Domain,
+	error,
+) {
+	if len(domainTypes) == 0 {
+		response, err := s.Spec(ctx, &api.SpecOpts{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain spec")
+		}
+		for k, v := range response.Data {
+			if domainType, isDomainType := v.(phase0.DomainType); isDomainType && strings.HasPrefix(k, "DOMAIN_") {
+				domainTypes = append(domainTypes, domainType)
+			}
+		}
+	}
+
+	domains := make(map[phase0.DomainType]phase0.Domain, len(domainTypes))
+	for _, domainType := range domainTypes {
+		domain, err := s.Domain(ctx, domainType, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain domain for %#x", domainType)
+		}
+		domains[domainType] = domain
+	}
+
+	return domains, nil
+}
+
 // GenesisDomain returns the domain for the given domain type at genesis.
 // N.B. this is not always the same as the domain at epoch 0.  It is possible
 // for a chain's fork schedule to have multiple forks at genesis.  In this situation,
@@ -0,0 +1,43 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	if s.NodeHealthFunc != nil {
+		return s.NodeHealthFunc(ctx, opts)
+	}
+
+	health := apiv1.NodeHealthReady
+	if s.SyncDistance > 0 {
+		health = apiv1.NodeHealthSyncing
+	}
+
+	return &api.Response[apiv1.NodeHealth]{
+		Data:     health,
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -51,14 +51,18 @@ type Service struct {
 
 	// Functions that can be provided to mock specific responses from this client.
 	AggregateAttestationFunc      func(context.Context, *api.AggregateAttestationOpts) (*api.Response[*spec.VersionedAttestation], error)
-	AttesterDutiesFunc            func(context.Context, *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error)
 	AttestationDataFunc           func(context.Context, *api.AttestationDataOpts) (*api.Response[*phase0.AttestationData], error)
 	AttestationRewardsFunc        func(context.Context, *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error)
+	AttesterDutiesFunc            func(context.Context, *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error)
 	BeaconBlockHeaderFunc         func(context.Context, *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error)
 	BeaconBlockRootFunc           func(context.Context, *api.BeaconBlockRootOpts) (*api.Response[*phase0.Root], error)
+	BeaconBlockRootsFunc          func(context.Context, *api.BeaconBlockRootsOpts) (*api.Response[map[string]*apiv1.BeaconBlockHeader], error)
+	BeaconCommitteesFunc          func(context.Context, *api.BeaconCommitteesOpts) (*api.Response[[]*apiv1.BeaconCommittee], error)
+	BeaconHeadsFunc               func(context.Context, *api.BeaconHeadsOpts) (*api.Response[[]*apiv1.BeaconHead], error)
 	BeaconStateFunc               func(context.Context, *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error)
 	BeaconStateRandaoFunc         func(context.Context, *api.BeaconStateRandaoOpts) (*api.Response[*phase0.Root], error)
 	BeaconStateRootFunc           func(context.Context, *api.BeaconStateRootOpts) (*api.Response[*phase0.Root], error)
+	BlindedBlockFunc              func(context.Context, *api.BlindedBlockOpts) (*api.Response[*api.VersionedSignedBlindedBeaconBlock], error)
 	BlockRewardsFunc              func(context.Context, *api.BlockRewardsOpts) (*api.Response[*apiv1.BlockRewards], error)
 	DepositContractFunc           func(context.Context, *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error)
 	EventsFunc                    func(context.Context, *api.EventsOpts) error
@@ -67,18 +71,25 @@ type Service struct {
 	ForkFunc                      func(context.Context, *api.ForkOpts) (*api.Response[*phase0.Fork], error)
 	ForkScheduleFunc              func(context.Context, *api.ForkScheduleOpts) (*api.Response[[]*phase0.Fork], error)
 	GenesisFunc                   func(context.Context, *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error)
+	NodeHealthFunc                func(context.Context, *api.NodeHealthOpts) (*api.Response[apiv1.NodeHealth], error)
+	NodeIdentityFunc              func(context.Context, *api.NodeIdentityOpts) (*api.Response[*apiv1.NodeIdentity], error)
 	NodePeersFunc                 func(context.Context, *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error)
 	NodeSyncingFunc               func(context.Context, *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error)
 	NodeVersionFunc               func(context.Context, *api.NodeVersionOpts) (*api.Response[string], error)
+	PendingConsolidationsFunc     func(context.Context, *api.PendingConsolidationsOpts) (*api.Response[[]*electra.PendingConsolidation], error)
 	PendingDepositsFunc           func(context.Context, *api.PendingDepositsOpts) (*api.Response[[]*electra.PendingDeposit], error)
+	PendingPartialWithdrawalsFunc func(context.Context, *api.PendingPartialWithdrawalsOpts) (*api.Response[[]*electra.PendingPartialWithdrawal], error)
 	ProposalFunc                  func(context.Context, *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error)
 	ProposerDutiesFunc            func(context.Context, *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error)
 	SignedBeaconBlockFunc         func(context.Context, *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error)
 	SpecFunc                      func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error)
+	SupportsForkFunc              func(context.Context, spec.DataVersion) (bool, error)
+	SyncCommitteeFunc             func(context.Context, *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error)
 	SyncCommitteeContributionFunc func(context.Context, *api.SyncCommitteeContributionOpts) (*api.Response[*altair.SyncCommitteeContribution], error)
 	SyncCommitteeDutiesFunc       func(context.Context, *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error)
 	SyncCommitteeRewardsFunc      func(context.Context, *api.SyncCommitteeRewardsOpts) (*api.Response[[]*apiv1.SyncCommitteeReward], error)
 	ValidatorBalancesFunc         func(context.Context, *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error)
+	ValidatorIdentitiesFunc       func(context.Context, *api.ValidatorIdentitiesOpts) (*api.Response[[]*apiv1.ValidatorIdentity], error)
 	ValidatorLivenessFunc         func(context.Context, *api.ValidatorLivenessOpts) (*api.Response[[]*apiv1.ValidatorLiveness], error)
 	ValidatorsFunc                func(context.Context, *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error)
 	VoluntaryExitPoolFunc         func(context.Context, *api.VoluntaryExitPoolOpts) (*api.Response[[]*phase0.SignedVoluntaryExit], error)
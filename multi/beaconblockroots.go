@@ -0,0 +1,49 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// BeaconBlockRoots fetches the roots of multiple blocks given a set of options.
+func (s *Service) BeaconBlockRoots(ctx context.Context,
+	opts *api.BeaconBlockRootsOpts,
+) (
+	*api.Response[map[string]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+		roots, err := client.(consensusclient.BeaconBlockRootsProvider).BeaconBlockRoots(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return roots, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, isResponse := res.(*api.Response[map[string]*apiv1.BeaconBlockHeader])
+	if !isResponse {
+		return nil, ErrIncorrectType
+	}
+
+	return response, nil
+}
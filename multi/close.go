@@ -0,0 +1,47 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// Close shuts down all clients that support it, returning the first error
+// encountered.  All clients are closed regardless of errors.
+func (s *Service) Close(ctx context.Context) error {
+	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	clients = append(clients, s.activeClients...)
+	clients = append(clients, s.inactiveClients...)
+	s.clientsMu.RUnlock()
+
+	var firstErr error
+	for _, client := range clients {
+		closer, isCloser := client.(consensusclient.ServiceCloser)
+		if !isCloser {
+			continue
+		}
+		if err := closer.Close(ctx); err != nil {
+			s.log.Debug().Str("client", client.Address()).Err(err).Msg("Failed to close client")
+			if firstErr == nil {
+				firstErr = errors.Wrapf(err, "failed to close client %s", client.Address())
+			}
+		}
+	}
+
+	return firstErr
+}
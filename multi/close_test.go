@@ -0,0 +1,68 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// closingClient is a mock client that records being closed.
+type closingClient struct {
+	*mock.Service
+	closed bool
+	err    error
+}
+
+func (c *closingClient) Close(context.Context) error {
+	c.closed = true
+
+	return c.err
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	closing1 := &closingClient{Service: client1, err: errors.New("mock error")}
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	closing2 := &closingClient{Service: client2}
+	// Clients that do not support closing are ignored.
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			closing1,
+			closing2,
+			client3,
+		}),
+	)
+	require.NoError(t, err)
+
+	err = multiClient.(consensusclient.ServiceCloser).Close(ctx)
+	require.EqualError(t, err, "failed to close client mock 1: mock error")
+	require.True(t, closing1.closed)
+	require.True(t, closing2.closed)
+}
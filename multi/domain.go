@@ -56,6 +56,40 @@ func (s *Service) Domain(ctx context.Context,
 	return response, nil
 }
 
+// DomainsForEpoch provides the domains for the given domain types at the
+// given epoch.
+func (s *Service) DomainsForEpoch(ctx context.Context,
+	epoch phase0.Epoch,
+	domainTypes ...phase0.DomainType,
+) (
+	map[phase0.DomainType]phase0.Domain,
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+		domains, err := client.(consensusclient.DomainsProvider).DomainsForEpoch(ctx, epoch, domainTypes...)
+		if err != nil {
+			return nil, err
+		}
+		for domainType, domain := range domains {
+			if bytes.Equal(domain[:], emptyDomain[:]) {
+				return nil, errors.Errorf("empty domain for %#x not a valid response", domainType)
+			}
+		}
+
+		return domains, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, isResponse := res.(map[phase0.DomainType]phase0.Domain)
+	if !isResponse {
+		return nil, ErrIncorrectType
+	}
+
+	return response, nil
+}
+
 // GenesisDomain provides a domain for a given domain type.
 func (s *Service) GenesisDomain(ctx context.Context,
 	domainType phase0.DomainType,
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/mock"
 	"github.com/attestantio/go-eth2-client/multi"
 	"github.com/attestantio/go-eth2-client/testclients"
@@ -60,3 +61,52 @@ func TestDomain(t *testing.T) {
 	// At this point we expect mock 3 to be in active (unless probability hates us).
 	require.Equal(t, "mock 3", multiClient.Address())
 }
+
+func TestDomainsForEpoch(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	erroringClient1, err := testclients.NewErroring(ctx, 0.1, client1)
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	erroringClient2, err := testclients.NewErroring(ctx, 0.1, client2)
+	require.NoError(t, err)
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]consensusclient.Service{
+			erroringClient1,
+			erroringClient2,
+			client3,
+		}),
+	)
+	require.NoError(t, err)
+
+	voluntaryExitDomain, err := client3.VoluntaryExitDomain(ctx)
+	require.NoError(t, err)
+
+	for i := 0; i < 128; i++ {
+		res, err := multiClient.(consensusclient.DomainsProvider).DomainsForEpoch(ctx, 0, voluntaryExitDomain)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+	}
+	// At this point we expect mock 3 to be in active (unless probability hates us).
+	require.Equal(t, "mock 3", multiClient.Address())
+
+	// Domain types are taken from the spec if not supplied.
+	client3.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"DOMAIN_VOLUNTARY_EXIT": voluntaryExitDomain,
+			},
+		}, nil
+	}
+	res, err := multiClient.(consensusclient.DomainsProvider).DomainsForEpoch(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Contains(t, res, voluntaryExitDomain)
+}
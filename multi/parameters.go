@@ -14,6 +14,7 @@
 package multi
 
 import (
+	"log/slog"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
@@ -23,6 +24,7 @@ import (
 )
 
 type parameters struct {
+	logger            *slog.Logger
 	logLevel          zerolog.Level
 	monitor           metrics.Service
 	clients           []consensusclient.Service
@@ -45,6 +47,15 @@ func (f parameterFunc) apply(p *parameters) {
 	f(p)
 }
 
+// WithLogger sets a structured logger to which the module's log output is
+// sent, in place of the default zerolog logger.  The log level set by
+// WithLogLevel continues to apply.
+func WithLogger(logger *slog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+	})
+}
+
 // WithLogLevel sets the log level for the module.
 func WithLogLevel(logLevel zerolog.Level) Parameter {
 	return parameterFunc(func(p *parameters) {
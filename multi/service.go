@@ -19,6 +19,7 @@ import (
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/logging"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -47,6 +48,9 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 
 	// Set logging.
 	log := zerologger.With().Str("service", "client").Str("impl", "multi").Logger()
+	if parameters.logger != nil {
+		log = logging.FromSlog(parameters.logger).With().Str("service", "client").Str("impl", "multi").Logger()
+	}
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
@@ -71,6 +75,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	}
 	for _, address := range parameters.addresses {
 		client, err := http.New(ctx,
+			http.WithLogger(parameters.logger),
 			http.WithLogLevel(parameters.logLevel),
 			http.WithTimeout(parameters.timeout),
 			http.WithAddress(address),
@@ -142,6 +142,13 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
 
+	assert.Implements(t, (*client.PendingDepositProvider)(nil), s)
+	assert.Implements(t, (*client.PendingPartialWithdrawalsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingConsolidationsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconHeadsProvider)(nil), s)
+	assert.Implements(t, (*client.ForkSupporter)(nil), s)
+	assert.Implements(t, (*client.ElectraAttestationSubmitter)(nil), s)
 	// Non-standard extensions.
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
@@ -0,0 +1,110 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// StrategyCallFunc is the definition for a call made to a single client as
+// part of a strategy.
+type StrategyCallFunc func(ctx context.Context, client consensusclient.Service) (any, error)
+
+// Strategy is the interface for combining the responses of a call made to
+// multiple clients into a single response.
+type Strategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// Execute carries out the call against the supplied clients, returning
+	// the selected response.
+	Execute(ctx context.Context, clients []consensusclient.Service, call StrategyCallFunc) (any, error)
+}
+
+// strategyResult is the result of a call to a single client.
+type strategyResult struct {
+	client consensusclient.Service
+	res    any
+	err    error
+}
+
+// callAll carries out the call against all clients concurrently, returning
+// the results in the same order as the clients.
+func callAll(ctx context.Context,
+	clients []consensusclient.Service,
+	call StrategyCallFunc,
+) []*strategyResult {
+	results := make([]*strategyResult, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			res, err := call(ctx, client)
+			results[i] = &strategyResult{
+				client: client,
+				res:    res,
+				err:    err,
+			}
+		}(i, client)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// firstError returns the first error in the results, or a generic error if
+// none is present.
+func firstError(results []*strategyResult) error {
+	for _, result := range results {
+		if result.err != nil {
+			return result.err
+		}
+	}
+
+	return errors.New("no successful responses")
+}
+
+// CallWithStrategy carries out the call against the active clients, using
+// the supplied strategy to select the response.
+func (s *Service) CallWithStrategy(ctx context.Context, strategy Strategy, call StrategyCallFunc) (any, error) {
+	if strategy == nil {
+		return nil, errors.New("no strategy specified")
+	}
+	if call == nil {
+		return nil, errors.New("no call specified")
+	}
+
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	if len(activeClients) == 0 {
+		// There are no active clients; attempt to re-enable the inactive clients.
+		s.recheck(ctx)
+		s.clientsMu.RLock()
+		activeClients = s.activeClients
+		s.clientsMu.RUnlock()
+	}
+
+	if len(activeClients) == 0 {
+		return nil, errors.New("no clients to which to make call")
+	}
+
+	return strategy.Execute(s.log.WithContext(ctx), activeClients, call)
+}
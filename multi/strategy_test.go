@@ -0,0 +1,275 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func strategyClients(ctx context.Context, t *testing.T, names ...string) []consensusclient.Service {
+	t.Helper()
+
+	clients := make([]consensusclient.Service, 0, len(names))
+	for _, name := range names {
+		client, err := mock.New(ctx, mock.WithName(name))
+		require.NoError(t, err)
+		clients = append(clients, client)
+	}
+
+	return clients
+}
+
+// strategyCall returns the configured response for each client, after the configured delay.
+func strategyCall(responses map[string]any, delays map[string]time.Duration) multi.StrategyCallFunc {
+	return func(ctx context.Context, client consensusclient.Service) (any, error) {
+		if delay, exists := delays[client.Address()]; exists {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		res, exists := responses[client.Address()]
+		if !exists {
+			return nil, fmt.Errorf("%s failed", client.Address())
+		}
+
+		return res, nil
+	}
+}
+
+func TestFirstSuccessStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "mock 1", "mock 2", "mock 3")
+
+	strategy := multi.NewFirstSuccessStrategy()
+
+	res, err := strategy.Execute(ctx, clients, strategyCall(map[string]any{"mock 2": 2, "mock 3": 3}, nil))
+	require.NoError(t, err)
+	require.Equal(t, 2, res)
+
+	_, err = strategy.Execute(ctx, clients, strategyCall(map[string]any{}, nil))
+	require.EqualError(t, err, "mock 3 failed")
+
+	_, err = strategy.Execute(ctx, nil, strategyCall(map[string]any{}, nil))
+	require.EqualError(t, err, "no clients supplied")
+}
+
+func TestMajorityStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "mock 1", "mock 2", "mock 3", "mock 4")
+
+	_, err := multi.NewMajorityStrategy(nil)
+	require.EqualError(t, err, "no key function specified")
+
+	strategy, err := multi.NewMajorityStrategy(func(res any) (string, error) {
+		value, isInt := res.(int)
+		if !isInt {
+			return "", errors.New("not an int")
+		}
+
+		return fmt.Sprintf("%d", value), nil
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		responses map[string]any
+		res       any
+		err       string
+	}{
+		{
+			name:      "Majority",
+			responses: map[string]any{"mock 1": 1, "mock 2": 2, "mock 3": 2, "mock 4": 3},
+			res:       2,
+		},
+		{
+			name:      "Tie",
+			responses: map[string]any{"mock 1": 1, "mock 2": 2, "mock 3": 2, "mock 4": 1},
+			res:       1,
+		},
+		{
+			name:      "BadKey",
+			responses: map[string]any{"mock 1": "bad", "mock 2": 2},
+			res:       2,
+		},
+		{
+			name:      "AllFailed",
+			responses: map[string]any{},
+			err:       "mock 1 failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := strategy.Execute(ctx, clients, strategyCall(test.responses, nil))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestHighestScoreStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "mock 1", "mock 2", "mock 3")
+
+	score := func(_ context.Context, _ consensusclient.Service, res any) (float64, error) {
+		value, isInt := res.(int)
+		if !isInt {
+			return 0, errors.New("not an int")
+		}
+
+		return float64(value), nil
+	}
+
+	_, err := multi.NewHighestScoreStrategy(nil, nil)
+	require.EqualError(t, err, "no score function specified")
+	_, err = multi.NewHighestScoreStrategy(score, map[string]float64{"mock 1": -1})
+	require.EqualError(t, err, "weight for mock 1 cannot be negative")
+
+	tests := []struct {
+		name      string
+		weights   map[string]float64
+		responses map[string]any
+		res       any
+		err       string
+	}{
+		{
+			name:      "Highest",
+			responses: map[string]any{"mock 1": 1, "mock 2": 3, "mock 3": 2},
+			res:       3,
+		},
+		{
+			name:      "Weighted",
+			weights:   map[string]float64{"mock 3": 2},
+			responses: map[string]any{"mock 1": 1, "mock 2": 3, "mock 3": 2},
+			res:       2,
+		},
+		{
+			name:      "BadScore",
+			responses: map[string]any{"mock 1": "bad", "mock 3": 2},
+			res:       2,
+		},
+		{
+			name:      "NoneUsable",
+			responses: map[string]any{"mock 2": "bad"},
+			err:       "mock 1 failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			strategy, err := multi.NewHighestScoreStrategy(score, test.weights)
+			require.NoError(t, err)
+			res, err := strategy.Execute(ctx, clients, strategyCall(test.responses, nil))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestDelayedSecondRequestStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "mock 1", "mock 2", "mock 3")
+
+	_, err := multi.NewDelayedSecondRequestStrategy(-time.Second)
+	require.EqualError(t, err, "delay cannot be negative")
+
+	strategy, err := multi.NewDelayedSecondRequestStrategy(50 * time.Millisecond)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		responses map[string]any
+		delays    map[string]time.Duration
+		res       any
+		err       string
+	}{
+		{
+			name:      "FirstFast",
+			responses: map[string]any{"mock 1": 1, "mock 2": 2, "mock 3": 3},
+			res:       1,
+		},
+		{
+			name:      "FirstSlow",
+			responses: map[string]any{"mock 1": 1, "mock 2": 2, "mock 3": 3},
+			delays:    map[string]time.Duration{"mock 1": time.Second, "mock 3": time.Second},
+			res:       2,
+		},
+		{
+			name:      "FirstFailed",
+			responses: map[string]any{"mock 3": 3},
+			res:       3,
+		},
+		{
+			name:      "AllFailed",
+			responses: map[string]any{},
+			err:       "mock 1 failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := strategy.Execute(ctx, clients, strategyCall(test.responses, test.delays))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestCallWithStrategy(t *testing.T) {
+	ctx := context.Background()
+	clients := strategyClients(ctx, t, "mock 1", "mock 2")
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+	)
+	require.NoError(t, err)
+	service := multiClient.(*multi.Service)
+
+	call := strategyCall(map[string]any{"mock 2": 2}, nil)
+
+	_, err = service.CallWithStrategy(ctx, nil, call)
+	require.EqualError(t, err, "no strategy specified")
+
+	_, err = service.CallWithStrategy(ctx, multi.NewFirstSuccessStrategy(), nil)
+	require.EqualError(t, err, "no call specified")
+
+	res, err := service.CallWithStrategy(ctx, multi.NewFirstSuccessStrategy(), call)
+	require.NoError(t, err)
+	require.Equal(t, 2, res)
+}
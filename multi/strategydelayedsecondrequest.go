@@ -0,0 +1,109 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// DelayedSecondRequestStrategy calls the first client and, if it has not
+// returned a successful response within the delay, calls the remaining
+// clients concurrently.  The first successful response is returned.
+type DelayedSecondRequestStrategy struct {
+	delay time.Duration
+}
+
+// NewDelayedSecondRequestStrategy creates a new delayed second request strategy.
+func NewDelayedSecondRequestStrategy(delay time.Duration) (*DelayedSecondRequestStrategy, error) {
+	if delay < 0 {
+		return nil, errors.New("delay cannot be negative")
+	}
+
+	return &DelayedSecondRequestStrategy{
+		delay: delay,
+	}, nil
+}
+
+// Name returns the name of the strategy.
+func (*DelayedSecondRequestStrategy) Name() string {
+	return "delayed second request"
+}
+
+// Execute carries out the call against the supplied clients, returning
+// the selected response.
+func (s *DelayedSecondRequestStrategy) Execute(ctx context.Context,
+	clients []consensusclient.Service,
+	call StrategyCallFunc,
+) (
+	any,
+	error,
+) {
+	if len(clients) == 0 {
+		return nil, errors.New("no clients supplied")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that outstanding calls do not block once we have returned.
+	resultCh := make(chan *strategyResult, len(clients))
+	callClient := func(client consensusclient.Service) {
+		res, err := call(ctx, client)
+		resultCh <- &strategyResult{
+			client: client,
+			res:    res,
+			err:    err,
+		}
+	}
+
+	go callClient(clients[0])
+	outstanding := 1
+	remaining := clients[1:]
+	callRemaining := func() {
+		for _, client := range remaining {
+			go callClient(client)
+		}
+		outstanding += len(remaining)
+		remaining = nil
+	}
+
+	timer := time.NewTimer(s.delay)
+	defer timer.Stop()
+
+	var err error
+	for outstanding > 0 || len(remaining) > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			callRemaining()
+		case result := <-resultCh:
+			outstanding--
+			if result.err == nil {
+				return result.res, nil
+			}
+			if err == nil {
+				err = result.err
+			}
+			// Do not wait for the delay if the first client has failed.
+			callRemaining()
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,62 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// FirstSuccessStrategy calls clients in turn, returning the first successful
+// response.
+type FirstSuccessStrategy struct{}
+
+// NewFirstSuccessStrategy creates a new first success strategy.
+func NewFirstSuccessStrategy() *FirstSuccessStrategy {
+	return &FirstSuccessStrategy{}
+}
+
+// Name returns the name of the strategy.
+func (*FirstSuccessStrategy) Name() string {
+	return "first success"
+}
+
+// Execute carries out the call against the supplied clients, returning
+// the selected response.
+func (*FirstSuccessStrategy) Execute(ctx context.Context,
+	clients []consensusclient.Service,
+	call StrategyCallFunc,
+) (
+	any,
+	error,
+) {
+	var err error
+	for _, client := range clients {
+		var res any
+		res, err = call(ctx, client)
+		if err == nil {
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+	}
+	if err == nil {
+		err = errors.New("no clients supplied")
+	}
+
+	return nil, err
+}
@@ -0,0 +1,99 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// ScoreFunc is the definition for a function that scores a response from
+// a client.  Higher scores are better.
+type ScoreFunc func(ctx context.Context, client consensusclient.Service, res any) (float64, error)
+
+// HighestScoreStrategy calls all clients concurrently, returning the
+// response with the highest weighted score.  Ties are broken in favor of
+// the response from the earliest client.
+type HighestScoreStrategy struct {
+	score   ScoreFunc
+	weights map[string]float64
+}
+
+// NewHighestScoreStrategy creates a new highest score strategy.  Weights
+// are keyed by client address, and multiply the score of responses from
+// that client.  Clients without a weight have a weight of 1.
+func NewHighestScoreStrategy(score ScoreFunc, weights map[string]float64) (*HighestScoreStrategy, error) {
+	if score == nil {
+		return nil, errors.New("no score function specified")
+	}
+	for address, weight := range weights {
+		if weight < 0 {
+			return nil, errors.Errorf("weight for %s cannot be negative", address)
+		}
+	}
+
+	return &HighestScoreStrategy{
+		score:   score,
+		weights: weights,
+	}, nil
+}
+
+// Name returns the name of the strategy.
+func (*HighestScoreStrategy) Name() string {
+	return "highest score"
+}
+
+// Execute carries out the call against the supplied clients, returning
+// the selected response.
+func (s *HighestScoreStrategy) Execute(ctx context.Context,
+	clients []consensusclient.Service,
+	call StrategyCallFunc,
+) (
+	any,
+	error,
+) {
+	results := callAll(ctx, clients, call)
+
+	var best any
+	bestScore := 0.0
+	found := false
+	for _, result := range results {
+		if result.err != nil {
+			continue
+		}
+		score, err := s.score(ctx, result.client, result.res)
+		if err != nil {
+			// Record the error in case no response is usable.
+			result.err = err
+
+			continue
+		}
+		if weight, exists := s.weights[result.client.Address()]; exists {
+			score *= weight
+		}
+		if !found || score > bestScore {
+			best = result.res
+			bestScore = score
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, firstError(results)
+	}
+
+	return best, nil
+}
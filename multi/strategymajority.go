@@ -0,0 +1,96 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// KeyFunc is the definition for a function that provides a key for a
+// response, such that responses with the same key are considered equal.
+type KeyFunc func(res any) (string, error)
+
+// MajorityStrategy calls all clients concurrently, returning the response
+// with which the most clients agree.  Ties are broken in favor of the
+// response from the earliest client.
+type MajorityStrategy struct {
+	key KeyFunc
+}
+
+// NewMajorityStrategy creates a new majority strategy.
+func NewMajorityStrategy(key KeyFunc) (*MajorityStrategy, error) {
+	if key == nil {
+		return nil, errors.New("no key function specified")
+	}
+
+	return &MajorityStrategy{
+		key: key,
+	}, nil
+}
+
+// Name returns the name of the strategy.
+func (*MajorityStrategy) Name() string {
+	return "majority"
+}
+
+// Execute carries out the call against the supplied clients, returning
+// the selected response.
+func (s *MajorityStrategy) Execute(ctx context.Context,
+	clients []consensusclient.Service,
+	call StrategyCallFunc,
+) (
+	any,
+	error,
+) {
+	log := zerolog.Ctx(ctx)
+
+	results := callAll(ctx, clients, call)
+
+	counts := make(map[string]int)
+	first := make(map[string]any)
+	order := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.err != nil {
+			continue
+		}
+		key, err := s.key(result.res)
+		if err != nil {
+			log.Debug().Str("client", result.client.Address()).Err(err).Msg("Failed to obtain key for response")
+
+			continue
+		}
+		if _, exists := counts[key]; !exists {
+			first[key] = result.res
+			order = append(order, key)
+		}
+		counts[key]++
+	}
+
+	if len(order) == 0 {
+		return nil, firstError(results)
+	}
+
+	bestKey := order[0]
+	for _, key := range order[1:] {
+		if counts[key] > counts[bestKey] {
+			bestKey = key
+		}
+	}
+
+	return first[bestKey], nil
+}
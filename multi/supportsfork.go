@@ -0,0 +1,43 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// SupportsFork returns true if the beacon node supports the given fork.
+func (s *Service) SupportsFork(ctx context.Context, version spec.DataVersion) (bool, error) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (any, error) {
+		supported, err := client.(consensusclient.ForkSupporter).SupportsFork(ctx, version)
+		if err != nil {
+			return nil, err
+		}
+
+		return supported, nil
+	}, nil)
+	if err != nil {
+		return false, err
+	}
+
+	supported, isSupported := res.(bool)
+	if !isSupported {
+		return false, ErrIncorrectType
+	}
+
+	return supported, nil
+}
@@ -0,0 +1,161 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks
+
+import (
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// mainnetDepositContractAddress is the address of the deposit contract on
+// mainnet, which is also used by Hoodi.
+var mainnetDepositContractAddress = []byte{
+	0x00, 0x00, 0x00, 0x00, 0x21, 0x9a, 0xb5, 0x40, 0x35, 0x6c,
+	0xbb, 0x83, 0x9c, 0xbe, 0x05, 0x30, 0x3d, 0x77, 0x05, 0xfa,
+}
+
+// Mainnet is the Ethereum mainnet.
+var Mainnet = &Network{
+	Name:        "mainnet",
+	GenesisTime: time.Unix(1606824023, 0),
+	GenesisValidatorsRoot: phase0.Root{
+		0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+		0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+	},
+	GenesisForkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+	DepositContract: &apiv1.DepositContract{
+		ChainID: 1,
+		Address: mainnetDepositContractAddress,
+	},
+	DepositContractBlock: 11052984,
+	Forks: schedule(
+		[]phase0.Version{
+			{0x00, 0x00, 0x00, 0x00},
+			{0x01, 0x00, 0x00, 0x00},
+			{0x02, 0x00, 0x00, 0x00},
+			{0x03, 0x00, 0x00, 0x00},
+			{0x04, 0x00, 0x00, 0x00},
+			{0x05, 0x00, 0x00, 0x00},
+		},
+		[]phase0.Epoch{0, 74240, 144896, 194048, 269568, 364032},
+	),
+}
+
+// Sepolia is the Sepolia testnet.
+var Sepolia = &Network{
+	Name:        "sepolia",
+	GenesisTime: time.Unix(1655733600, 0),
+	GenesisValidatorsRoot: phase0.Root{
+		0xd8, 0xea, 0x17, 0x1f, 0x3c, 0x94, 0xae, 0xa2, 0x1e, 0xbc, 0x42, 0xa1, 0xed, 0x61, 0x05, 0x2a,
+		0xcf, 0x3f, 0x92, 0x09, 0xc0, 0x0e, 0x4e, 0xfb, 0xaa, 0xdd, 0xac, 0x09, 0xed, 0x9b, 0x80, 0x78,
+	},
+	GenesisForkVersion: phase0.Version{0x90, 0x00, 0x00, 0x69},
+	DepositContract: &apiv1.DepositContract{
+		ChainID: 11155111,
+		Address: []byte{
+			0x7f, 0x02, 0xc3, 0xe3, 0xc9, 0x8b, 0x13, 0x30, 0x55, 0xb8,
+			0xb3, 0x48, 0xb2, 0xac, 0x62, 0x56, 0x69, 0xed, 0x29, 0x5d,
+		},
+	},
+	DepositContractBlock: 1273020,
+	Forks: schedule(
+		[]phase0.Version{
+			{0x90, 0x00, 0x00, 0x69},
+			{0x90, 0x00, 0x00, 0x70},
+			{0x90, 0x00, 0x00, 0x71},
+			{0x90, 0x00, 0x00, 0x72},
+			{0x90, 0x00, 0x00, 0x73},
+			{0x90, 0x00, 0x00, 0x74},
+		},
+		[]phase0.Epoch{0, 50, 100, 56832, 132608, 222464},
+	),
+}
+
+// Holesky is the Holesky testnet.
+var Holesky = &Network{
+	Name:        "holesky",
+	GenesisTime: time.Unix(1695902400, 0),
+	GenesisValidatorsRoot: phase0.Root{
+		0x91, 0x43, 0xaa, 0x7c, 0x61, 0x5a, 0x7f, 0x71, 0x15, 0xe2, 0xb6, 0xaa, 0xc3, 0x19, 0xc0, 0x35,
+		0x29, 0xdf, 0x82, 0x42, 0xae, 0x70, 0x5f, 0xba, 0x9d, 0xf3, 0x9b, 0x79, 0xc5, 0x9f, 0xa8, 0xb1,
+	},
+	GenesisForkVersion: phase0.Version{0x01, 0x01, 0x70, 0x00},
+	DepositContract: &apiv1.DepositContract{
+		ChainID: 17000,
+		Address: []byte{
+			0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42,
+			0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42,
+		},
+	},
+	DepositContractBlock: 0,
+	Forks: schedule(
+		[]phase0.Version{
+			{0x01, 0x01, 0x70, 0x00},
+			{0x02, 0x01, 0x70, 0x00},
+			{0x03, 0x01, 0x70, 0x00},
+			{0x04, 0x01, 0x70, 0x00},
+			{0x05, 0x01, 0x70, 0x00},
+			{0x06, 0x01, 0x70, 0x00},
+		},
+		[]phase0.Epoch{0, 0, 0, 256, 29696, 115968},
+	),
+}
+
+// Hoodi is the Hoodi testnet.
+var Hoodi = &Network{
+	Name:        "hoodi",
+	GenesisTime: time.Unix(1742213400, 0),
+	GenesisValidatorsRoot: phase0.Root{
+		0x21, 0x2f, 0x13, 0xfc, 0x4d, 0xf0, 0x78, 0xb6, 0xcb, 0x7d, 0xb2, 0x28, 0xf1, 0xc8, 0x30, 0x75,
+		0x66, 0xdc, 0xec, 0xf9, 0x00, 0x86, 0x74, 0x01, 0xa9, 0x20, 0x23, 0xd7, 0xba, 0x99, 0xcb, 0x5f,
+	},
+	GenesisForkVersion: phase0.Version{0x10, 0x00, 0x09, 0x10},
+	DepositContract: &apiv1.DepositContract{
+		ChainID: 560048,
+		Address: mainnetDepositContractAddress,
+	},
+	DepositContractBlock: 0,
+	Forks: schedule(
+		[]phase0.Version{
+			{0x10, 0x00, 0x09, 0x10},
+			{0x20, 0x00, 0x09, 0x10},
+			{0x30, 0x00, 0x09, 0x10},
+			{0x40, 0x00, 0x09, 0x10},
+			{0x50, 0x00, 0x09, 0x10},
+			{0x60, 0x00, 0x09, 0x10},
+		},
+		[]phase0.Epoch{0, 0, 0, 0, 0, 2048},
+	),
+}
+
+// schedule creates a fork schedule from fork versions and their activation
+// epochs, in the same form as that returned by a beacon node.
+func schedule(versions []phase0.Version, epochs []phase0.Epoch) []*phase0.Fork {
+	forks := make([]*phase0.Fork, len(versions))
+	for i := range versions {
+		forks[i] = &phase0.Fork{
+			CurrentVersion: versions[i],
+			Epoch:          epochs[i],
+		}
+		if i == 0 {
+			forks[i].PreviousVersion = versions[i]
+		} else {
+			forks[i].PreviousVersion = versions[i-1]
+		}
+	}
+
+	return forks
+}
@@ -0,0 +1,194 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package networks contains the parameters of well-known public networks,
+// allowing values such as fork digests to be computed without a connection to
+// a beacon node, and connected beacon nodes to be checked against the network
+// they are expected to be on.
+package networks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ErrUnknownNetwork is returned when a network is not one of the known networks.
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// ErrNetworkMismatch is returned when a beacon node is not on the expected network.
+var ErrNetworkMismatch = errors.New("network mismatch")
+
+// Network contains the parameters of a network.
+type Network struct {
+	// Name is the name of the network.
+	Name string
+	// GenesisTime is the time of the network's genesis.
+	GenesisTime time.Time
+	// GenesisValidatorsRoot is the root of the validators at genesis.
+	GenesisValidatorsRoot phase0.Root
+	// GenesisForkVersion is the fork version at genesis.
+	GenesisForkVersion phase0.Version
+	// DepositContract is the deposit contract of the network.
+	DepositContract *apiv1.DepositContract
+	// DepositContractBlock is the execution block at which the deposit
+	// contract was deployed.
+	DepositContractBlock uint64
+	// Forks is the fork schedule of the network, in epoch order.
+	Forks []*phase0.Fork
+}
+
+// Networks returns all known networks.
+func Networks() []*Network {
+	return []*Network{
+		Mainnet,
+		Sepolia,
+		Holesky,
+		Hoodi,
+	}
+}
+
+// ByName returns the known network with the given name, ignoring case.
+func ByName(name string) (*Network, error) {
+	for _, network := range Networks() {
+		if strings.EqualFold(network.Name, name) {
+			return network, nil
+		}
+	}
+
+	return nil, ErrUnknownNetwork
+}
+
+// ByGenesisValidatorsRoot returns the known network with the given genesis validators root.
+func ByGenesisValidatorsRoot(root phase0.Root) (*Network, error) {
+	for _, network := range Networks() {
+		if network.GenesisValidatorsRoot == root {
+			return network, nil
+		}
+	}
+
+	return nil, ErrUnknownNetwork
+}
+
+// ForkAtEpoch returns the fork that is active at the given epoch.
+func (n *Network) ForkAtEpoch(epoch phase0.Epoch) *phase0.Fork {
+	var fork *phase0.Fork
+	for _, candidate := range n.Forks {
+		if candidate.Epoch > epoch {
+			break
+		}
+		fork = candidate
+	}
+
+	return fork
+}
+
+// ForkDigest returns the fork digest of the network at the given epoch.
+func (n *Network) ForkDigest(epoch phase0.Epoch) (phase0.ForkDigest, error) {
+	fork := n.ForkAtEpoch(epoch)
+	if fork == nil {
+		return phase0.ForkDigest{}, fmt.Errorf("no fork at epoch %d", epoch)
+	}
+
+	return ComputeForkDigest(fork.CurrentVersion, n.GenesisValidatorsRoot)
+}
+
+// ComputeForkDigest computes the fork digest for the given fork version and
+// genesis validators root.
+func ComputeForkDigest(forkVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.ForkDigest, error) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.ForkDigest{}, errors.Join(errors.New("failed to calculate fork data root"), err)
+	}
+
+	var digest phase0.ForkDigest
+	copy(digest[:], root[:4])
+
+	return digest, nil
+}
+
+// CheckGenesis checks that genesis information matches that of the network.
+func (n *Network) CheckGenesis(genesis *apiv1.Genesis) error {
+	if genesis == nil {
+		return errors.New("no genesis supplied")
+	}
+	if genesis.GenesisValidatorsRoot != n.GenesisValidatorsRoot {
+		return errors.Join(fmt.Errorf("genesis validators root %#x does not match %s genesis validators root %#x",
+			genesis.GenesisValidatorsRoot, n.Name, n.GenesisValidatorsRoot), ErrNetworkMismatch)
+	}
+	if genesis.GenesisForkVersion != n.GenesisForkVersion {
+		return errors.Join(fmt.Errorf("genesis fork version %#x does not match %s genesis fork version %#x",
+			genesis.GenesisForkVersion, n.Name, n.GenesisForkVersion), ErrNetworkMismatch)
+	}
+	if !genesis.GenesisTime.Equal(n.GenesisTime) {
+		return errors.Join(fmt.Errorf("genesis time %s does not match %s genesis time %s",
+			genesis.GenesisTime.UTC().Format(time.RFC3339), n.Name, n.GenesisTime.UTC().Format(time.RFC3339)), ErrNetworkMismatch)
+	}
+
+	return nil
+}
+
+// CheckNode checks that the beacon node behind the provider is on the network.
+func (n *Network) CheckNode(ctx context.Context, provider consensusclient.GenesisProvider) error {
+	genesis, err := obtainGenesis(ctx, provider)
+	if err != nil {
+		return err
+	}
+
+	return n.CheckGenesis(genesis)
+}
+
+// Identify returns the known network of the beacon node behind the provider.
+func Identify(ctx context.Context, provider consensusclient.GenesisProvider) (*Network, error) {
+	genesis, err := obtainGenesis(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := ByGenesisValidatorsRoot(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	if err := network.CheckGenesis(genesis); err != nil {
+		return nil, err
+	}
+
+	return network, nil
+}
+
+func obtainGenesis(ctx context.Context, provider consensusclient.GenesisProvider) (*apiv1.Genesis, error) {
+	if provider == nil {
+		return nil, errors.New("no genesis provider supplied")
+	}
+
+	response, err := provider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+	if response == nil || response.Data == nil {
+		return nil, errors.New("no genesis returned")
+	}
+
+	return response.Data, nil
+}
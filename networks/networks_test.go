@@ -0,0 +1,107 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/networks"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkDigest(t *testing.T) {
+	tests := []struct {
+		epoch  phase0.Epoch
+		digest string
+	}{
+		{epoch: 0, digest: "0xb5303f2a"},
+		{epoch: 74240, digest: "0xafcaaba0"},
+		{epoch: 144896, digest: "0x4a26c58b"},
+		{epoch: 194048, digest: "0xbba4da96"},
+		{epoch: 269568, digest: "0x6a95a1a9"},
+		{epoch: 269567, digest: "0xbba4da96"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d", test.epoch), func(t *testing.T) {
+			digest, err := networks.Mainnet.ForkDigest(test.epoch)
+			require.NoError(t, err)
+			require.Equal(t, test.digest, fmt.Sprintf("%#x", digest))
+		})
+	}
+}
+
+func TestSchedules(t *testing.T) {
+	for _, network := range networks.Networks() {
+		t.Run(network.Name, func(t *testing.T) {
+			require.NotEmpty(t, network.Forks)
+			require.Equal(t, network.GenesisForkVersion, network.Forks[0].CurrentVersion)
+			require.Equal(t, phase0.Epoch(0), network.Forks[0].Epoch)
+			for i := 1; i < len(network.Forks); i++ {
+				require.LessOrEqual(t, network.Forks[i-1].Epoch, network.Forks[i].Epoch)
+				require.Equal(t, network.Forks[i-1].CurrentVersion, network.Forks[i].PreviousVersion)
+			}
+			require.Len(t, network.DepositContract.Address, 20)
+
+			byName, err := networks.ByName(network.Name)
+			require.NoError(t, err)
+			require.Equal(t, network, byName)
+			byRoot, err := networks.ByGenesisValidatorsRoot(network.GenesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, network, byRoot)
+		})
+	}
+
+	_, err := networks.ByName("unknown")
+	require.ErrorIs(t, err, networks.ErrUnknownNetwork)
+}
+
+func TestIdentify(t *testing.T) {
+	ctx := context.Background()
+
+	genesis := &apiv1.Genesis{
+		GenesisTime:           networks.Hoodi.GenesisTime,
+		GenesisValidatorsRoot: networks.Hoodi.GenesisValidatorsRoot,
+		GenesisForkVersion:    networks.Hoodi.GenesisForkVersion,
+	}
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.GenesisFunc = func(context.Context, *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+		return &api.Response[*apiv1.Genesis]{Data: genesis}, nil
+	}
+
+	network, err := networks.Identify(ctx, client)
+	require.NoError(t, err)
+	require.Equal(t, networks.Hoodi, network)
+	require.NoError(t, networks.Hoodi.CheckNode(ctx, client))
+
+	err = networks.Mainnet.CheckNode(ctx, client)
+	require.ErrorIs(t, err, networks.ErrNetworkMismatch)
+
+	genesis.GenesisTime = genesis.GenesisTime.Add(time.Second)
+	_, err = networks.Identify(ctx, client)
+	require.ErrorIs(t, err, networks.ErrNetworkMismatch)
+	require.ErrorContains(t, err, "genesis time 2025-03-17T12:10:01Z does not match hoodi genesis time 2025-03-17T12:10:00Z")
+
+	genesis.GenesisValidatorsRoot = phase0.Root{0x01}
+	_, err = networks.Identify(ctx, client)
+	require.ErrorIs(t, err, networks.ErrUnknownNetwork)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pools
+
+import (
+	"sync"
+)
+
+// maxBufferSize is the largest buffer capacity that will be returned to the
+// pool.  Larger buffers are left to the garbage collector, so that a single
+// oversized object does not pin a large allocation for the life of the
+// process.
+const maxBufferSize = 16 * 1024 * 1024
+
+var buffers = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 64*1024)
+
+		return &buf
+	},
+}
+
+// GetBuffer obtains an empty scratch buffer, for example for use with
+// MarshalSSZTo.
+func GetBuffer() *[]byte {
+	buf, ok := buffers.Get().(*[]byte)
+	if !ok {
+		buf = new([]byte)
+	}
+	*buf = (*buf)[:0]
+
+	return buf
+}
+
+// PutBuffer returns a scratch buffer to the pool.  The caller should store
+// any growth of the buffer back in to it before returning it, so that the
+// larger capacity can be reused.
+func PutBuffer(buf *[]byte) {
+	if buf == nil || cap(*buf) > maxBufferSize {
+		return
+	}
+	*buf = (*buf)[:0]
+	buffers.Put(buf)
+}
@@ -0,0 +1,163 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pools
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Signed beacon block pools retain the block's message, body, ETH1 data and
+// execution payload when a block is returned, as SSZ decoding fills in
+// existing nested containers rather than allocating new ones.  Byte slices
+// that the decoder appends to are truncated rather than dropped, so that
+// their capacity is reused.
+var (
+	// Phase0SignedBeaconBlocks is a pool of phase 0 signed beacon blocks.
+	Phase0SignedBeaconBlocks = NewPool(resetPhase0SignedBeaconBlock)
+	// AltairSignedBeaconBlocks is a pool of altair signed beacon blocks.
+	AltairSignedBeaconBlocks = NewPool(resetAltairSignedBeaconBlock)
+	// BellatrixSignedBeaconBlocks is a pool of bellatrix signed beacon blocks.
+	BellatrixSignedBeaconBlocks = NewPool(resetBellatrixSignedBeaconBlock)
+	// CapellaSignedBeaconBlocks is a pool of capella signed beacon blocks.
+	CapellaSignedBeaconBlocks = NewPool(resetCapellaSignedBeaconBlock)
+	// DenebSignedBeaconBlocks is a pool of deneb signed beacon blocks.
+	DenebSignedBeaconBlocks = NewPool(resetDenebSignedBeaconBlock)
+	// ElectraSignedBeaconBlocks is a pool of electra signed beacon blocks.
+	ElectraSignedBeaconBlocks = NewPool(resetElectraSignedBeaconBlock)
+)
+
+func resetPhase0SignedBeaconBlock(block *phase0.SignedBeaconBlock) {
+	message := block.Message
+	*block = phase0.SignedBeaconBlock{Message: message}
+	if message == nil {
+		return
+	}
+	body := message.Body
+	*message = phase0.BeaconBlock{Body: body}
+	if body != nil {
+		*body = phase0.BeaconBlockBody{ETH1Data: resetETH1Data(body.ETH1Data)}
+	}
+}
+
+func resetAltairSignedBeaconBlock(block *altair.SignedBeaconBlock) {
+	message := block.Message
+	*block = altair.SignedBeaconBlock{Message: message}
+	if message == nil {
+		return
+	}
+	body := message.Body
+	*message = altair.BeaconBlock{Body: body}
+	if body != nil {
+		*body = altair.BeaconBlockBody{ETH1Data: resetETH1Data(body.ETH1Data)}
+	}
+}
+
+func resetBellatrixSignedBeaconBlock(block *bellatrix.SignedBeaconBlock) {
+	message := block.Message
+	*block = bellatrix.SignedBeaconBlock{Message: message}
+	if message == nil {
+		return
+	}
+	body := message.Body
+	*message = bellatrix.BeaconBlock{Body: body}
+	if body == nil {
+		return
+	}
+	payload := body.ExecutionPayload
+	*body = bellatrix.BeaconBlockBody{
+		ETH1Data:         resetETH1Data(body.ETH1Data),
+		ExecutionPayload: payload,
+	}
+	if payload != nil {
+		*payload = bellatrix.ExecutionPayload{ExtraData: payload.ExtraData[:0]}
+	}
+}
+
+func resetCapellaSignedBeaconBlock(block *capella.SignedBeaconBlock) {
+	message := block.Message
+	*block = capella.SignedBeaconBlock{Message: message}
+	if message == nil {
+		return
+	}
+	body := message.Body
+	*message = capella.BeaconBlock{Body: body}
+	if body == nil {
+		return
+	}
+	payload := body.ExecutionPayload
+	*body = capella.BeaconBlockBody{
+		ETH1Data:         resetETH1Data(body.ETH1Data),
+		ExecutionPayload: payload,
+	}
+	if payload != nil {
+		*payload = capella.ExecutionPayload{ExtraData: payload.ExtraData[:0]}
+	}
+}
+
+func resetDenebSignedBeaconBlock(block *deneb.SignedBeaconBlock) {
+	message := block.Message
+	*block = deneb.SignedBeaconBlock{Message: message}
+	if message == nil {
+		return
+	}
+	body := message.Body
+	*message = deneb.BeaconBlock{Body: body}
+	if body == nil {
+		return
+	}
+	payload := body.ExecutionPayload
+	*body = deneb.BeaconBlockBody{
+		ETH1Data:         resetETH1Data(body.ETH1Data),
+		ExecutionPayload: payload,
+	}
+	if payload != nil {
+		*payload = deneb.ExecutionPayload{ExtraData: payload.ExtraData[:0]}
+	}
+}
+
+func resetElectraSignedBeaconBlock(block *electra.SignedBeaconBlock) {
+	message := block.Message
+	*block = electra.SignedBeaconBlock{Message: message}
+	if message == nil {
+		return
+	}
+	body := message.Body
+	*message = electra.BeaconBlock{Body: body}
+	if body == nil {
+		return
+	}
+	payload := body.ExecutionPayload
+	*body = electra.BeaconBlockBody{
+		ETH1Data:         resetETH1Data(body.ETH1Data),
+		ExecutionPayload: payload,
+	}
+	if payload != nil {
+		*payload = deneb.ExecutionPayload{ExtraData: payload.ExtraData[:0]}
+	}
+}
+
+// resetETH1Data clears ETH1 data, retaining the capacity of its block hash.
+func resetETH1Data(data *phase0.ETH1Data) *phase0.ETH1Data {
+	if data == nil {
+		return nil
+	}
+	*data = phase0.ETH1Data{BlockHash: data.BlockHash[:0]}
+
+	return data
+}
@@ -0,0 +1,73 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pools provides pools of frequently allocated containers and
+// scratch buffers, to reduce garbage collection pressure when handling large
+// numbers of blocks.
+//
+// Objects obtained from a pool belong to the caller until they are returned
+// with Put.  Once returned an object must not be used again, nor may any
+// reference into it be retained, as it may be handed out to another caller
+// at any time.  Returning objects is optional: objects that are not returned
+// are garbage collected as usual.
+package pools
+
+import (
+	"sync"
+)
+
+// Pool is a typed pool of objects.
+type Pool[T any] struct {
+	pool  sync.Pool
+	reset func(*T)
+}
+
+// NewPool creates a pool of objects.  If supplied, reset is called on each
+// object as it is returned to the pool; otherwise the object is set to its
+// zero value.
+func NewPool[T any](reset func(*T)) *Pool[T] {
+	if reset == nil {
+		reset = func(obj *T) {
+			var zero T
+			*obj = zero
+		}
+	}
+
+	return &Pool[T]{
+		pool: sync.Pool{
+			New: func() any {
+				return new(T)
+			},
+		},
+		reset: reset,
+	}
+}
+
+// Get obtains an object from the pool, allocating it if required.
+func (p *Pool[T]) Get() *T {
+	obj, ok := p.pool.Get().(*T)
+	if !ok {
+		return new(T)
+	}
+
+	return obj
+}
+
+// Put resets an object and returns it to the pool.
+func (p *Pool[T]) Put(obj *T) {
+	if obj == nil {
+		return
+	}
+	p.reset(obj)
+	p.pool.Put(obj)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pools_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/pools"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool(t *testing.T) {
+	block := pools.Phase0SignedBeaconBlocks.Get()
+	require.NotNil(t, block)
+	block.Message = &phase0.BeaconBlock{
+		Slot: 1,
+		Body: &phase0.BeaconBlockBody{
+			Graffiti:     [32]byte{0x01},
+			Attestations: []*phase0.Attestation{{}},
+		},
+	}
+	block.Signature = phase0.BLSSignature{0x01}
+	message := block.Message
+	body := block.Message.Body
+	pools.Phase0SignedBeaconBlocks.Put(block)
+	// Objects are reset on return, retaining nested containers.
+	require.Equal(t, &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Body: &phase0.BeaconBlockBody{},
+		},
+	}, block)
+	require.Same(t, message, block.Message)
+	require.Same(t, body, block.Message.Body)
+
+	// Nil objects are ignored.
+	pools.Phase0SignedBeaconBlocks.Put(nil)
+}
+
+func TestPoolElectraBlock(t *testing.T) {
+	block := pools.ElectraSignedBeaconBlocks.Get()
+	// Empty blocks can be returned.
+	pools.ElectraSignedBeaconBlocks.Put(block)
+	require.Nil(t, block.Message)
+
+	block.Message = &electra.BeaconBlock{
+		Slot: 1,
+		Body: &electra.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{
+				DepositCount: 1,
+				BlockHash:    make([]byte, 32),
+			},
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BlockNumber: 1,
+				ExtraData:   []byte{0x01, 0x02},
+			},
+		},
+	}
+	eth1Data := block.Message.Body.ETH1Data
+	payload := block.Message.Body.ExecutionPayload
+	pools.ElectraSignedBeaconBlocks.Put(block)
+	require.Zero(t, block.Message.Slot)
+	// Byte slices are truncated, retaining their capacity.
+	require.Same(t, eth1Data, block.Message.Body.ETH1Data)
+	require.Zero(t, eth1Data.DepositCount)
+	require.Empty(t, eth1Data.BlockHash)
+	require.Equal(t, 32, cap(eth1Data.BlockHash))
+	require.Same(t, payload, block.Message.Body.ExecutionPayload)
+	require.Zero(t, payload.BlockNumber)
+	require.Empty(t, payload.ExtraData)
+	require.Equal(t, 2, cap(payload.ExtraData))
+}
+
+func TestPoolReset(t *testing.T) {
+	resets := 0
+	pool := pools.NewPool(func(obj *[]int) {
+		resets++
+		*obj = (*obj)[:0]
+	})
+
+	obj := pool.Get()
+	*obj = append(*obj, 1, 2, 3)
+	pool.Put(obj)
+	require.Equal(t, 1, resets)
+	require.Empty(t, *obj)
+	require.GreaterOrEqual(t, cap(*obj), 3)
+}
+
+func TestBuffers(t *testing.T) {
+	buf := pools.GetBuffer()
+	require.Empty(t, *buf)
+	*buf = append(*buf, []byte("data")...)
+	pools.PutBuffer(buf)
+	require.Empty(t, *buf)
+
+	// Oversized buffers are not retained.
+	large := make([]byte, 0, 32*1024*1024)
+	pools.PutBuffer(&large)
+	require.Equal(t, 32*1024*1024, cap(large))
+
+	pools.PutBuffer(nil)
+}
@@ -0,0 +1,104 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposalpreparations
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel     zerolog.Level
+	chainTime    *chaintime.Service
+	submitter    consensusclient.ProposalPreparationsSubmitter
+	preparations []*apiv1.ProposalPreparation
+	chunkSize    int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithProposalPreparationsSubmitter sets the proposal preparations submitter.
+func WithProposalPreparationsSubmitter(submitter consensusclient.ProposalPreparationsSubmitter) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submitter = submitter
+	})
+}
+
+// WithPreparations sets the initial proposal preparations.
+func WithPreparations(preparations []*apiv1.ProposalPreparation) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preparations = preparations
+	})
+}
+
+// WithChunkSize sets the maximum number of preparations sent in a single
+// submission.
+// Defaults to 1024.
+func WithChunkSize(chunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chunkSize = chunkSize
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:  zerolog.GlobalLevel(),
+		chunkSize: 1024,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.submitter == nil {
+		return nil, errors.New("no proposal preparations submitter specified")
+	}
+	if parameters.chunkSize <= 0 {
+		return nil, errors.New("chunk size must be greater than 0")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,151 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proposalpreparations manages proposal preparations for a set of
+// validators.  Beacon nodes forget preparations that have not been
+// resubmitted recently, so the preparations are submitted at the start of
+// every epoch.
+package proposalpreparations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service manages proposal preparations.
+type Service struct {
+	log       zerolog.Logger
+	chainTime *chaintime.Service
+	submitter consensusclient.ProposalPreparationsSubmitter
+	chunkSize int
+
+	preparationsMu sync.RWMutex
+	preparations   []*apiv1.ProposalPreparation
+}
+
+// New creates a new proposal preparations manager.  Preparations are
+// submitted immediately and then at the start of each epoch, until the
+// supplied context is canceled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "proposalpreparations").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:          log,
+		chainTime:    parameters.chainTime,
+		submitter:    parameters.submitter,
+		chunkSize:    parameters.chunkSize,
+		preparations: deduplicate(parameters.preparations),
+	}
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// SetPreparations sets the proposal preparations.  If a validator has more
+// than one preparation then the last is used.  The new set takes effect from
+// the next submission.
+func (s *Service) SetPreparations(preparations []*apiv1.ProposalPreparation) {
+	deduplicated := deduplicate(preparations)
+
+	s.preparationsMu.Lock()
+	s.preparations = deduplicated
+	s.preparationsMu.Unlock()
+}
+
+// Preparations returns the current proposal preparations, ordered by
+// validator index.
+func (s *Service) Preparations() []*apiv1.ProposalPreparation {
+	s.preparationsMu.RLock()
+	defer s.preparationsMu.RUnlock()
+
+	return s.preparations
+}
+
+// Submit submits the current proposal preparations, in chunks of at most the
+// configured chunk size.  All chunks are submitted even if some fail.
+func (s *Service) Submit(ctx context.Context) error {
+	preparations := s.Preparations()
+
+	var errs error
+	for start := 0; start < len(preparations); start += s.chunkSize {
+		end := min(start+s.chunkSize, len(preparations))
+		if err := s.submitter.SubmitProposalPreparations(ctx, preparations[start:end]); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to submit proposal preparations %d to %d: %w", start, end-1, err))
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+	s.log.Trace().Int("preparations", len(preparations)).Msg("Submitted proposal preparations")
+
+	return nil
+}
+
+// run submits proposal preparations immediately and at the start of each
+// epoch.
+func (s *Service) run(ctx context.Context) {
+	if err := s.Submit(ctx); err != nil {
+		s.log.Warn().Err(err).Msg("Failed to submit proposal preparations")
+	}
+
+	epochs := s.chainTime.EpochTicker(ctx)
+	for epoch := range epochs {
+		if err := s.Submit(ctx); err != nil {
+			s.log.Warn().Err(err).Uint64("epoch", uint64(epoch)).Msg("Failed to submit proposal preparations")
+		}
+	}
+	s.log.Trace().Msg("Context done; stopping")
+}
+
+// deduplicate returns the preparations with a single entry for each
+// validator, ordered by validator index.  The last preparation supplied for
+// a validator is used.
+func deduplicate(preparations []*apiv1.ProposalPreparation) []*apiv1.ProposalPreparation {
+	byIndex := make(map[phase0.ValidatorIndex]*apiv1.ProposalPreparation, len(preparations))
+	for _, preparation := range preparations {
+		if preparation == nil {
+			continue
+		}
+		byIndex[preparation.ValidatorIndex] = preparation
+	}
+
+	res := make([]*apiv1.ProposalPreparation, 0, len(byIndex))
+	for _, preparation := range byIndex {
+		res = append(res, preparation)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ValidatorIndex < res[j].ValidatorIndex
+	})
+
+	return res
+}
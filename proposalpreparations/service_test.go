@@ -0,0 +1,234 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposalpreparations_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/proposalpreparations"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testSubmitter records submitted preparations, failing the first failures submissions.
+type testSubmitter struct {
+	mu          sync.Mutex
+	failures    int
+	submissions [][]*apiv1.ProposalPreparation
+}
+
+func (s *testSubmitter) SubmitProposalPreparations(_ context.Context, preparations []*apiv1.ProposalPreparation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+
+		return errors.New("submission failed")
+	}
+	s.submissions = append(s.submissions, preparations)
+
+	return nil
+}
+
+func (s *testSubmitter) submitted() [][]*apiv1.ProposalPreparation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.submissions
+}
+
+func testChainTime(t *testing.T, slotDuration time.Duration) *chaintime.Service {
+	t.Helper()
+
+	client, err := mock.New(context.Background(), mock.WithGenesisTime(time.Now().Add(-time.Hour)))
+	require.NoError(t, err)
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"SECONDS_PER_SLOT": slotDuration,
+				"SLOTS_PER_EPOCH":  uint64(2),
+			},
+		}, nil
+	}
+
+	chainTime, err := chaintime.New(context.Background(),
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+	)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func preparation(index phase0.ValidatorIndex, feeRecipient byte) *apiv1.ProposalPreparation {
+	return &apiv1.ProposalPreparation{
+		ValidatorIndex: index,
+		FeeRecipient:   bellatrix.ExecutionAddress{feeRecipient},
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chainTime := testChainTime(t, time.Hour)
+	submitter := &testSubmitter{}
+
+	tests := []struct {
+		name   string
+		params []proposalpreparations.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []proposalpreparations.Parameter{
+				proposalpreparations.WithLogLevel(zerolog.Disabled),
+				proposalpreparations.WithProposalPreparationsSubmitter(submitter),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "SubmitterMissing",
+			params: []proposalpreparations.Parameter{
+				proposalpreparations.WithLogLevel(zerolog.Disabled),
+				proposalpreparations.WithChainTime(chainTime),
+			},
+			err: "problem with parameters\nno proposal preparations submitter specified",
+		},
+		{
+			name: "ChunkSizeZero",
+			params: []proposalpreparations.Parameter{
+				proposalpreparations.WithLogLevel(zerolog.Disabled),
+				proposalpreparations.WithChainTime(chainTime),
+				proposalpreparations.WithProposalPreparationsSubmitter(submitter),
+				proposalpreparations.WithChunkSize(0),
+			},
+			err: "problem with parameters\nchunk size must be greater than 0",
+		},
+		{
+			name: "Good",
+			params: []proposalpreparations.Parameter{
+				proposalpreparations.WithLogLevel(zerolog.Disabled),
+				proposalpreparations.WithChainTime(chainTime),
+				proposalpreparations.WithProposalPreparationsSubmitter(submitter),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := proposalpreparations.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeduplication(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := proposalpreparations.New(ctx,
+		proposalpreparations.WithLogLevel(zerolog.Disabled),
+		proposalpreparations.WithChainTime(testChainTime(t, time.Hour)),
+		proposalpreparations.WithProposalPreparationsSubmitter(&testSubmitter{}),
+		proposalpreparations.WithPreparations([]*apiv1.ProposalPreparation{
+			preparation(3, 0x03),
+			preparation(1, 0x01),
+			nil,
+			preparation(3, 0x04),
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.ProposalPreparation{preparation(1, 0x01), preparation(3, 0x04)}, s.Preparations())
+
+	s.SetPreparations([]*apiv1.ProposalPreparation{preparation(2, 0x02), preparation(2, 0x02)})
+	require.Equal(t, []*apiv1.ProposalPreparation{preparation(2, 0x02)}, s.Preparations())
+}
+
+func TestSubmitChunks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	submitter := &testSubmitter{}
+	s, err := proposalpreparations.New(ctx,
+		proposalpreparations.WithLogLevel(zerolog.Disabled),
+		proposalpreparations.WithChainTime(testChainTime(t, time.Hour)),
+		proposalpreparations.WithProposalPreparationsSubmitter(submitter),
+		proposalpreparations.WithChunkSize(2),
+	)
+	require.NoError(t, err)
+
+	// Wait for the initial empty submission to complete.
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, submitter.submitted())
+
+	s.SetPreparations([]*apiv1.ProposalPreparation{
+		preparation(5, 0x05),
+		preparation(4, 0x04),
+		preparation(3, 0x03),
+		preparation(2, 0x02),
+		preparation(1, 0x01),
+	})
+
+	require.NoError(t, s.Submit(ctx))
+	require.Equal(t, [][]*apiv1.ProposalPreparation{
+		{preparation(1, 0x01), preparation(2, 0x02)},
+		{preparation(3, 0x03), preparation(4, 0x04)},
+		{preparation(5, 0x05)},
+	}, submitter.submitted())
+
+	// A failed chunk does not stop the remaining chunks being submitted.
+	submitter.mu.Lock()
+	submitter.failures = 1
+	submitter.mu.Unlock()
+	require.ErrorContains(t, s.Submit(ctx), "failed to submit proposal preparations 0 to 1")
+	require.Len(t, submitter.submitted(), 5)
+}
+
+func TestPeriodicSubmission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	submitter := &testSubmitter{}
+	_, err := proposalpreparations.New(ctx,
+		proposalpreparations.WithLogLevel(zerolog.Disabled),
+		proposalpreparations.WithChainTime(testChainTime(t, 25*time.Millisecond)),
+		proposalpreparations.WithProposalPreparationsSubmitter(submitter),
+		proposalpreparations.WithPreparations([]*apiv1.ProposalPreparation{preparation(1, 0x01)}),
+	)
+	require.NoError(t, err)
+
+	// Initial submission plus at least two epochs.
+	require.Eventually(t, func() bool {
+		return len(submitter.submitted()) >= 3
+	}, time.Second, 10*time.Millisecond)
+	for _, submission := range submitter.submitted() {
+		require.Equal(t, []*apiv1.ProposalPreparation{preparation(1, 0x01)}, submission)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proposalverifier verifies execution payload bids, whether blinded
+// proposals from a beacon node or bids from a block builder, against the
+// values that a proposer expects, protecting the proposer from signing a
+// header that pays someone else, ignores its registered gas limit, builds on
+// the wrong parent or is worth less than its minimum.
+package proposalverifier
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Bid is the interface for a bid to be verified.  api.VersionedProposal,
+// blinded or otherwise, satisfies this interface; builder bids from other
+// libraries can be verified with a thin wrapper.
+type Bid interface {
+	// FeeRecipient returns the fee recipient of the execution payload.
+	FeeRecipient() (bellatrix.ExecutionAddress, error)
+	// GasLimit returns the gas limit of the execution payload.
+	GasLimit() (uint64, error)
+	// ExecutionParentHash returns the parent hash of the execution payload.
+	ExecutionParentHash() (phase0.Hash32, error)
+	// Value returns the value of the bid, in Wei.
+	Value() *big.Int
+}
+
+// Expectations are the values against which a bid is verified.  Only those
+// expectations that are set are checked.
+type Expectations struct {
+	// FeeRecipient is the fee recipient that the proposer expects.
+	FeeRecipient *bellatrix.ExecutionAddress
+	// GasLimit is the gas limit registered by the proposer.
+	GasLimit *uint64
+	// ParentGasLimit is the gas limit of the parent execution block.  If set,
+	// the gas limit of the bid is expected to move from the parent gas limit
+	// towards the registered gas limit by no more than the execution layer
+	// allows in a single block.  If not set, the gas limit of the bid is
+	// expected to equal the registered gas limit.
+	ParentGasLimit *uint64
+	// ParentHash is the hash of the execution block on which the bid should build.
+	ParentHash *phase0.Hash32
+	// MinValue is the minimum value of the bid, in Wei.
+	MinValue *big.Int
+}
+
+// ViolationType is the type of a violation.
+type ViolationType string
+
+const (
+	// ViolationFeeRecipient is a violation of the expected fee recipient.
+	ViolationFeeRecipient ViolationType = "fee_recipient"
+	// ViolationGasLimit is a violation of the expected gas limit.
+	ViolationGasLimit ViolationType = "gas_limit"
+	// ViolationParentHash is a violation of the expected parent hash.
+	ViolationParentHash ViolationType = "parent_hash"
+	// ViolationValue is a violation of the minimum value.
+	ViolationValue ViolationType = "value"
+)
+
+// Violation is a failure of a bid to meet an expectation.
+type Violation struct {
+	Type     ViolationType
+	Expected string
+	Actual   string
+}
+
+// String returns a string version of the structure.
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s: expected %s, actual %s", v.Type, v.Expected, v.Actual)
+}
+
+// Verify verifies a bid against the supplied expectations, returning the
+// violations found.  An error is returned only if the bid could not be
+// examined.
+func Verify(bid Bid, expectations *Expectations) ([]*Violation, error) {
+	if bid == nil {
+		return nil, errors.New("no bid specified")
+	}
+	if expectations == nil {
+		return nil, errors.New("no expectations specified")
+	}
+
+	violations := make([]*Violation, 0)
+
+	if expectations.FeeRecipient != nil {
+		feeRecipient, err := bid.FeeRecipient()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain fee recipient"), err)
+		}
+		if feeRecipient != *expectations.FeeRecipient {
+			violations = append(violations, &Violation{
+				Type:     ViolationFeeRecipient,
+				Expected: expectations.FeeRecipient.String(),
+				Actual:   feeRecipient.String(),
+			})
+		}
+	}
+
+	if expectations.GasLimit != nil {
+		gasLimit, err := bid.GasLimit()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain gas limit"), err)
+		}
+		expected := *expectations.GasLimit
+		if expectations.ParentGasLimit != nil {
+			expected = ExpectedGasLimit(*expectations.ParentGasLimit, *expectations.GasLimit)
+		}
+		if gasLimit != expected {
+			violations = append(violations, &Violation{
+				Type:     ViolationGasLimit,
+				Expected: fmt.Sprintf("%d", expected),
+				Actual:   fmt.Sprintf("%d", gasLimit),
+			})
+		}
+	}
+
+	if expectations.ParentHash != nil {
+		parentHash, err := bid.ExecutionParentHash()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain parent hash"), err)
+		}
+		if parentHash != *expectations.ParentHash {
+			violations = append(violations, &Violation{
+				Type:     ViolationParentHash,
+				Expected: expectations.ParentHash.String(),
+				Actual:   parentHash.String(),
+			})
+		}
+	}
+
+	if expectations.MinValue != nil {
+		value := bid.Value()
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		if value.Cmp(expectations.MinValue) < 0 {
+			violations = append(violations, &Violation{
+				Type:     ViolationValue,
+				Expected: fmt.Sprintf(">= %s", expectations.MinValue.String()),
+				Actual:   value.String(),
+			})
+		}
+	}
+
+	return violations, nil
+}
+
+// ExpectedGasLimit returns the gas limit that a block built on a parent with
+// the given gas limit should have, given the gas limit registered by the
+// proposer.  The execution layer only allows the gas limit to change by less
+// than 1/1024 of the parent gas limit in each block, so the result moves
+// towards the registered gas limit by at most that amount.
+func ExpectedGasLimit(parentGasLimit uint64, registeredGasLimit uint64) uint64 {
+	maxDifference := parentGasLimit / 1024
+	if maxDifference > 0 {
+		maxDifference--
+	}
+
+	switch {
+	case registeredGasLimit > parentGasLimit:
+		return min(registeredGasLimit, parentGasLimit+maxDifference)
+	case registeredGasLimit < parentGasLimit:
+		return max(registeredGasLimit, parentGasLimit-maxDifference)
+	default:
+		return parentGasLimit
+	}
+}
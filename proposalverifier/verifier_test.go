@@ -0,0 +1,214 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposalverifier_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/proposalverifier"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+var _ proposalverifier.Bid = &api.VersionedProposal{}
+
+func blindedProposal(feeRecipient bellatrix.ExecutionAddress,
+	gasLimit uint64,
+	parentHash phase0.Hash32,
+	value int64,
+) *api.VersionedProposal {
+	return &api.VersionedProposal{
+		Version:        spec.DataVersionDeneb,
+		Blinded:        true,
+		ExecutionValue: big.NewInt(value),
+		DenebBlinded: &apiv1deneb.BlindedBeaconBlock{
+			Body: &apiv1deneb.BlindedBeaconBlockBody{
+				ExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+					ParentHash:   parentHash,
+					FeeRecipient: feeRecipient,
+					GasLimit:     gasLimit,
+				},
+			},
+		},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestVerify(t *testing.T) {
+	feeRecipient := bellatrix.ExecutionAddress{0x01}
+	parentHash := phase0.Hash32{0x02}
+
+	tests := []struct {
+		name         string
+		bid          proposalverifier.Bid
+		expectations *proposalverifier.Expectations
+		violations   []*proposalverifier.Violation
+		err          string
+	}{
+		{
+			name:         "BidNil",
+			expectations: &proposalverifier.Expectations{},
+			err:          "no bid specified",
+		},
+		{
+			name: "ExpectationsNil",
+			bid:  blindedProposal(feeRecipient, 30_000_000, parentHash, 1),
+			err:  "no expectations specified",
+		},
+		{
+			name: "DataMissing",
+			bid:  &api.VersionedProposal{Version: spec.DataVersionDeneb, Blinded: true},
+			expectations: &proposalverifier.Expectations{
+				FeeRecipient: &feeRecipient,
+			},
+			err: "failed to obtain fee recipient\ndata missing",
+		},
+		{
+			name:         "NoExpectations",
+			bid:          blindedProposal(bellatrix.ExecutionAddress{0x09}, 1, phase0.Hash32{0x09}, 0),
+			expectations: &proposalverifier.Expectations{},
+		},
+		{
+			name: "Good",
+			bid:  blindedProposal(feeRecipient, 30_000_000, parentHash, 1000),
+			expectations: &proposalverifier.Expectations{
+				FeeRecipient: &feeRecipient,
+				GasLimit:     ptr(uint64(30_000_000)),
+				ParentHash:   &parentHash,
+				MinValue:     big.NewInt(1000),
+			},
+		},
+		{
+			name: "GasLimitMovingTowardsRegistered",
+			bid:  blindedProposal(feeRecipient, 30_029_295, parentHash, 1000),
+			expectations: &proposalverifier.Expectations{
+				GasLimit:       ptr(uint64(36_000_000)),
+				ParentGasLimit: ptr(uint64(30_000_000)),
+			},
+		},
+		{
+			name: "AllViolated",
+			bid:  blindedProposal(bellatrix.ExecutionAddress{0x03}, 36_000_000, phase0.Hash32{0x04}, 999),
+			expectations: &proposalverifier.Expectations{
+				FeeRecipient:   &feeRecipient,
+				GasLimit:       ptr(uint64(36_000_000)),
+				ParentGasLimit: ptr(uint64(30_000_000)),
+				ParentHash:     &parentHash,
+				MinValue:       big.NewInt(1000),
+			},
+			violations: []*proposalverifier.Violation{
+				{
+					Type:     proposalverifier.ViolationFeeRecipient,
+					Expected: "0x0100000000000000000000000000000000000000",
+					Actual:   "0x0300000000000000000000000000000000000000",
+				},
+				{
+					Type:     proposalverifier.ViolationGasLimit,
+					Expected: "30029295",
+					Actual:   "36000000",
+				},
+				{
+					Type:     proposalverifier.ViolationParentHash,
+					Expected: "0x0200000000000000000000000000000000000000000000000000000000000000",
+					Actual:   "0x0400000000000000000000000000000000000000000000000000000000000000",
+				},
+				{
+					Type:     proposalverifier.ViolationValue,
+					Expected: ">= 1000",
+					Actual:   "999",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			violations, err := proposalverifier.Verify(test.bid, test.expectations)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				if len(test.violations) == 0 {
+					require.Empty(t, violations)
+				} else {
+					require.Equal(t, test.violations, violations)
+				}
+			}
+		})
+	}
+}
+
+func TestExpectedGasLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		parent     uint64
+		registered uint64
+		expected   uint64
+	}{
+		{
+			name:       "Equal",
+			parent:     30_000_000,
+			registered: 30_000_000,
+			expected:   30_000_000,
+		},
+		{
+			name:       "Increase",
+			parent:     30_000_000,
+			registered: 36_000_000,
+			expected:   30_029_295,
+		},
+		{
+			name:       "IncreaseWithinLimit",
+			parent:     30_000_000,
+			registered: 30_010_000,
+			expected:   30_010_000,
+		},
+		{
+			name:       "Decrease",
+			parent:     30_000_000,
+			registered: 15_000_000,
+			expected:   29_970_705,
+		},
+		{
+			name:       "Tiny",
+			parent:     1000,
+			registered: 2000,
+			expected:   1000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, proposalverifier.ExpectedGasLimit(test.parent, test.registered))
+		})
+	}
+}
+
+func TestViolationString(t *testing.T) {
+	violation := &proposalverifier.Violation{
+		Type:     proposalverifier.ViolationGasLimit,
+		Expected: "30000000",
+		Actual:   "36000000",
+	}
+	require.Equal(t, "gas_limit: expected 30000000, actual 36000000", violation.String())
+}
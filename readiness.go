@@ -0,0 +1,65 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// NodeReady returns nil if the node is ready to carry out duties, otherwise
+// an error wrapping ErrNotReady that gives the reason.  A node is ready if
+// it reports itself as healthy or syncing, is no more than the given number
+// of slots behind the head of the chain, is not optimistic, and its execution
+// client is online.  Health is only checked if the provider is also a
+// NodeHealthProvider.
+func NodeReady(ctx context.Context,
+	provider NodeSyncingProvider,
+	maxSyncDistance phase0.Slot,
+) error {
+	if healthProvider, isProvider := provider.(NodeHealthProvider); isProvider {
+		response, err := healthProvider.NodeHealth(ctx, &api.NodeHealthOpts{})
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain node health"), err)
+		}
+		switch response.Data {
+		case apiv1.NodeHealthReady, apiv1.NodeHealthSyncing:
+			// Syncing nodes may still be close enough to the head; this is
+			// checked below.
+		default:
+			return errors.Join(fmt.Errorf("node health is %s", response.Data), ErrNotReady)
+		}
+	}
+
+	response, err := provider.NodeSyncing(ctx, &api.NodeSyncingOpts{})
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain node sync state"), err)
+	}
+	syncState := response.Data
+	switch {
+	case syncState.SyncDistance > maxSyncDistance:
+		return errors.Join(fmt.Errorf("node sync distance %d greater than %d", syncState.SyncDistance, maxSyncDistance), ErrNotReady)
+	case syncState.IsOptimistic:
+		return errors.Join(errors.New("node is optimistic"), ErrNotReady)
+	case syncState.ELOffline:
+		return errors.Join(errors.New("node execution client is offline"), ErrNotReady)
+	}
+
+	return nil
+}
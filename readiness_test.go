@@ -0,0 +1,134 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeReady(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		health     apiv1.NodeHealth
+		healthErr  error
+		syncState  *apiv1.SyncState
+		syncingErr error
+		err        string
+		notReady   bool
+	}{
+		{
+			name:      "Ready",
+			health:    apiv1.NodeHealthReady,
+			syncState: &apiv1.SyncState{HeadSlot: 100},
+		},
+		{
+			name:      "SyncingWithinDistance",
+			health:    apiv1.NodeHealthSyncing,
+			syncState: &apiv1.SyncState{HeadSlot: 100, SyncDistance: 2, IsSyncing: true},
+		},
+		{
+			name:      "SyncingBeyondDistance",
+			health:    apiv1.NodeHealthSyncing,
+			syncState: &apiv1.SyncState{HeadSlot: 100, SyncDistance: 3, IsSyncing: true},
+			err:       "node sync distance 3 greater than 2\nnode is not ready",
+			notReady:  true,
+		},
+		{
+			name:     "NotInitialized",
+			health:   apiv1.NodeHealthNotInitialized,
+			err:      "node health is not_initialized\nnode is not ready",
+			notReady: true,
+		},
+		{
+			name:      "HealthError",
+			healthErr: errors.New("connection refused"),
+			err:       "failed to obtain node health\nconnection refused",
+		},
+		{
+			name:      "Optimistic",
+			health:    apiv1.NodeHealthReady,
+			syncState: &apiv1.SyncState{HeadSlot: 100, IsOptimistic: true},
+			err:       "node is optimistic\nnode is not ready",
+			notReady:  true,
+		},
+		{
+			name:      "ELOffline",
+			health:    apiv1.NodeHealthReady,
+			syncState: &apiv1.SyncState{HeadSlot: 100, ELOffline: true},
+			err:       "node execution client is offline\nnode is not ready",
+			notReady:  true,
+		},
+		{
+			name:       "SyncingError",
+			health:     apiv1.NodeHealthReady,
+			syncingErr: errors.New("timeout"),
+			err:        "failed to obtain node sync state\ntimeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider, err := mock.New(ctx)
+			require.NoError(t, err)
+			provider.NodeHealthFunc = func(context.Context, *api.NodeHealthOpts) (*api.Response[apiv1.NodeHealth], error) {
+				if test.healthErr != nil {
+					return nil, test.healthErr
+				}
+
+				return &api.Response[apiv1.NodeHealth]{Data: test.health}, nil
+			}
+			provider.NodeSyncingFunc = func(context.Context, *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
+				if test.syncingErr != nil {
+					return nil, test.syncingErr
+				}
+
+				return &api.Response[*apiv1.SyncState]{Data: test.syncState}, nil
+			}
+
+			err = client.NodeReady(ctx, provider, 2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Equal(t, test.notReady, errors.Is(err, client.ErrNotReady))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// syncingOnlyProvider provides sync state but not health.
+type syncingOnlyProvider struct {
+	syncState *apiv1.SyncState
+}
+
+func (p *syncingOnlyProvider) NodeSyncing(context.Context, *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
+	return &api.Response[*apiv1.SyncState]{Data: p.syncState}, nil
+}
+
+func TestNodeReadyWithoutHealth(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, client.NodeReady(ctx, &syncingOnlyProvider{syncState: &apiv1.SyncState{}}, 0))
+	require.ErrorIs(t, client.NodeReady(ctx, &syncingOnlyProvider{syncState: &apiv1.SyncState{SyncDistance: 1}}, 0), client.ErrNotReady)
+}
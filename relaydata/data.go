@@ -0,0 +1,71 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relaydata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DeliveredPayloads provides the payloads that the relay has delivered to
+// proposers, most recent first.
+func (s *Service) DeliveredPayloads(ctx context.Context, opts *DeliveredPayloadsOpts) ([]*DeliveredPayload, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	res := make([]*DeliveredPayload, 0)
+	if err := s.get(ctx, "/relay/v1/data/bidtraces/proposer_payload_delivered", opts.query(), &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ReceivedBids provides the bids that the relay has received from builders.
+func (s *Service) ReceivedBids(ctx context.Context, opts *ReceivedBidsOpts) ([]*ReceivedBid, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+	if opts.Slot == nil && opts.BlockHash == nil && opts.BlockNumber == nil && opts.BuilderPubkey == nil {
+		return nil, errors.Join(errors.New("no slot, block hash, block number or builder public key specified"), client.ErrInvalidOptions)
+	}
+
+	res := make([]*ReceivedBid, 0)
+	if err := s.get(ctx, "/relay/v1/data/bidtraces/builder_blocks_received", opts.query(), &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ValidatorRegistration provides the latest registration that the relay has
+// received for the validator with the given public key.
+func (s *Service) ValidatorRegistration(ctx context.Context, pubkey phase0.BLSPubKey) (*apiv1.SignedValidatorRegistration, error) {
+	query := url.Values{}
+	query.Set("pubkey", fmt.Sprintf("%#x", pubkey))
+
+	res := &apiv1.SignedValidatorRegistration{}
+	if err := s.get(ctx, "/relay/v1/data/validator_registration", query, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relaydata
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// DeliveredPayloadsOpts are the options for obtaining delivered payloads.
+type DeliveredPayloadsOpts struct {
+	// Slot restricts the results to the given slot.
+	Slot *phase0.Slot
+	// Cursor restricts the results to slots at or before the given slot,
+	// for paging through results.
+	Cursor *phase0.Slot
+	// Limit is the maximum number of results to return.  0 uses the relay's
+	// default.
+	Limit uint64
+	// BlockHash restricts the results to the given execution block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber restricts the results to the given execution block number.
+	BlockNumber *uint64
+	// ProposerPubkey restricts the results to the given proposer.
+	ProposerPubkey *phase0.BLSPubKey
+	// BuilderPubkey restricts the results to the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// OrderBy orders the results, for example "value" or "-value".
+	OrderBy string
+}
+
+// query returns the query for the options.
+func (o *DeliveredPayloadsOpts) query() url.Values {
+	query := url.Values{}
+	if o.Slot != nil {
+		query.Set("slot", strconv.FormatUint(uint64(*o.Slot), 10))
+	}
+	if o.Cursor != nil {
+		query.Set("cursor", strconv.FormatUint(uint64(*o.Cursor), 10))
+	}
+	if o.Limit != 0 {
+		query.Set("limit", strconv.FormatUint(o.Limit, 10))
+	}
+	if o.BlockHash != nil {
+		query.Set("block_hash", fmt.Sprintf("%#x", *o.BlockHash))
+	}
+	if o.BlockNumber != nil {
+		query.Set("block_number", strconv.FormatUint(*o.BlockNumber, 10))
+	}
+	if o.ProposerPubkey != nil {
+		query.Set("proposer_pubkey", fmt.Sprintf("%#x", *o.ProposerPubkey))
+	}
+	if o.BuilderPubkey != nil {
+		query.Set("builder_pubkey", fmt.Sprintf("%#x", *o.BuilderPubkey))
+	}
+	if o.OrderBy != "" {
+		query.Set("order_by", o.OrderBy)
+	}
+
+	return query
+}
+
+// ReceivedBidsOpts are the options for obtaining received bids.  Relays
+// require at least one of slot, block hash, block number or builder public
+// key.
+type ReceivedBidsOpts struct {
+	// Slot restricts the results to the given slot.
+	Slot *phase0.Slot
+	// Limit is the maximum number of results to return.  0 uses the relay's
+	// default.
+	Limit uint64
+	// BlockHash restricts the results to the given execution block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber restricts the results to the given execution block number.
+	BlockNumber *uint64
+	// BuilderPubkey restricts the results to the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+}
+
+// query returns the query for the options.
+func (o *ReceivedBidsOpts) query() url.Values {
+	query := url.Values{}
+	if o.Slot != nil {
+		query.Set("slot", strconv.FormatUint(uint64(*o.Slot), 10))
+	}
+	if o.Limit != 0 {
+		query.Set("limit", strconv.FormatUint(o.Limit, 10))
+	}
+	if o.BlockHash != nil {
+		query.Set("block_hash", fmt.Sprintf("%#x", *o.BlockHash))
+	}
+	if o.BlockNumber != nil {
+		query.Set("block_number", strconv.FormatUint(*o.BlockNumber, 10))
+	}
+	if o.BuilderPubkey != nil {
+		query.Set("builder_pubkey", fmt.Sprintf("%#x", *o.BuilderPubkey))
+	}
+
+	return query
+}
@@ -0,0 +1,131 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package relaydata is a client for the data API provided by MEV relays,
+// which reports the payloads that relays have delivered to proposers, the
+// bids they have received from builders, and validator registrations.
+//
+// Bids are returned using the same BidTrace type as other builder APIs, so
+// that data from relays can be combined with data from beacon nodes without
+// conversion.
+package relaydata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a relay data API client.
+type Service struct {
+	log     zerolog.Logger
+	base    *url.URL
+	address string
+	timeout time.Duration
+	client  *http.Client
+}
+
+// New creates a new relay data API client.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "relaydata").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	address := parameters.address
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("https://%s", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid URL"), err)
+	}
+	// Remove any user information from the address used in logs and errors.
+	redacted := *base
+	redacted.User = nil
+
+	client := parameters.client
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	return &Service{
+		log:     log.With().Str("address", redacted.String()).Logger(),
+		base:    base,
+		address: redacted.String(),
+		timeout: parameters.timeout,
+		client:  client,
+	}, nil
+}
+
+// Address returns the address of the relay.
+func (s *Service) Address() string {
+	return s.address
+}
+
+// get fetches the endpoint with the supplied query and decodes the JSON
+// response in to res.
+func (s *Service) get(ctx context.Context, endpoint string, query url.Values, res any) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	callURL := s.base.JoinPath(endpoint)
+	callURL.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, callURL.String(), nil)
+	if err != nil {
+		return errors.Join(errors.New("failed to create request"), err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	s.log.Trace().Str("endpoint", endpoint).Str("query", callURL.RawQuery).Msg("GET request")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Join(errors.New("failed to call relay"), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New("failed to read response"), err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &api.Error{
+			Method:     http.MethodGet,
+			Endpoint:   endpoint,
+			StatusCode: resp.StatusCode,
+			Data:       body,
+		}
+	}
+
+	if err := json.Unmarshal(body, res); err != nil {
+		return errors.Join(errors.New("failed to parse response"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relaydata_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/relaydata"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+const bidTrace = `"slot":"100","parent_hash":"0x0101010101010101010101010101010101010101010101010101010101010101","block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","builder_pubkey":"0x030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303030303","proposer_pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404","proposer_fee_recipient":"0x0505050505050505050505050505050505050505","gas_limit":"30000000","gas_used":"12345678","value":"1000000000000000000"`
+
+func testServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/relay/v1/data/bidtraces/proposer_payload_delivered":
+			require.Equal(t, "100", r.URL.Query().Get("slot"))
+			require.Equal(t, "5", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`[{` + bidTrace + `,"block_number":"2000","num_tx":"150"}]`))
+		case "/relay/v1/data/bidtraces/builder_blocks_received":
+			_, _ = w.Write([]byte(`[{` + bidTrace + `,"block_number":"2000","num_tx":"150","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":false},{` + bidTrace + `,"block_number":"2000","num_tx":"151","timestamp":"1700000001"}]`))
+		case "/relay/v1/data/validator_registration":
+			if r.URL.Query().Get("pubkey") != "0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":400,"message":"no registration found for validator"}`))
+
+				return
+			}
+			_, _ = w.Write([]byte(`{"message":{"fee_recipient":"0x0505050505050505050505050505050505050505","gas_limit":"30000000","timestamp":"1700000000","pubkey":"0x040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404"},"signature":"0x060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606060606"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []relaydata.Parameter
+		err    string
+	}{
+		{
+			name: "AddressMissing",
+			params: []relaydata.Parameter{
+				relaydata.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters\nno address specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []relaydata.Parameter{
+				relaydata.WithLogLevel(zerolog.Disabled),
+				relaydata.WithAddress("relay.example.com"),
+				relaydata.WithTimeout(0),
+			},
+			err: "problem with parameters\nno timeout specified",
+		},
+		{
+			name: "Good",
+			params: []relaydata.Parameter{
+				relaydata.WithLogLevel(zerolog.Disabled),
+				relaydata.WithAddress("https://0xabcd@relay.example.com"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := relaydata.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "https://relay.example.com", s.Address())
+			}
+		})
+	}
+}
+
+func TestDeliveredPayloads(t *testing.T) {
+	ctx := context.Background()
+	server := testServer(t)
+	defer server.Close()
+
+	s, err := relaydata.New(ctx,
+		relaydata.WithLogLevel(zerolog.Disabled),
+		relaydata.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = s.DeliveredPayloads(ctx, nil)
+	require.ErrorIs(t, err, client.ErrNoOptions)
+
+	slot := phase0.Slot(100)
+	payloads, err := s.DeliveredPayloads(ctx, &relaydata.DeliveredPayloadsOpts{
+		Slot:  &slot,
+		Limit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, payloads, 1)
+	require.Equal(t, phase0.Slot(100), payloads[0].BidTrace.Slot)
+	require.Equal(t, uint256.NewInt(1000000000000000000), payloads[0].BidTrace.Value)
+	require.Equal(t, uint64(2000), payloads[0].BlockNumber)
+	require.Equal(t, uint64(150), payloads[0].NumTx)
+}
+
+func TestReceivedBids(t *testing.T) {
+	ctx := context.Background()
+	server := testServer(t)
+	defer server.Close()
+
+	s, err := relaydata.New(ctx,
+		relaydata.WithLogLevel(zerolog.Disabled),
+		relaydata.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = s.ReceivedBids(ctx, &relaydata.ReceivedBidsOpts{})
+	require.ErrorIs(t, err, client.ErrInvalidOptions)
+
+	slot := phase0.Slot(100)
+	bids, err := s.ReceivedBids(ctx, &relaydata.ReceivedBidsOpts{Slot: &slot})
+	require.NoError(t, err)
+	require.Len(t, bids, 2)
+	require.Equal(t, time.UnixMilli(1700000000123), bids[0].Timestamp)
+	require.Equal(t, time.Unix(1700000001, 0), bids[1].Timestamp)
+	require.Equal(t, uint64(151), bids[1].NumTx)
+	require.Equal(t, bids[0].BidTrace, bids[1].BidTrace)
+}
+
+func TestValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+	server := testServer(t)
+	defer server.Close()
+
+	s, err := relaydata.New(ctx,
+		relaydata.WithLogLevel(zerolog.Disabled),
+		relaydata.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	pubkey := phase0.BLSPubKey{}
+	for i := range pubkey {
+		pubkey[i] = 0x04
+	}
+	registration, err := s.ValidatorRegistration(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, pubkey, registration.Message.Pubkey)
+	require.Equal(t, uint64(30000000), registration.Message.GasLimit)
+
+	_, err = s.ValidatorRegistration(ctx, phase0.BLSPubKey{})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relaydata
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// DeliveredPayload is a payload delivered by a relay to a proposer.
+type DeliveredPayload struct {
+	BidTrace    *apiv1.BidTrace
+	BlockNumber uint64
+	NumTx       uint64
+}
+
+// ReceivedBid is a bid received by a relay from a builder.
+type ReceivedBid struct {
+	BidTrace    *apiv1.BidTrace
+	BlockNumber uint64
+	NumTx       uint64
+	Timestamp   time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeliveredPayload) UnmarshalJSON(input []byte) error {
+	bidTrace, extra, err := unpackBidTrace(input, "block_number", "num_tx")
+	if err != nil {
+		return err
+	}
+	d.BidTrace = bidTrace
+	if d.BlockNumber, err = uint64Field(extra, "block_number"); err != nil {
+		return err
+	}
+	if d.NumTx, err = uint64Field(extra, "num_tx"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *ReceivedBid) UnmarshalJSON(input []byte) error {
+	bidTrace, extra, err := unpackBidTrace(input, "block_number", "num_tx", "timestamp", "timestamp_ms", "optimistic_submission")
+	if err != nil {
+		return err
+	}
+	r.BidTrace = bidTrace
+	if r.BlockNumber, err = uint64Field(extra, "block_number"); err != nil {
+		return err
+	}
+	if r.NumTx, err = uint64Field(extra, "num_tx"); err != nil {
+		return err
+	}
+
+	// Prefer the millisecond timestamp if present.
+	if _, exists := extra["timestamp_ms"]; exists {
+		timestampMs, err := uint64Field(extra, "timestamp_ms")
+		if err != nil {
+			return err
+		}
+		r.Timestamp = time.UnixMilli(int64(timestampMs))
+	} else {
+		timestamp, err := uint64Field(extra, "timestamp")
+		if err != nil {
+			return err
+		}
+		r.Timestamp = time.Unix(int64(timestamp), 0)
+	}
+
+	return nil
+}
+
+// unpackBidTrace decodes the bid trace from input, returning the named
+// relay-specific fields separately.
+func unpackBidTrace(input []byte, fields ...string) (*apiv1.BidTrace, map[string]json.RawMessage, error) {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return nil, nil, errors.Join(errors.New("invalid JSON"), err)
+	}
+
+	extra := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if val, exists := raw[field]; exists {
+			extra[field] = val
+			delete(raw, field)
+		}
+	}
+
+	remainder, err := json.Marshal(raw)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("failed to re-encode bid trace"), err)
+	}
+	bidTrace := &apiv1.BidTrace{}
+	if err := json.Unmarshal(remainder, bidTrace); err != nil {
+		return nil, nil, errors.Join(errors.New("invalid bid trace"), err)
+	}
+
+	return bidTrace, extra, nil
+}
+
+// uint64Field returns the value of a field supplied as a decimal string.
+func uint64Field(fields map[string]json.RawMessage, name string) (uint64, error) {
+	raw, exists := fields[name]
+	if !exists {
+		return 0, errors.New(name + " missing")
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err != nil {
+		return 0, errors.Join(errors.New("invalid value for "+name), err)
+	}
+	val, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return 0, errors.Join(errors.New("invalid value for "+name), err)
+	}
+
+	return val, nil
+}
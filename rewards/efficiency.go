@@ -0,0 +1,203 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rewards provides helpers to compare the attestation rewards earned
+// by validators with the ideal rewards available to them, and to aggregate
+// the results for reporting.
+package rewards
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Component is the actual and ideal reward for a component of an attestation.
+type Component struct {
+	// Actual is the reward earned, which is negative for a penalty.
+	Actual int64
+	// Ideal is the reward that would have been earned by a perfect attestation.
+	Ideal phase0.Gwei
+}
+
+// Ratio returns the ratio of the actual to the ideal reward.  A perfect
+// attestation has a ratio of 1, and a missed attestation has a ratio of -1
+// as the penalty matches the reward.  If the ideal reward is zero, for
+// example during an inactivity leak, the ratio is 1 if nothing was lost and
+// -1 otherwise.
+func (c Component) Ratio() float64 {
+	return ratio(c.Actual, c.Ideal)
+}
+
+// add adds another component to this one.
+func (c *Component) add(other Component) {
+	c.Actual += other.Actual
+	c.Ideal += other.Ideal
+}
+
+// Efficiency is the attestation efficiency of a validator for an epoch.
+type Efficiency struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// EffectiveBalance is the effective balance of the validator.
+	EffectiveBalance phase0.Gwei
+	// Head is the head component of the rewards.
+	Head Component
+	// Target is the target component of the rewards.
+	Target Component
+	// Source is the source component of the rewards.
+	Source Component
+}
+
+// Total returns the combined head, target and source rewards.
+func (e *Efficiency) Total() Component {
+	total := e.Head
+	total.add(e.Target)
+	total.add(e.Source)
+
+	return total
+}
+
+// Ratio returns the ratio of the combined actual to ideal rewards.
+func (e *Efficiency) Ratio() float64 {
+	return e.Total().Ratio()
+}
+
+// Efficiencies calculates the attestation efficiency of each validator in
+// the rewards.  Ideal rewards are provided per effective balance, so the
+// effective balance of each validator at the epoch of the rewards must be
+// supplied.  The results are ordered by validator index.
+func Efficiencies(rewards *apiv1.AttestationRewards,
+	effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei,
+) (
+	[]*Efficiency,
+	error,
+) {
+	if rewards == nil {
+		return nil, errors.New("no rewards supplied")
+	}
+
+	ideals := make(map[phase0.Gwei]*apiv1.IdealAttestationRewards, len(rewards.IdealRewards))
+	for i := range rewards.IdealRewards {
+		ideals[rewards.IdealRewards[i].EffectiveBalance] = &rewards.IdealRewards[i]
+	}
+
+	res := make([]*Efficiency, 0, len(rewards.TotalRewards))
+	for _, actual := range rewards.TotalRewards {
+		effectiveBalance, exists := effectiveBalances[actual.ValidatorIndex]
+		if !exists {
+			return nil, fmt.Errorf("no effective balance for validator %d", actual.ValidatorIndex)
+		}
+		ideal, exists := ideals[effectiveBalance]
+		if !exists {
+			return nil, fmt.Errorf("no ideal rewards for effective balance %d of validator %d", effectiveBalance, actual.ValidatorIndex)
+		}
+		res = append(res, &Efficiency{
+			ValidatorIndex:   actual.ValidatorIndex,
+			EffectiveBalance: effectiveBalance,
+			Head: Component{
+				Actual: int64(actual.Head),
+				Ideal:  ideal.Head,
+			},
+			Target: Component{
+				Actual: actual.Target,
+				Ideal:  ideal.Target,
+			},
+			Source: Component{
+				Actual: actual.Source,
+				Ideal:  ideal.Source,
+			},
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ValidatorIndex < res[j].ValidatorIndex
+	})
+
+	return res, nil
+}
+
+// Summary is the combined attestation efficiency of a group of validators.
+type Summary struct {
+	// Label is the label of the group.
+	Label string
+	// Validators is the number of validators in the group.
+	Validators int
+	// Head is the combined head component of the rewards.
+	Head Component
+	// Target is the combined target component of the rewards.
+	Target Component
+	// Source is the combined source component of the rewards.
+	Source Component
+}
+
+// Total returns the combined head, target and source rewards.
+func (s *Summary) Total() Component {
+	total := s.Head
+	total.add(s.Target)
+	total.add(s.Source)
+
+	return total
+}
+
+// Ratio returns the ratio of the combined actual to ideal rewards.
+func (s *Summary) Ratio() float64 {
+	return s.Total().Ratio()
+}
+
+// Aggregate combines efficiencies by label, for example by operator.
+// Validators without a label are combined under the empty label.  The
+// results are ordered by label.
+func Aggregate(efficiencies []*Efficiency, labels map[phase0.ValidatorIndex]string) []*Summary {
+	summaries := make(map[string]*Summary)
+	for _, efficiency := range efficiencies {
+		if efficiency == nil {
+			continue
+		}
+		label := labels[efficiency.ValidatorIndex]
+		summary, exists := summaries[label]
+		if !exists {
+			summary = &Summary{Label: label}
+			summaries[label] = summary
+		}
+		summary.Validators++
+		summary.Head.add(efficiency.Head)
+		summary.Target.add(efficiency.Target)
+		summary.Source.add(efficiency.Source)
+	}
+
+	res := make([]*Summary, 0, len(summaries))
+	for _, summary := range summaries {
+		res = append(res, summary)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Label < res[j].Label
+	})
+
+	return res
+}
+
+// ratio returns the ratio of an actual to an ideal reward.
+func ratio(actual int64, ideal phase0.Gwei) float64 {
+	if ideal == 0 {
+		if actual < 0 {
+			return -1
+		}
+
+		return 1
+	}
+
+	return float64(actual) / float64(ideal)
+}
@@ -0,0 +1,114 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewards_test
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/rewards"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testRewards() *apiv1.AttestationRewards {
+	return &apiv1.AttestationRewards{
+		IdealRewards: []apiv1.IdealAttestationRewards{
+			{EffectiveBalance: 31000000000, Head: 2900, Target: 5400, Source: 2900},
+			{EffectiveBalance: 32000000000, Head: 3000, Target: 5600, Source: 3000},
+		},
+		TotalRewards: []apiv1.ValidatorAttestationRewards{
+			// Missed head.
+			{ValidatorIndex: 3, Head: 0, Target: 5600, Source: 3000},
+			// Perfect.
+			{ValidatorIndex: 1, Head: 3000, Target: 5600, Source: 3000},
+			// Missed attestation.
+			{ValidatorIndex: 2, Head: 0, Target: -5400, Source: -2900},
+		},
+	}
+}
+
+func TestEfficiencies(t *testing.T) {
+	_, err := rewards.Efficiencies(nil, nil)
+	require.EqualError(t, err, "no rewards supplied")
+
+	_, err = rewards.Efficiencies(testRewards(), map[phase0.ValidatorIndex]phase0.Gwei{
+		1: 32000000000,
+		2: 31000000000,
+	})
+	require.EqualError(t, err, "no effective balance for validator 3")
+
+	_, err = rewards.Efficiencies(testRewards(), map[phase0.ValidatorIndex]phase0.Gwei{
+		1: 32000000000,
+		2: 31000000000,
+		3: 30000000000,
+	})
+	require.EqualError(t, err, "no ideal rewards for effective balance 30000000000 of validator 3")
+
+	efficiencies, err := rewards.Efficiencies(testRewards(), map[phase0.ValidatorIndex]phase0.Gwei{
+		1: 32000000000,
+		2: 31000000000,
+		3: 32000000000,
+	})
+	require.NoError(t, err)
+	require.Len(t, efficiencies, 3)
+
+	require.Equal(t, phase0.ValidatorIndex(1), efficiencies[0].ValidatorIndex)
+	require.Equal(t, rewards.Component{Actual: 11600, Ideal: 11600}, efficiencies[0].Total())
+	require.InDelta(t, 1.0, efficiencies[0].Ratio(), 1e-9)
+
+	require.Equal(t, phase0.ValidatorIndex(2), efficiencies[1].ValidatorIndex)
+	require.Equal(t, phase0.Gwei(31000000000), efficiencies[1].EffectiveBalance)
+	require.InDelta(t, 0.0, efficiencies[1].Head.Ratio(), 1e-9)
+	require.InDelta(t, -1.0, efficiencies[1].Target.Ratio(), 1e-9)
+	require.InDelta(t, -1.0, efficiencies[1].Source.Ratio(), 1e-9)
+
+	require.Equal(t, phase0.ValidatorIndex(3), efficiencies[2].ValidatorIndex)
+	require.InDelta(t, 0.0, efficiencies[2].Head.Ratio(), 1e-9)
+	require.InDelta(t, 8600.0/11600.0, efficiencies[2].Ratio(), 1e-9)
+}
+
+func TestComponentRatio(t *testing.T) {
+	require.InDelta(t, 0.5, rewards.Component{Actual: 50, Ideal: 100}.Ratio(), 1e-9)
+	require.InDelta(t, 1.0, rewards.Component{Actual: 0, Ideal: 0}.Ratio(), 1e-9)
+	require.InDelta(t, -1.0, rewards.Component{Actual: -10, Ideal: 0}.Ratio(), 1e-9)
+}
+
+func TestAggregate(t *testing.T) {
+	efficiencies, err := rewards.Efficiencies(testRewards(), map[phase0.ValidatorIndex]phase0.Gwei{
+		1: 32000000000,
+		2: 31000000000,
+		3: 32000000000,
+	})
+	require.NoError(t, err)
+
+	summaries := rewards.Aggregate(efficiencies, map[phase0.ValidatorIndex]string{
+		1: "operator b",
+		3: "operator b",
+		2: "operator a",
+	})
+	require.Len(t, summaries, 2)
+	require.Equal(t, "operator a", summaries[0].Label)
+	require.Equal(t, 1, summaries[0].Validators)
+	require.Equal(t, rewards.Component{Actual: -8300, Ideal: 11200}, summaries[0].Total())
+	require.Equal(t, "operator b", summaries[1].Label)
+	require.Equal(t, 2, summaries[1].Validators)
+	require.Equal(t, rewards.Component{Actual: 3000, Ideal: 6000}, summaries[1].Head)
+	require.InDelta(t, 20200.0/23200.0, summaries[1].Ratio(), 1e-9)
+
+	summaries = rewards.Aggregate(efficiencies, nil)
+	require.Len(t, summaries, 1)
+	require.Equal(t, "", summaries[0].Label)
+	require.Equal(t, 3, summaries[0].Validators)
+}
@@ -0,0 +1,255 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scheduler provides timing primitives for validator clients: slot
+// and epoch tickers that fire at a configurable offset within the slot or
+// epoch, and one-off jobs that run at a given time.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// ErrJobAlreadyScheduled is returned when scheduling a job with the name of a job that is already scheduled.
+var ErrJobAlreadyScheduled = errors.New("job already scheduled")
+
+// ErrNoSuchJob is returned when attempting to cancel a job that is not scheduled.
+var ErrNoSuchJob = errors.New("no such job")
+
+// JobFunc is the definition for a scheduled job.
+type JobFunc func(ctx context.Context)
+
+// Service schedules work against chain time.
+type Service struct {
+	log       zerolog.Logger
+	chainTime *chaintime.Service
+
+	jobsMu sync.Mutex
+	jobs   map[string]*scheduledJob
+}
+
+// scheduledJob is a job that is scheduled or running.
+type scheduledJob struct {
+	cancel context.CancelFunc
+}
+
+// New creates a new scheduler.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "scheduler").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:       log,
+		chainTime: parameters.chainTime,
+		jobs:      make(map[string]*scheduledJob),
+	}, nil
+}
+
+// SlotOffset returns the offset into a slot for the given fraction of the
+// slot, for example SlotOffset(1, 3) for attestations.
+func (s *Service) SlotOffset(numerator uint64, denominator uint64) time.Duration {
+	if denominator == 0 {
+		return 0
+	}
+
+	return time.Duration(uint64(s.chainTime.SlotDuration()) * numerator / denominator)
+}
+
+// SlotTicker returns a channel that receives each slot when the given offset
+// into the slot is reached, until the context is canceled.  The first value
+// sent is the first slot whose tick is in the future.  Slots are skipped if
+// the receiver falls behind.
+func (s *Service) SlotTicker(ctx context.Context, offset time.Duration) (<-chan phase0.Slot, error) {
+	if offset < 0 || offset >= s.chainTime.SlotDuration() {
+		return nil, fmt.Errorf("offset must be at least 0 and less than the slot duration of %v", s.chainTime.SlotDuration())
+	}
+
+	tickTime := func(slot phase0.Slot) time.Time {
+		return s.chainTime.SlotToTime(slot).Add(offset)
+	}
+	ch := make(chan phase0.Slot, 1)
+	go func() {
+		defer close(ch)
+		slot := s.nextTick(uint64(s.chainTime.CurrentSlot()), func(v uint64) time.Time { return tickTime(phase0.Slot(v)) })
+		for {
+			if !s.waitUntil(ctx, tickTime(phase0.Slot(slot))) {
+				s.log.Trace().Msg("Context done; stopping slot ticker")
+
+				return
+			}
+
+			select {
+			case ch <- phase0.Slot(slot):
+			default:
+				s.log.Debug().Uint64("slot", slot).Msg("Slot ticker receiver not ready; skipping slot")
+			}
+			slot = max(slot+1, s.nextTick(uint64(s.chainTime.CurrentSlot()), func(v uint64) time.Time { return tickTime(phase0.Slot(v)) }))
+		}
+	}()
+
+	return ch, nil
+}
+
+// EpochTicker returns a channel that receives each epoch when the given
+// offset into the epoch is reached, until the context is canceled.  The
+// first value sent is the first epoch whose tick is in the future.  Epochs
+// are skipped if the receiver falls behind.
+func (s *Service) EpochTicker(ctx context.Context, offset time.Duration) (<-chan phase0.Epoch, error) {
+	epochDuration := s.chainTime.SlotDuration() * time.Duration(s.chainTime.SlotsPerEpoch())
+	if offset < 0 || offset >= epochDuration {
+		return nil, fmt.Errorf("offset must be at least 0 and less than the epoch duration of %v", epochDuration)
+	}
+
+	tickTime := func(epoch phase0.Epoch) time.Time {
+		return s.chainTime.EpochStart(epoch).Add(offset)
+	}
+	ch := make(chan phase0.Epoch, 1)
+	go func() {
+		defer close(ch)
+		epoch := s.nextTick(uint64(s.chainTime.CurrentEpoch()), func(v uint64) time.Time { return tickTime(phase0.Epoch(v)) })
+		for {
+			if !s.waitUntil(ctx, tickTime(phase0.Epoch(epoch))) {
+				s.log.Trace().Msg("Context done; stopping epoch ticker")
+
+				return
+			}
+
+			select {
+			case ch <- phase0.Epoch(epoch):
+			default:
+				s.log.Debug().Uint64("epoch", epoch).Msg("Epoch ticker receiver not ready; skipping epoch")
+			}
+			epoch = max(epoch+1, s.nextTick(uint64(s.chainTime.CurrentEpoch()), func(v uint64) time.Time { return tickTime(phase0.Epoch(v)) }))
+		}
+	}()
+
+	return ch, nil
+}
+
+// ScheduleJob schedules a job to run at the given time.  The job is canceled
+// if the context is canceled before it runs; the context passed to the job
+// is canceled if the job is canceled while running.
+func (s *Service) ScheduleJob(ctx context.Context, name string, runTime time.Time, job JobFunc) error {
+	if job == nil {
+		return errors.New("no job supplied")
+	}
+
+	s.jobsMu.Lock()
+	if _, exists := s.jobs[name]; exists {
+		s.jobsMu.Unlock()
+
+		return errors.Join(fmt.Errorf("job %s", name), ErrJobAlreadyScheduled)
+	}
+	jobCtx, cancel := context.WithCancel(ctx)
+	scheduled := &scheduledJob{cancel: cancel}
+	s.jobs[name] = scheduled
+	s.jobsMu.Unlock()
+
+	go func() {
+		defer s.removeJob(name, scheduled)
+		if !s.waitUntil(jobCtx, runTime) {
+			s.log.Trace().Str("job", name).Msg("Job canceled")
+
+			return
+		}
+		s.log.Trace().Str("job", name).Msg("Running job")
+		job(jobCtx)
+	}()
+
+	return nil
+}
+
+// ScheduleSlotJob schedules a job to run at the given offset into a slot.
+func (s *Service) ScheduleSlotJob(ctx context.Context, name string, slot phase0.Slot, offset time.Duration, job JobFunc) error {
+	return s.ScheduleJob(ctx, name, s.chainTime.SlotToTime(slot).Add(offset), job)
+}
+
+// CancelJob cancels a scheduled job.
+func (s *Service) CancelJob(name string) error {
+	s.jobsMu.Lock()
+	scheduled, exists := s.jobs[name]
+	if exists {
+		delete(s.jobs, name)
+	}
+	s.jobsMu.Unlock()
+
+	if !exists {
+		return errors.Join(fmt.Errorf("job %s", name), ErrNoSuchJob)
+	}
+	scheduled.cancel()
+
+	return nil
+}
+
+// JobScheduled returns true if a job with the given name is scheduled or running.
+func (s *Service) JobScheduled(name string) bool {
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+
+	_, exists := s.jobs[name]
+
+	return exists
+}
+
+// removeJob removes a completed job, unless it has since been replaced.
+func (s *Service) removeJob(name string, scheduled *scheduledJob) {
+	scheduled.cancel()
+
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	if s.jobs[name] == scheduled {
+		delete(s.jobs, name)
+	}
+}
+
+// nextTick returns the first value at or after current whose tick time is in the future.
+func (s *Service) nextTick(current uint64, tickTime func(uint64) time.Time) uint64 {
+	now := s.chainTime.Clock().Now()
+	for !tickTime(current).After(now) {
+		current++
+	}
+
+	return current
+}
+
+// waitUntil waits until the given time, returning false if the context is
+// canceled first.
+func (s *Service) waitUntil(ctx context.Context, t time.Time) bool {
+	clock := s.chainTime.Clock()
+	timer := clock.NewTimer(t.Sub(clock.Now()))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C():
+		return true
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/scheduler"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// shortEpochChainTime creates a chain time with the given genesis time and
+// slot duration, and 2 slots per epoch.
+func shortEpochChainTime(t *testing.T, genesisTime time.Time, slotDuration time.Duration, params ...chaintime.Parameter) *chaintime.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(genesisTime, map[string]any{
+		"SECONDS_PER_SLOT": slotDuration,
+		"SLOTS_PER_EPOCH":  uint64(2),
+	})
+	require.NoError(t, err)
+	chainTime, err := testfixtures.ChainTime(client, params...)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []scheduler.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []scheduler.Parameter{
+				scheduler.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "Good",
+			params: []scheduler.Parameter{
+				scheduler.WithLogLevel(zerolog.Disabled),
+				scheduler.WithChainTime(shortEpochChainTime(t, time.Unix(1606824023, 0), 12*time.Second)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := scheduler.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSlotOffset(t *testing.T) {
+	s, err := scheduler.New(context.Background(),
+		scheduler.WithLogLevel(zerolog.Disabled),
+		scheduler.WithChainTime(shortEpochChainTime(t, time.Unix(1606824023, 0), 12*time.Second)),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 4*time.Second, s.SlotOffset(1, 3))
+	require.Equal(t, 8*time.Second, s.SlotOffset(2, 3))
+	require.Equal(t, time.Duration(0), s.SlotOffset(1, 0))
+}
+
+func TestTickers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slotDuration := 60 * time.Millisecond
+	chainTime := shortEpochChainTime(t, time.Now().Add(slotDuration), slotDuration)
+	s, err := scheduler.New(ctx,
+		scheduler.WithLogLevel(zerolog.Disabled),
+		scheduler.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	_, err = s.SlotTicker(ctx, slotDuration)
+	require.EqualError(t, err, "offset must be at least 0 and less than the slot duration of 60ms")
+	_, err = s.EpochTicker(ctx, -time.Millisecond)
+	require.EqualError(t, err, "offset must be at least 0 and less than the epoch duration of 120ms")
+
+	offset := s.SlotOffset(1, 3)
+	slots, err := s.SlotTicker(ctx, offset)
+	require.NoError(t, err)
+	epochs, err := s.EpochTicker(ctx, slotDuration)
+	require.NoError(t, err)
+
+	slot := <-slots
+	require.False(t, time.Now().Before(chainTime.SlotToTime(slot).Add(offset)))
+	nextSlot := <-slots
+	require.Greater(t, nextSlot, slot)
+
+	epoch := <-epochs
+	require.False(t, time.Now().Before(chainTime.EpochStart(epoch).Add(slotDuration)))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, open := <-slots
+
+		return !open
+	}, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		_, open := <-epochs
+
+		return !open
+	}, time.Second, time.Millisecond)
+}
+
+func TestJobs(t *testing.T) {
+	ctx := context.Background()
+
+	slotDuration := 50 * time.Millisecond
+	chainTime := shortEpochChainTime(t, time.Now(), slotDuration)
+	s, err := scheduler.New(ctx,
+		scheduler.WithLogLevel(zerolog.Disabled),
+		scheduler.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	require.EqualError(t, s.ScheduleJob(ctx, "nil", time.Now(), nil), "no job supplied")
+
+	var ran atomic.Bool
+	slot := chainTime.CurrentSlot() + 1
+	require.NoError(t, s.ScheduleSlotJob(ctx, "run", slot, s.SlotOffset(1, 2), func(context.Context) {
+		ran.Store(true)
+	}))
+	require.True(t, s.JobScheduled("run"))
+	err = s.ScheduleSlotJob(ctx, "run", slot, 0, func(context.Context) {})
+	require.True(t, errors.Is(err, scheduler.ErrJobAlreadyScheduled))
+	require.Eventually(t, func() bool {
+		return ran.Load() && !s.JobScheduled("run")
+	}, time.Second, time.Millisecond)
+	require.False(t, time.Now().Before(chainTime.SlotToTime(slot).Add(s.SlotOffset(1, 2))))
+
+	var canceledRan atomic.Bool
+	require.NoError(t, s.ScheduleJob(ctx, "cancel", time.Now().Add(100*time.Millisecond), func(context.Context) {
+		canceledRan.Store(true)
+	}))
+	require.NoError(t, s.CancelJob("cancel"))
+	require.False(t, s.JobScheduled("cancel"))
+	require.True(t, errors.Is(s.CancelJob("cancel"), scheduler.ErrNoSuchJob))
+	time.Sleep(150 * time.Millisecond)
+	require.False(t, canceledRan.Load())
+
+	// Name can be reused once the job has been canceled.
+	ctxCancel, cancel := context.WithCancel(ctx)
+	var reused atomic.Bool
+	require.NoError(t, s.ScheduleJob(ctxCancel, "cancel", time.Now(), func(context.Context) {
+		reused.Store(true)
+	}))
+	require.Eventually(t, reused.Load, time.Second, time.Millisecond)
+	cancel()
+}
+
+func TestVirtualTime(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	genesisTime := time.Unix(1606824023, 0)
+	virtualClock := clock.NewVirtual(genesisTime)
+	chainTime := shortEpochChainTime(t, genesisTime, 12*time.Second, chaintime.WithClock(virtualClock))
+	s, err := scheduler.New(ctx,
+		scheduler.WithLogLevel(zerolog.Disabled),
+		scheduler.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	slots, err := s.SlotTicker(ctx, s.SlotOffset(1, 3))
+	require.NoError(t, err)
+	ran := make(chan struct{})
+	require.NoError(t, s.ScheduleSlotJob(ctx, "job", 2, 0, func(context.Context) {
+		close(ran)
+	}))
+
+	// Slot 0 ticks a third of the way in to the slot.
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 2))
+	virtualClock.Advance(4 * time.Second)
+	require.Equal(t, phase0.Slot(0), <-slots)
+
+	// Jobs run at their scheduled time.
+	require.NoError(t, virtualClock.WaitForWaiters(ctx, 2))
+	virtualClock.Set(chainTime.SlotToTime(2))
+	<-ran
+	require.Equal(t, phase0.Slot(1), <-slots)
+}
@@ -42,6 +42,22 @@ type Service interface {
 	IsSynced() bool
 }
 
+// ServiceCloser is the interface for shutting down a service.
+type ServiceCloser interface {
+	// Close shuts down the service, rejecting new requests, stopping event
+	// streams and waiting for in-flight requests to complete.  In-flight
+	// requests are canceled if they have not completed when the supplied
+	// context is done.
+	Close(ctx context.Context) error
+}
+
+// StaticValuesRefresher is the interface for refreshing values that are
+// cached for the lifetime of a beacon node.
+type StaticValuesRefresher interface {
+	// Refresh discards and refetches cached static values.
+	Refresh(ctx context.Context) error
+}
+
 // EpochFromStateIDProvider is the interface for providing epochs from state IDs.
 type EpochFromStateIDProvider interface {
 	// EpochFromStateID converts a state ID to its epoch.
@@ -118,6 +134,17 @@ type SignedBeaconBlockProvider interface {
 	)
 }
 
+// BlindedBlockProvider is the interface for providing blinded beacon blocks.
+type BlindedBlockProvider interface {
+	// BlindedBlock fetches a signed blinded beacon block given a block ID.
+	BlindedBlock(ctx context.Context,
+		opts *api.BlindedBlockOpts,
+	) (
+		*api.Response[*api.VersionedSignedBlindedBeaconBlock],
+		error,
+	)
+}
+
 // BlobSidecarsProvider is the interface for providing blobs for a given beacon block.
 type BlobSidecarsProvider interface {
 	// BlobSidecars fetches the blobs given a block ID.
@@ -344,6 +371,20 @@ type BeaconBlockRootProvider interface {
 	)
 }
 
+// BeaconBlockRootsProvider is the interface for providing multiple beacon block roots.
+type BeaconBlockRootsProvider interface {
+	// BeaconBlockRoots fetches the roots of multiple blocks given a set of options.
+	// The returned map is keyed by the supplied block ID, and contains the root,
+	// canonical status and header of each block.  Blocks that are not found are
+	// omitted from the map.
+	BeaconBlockRoots(ctx context.Context,
+		opts *api.BeaconBlockRootsOpts,
+	) (
+		*api.Response[map[string]*apiv1.BeaconBlockHeader],
+		error,
+	)
+}
+
 // BeaconBlockSubmitter is the interface for submitting beacon blocks.
 type BeaconBlockSubmitter interface {
 	// SubmitBeaconBlock submits a beacon block.
@@ -448,6 +489,17 @@ type ForkChoiceProvider interface {
 	)
 }
 
+// BeaconHeadsProvider is the interface for providing the heads of the beacon chain.
+type BeaconHeadsProvider interface {
+	// BeaconHeads fetches all heads of the beacon chain known to the node.
+	BeaconHeads(ctx context.Context,
+		opts *api.BeaconHeadsOpts,
+	) (
+		*api.Response[[]*apiv1.BeaconHead],
+		error,
+	)
+}
+
 // ForkProvider is the interface for providing fork information.
 type ForkProvider interface {
 	// Fork fetches fork information for the given state.
@@ -481,6 +533,17 @@ type GenesisProvider interface {
 	)
 }
 
+// NodeIdentityProvider is the interface for providing the network identity of the node.
+type NodeIdentityProvider interface {
+	// NodeIdentity provides the network identity of the node.
+	NodeIdentity(ctx context.Context,
+		opts *api.NodeIdentityOpts,
+	) (
+		*api.Response[*apiv1.NodeIdentity],
+		error,
+	)
+}
+
 // NodePeersProvider is the interface for providing peer information.
 type NodePeersProvider interface {
 	// NodePeers provides the peers of the node.
@@ -492,6 +555,17 @@ type NodePeersProvider interface {
 	)
 }
 
+// NodeHealthProvider is the interface for providing node health.
+type NodeHealthProvider interface {
+	// NodeHealth provides the health of the node.
+	NodeHealth(ctx context.Context,
+		opts *api.NodeHealthOpts,
+	) (
+		*api.Response[apiv1.NodeHealth],
+		error,
+	)
+}
+
 // NodeSyncingProvider is the interface for providing synchronization state.
 type NodeSyncingProvider interface {
 	// NodeSyncing provides the state of the node's synchronization with the chain.
@@ -584,6 +658,19 @@ type ValidatorsProvider interface {
 	)
 }
 
+// ValidatorIdentitiesProvider is the interface for providing validator identities.
+// This is a lighter alternative to ValidatorsProvider when only the mapping between
+// validator index and public key is required.
+type ValidatorIdentitiesProvider interface {
+	// ValidatorIdentities provides the identities of validators for the given options.
+	ValidatorIdentities(ctx context.Context,
+		opts *api.ValidatorIdentitiesOpts,
+	) (
+		*api.Response[[]*apiv1.ValidatorIdentity],
+		error,
+	)
+}
+
 // VoluntaryExitSubmitter is the interface for submitting voluntary exits.
 type VoluntaryExitSubmitter interface {
 	// SubmitVoluntaryExit submits a voluntary exit.
@@ -612,6 +699,28 @@ type PendingDepositProvider interface {
 	)
 }
 
+// PendingPartialWithdrawalsProvider is the interface for providing pending partial withdrawals.
+type PendingPartialWithdrawalsProvider interface {
+	// PendingPartialWithdrawals provides the pending partial withdrawals for a given state.
+	PendingPartialWithdrawals(ctx context.Context,
+		opts *api.PendingPartialWithdrawalsOpts,
+	) (
+		*api.Response[[]*electra.PendingPartialWithdrawal],
+		error,
+	)
+}
+
+// PendingConsolidationsProvider is the interface for providing pending consolidations.
+type PendingConsolidationsProvider interface {
+	// PendingConsolidations provides the pending consolidations for a given state.
+	PendingConsolidations(ctx context.Context,
+		opts *api.PendingConsolidationsOpts,
+	) (
+		*api.Response[[]*electra.PendingConsolidation],
+		error,
+	)
+}
+
 //
 // Local extensions
 //
@@ -628,6 +737,20 @@ type DomainProvider interface {
 	GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error)
 }
 
+// DomainsProvider provides domains for multiple domain types at an epoch.
+type DomainsProvider interface {
+	// DomainsForEpoch provides the domains for the given domain types at the
+	// given epoch.  If no domain types are supplied, domains are provided for
+	// all domain types in the chain specification.
+	DomainsForEpoch(ctx context.Context,
+		epoch phase0.Epoch,
+		domainTypes ...phase0.DomainType,
+	) (
+		map[phase0.DomainType]phase0.Domain,
+		error,
+	)
+}
+
 // GenesisTimeProvider is the interface for providing the genesis time of a chain.
 //
 // Deprecated: use Genesis().
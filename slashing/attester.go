@@ -0,0 +1,133 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashing
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// IsDoubleVote returns true if the two attestation data are different but
+// have the same target epoch.
+func IsDoubleVote(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	if !validData(data1) || !validData(data2) {
+		return false
+	}
+
+	return data1.Target.Epoch == data2.Target.Epoch && !data1.Equal(data2)
+}
+
+// IsSurroundVote returns true if the first attestation data surrounds the
+// second, that is it has an earlier source epoch and a later target epoch.
+func IsSurroundVote(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	return data1.Surrounds(data2)
+}
+
+// IsSlashableAttestationData returns true if the two attestation data are
+// slashable with respect to each other, either as a double vote or with one
+// surrounding the other.
+func IsSlashableAttestationData(data1 *phase0.AttestationData, data2 *phase0.AttestationData) bool {
+	return data1.IsConflicting(data2)
+}
+
+// SlashableIndices returns the indices of the validators that attested to
+// both of the indexed attestations.  It does not check that the attestations
+// are slashable.
+func SlashableIndices(attestation1 *electra.IndexedAttestation,
+	attestation2 *electra.IndexedAttestation,
+) []phase0.ValidatorIndex {
+	if attestation1 == nil || attestation2 == nil {
+		return nil
+	}
+
+	attesters := make(map[uint64]struct{}, len(attestation1.AttestingIndices))
+	for _, index := range attestation1.AttestingIndices {
+		attesters[index] = struct{}{}
+	}
+
+	indices := make([]phase0.ValidatorIndex, 0)
+	for _, index := range attestation2.AttestingIndices {
+		if _, exists := attesters[index]; exists {
+			indices = append(indices, phase0.ValidatorIndex(index))
+			delete(attesters, index)
+		}
+	}
+	slices.Sort(indices)
+
+	return indices
+}
+
+// NewAttesterSlashing creates an attester slashing from two conflicting
+// indexed attestations.  The attestations are ordered such that if one
+// surrounds the other it is the first attestation in the slashing, as
+// required by the specification.
+func NewAttesterSlashing(attestation1 *electra.IndexedAttestation,
+	attestation2 *electra.IndexedAttestation,
+) (
+	*electra.AttesterSlashing,
+	error,
+) {
+	if attestation1 == nil || attestation2 == nil {
+		return nil, errors.New("attestation missing")
+	}
+	if !validData(attestation1.Data) || !validData(attestation2.Data) {
+		return nil, errors.New("attestation data missing")
+	}
+	if err := checkAttestingIndices(attestation1); err != nil {
+		return nil, errors.Join(errors.New("invalid first attestation"), err)
+	}
+	if err := checkAttestingIndices(attestation2); err != nil {
+		return nil, errors.Join(errors.New("invalid second attestation"), err)
+	}
+
+	if !IsSlashableAttestationData(attestation1.Data, attestation2.Data) {
+		return nil, errors.Join(errors.New("attestation data are not slashable"), ErrNotSlashable)
+	}
+	if len(SlashableIndices(attestation1, attestation2)) == 0 {
+		return nil, errors.Join(errors.New("attestations have no common attesters"), ErrNotSlashable)
+	}
+
+	if IsSurroundVote(attestation2.Data, attestation1.Data) {
+		attestation1, attestation2 = attestation2, attestation1
+	}
+
+	return &electra.AttesterSlashing{
+		Attestation1: attestation1,
+		Attestation2: attestation2,
+	}, nil
+}
+
+// checkAttestingIndices checks that the attesting indices of an indexed
+// attestation are present, sorted and unique.
+func checkAttestingIndices(attestation *electra.IndexedAttestation) error {
+	if len(attestation.AttestingIndices) == 0 {
+		return errors.New("no attesting indices")
+	}
+	for i := 1; i < len(attestation.AttestingIndices); i++ {
+		if attestation.AttestingIndices[i] <= attestation.AttestingIndices[i-1] {
+			return errors.New("attesting indices not sorted and unique")
+		}
+	}
+
+	return nil
+}
+
+// validData returns true if the attestation data has all of the fields
+// required for slashing checks.
+func validData(data *phase0.AttestationData) bool {
+	return data != nil && data.Source != nil && data.Target != nil
+}
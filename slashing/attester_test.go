@@ -0,0 +1,206 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/slashing"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func attestationData(slot phase0.Slot, root byte, source phase0.Epoch, target phase0.Epoch) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            slot,
+		BeaconBlockRoot: phase0.Root{root},
+		Source:          &phase0.Checkpoint{Epoch: source},
+		Target:          &phase0.Checkpoint{Epoch: target},
+	}
+}
+
+func TestIsSlashableAttestationData(t *testing.T) {
+	tests := []struct {
+		name      string
+		data1     *phase0.AttestationData
+		data2     *phase0.AttestationData
+		double    bool
+		surround  bool
+		slashable bool
+	}{
+		{
+			name:  "Nil",
+			data1: nil,
+			data2: attestationData(64, 1, 1, 2),
+		},
+		{
+			name:  "TargetMissing",
+			data1: &phase0.AttestationData{Source: &phase0.Checkpoint{}},
+			data2: attestationData(64, 1, 1, 2),
+		},
+		{
+			name:  "Identical",
+			data1: attestationData(64, 1, 1, 2),
+			data2: attestationData(64, 1, 1, 2),
+		},
+		{
+			name:  "DifferentTargets",
+			data1: attestationData(64, 1, 1, 2),
+			data2: attestationData(96, 2, 2, 3),
+		},
+		{
+			name:      "DoubleVote",
+			data1:     attestationData(64, 1, 1, 2),
+			data2:     attestationData(64, 2, 1, 2),
+			double:    true,
+			slashable: true,
+		},
+		{
+			name:      "Surrounding",
+			data1:     attestationData(160, 1, 1, 5),
+			data2:     attestationData(96, 2, 2, 3),
+			surround:  true,
+			slashable: true,
+		},
+		{
+			name:      "Surrounded",
+			data1:     attestationData(96, 2, 2, 3),
+			data2:     attestationData(160, 1, 1, 5),
+			slashable: true,
+		},
+		{
+			name:  "SameSource",
+			data1: attestationData(160, 1, 2, 5),
+			data2: attestationData(96, 2, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.double, slashing.IsDoubleVote(test.data1, test.data2))
+			require.Equal(t, test.surround, slashing.IsSurroundVote(test.data1, test.data2))
+			require.Equal(t, test.slashable, slashing.IsSlashableAttestationData(test.data1, test.data2))
+			require.Equal(t, test.slashable, slashing.IsSlashableAttestationData(test.data2, test.data1))
+		})
+	}
+}
+
+func TestSlashableIndices(t *testing.T) {
+	attestation1 := &electra.IndexedAttestation{AttestingIndices: []uint64{1, 3, 5, 7}}
+	attestation2 := &electra.IndexedAttestation{AttestingIndices: []uint64{2, 3, 4, 7}}
+
+	require.Nil(t, slashing.SlashableIndices(nil, attestation2))
+	require.Equal(t, []phase0.ValidatorIndex{3, 7}, slashing.SlashableIndices(attestation1, attestation2))
+	require.Equal(t, []phase0.ValidatorIndex{3, 7}, slashing.SlashableIndices(attestation2, attestation1))
+}
+
+func TestNewAttesterSlashing(t *testing.T) {
+	surrounding := &electra.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2, 3},
+		Data:             attestationData(160, 1, 1, 5),
+	}
+	surrounded := &electra.IndexedAttestation{
+		AttestingIndices: []uint64{3, 4},
+		Data:             attestationData(96, 2, 2, 3),
+	}
+
+	tests := []struct {
+		name         string
+		attestation1 *electra.IndexedAttestation
+		attestation2 *electra.IndexedAttestation
+		expected     *electra.AttesterSlashing
+		err          string
+		notSlashable bool
+	}{
+		{
+			name:         "Attestation1Missing",
+			attestation2: surrounded,
+			err:          "attestation missing",
+		},
+		{
+			name:         "DataMissing",
+			attestation1: &electra.IndexedAttestation{AttestingIndices: []uint64{1}},
+			attestation2: surrounded,
+			err:          "attestation data missing",
+		},
+		{
+			name: "IndicesUnsorted",
+			attestation1: &electra.IndexedAttestation{
+				AttestingIndices: []uint64{3, 1},
+				Data:             attestationData(160, 1, 1, 5),
+			},
+			attestation2: surrounded,
+			err:          "invalid first attestation\nattesting indices not sorted and unique",
+		},
+		{
+			name:         "IndicesMissing",
+			attestation1: surrounding,
+			attestation2: &electra.IndexedAttestation{
+				Data: attestationData(96, 2, 2, 3),
+			},
+			err: "invalid second attestation\nno attesting indices",
+		},
+		{
+			name:         "NotSlashable",
+			attestation1: surrounding,
+			attestation2: surrounding,
+			err:          "attestation data are not slashable\nnot slashable",
+			notSlashable: true,
+		},
+		{
+			name:         "NoCommonAttesters",
+			attestation1: surrounding,
+			attestation2: &electra.IndexedAttestation{
+				AttestingIndices: []uint64{4, 5},
+				Data:             attestationData(96, 2, 2, 3),
+			},
+			err:          "attestations have no common attesters\nnot slashable",
+			notSlashable: true,
+		},
+		{
+			name:         "Good",
+			attestation1: surrounding,
+			attestation2: surrounded,
+			expected: &electra.AttesterSlashing{
+				Attestation1: surrounding,
+				Attestation2: surrounded,
+			},
+		},
+		{
+			name:         "Reordered",
+			attestation1: surrounded,
+			attestation2: surrounding,
+			expected: &electra.AttesterSlashing{
+				Attestation1: surrounding,
+				Attestation2: surrounded,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := slashing.NewAttesterSlashing(test.attestation1, test.attestation2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				if test.notSlashable {
+					require.ErrorIs(t, err, slashing.ErrNotSlashable)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interchange
+
+import "errors"
+
+// ErrUnsupportedVersion is returned when an interchange document is not of
+// the supported format version.
+var ErrUnsupportedVersion = errors.New("unsupported interchange format version")
+
+// ErrGenesisValidatorsRootMismatch is returned when interchange documents
+// for different networks are combined.
+var ErrGenesisValidatorsRootMismatch = errors.New("genesis validators root mismatch")
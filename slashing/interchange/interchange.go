@@ -0,0 +1,324 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interchange provides the types of the slashing protection
+// interchange format defined in EIP-3076, along with functions to read,
+// write, validate and merge interchange documents.
+package interchange
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// FormatVersion is the version of the interchange format supported by this package.
+const FormatVersion = "5"
+
+// Interchange is a slashing protection interchange document.
+type Interchange struct {
+	Metadata *Metadata
+	Data     []*Record
+}
+
+// Metadata is the metadata of an interchange document.
+type Metadata struct {
+	// InterchangeFormatVersion is the version of the interchange format.
+	InterchangeFormatVersion string
+	// GenesisValidatorsRoot is the genesis validators root of the network
+	// to which the document applies.
+	GenesisValidatorsRoot phase0.Root
+}
+
+// Record is the slashing protection data for a single validator.
+type Record struct {
+	Pubkey             phase0.BLSPubKey
+	SignedBlocks       []*SignedBlock
+	SignedAttestations []*SignedAttestation
+}
+
+// SignedBlock is a block signed by a validator.
+type SignedBlock struct {
+	Slot phase0.Slot
+	// SigningRoot is the signing root of the block.  It is optional.
+	SigningRoot *phase0.Root
+}
+
+// SignedAttestation is an attestation signed by a validator.
+type SignedAttestation struct {
+	SourceEpoch phase0.Epoch
+	TargetEpoch phase0.Epoch
+	// SigningRoot is the signing root of the attestation.  It is optional.
+	SigningRoot *phase0.Root
+}
+
+// interchangeJSON is the spec representation of the struct.
+type interchangeJSON struct {
+	Metadata *Metadata `json:"metadata"`
+	Data     []*Record `json:"data"`
+}
+
+// metadataJSON is the spec representation of the struct.
+type metadataJSON struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+// recordJSON is the spec representation of the struct.
+type recordJSON struct {
+	Pubkey             string               `json:"pubkey"`
+	SignedBlocks       []*SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []*SignedAttestation `json:"signed_attestations"`
+}
+
+// signedBlockJSON is the spec representation of the struct.
+type signedBlockJSON struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// signedAttestationJSON is the spec representation of the struct.
+type signedAttestationJSON struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *Interchange) MarshalJSON() ([]byte, error) {
+	data := i.Data
+	if data == nil {
+		data = make([]*Record, 0)
+	}
+
+	return json.Marshal(&interchangeJSON{
+		Metadata: i.Metadata,
+		Data:     data,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Interchange) UnmarshalJSON(input []byte) error {
+	var data interchangeJSON
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+	if data.Metadata == nil {
+		return errors.New("metadata missing")
+	}
+	i.Metadata = data.Metadata
+	if data.Data == nil {
+		return errors.New("data missing")
+	}
+	for index := range data.Data {
+		if data.Data[index] == nil {
+			return fmt.Errorf("data %d missing", index)
+		}
+	}
+	i.Data = data.Data
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *Metadata) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&metadataJSON{
+		InterchangeFormatVersion: m.InterchangeFormatVersion,
+		GenesisValidatorsRoot:    codecs.EncodeHex(m.GenesisValidatorsRoot[:]),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *Metadata) UnmarshalJSON(input []byte) error {
+	var data metadataJSON
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+	if data.InterchangeFormatVersion == "" {
+		return errors.New("interchange format version missing")
+	}
+	m.InterchangeFormatVersion = data.InterchangeFormatVersion
+	if data.GenesisValidatorsRoot == "" {
+		return errors.New("genesis validators root missing")
+	}
+	root, err := decodeRoot(data.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Join(errors.New("invalid value for genesis validators root"), err)
+	}
+	m.GenesisValidatorsRoot = *root
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	signedBlocks := r.SignedBlocks
+	if signedBlocks == nil {
+		signedBlocks = make([]*SignedBlock, 0)
+	}
+	signedAttestations := r.SignedAttestations
+	if signedAttestations == nil {
+		signedAttestations = make([]*SignedAttestation, 0)
+	}
+
+	return json.Marshal(&recordJSON{
+		Pubkey:             codecs.EncodeHex(r.Pubkey[:]),
+		SignedBlocks:       signedBlocks,
+		SignedAttestations: signedAttestations,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Record) UnmarshalJSON(input []byte) error {
+	var data recordJSON
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+	if data.Pubkey == "" {
+		return errors.New("pubkey missing")
+	}
+	pubkey, err := codecs.DecodeHexFixed(data.Pubkey, phase0.PublicKeyLength)
+	if err != nil {
+		return errors.Join(errors.New("invalid value for pubkey"), err)
+	}
+	copy(r.Pubkey[:], pubkey)
+	if data.SignedBlocks == nil {
+		return errors.New("signed blocks missing")
+	}
+	for index := range data.SignedBlocks {
+		if data.SignedBlocks[index] == nil {
+			return fmt.Errorf("signed block %d missing", index)
+		}
+	}
+	r.SignedBlocks = data.SignedBlocks
+	if data.SignedAttestations == nil {
+		return errors.New("signed attestations missing")
+	}
+	for index := range data.SignedAttestations {
+		if data.SignedAttestations[index] == nil {
+			return fmt.Errorf("signed attestation %d missing", index)
+		}
+	}
+	r.SignedAttestations = data.SignedAttestations
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SignedBlock) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signedBlockJSON{
+		Slot:        codecs.EncodeUint64(uint64(s.Slot)),
+		SigningRoot: encodeRoot(s.SigningRoot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SignedBlock) UnmarshalJSON(input []byte) error {
+	var data signedBlockJSON
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := codecs.DecodeUint64(data.Slot)
+	if err != nil {
+		return errors.Join(errors.New("invalid value for slot"), err)
+	}
+	s.Slot = phase0.Slot(slot)
+	s.SigningRoot = nil
+	if data.SigningRoot != "" {
+		s.SigningRoot, err = decodeRoot(data.SigningRoot)
+		if err != nil {
+			return errors.Join(errors.New("invalid value for signing root"), err)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SignedAttestation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signedAttestationJSON{
+		SourceEpoch: codecs.EncodeUint64(uint64(s.SourceEpoch)),
+		TargetEpoch: codecs.EncodeUint64(uint64(s.TargetEpoch)),
+		SigningRoot: encodeRoot(s.SigningRoot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SignedAttestation) UnmarshalJSON(input []byte) error {
+	var data signedAttestationJSON
+	if err := codecs.UnmarshalJSON(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+	if data.SourceEpoch == "" {
+		return errors.New("source epoch missing")
+	}
+	sourceEpoch, err := codecs.DecodeUint64(data.SourceEpoch)
+	if err != nil {
+		return errors.Join(errors.New("invalid value for source epoch"), err)
+	}
+	s.SourceEpoch = phase0.Epoch(sourceEpoch)
+	if data.TargetEpoch == "" {
+		return errors.New("target epoch missing")
+	}
+	targetEpoch, err := codecs.DecodeUint64(data.TargetEpoch)
+	if err != nil {
+		return errors.Join(errors.New("invalid value for target epoch"), err)
+	}
+	s.TargetEpoch = phase0.Epoch(targetEpoch)
+	s.SigningRoot = nil
+	if data.SigningRoot != "" {
+		s.SigningRoot, err = decodeRoot(data.SigningRoot)
+		if err != nil {
+			return errors.Join(errors.New("invalid value for signing root"), err)
+		}
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (i *Interchange) String() string {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// encodeRoot encodes an optional root, returning an empty string if it is not present.
+func encodeRoot(root *phase0.Root) string {
+	if root == nil {
+		return ""
+	}
+
+	return codecs.EncodeHex(root[:])
+}
+
+// decodeRoot decodes a root.
+func decodeRoot(input string) (*phase0.Root, error) {
+	data, err := codecs.DecodeHexFixed(input, phase0.RootLength)
+	if err != nil {
+		return nil, err
+	}
+	root := phase0.Root{}
+	copy(root[:], data)
+
+	return &root, nil
+}
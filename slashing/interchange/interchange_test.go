@@ -0,0 +1,206 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interchange_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/slashing/interchange"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	gvr    = "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+	pubkey = "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed"
+	root   = "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"
+)
+
+func document(records ...string) string {
+	return `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"` + gvr + `"},"data":[` + strings.Join(records, ",") + `]}`
+}
+
+func TestInterchangeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "JSONBad",
+			input: `[]`,
+			err:   "invalid JSON",
+		},
+		{
+			name:  "MetadataMissing",
+			input: `{"data":[]}`,
+			err:   "metadata missing",
+		},
+		{
+			name:  "VersionMissing",
+			input: `{"metadata":{"genesis_validators_root":"` + gvr + `"},"data":[]}`,
+			err:   "interchange format version missing",
+		},
+		{
+			name:  "GenesisValidatorsRootMissing",
+			input: `{"metadata":{"interchange_format_version":"5"},"data":[]}`,
+			err:   "genesis validators root missing",
+		},
+		{
+			name:  "GenesisValidatorsRootInvalid",
+			input: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"0x01"},"data":[]}`,
+			err:   "invalid value for genesis validators root",
+		},
+		{
+			name:  "DataMissing",
+			input: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"` + gvr + `"}}`,
+			err:   "data missing",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: document(`{"signed_blocks":[],"signed_attestations":[]}`),
+			err:   "pubkey missing",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: document(`{"pubkey":"0x01","signed_blocks":[],"signed_attestations":[]}`),
+			err:   "invalid value for pubkey",
+		},
+		{
+			name:  "SignedBlocksMissing",
+			input: document(`{"pubkey":"` + pubkey + `","signed_attestations":[]}`),
+			err:   "signed blocks missing",
+		},
+		{
+			name:  "SignedAttestationsMissing",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[]}`),
+			err:   "signed attestations missing",
+		},
+		{
+			name:  "SlotMissing",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[{}],"signed_attestations":[]}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotInvalid",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[{"slot":"-1"}],"signed_attestations":[]}`),
+			err:   "invalid value for slot",
+		},
+		{
+			name:  "BlockSigningRootInvalid",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[{"slot":"1","signing_root":"0x01"}],"signed_attestations":[]}`),
+			err:   "invalid value for signing root",
+		},
+		{
+			name:  "SourceEpochMissing",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[],"signed_attestations":[{"target_epoch":"2"}]}`),
+			err:   "source epoch missing",
+		},
+		{
+			name:  "TargetEpochMissing",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[],"signed_attestations":[{"source_epoch":"1"}]}`),
+			err:   "target epoch missing",
+		},
+		{
+			name:  "TargetEpochInvalid",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[],"signed_attestations":[{"source_epoch":"1","target_epoch":"x"}]}`),
+			err:   "invalid value for target epoch",
+		},
+		{
+			name:  "Empty",
+			input: document(),
+		},
+		{
+			name:  "Good",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[{"slot":"81952","signing_root":"` + root + `"},{"slot":"81951"}],"signed_attestations":[{"source_epoch":"2290","target_epoch":"3007","signing_root":"` + root + `"},{"source_epoch":"2290","target_epoch":"3008"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res interchange.Interchange
+			err := json.Unmarshal([]byte(test.input), &res)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, test.input, string(rt))
+				require.Equal(t, test.input, res.String())
+			}
+		})
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+		errIs error
+	}{
+		{
+			name:  "Invalid",
+			input: `{`,
+			err:   "failed to parse interchange document",
+		},
+		{
+			name:  "UnsupportedVersion",
+			input: `{"metadata":{"interchange_format_version":"4","genesis_validators_root":"` + gvr + `"},"data":[]}`,
+			errIs: interchange.ErrUnsupportedVersion,
+		},
+		{
+			name:  "GenesisValidatorsRootZero",
+			input: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"data":[]}`,
+			err:   "genesis validators root missing",
+		},
+		{
+			name:  "SourceAfterTarget",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[],"signed_attestations":[{"source_epoch":"3","target_epoch":"2"}]}`),
+			err:   "signed attestation 0 source epoch 3 after target epoch 2",
+		},
+		{
+			name:  "Good",
+			input: document(`{"pubkey":"` + pubkey + `","signed_blocks":[{"slot":"81952"}],"signed_attestations":[{"source_epoch":"2290","target_epoch":"3007"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := interchange.Read(strings.NewReader(test.input))
+			switch {
+			case test.errIs != nil:
+				require.True(t, errors.Is(err, test.errIs))
+			case test.err != "":
+				require.ErrorContains(t, err, test.err)
+			default:
+				require.NoError(t, err)
+				var output bytes.Buffer
+				require.NoError(t, interchange.Write(&output, res))
+				var compacted bytes.Buffer
+				require.NoError(t, json.Compact(&compacted, output.Bytes()))
+				require.Equal(t, test.input, compacted.String())
+			}
+		})
+	}
+}
+
+func TestWriteNil(t *testing.T) {
+	var output bytes.Buffer
+	require.EqualError(t, interchange.Write(&output, nil), "no interchange document supplied")
+}
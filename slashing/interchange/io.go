@@ -0,0 +1,56 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interchange
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Read reads and validates an interchange document.
+func Read(r io.Reader) (*Interchange, error) {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read interchange document"), err)
+	}
+
+	interchange := &Interchange{}
+	if err := json.Unmarshal(input, interchange); err != nil {
+		return nil, errors.Join(errors.New("failed to parse interchange document"), err)
+	}
+	if err := interchange.Validate(); err != nil {
+		return nil, err
+	}
+
+	return interchange, nil
+}
+
+// Write validates and writes an interchange document.
+func Write(w io.Writer, interchange *Interchange) error {
+	if interchange == nil {
+		return errors.New("no interchange document supplied")
+	}
+	if err := interchange.Validate(); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(interchange); err != nil {
+		return errors.Join(errors.New("failed to write interchange document"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interchange
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Merge combines interchange documents for the same network in to a single
+// document.  Records for the same public key are combined, with duplicate
+// blocks and attestations removed and the remainder sorted by slot and epoch
+// respectively.  Records are returned in the order in which their public keys
+// are first seen.
+func Merge(interchanges ...*Interchange) (*Interchange, error) {
+	if len(interchanges) == 0 {
+		return nil, errors.New("no interchange documents supplied")
+	}
+
+	records := make([]*Record, 0)
+	recordsByPubkey := make(map[phase0.BLSPubKey]*Record)
+	for _, interchange := range interchanges {
+		if interchange == nil {
+			return nil, errors.New("nil interchange document supplied")
+		}
+		if err := interchange.Validate(); err != nil {
+			return nil, err
+		}
+		if interchange.Metadata.GenesisValidatorsRoot != interchanges[0].Metadata.GenesisValidatorsRoot {
+			return nil, ErrGenesisValidatorsRootMismatch
+		}
+
+		for _, record := range interchange.Data {
+			merged, exists := recordsByPubkey[record.Pubkey]
+			if !exists {
+				merged = &Record{
+					Pubkey:             record.Pubkey,
+					SignedBlocks:       make([]*SignedBlock, 0),
+					SignedAttestations: make([]*SignedAttestation, 0),
+				}
+				recordsByPubkey[record.Pubkey] = merged
+				records = append(records, merged)
+			}
+			merged.SignedBlocks = append(merged.SignedBlocks, record.SignedBlocks...)
+			merged.SignedAttestations = append(merged.SignedAttestations, record.SignedAttestations...)
+		}
+	}
+
+	for _, record := range records {
+		record.SignedBlocks = mergeBlocks(record.SignedBlocks)
+		record.SignedAttestations = mergeAttestations(record.SignedAttestations)
+	}
+
+	return &Interchange{
+		Metadata: &Metadata{
+			InterchangeFormatVersion: FormatVersion,
+			GenesisValidatorsRoot:    interchanges[0].Metadata.GenesisValidatorsRoot,
+		},
+		Data: records,
+	}, nil
+}
+
+// Minimal returns a copy of the interchange document in the minimal form,
+// in which each record holds at most the block with the highest slot and a
+// single attestation with the highest source and target epochs seen.
+// Signing roots are not retained, so a signer importing the result will
+// refuse to sign again at the recorded slot and epochs.
+func (i *Interchange) Minimal() *Interchange {
+	minimal := &Interchange{
+		Data: make([]*Record, 0, len(i.Data)),
+	}
+	if i.Metadata != nil {
+		minimal.Metadata = &Metadata{
+			InterchangeFormatVersion: i.Metadata.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    i.Metadata.GenesisValidatorsRoot,
+		}
+	}
+
+	for _, record := range i.Data {
+		minimalRecord := &Record{
+			Pubkey:             record.Pubkey,
+			SignedBlocks:       make([]*SignedBlock, 0, 1),
+			SignedAttestations: make([]*SignedAttestation, 0, 1),
+		}
+		if len(record.SignedBlocks) > 0 {
+			block := &SignedBlock{}
+			for _, signedBlock := range record.SignedBlocks {
+				block.Slot = max(block.Slot, signedBlock.Slot)
+			}
+			minimalRecord.SignedBlocks = append(minimalRecord.SignedBlocks, block)
+		}
+		if len(record.SignedAttestations) > 0 {
+			attestation := &SignedAttestation{}
+			for _, signedAttestation := range record.SignedAttestations {
+				attestation.SourceEpoch = max(attestation.SourceEpoch, signedAttestation.SourceEpoch)
+				attestation.TargetEpoch = max(attestation.TargetEpoch, signedAttestation.TargetEpoch)
+			}
+			minimalRecord.SignedAttestations = append(minimalRecord.SignedAttestations, attestation)
+		}
+		minimal.Data = append(minimal.Data, minimalRecord)
+	}
+
+	return minimal
+}
+
+// mergeBlocks sorts blocks by slot and removes duplicates.
+func mergeBlocks(blocks []*SignedBlock) []*SignedBlock {
+	slices.SortStableFunc(blocks, func(a, b *SignedBlock) int {
+		if c := cmp.Compare(a.Slot, b.Slot); c != 0 {
+			return c
+		}
+
+		return compareRoots(a.SigningRoot, b.SigningRoot)
+	})
+
+	return slices.CompactFunc(blocks, func(a, b *SignedBlock) bool {
+		return a.Slot == b.Slot && compareRoots(a.SigningRoot, b.SigningRoot) == 0
+	})
+}
+
+// mergeAttestations sorts attestations by target and source epoch and
+// removes duplicates.
+func mergeAttestations(attestations []*SignedAttestation) []*SignedAttestation {
+	slices.SortStableFunc(attestations, func(a, b *SignedAttestation) int {
+		if c := cmp.Compare(a.TargetEpoch, b.TargetEpoch); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(a.SourceEpoch, b.SourceEpoch); c != 0 {
+			return c
+		}
+
+		return compareRoots(a.SigningRoot, b.SigningRoot)
+	})
+
+	return slices.CompactFunc(attestations, func(a, b *SignedAttestation) bool {
+		return a.SourceEpoch == b.SourceEpoch &&
+			a.TargetEpoch == b.TargetEpoch &&
+			compareRoots(a.SigningRoot, b.SigningRoot) == 0
+	})
+}
+
+// compareRoots compares two optional roots, with a missing root ordered
+// before any present root.
+func compareRoots(a *phase0.Root, b *phase0.Root) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return bytes.Compare(a[:], b[:])
+	}
+}
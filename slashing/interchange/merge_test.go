@@ -0,0 +1,136 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interchange_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/slashing/interchange"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func record(pubkey byte, blocks []*interchange.SignedBlock, attestations []*interchange.SignedAttestation) *interchange.Record {
+	return &interchange.Record{
+		Pubkey:             phase0.BLSPubKey{pubkey},
+		SignedBlocks:       blocks,
+		SignedAttestations: attestations,
+	}
+}
+
+func newInterchange(gvr byte, records ...*interchange.Record) *interchange.Interchange {
+	return &interchange.Interchange{
+		Metadata: &interchange.Metadata{
+			InterchangeFormatVersion: interchange.FormatVersion,
+			GenesisValidatorsRoot:    phase0.Root{gvr},
+		},
+		Data: records,
+	}
+}
+
+func TestMerge(t *testing.T) {
+	root1 := &phase0.Root{0x01}
+	root2 := &phase0.Root{0x02}
+
+	tests := []struct {
+		name         string
+		interchanges []*interchange.Interchange
+		expected     *interchange.Interchange
+		err          string
+	}{
+		{
+			name: "Empty",
+			err:  "no interchange documents supplied",
+		},
+		{
+			name:         "Nil",
+			interchanges: []*interchange.Interchange{nil},
+			err:          "nil interchange document supplied",
+		},
+		{
+			name: "GenesisValidatorsRootMismatch",
+			interchanges: []*interchange.Interchange{
+				newInterchange(1),
+				newInterchange(2),
+			},
+			err: "genesis validators root mismatch",
+		},
+		{
+			name: "Invalid",
+			interchanges: []*interchange.Interchange{
+				newInterchange(1, record(1, nil, []*interchange.SignedAttestation{{SourceEpoch: 2, TargetEpoch: 1}})),
+			},
+			err: "signed attestation 0 source epoch 2 after target epoch 1",
+		},
+		{
+			name: "Good",
+			interchanges: []*interchange.Interchange{
+				newInterchange(1,
+					record(2,
+						[]*interchange.SignedBlock{{Slot: 10, SigningRoot: root2}, {Slot: 5}},
+						[]*interchange.SignedAttestation{{SourceEpoch: 3, TargetEpoch: 4}},
+					),
+					record(1, nil, nil),
+				),
+				newInterchange(1,
+					record(3, []*interchange.SignedBlock{{Slot: 1}}, nil),
+					record(2,
+						[]*interchange.SignedBlock{{Slot: 10, SigningRoot: root1}, {Slot: 10, SigningRoot: root2}, {Slot: 5}},
+						[]*interchange.SignedAttestation{{SourceEpoch: 2, TargetEpoch: 4}, {SourceEpoch: 3, TargetEpoch: 4}, {SourceEpoch: 1, TargetEpoch: 2}},
+					),
+				),
+			},
+			expected: newInterchange(1,
+				record(2,
+					[]*interchange.SignedBlock{{Slot: 5}, {Slot: 10, SigningRoot: root1}, {Slot: 10, SigningRoot: root2}},
+					[]*interchange.SignedAttestation{{SourceEpoch: 1, TargetEpoch: 2}, {SourceEpoch: 2, TargetEpoch: 4}, {SourceEpoch: 3, TargetEpoch: 4}},
+				),
+				record(1, []*interchange.SignedBlock{}, []*interchange.SignedAttestation{}),
+				record(3, []*interchange.SignedBlock{{Slot: 1}}, []*interchange.SignedAttestation{}),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := interchange.Merge(test.interchanges...)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestMinimal(t *testing.T) {
+	root := &phase0.Root{0x01}
+
+	input := newInterchange(1,
+		record(1,
+			[]*interchange.SignedBlock{{Slot: 10, SigningRoot: root}, {Slot: 12}, {Slot: 11}},
+			[]*interchange.SignedAttestation{{SourceEpoch: 5, TargetEpoch: 6, SigningRoot: root}, {SourceEpoch: 4, TargetEpoch: 7}},
+		),
+		record(2, nil, nil),
+	)
+
+	require.Equal(t, newInterchange(1,
+		record(1,
+			[]*interchange.SignedBlock{{Slot: 12}},
+			[]*interchange.SignedAttestation{{SourceEpoch: 5, TargetEpoch: 7}},
+		),
+		record(2, []*interchange.SignedBlock{}, []*interchange.SignedAttestation{}),
+	), input.Minimal())
+}
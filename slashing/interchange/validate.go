@@ -0,0 +1,66 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interchange
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate checks that the interchange document is well-formed.
+func (i *Interchange) Validate() error {
+	if i.Metadata == nil {
+		return errors.New("metadata missing")
+	}
+	if i.Metadata.InterchangeFormatVersion != FormatVersion {
+		return errors.Join(ErrUnsupportedVersion, fmt.Errorf("version %s", i.Metadata.InterchangeFormatVersion))
+	}
+	if i.Metadata.GenesisValidatorsRoot.IsZero() {
+		return errors.New("genesis validators root missing")
+	}
+
+	for index, record := range i.Data {
+		if record == nil {
+			return fmt.Errorf("data %d missing", index)
+		}
+		if err := record.validate(); err != nil {
+			return errors.Join(fmt.Errorf("invalid data %d", index), err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the record is well-formed.
+func (r *Record) validate() error {
+	if r.Pubkey.IsZero() {
+		return errors.New("pubkey missing")
+	}
+	for index, block := range r.SignedBlocks {
+		if block == nil {
+			return fmt.Errorf("signed block %d missing", index)
+		}
+	}
+	for index, attestation := range r.SignedAttestations {
+		if attestation == nil {
+			return fmt.Errorf("signed attestation %d missing", index)
+		}
+		if attestation.SourceEpoch > attestation.TargetEpoch {
+			return fmt.Errorf("signed attestation %d source epoch %d after target epoch %d",
+				index, attestation.SourceEpoch, attestation.TargetEpoch)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// NewProposerSlashing creates a proposer slashing from two signed block
+// headers.  The headers must be for the same slot and proposer, and have
+// different roots.
+func NewProposerSlashing(header1 *phase0.SignedBeaconBlockHeader,
+	header2 *phase0.SignedBeaconBlockHeader,
+) (
+	*phase0.ProposerSlashing,
+	error,
+) {
+	if header1 == nil || header1.Message == nil {
+		return nil, errors.New("first header missing")
+	}
+	if header2 == nil || header2.Message == nil {
+		return nil, errors.New("second header missing")
+	}
+
+	if header1.Message.Slot != header2.Message.Slot {
+		return nil, errors.Join(
+			fmt.Errorf("headers are for different slots (%d, %d)", header1.Message.Slot, header2.Message.Slot),
+			ErrNotSlashable,
+		)
+	}
+	if header1.Message.ProposerIndex != header2.Message.ProposerIndex {
+		return nil, errors.Join(
+			fmt.Errorf("headers are for different proposers (%d, %d)", header1.Message.ProposerIndex, header2.Message.ProposerIndex),
+			ErrNotSlashable,
+		)
+	}
+
+	root1, err := header1.Message.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain root of first header"), err)
+	}
+	root2, err := header2.Message.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain root of second header"), err)
+	}
+	if root1 == root2 {
+		return nil, errors.Join(errors.New("headers have the same root"), ErrNotSlashable)
+	}
+
+	return &phase0.ProposerSlashing{
+		SignedHeader1: header1,
+		SignedHeader2: header2,
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/slashing"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func signedHeader(slot phase0.Slot, proposer phase0.ValidatorIndex, bodyRoot byte) *phase0.SignedBeaconBlockHeader {
+	return &phase0.SignedBeaconBlockHeader{
+		Message: &phase0.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: proposer,
+			BodyRoot:      phase0.Root{bodyRoot},
+		},
+	}
+}
+
+func TestNewProposerSlashing(t *testing.T) {
+	tests := []struct {
+		name         string
+		header1      *phase0.SignedBeaconBlockHeader
+		header2      *phase0.SignedBeaconBlockHeader
+		err          string
+		notSlashable bool
+	}{
+		{
+			name:    "Header1Missing",
+			header2: signedHeader(1, 2, 3),
+			err:     "first header missing",
+		},
+		{
+			name:    "Header2MessageMissing",
+			header1: signedHeader(1, 2, 3),
+			header2: &phase0.SignedBeaconBlockHeader{},
+			err:     "second header missing",
+		},
+		{
+			name:         "DifferentSlots",
+			header1:      signedHeader(1, 2, 3),
+			header2:      signedHeader(2, 2, 4),
+			err:          "headers are for different slots (1, 2)\nnot slashable",
+			notSlashable: true,
+		},
+		{
+			name:         "DifferentProposers",
+			header1:      signedHeader(1, 2, 3),
+			header2:      signedHeader(1, 3, 4),
+			err:          "headers are for different proposers (2, 3)\nnot slashable",
+			notSlashable: true,
+		},
+		{
+			name:         "SameRoot",
+			header1:      signedHeader(1, 2, 3),
+			header2:      signedHeader(1, 2, 3),
+			err:          "headers have the same root\nnot slashable",
+			notSlashable: true,
+		},
+		{
+			name:    "Good",
+			header1: signedHeader(1, 2, 3),
+			header2: signedHeader(1, 2, 4),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := slashing.NewProposerSlashing(test.header1, test.header2)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				if test.notSlashable {
+					require.ErrorIs(t, err, slashing.ErrNotSlashable)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.header1, res.SignedHeader1)
+				require.Equal(t, test.header2, res.SignedHeader2)
+			}
+		})
+	}
+}
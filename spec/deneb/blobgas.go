@@ -0,0 +1,82 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import "math/big"
+
+const (
+	// MinBaseFeePerBlobGas is the minimum base fee per unit of blob gas, in wei.
+	MinBaseFeePerBlobGas = 1
+	// BlobBaseFeeUpdateFraction is the denominator controlling the rate of
+	// change of the blob base fee.
+	BlobBaseFeeUpdateFraction = 3338477
+)
+
+// BlobBaseFee calculates the base fee per unit of blob gas, in wei, given the
+// excess blob gas of a block and the update fraction in force at the time.
+func BlobBaseFee(excessBlobGas uint64, updateFraction uint64) *big.Int {
+	return fakeExponential(
+		big.NewInt(MinBaseFeePerBlobGas),
+		new(big.Int).SetUint64(excessBlobGas),
+		new(big.Int).SetUint64(updateFraction),
+	)
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using
+// a Taylor expansion, as defined in EIP-4844.
+func fakeExponential(factor *big.Int, numerator *big.Int, denominator *big.Int) *big.Int {
+	output := new(big.Int)
+	if denominator.Sign() == 0 {
+		return output
+	}
+
+	numeratorAccum := new(big.Int).Mul(factor, denominator)
+	tmp := new(big.Int)
+	for i := int64(1); numeratorAccum.Sign() > 0; i++ {
+		output.Add(output, numeratorAccum)
+		tmp.Mul(denominator, big.NewInt(i))
+		numeratorAccum.Mul(numeratorAccum, numerator)
+		numeratorAccum.Div(numeratorAccum, tmp)
+	}
+
+	return output.Div(output, denominator)
+}
+
+// BlobBaseFee calculates the base fee per unit of blob gas, in wei, for the
+// execution payload using the given update fraction.
+func (e *ExecutionPayload) BlobBaseFee(updateFraction uint64) *big.Int {
+	return BlobBaseFee(e.ExcessBlobGas, updateFraction)
+}
+
+// BlobFee calculates the total blob fee paid by the execution payload, in wei,
+// using the given update fraction.
+func (e *ExecutionPayload) BlobFee(updateFraction uint64) *big.Int {
+	fee := e.BlobBaseFee(updateFraction)
+
+	return fee.Mul(fee, new(big.Int).SetUint64(e.BlobGasUsed))
+}
+
+// BlobBaseFee calculates the base fee per unit of blob gas, in wei, for the
+// execution payload header using the given update fraction.
+func (e *ExecutionPayloadHeader) BlobBaseFee(updateFraction uint64) *big.Int {
+	return BlobBaseFee(e.ExcessBlobGas, updateFraction)
+}
+
+// BlobFee calculates the total blob fee paid by the execution payload header,
+// in wei, using the given update fraction.
+func (e *ExecutionPayloadHeader) BlobFee(updateFraction uint64) *big.Int {
+	fee := e.BlobBaseFee(updateFraction)
+
+	return fee.Mul(fee, new(big.Int).SetUint64(e.BlobGasUsed))
+}
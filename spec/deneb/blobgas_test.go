@@ -0,0 +1,77 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobBaseFee(t *testing.T) {
+	tests := []struct {
+		name           string
+		excessBlobGas  uint64
+		updateFraction uint64
+		expected       *big.Int
+	}{
+		{
+			name:           "Zero",
+			excessBlobGas:  0,
+			updateFraction: deneb.BlobBaseFeeUpdateFraction,
+			expected:       big.NewInt(1),
+		},
+		{
+			name:           "UpdateFraction",
+			excessBlobGas:  deneb.BlobBaseFeeUpdateFraction,
+			updateFraction: deneb.BlobBaseFeeUpdateFraction,
+			expected:       big.NewInt(2),
+		},
+		{
+			name:           "Large",
+			excessBlobGas:  10 * deneb.BlobBaseFeeUpdateFraction,
+			updateFraction: deneb.BlobBaseFeeUpdateFraction,
+			expected:       big.NewInt(22026),
+		},
+		{
+			name:           "ElectraFraction",
+			excessBlobGas:  10 * deneb.BlobBaseFeeUpdateFraction,
+			updateFraction: 5007716,
+			expected:       big.NewInt(785),
+		},
+		{
+			name:           "ZeroFraction",
+			excessBlobGas:  1000,
+			updateFraction: 0,
+			expected:       big.NewInt(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, 0, test.expected.Cmp(deneb.BlobBaseFee(test.excessBlobGas, test.updateFraction)))
+		})
+	}
+}
+
+func TestExecutionPayloadBlobFee(t *testing.T) {
+	payload := &deneb.ExecutionPayload{
+		BlobGasUsed:   262144,
+		ExcessBlobGas: 10 * deneb.BlobBaseFeeUpdateFraction,
+	}
+	require.Equal(t, 0, big.NewInt(22026).Cmp(payload.BlobBaseFee(deneb.BlobBaseFeeUpdateFraction)))
+	require.Equal(t, 0, big.NewInt(22026*262144).Cmp(payload.BlobFee(deneb.BlobBaseFeeUpdateFraction)))
+}
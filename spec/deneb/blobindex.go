@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -56,3 +57,19 @@ func (b *BlobIndex) MarshalJSON() ([]byte, error) {
 
 	return []byte(fmt.Sprintf(`"%d"`, *b)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BlobIndex) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeUint64(uint64(b))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BlobIndex) UnmarshalText(input []byte) error {
+	val, err := codecs.DecodeUint64(string(input))
+	if err != nil {
+		return err
+	}
+	*b = BlobIndex(val)
+
+	return nil
+}
@@ -0,0 +1,429 @@
+// Code generated by copygen. DO NOT EDIT.
+
+package deneb
+
+import (
+	"bytes"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// Copy returns a deep copy of the beacon block.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+	}
+	res.Body = b.Body.Copy()
+
+	return res
+}
+
+// Equal returns true if the beacon block is equal to other.
+func (b *BeaconBlock) Equal(other *BeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the beacon block body.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlockBody{
+		RANDAOReveal: b.RANDAOReveal,
+		Graffiti:     b.Graffiti,
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = make([]*phase0.ProposerSlashing, len(b.ProposerSlashings))
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = make([]*phase0.AttesterSlashing, len(b.AttesterSlashings))
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = make([]*phase0.Attestation, len(b.Attestations))
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = make([]*phase0.Deposit, len(b.Deposits))
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = make([]*phase0.SignedVoluntaryExit, len(b.VoluntaryExits))
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+	res.ExecutionPayload = b.ExecutionPayload.Copy()
+	if b.BLSToExecutionChanges != nil {
+		res.BLSToExecutionChanges = make([]*capella.SignedBLSToExecutionChange, len(b.BLSToExecutionChanges))
+		for i := range b.BLSToExecutionChanges {
+			res.BLSToExecutionChanges[i] = b.BLSToExecutionChanges[i].Copy()
+		}
+	}
+	if b.BlobKZGCommitments != nil {
+		res.BlobKZGCommitments = make([]KZGCommitment, len(b.BlobKZGCommitments))
+		copy(res.BlobKZGCommitments, b.BlobKZGCommitments)
+	}
+
+	return res
+}
+
+// Equal returns true if the beacon block body is equal to other.
+func (b *BeaconBlockBody) Equal(other *BeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.RANDAOReveal != other.RANDAOReveal {
+		return false
+	}
+	if !b.ETH1Data.Equal(other.ETH1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for i := range b.ProposerSlashings {
+		if !b.ProposerSlashings[i].Equal(other.ProposerSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for i := range b.AttesterSlashings {
+		if !b.AttesterSlashings[i].Equal(other.AttesterSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for i := range b.Attestations {
+		if !b.Attestations[i].Equal(other.Attestations[i]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for i := range b.Deposits {
+		if !b.Deposits[i].Equal(other.Deposits[i]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for i := range b.VoluntaryExits {
+		if !b.VoluntaryExits[i].Equal(other.VoluntaryExits[i]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	if len(b.BLSToExecutionChanges) != len(other.BLSToExecutionChanges) {
+		return false
+	}
+	for i := range b.BLSToExecutionChanges {
+		if !b.BLSToExecutionChanges[i].Equal(other.BLSToExecutionChanges[i]) {
+			return false
+		}
+	}
+	if len(b.BlobKZGCommitments) != len(other.BlobKZGCommitments) {
+		return false
+	}
+	for i := range b.BlobKZGCommitments {
+		if b.BlobKZGCommitments[i] != other.BlobKZGCommitments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the execution payload.
+func (e *ExecutionPayload) Copy() *ExecutionPayload {
+	if e == nil {
+		return nil
+	}
+
+	res := &ExecutionPayload{
+		ParentHash:    e.ParentHash,
+		FeeRecipient:  e.FeeRecipient,
+		StateRoot:     e.StateRoot,
+		ReceiptsRoot:  e.ReceiptsRoot,
+		LogsBloom:     e.LogsBloom,
+		PrevRandao:    e.PrevRandao,
+		BlockNumber:   e.BlockNumber,
+		GasLimit:      e.GasLimit,
+		GasUsed:       e.GasUsed,
+		Timestamp:     e.Timestamp,
+		BlockHash:     e.BlockHash,
+		BlobGasUsed:   e.BlobGasUsed,
+		ExcessBlobGas: e.ExcessBlobGas,
+	}
+	if e.ExtraData != nil {
+		res.ExtraData = make([]byte, len(e.ExtraData))
+		copy(res.ExtraData, e.ExtraData)
+	}
+	if e.BaseFeePerGas != nil {
+		res.BaseFeePerGas = new(uint256.Int).Set(e.BaseFeePerGas)
+	}
+	if e.Transactions != nil {
+		res.Transactions = make([]bellatrix.Transaction, len(e.Transactions))
+		for i := range e.Transactions {
+			if e.Transactions[i] != nil {
+				res.Transactions[i] = make(bellatrix.Transaction, len(e.Transactions[i]))
+				copy(res.Transactions[i], e.Transactions[i])
+			}
+		}
+	}
+	if e.Withdrawals != nil {
+		res.Withdrawals = make([]*capella.Withdrawal, len(e.Withdrawals))
+		for i := range e.Withdrawals {
+			res.Withdrawals[i] = e.Withdrawals[i].Copy()
+		}
+	}
+
+	return res
+}
+
+// Equal returns true if the execution payload is equal to other.
+func (e *ExecutionPayload) Equal(other *ExecutionPayload) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if (e.BaseFeePerGas == nil) != (other.BaseFeePerGas == nil) || (e.BaseFeePerGas != nil && !e.BaseFeePerGas.Eq(other.BaseFeePerGas)) {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if len(e.Transactions) != len(other.Transactions) {
+		return false
+	}
+	for i := range e.Transactions {
+		if !bytes.Equal(e.Transactions[i], other.Transactions[i]) {
+			return false
+		}
+	}
+	if len(e.Withdrawals) != len(other.Withdrawals) {
+		return false
+	}
+	for i := range e.Withdrawals {
+		if !e.Withdrawals[i].Equal(other.Withdrawals[i]) {
+			return false
+		}
+	}
+	if e.BlobGasUsed != other.BlobGasUsed {
+		return false
+	}
+	if e.ExcessBlobGas != other.ExcessBlobGas {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the execution payload header.
+func (e *ExecutionPayloadHeader) Copy() *ExecutionPayloadHeader {
+	if e == nil {
+		return nil
+	}
+
+	res := &ExecutionPayloadHeader{
+		ParentHash:       e.ParentHash,
+		FeeRecipient:     e.FeeRecipient,
+		StateRoot:        e.StateRoot,
+		ReceiptsRoot:     e.ReceiptsRoot,
+		LogsBloom:        e.LogsBloom,
+		PrevRandao:       e.PrevRandao,
+		BlockNumber:      e.BlockNumber,
+		GasLimit:         e.GasLimit,
+		GasUsed:          e.GasUsed,
+		Timestamp:        e.Timestamp,
+		BlockHash:        e.BlockHash,
+		TransactionsRoot: e.TransactionsRoot,
+		WithdrawalsRoot:  e.WithdrawalsRoot,
+		BlobGasUsed:      e.BlobGasUsed,
+		ExcessBlobGas:    e.ExcessBlobGas,
+	}
+	if e.ExtraData != nil {
+		res.ExtraData = make([]byte, len(e.ExtraData))
+		copy(res.ExtraData, e.ExtraData)
+	}
+	if e.BaseFeePerGas != nil {
+		res.BaseFeePerGas = new(uint256.Int).Set(e.BaseFeePerGas)
+	}
+
+	return res
+}
+
+// Equal returns true if the execution payload header is equal to other.
+func (e *ExecutionPayloadHeader) Equal(other *ExecutionPayloadHeader) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if (e.BaseFeePerGas == nil) != (other.BaseFeePerGas == nil) || (e.BaseFeePerGas != nil && !e.BaseFeePerGas.Eq(other.BaseFeePerGas)) {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if e.TransactionsRoot != other.TransactionsRoot {
+		return false
+	}
+	if e.WithdrawalsRoot != other.WithdrawalsRoot {
+		return false
+	}
+	if e.BlobGasUsed != other.BlobGasUsed {
+		return false
+	}
+	if e.ExcessBlobGas != other.ExcessBlobGas {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the signed beacon block.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := &SignedBeaconBlock{
+		Signature: s.Signature,
+	}
+	res.Message = s.Message.Copy()
+
+	return res
+}
+
+// Equal returns true if the signed beacon block is equal to other.
+func (s *SignedBeaconBlock) Equal(other *SignedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -113,3 +114,19 @@ func (k *KZGCommitment) UnmarshalYAML(input []byte) error {
 func (k KZGCommitment) MarshalYAML() ([]byte, error) {
 	return []byte(fmt.Sprintf(`'%#x'`, k)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (k KZGCommitment) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(k[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KZGCommitment) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), KZGCommitmentLength)
+	if err != nil {
+		return err
+	}
+	copy(k[:], data)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/stretchr/testify/require"
+)
+
+type textType interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		res   func() textType
+		err   string
+	}{
+		{
+			name:  "BlobIndex",
+			input: "5",
+			res:   func() textType { b := deneb.BlobIndex(0); return &b },
+		},
+		{
+			name:  "BlobIndexInvalid",
+			input: "0x05",
+			res:   func() textType { b := deneb.BlobIndex(0); return &b },
+			err:   `invalid value 0x05: strconv.ParseUint: parsing "0x05": invalid syntax`,
+		},
+		{
+			name:  "KZGCommitment",
+			input: "0x95cc5099bbd8420d8ebade383c00a2346dace60a7604f768cd71501757b4d72eeb7d5474a6b615af10379d69aa9f478f",
+			res:   func() textType { return &deneb.KZGCommitment{} },
+		},
+		{
+			name:  "KZGProof",
+			input: "0xae9f2d2217013ef61f995f9074faead9ec24e8048440164ec3d6029b87d43686dd0c97c2df9554fc997d0d66c3a78929",
+			res:   func() textType { return &deneb.KZGProof{} },
+		},
+		{
+			name:  "KZGProofShort",
+			input: "0xae9f",
+			res:   func() textType { return &deneb.KZGProof{} },
+			err:   "incorrect length",
+		},
+		{
+			name:  "VersionedHash",
+			input: "0x01a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f",
+			res:   func() textType { return &deneb.VersionedHash{} },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := test.res()
+			err := res.UnmarshalText([]byte(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := res.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, test.input, string(rt))
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -121,3 +122,19 @@ func (h *VersionedHash) MarshalYAML() ([]byte, error) {
 
 	return []byte(fmt.Sprintf(`'%#x'`, h)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (h VersionedHash) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(h[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *VersionedHash) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), VersionedHashLength)
+	if err != nil {
+		return err
+	}
+	copy(h[:], data)
+
+	return nil
+}
@@ -0,0 +1,543 @@
+// Code generated by copygen. DO NOT EDIT.
+
+package electra
+
+import (
+	"bytes"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Copy returns a deep copy of the attestation.
+func (a *Attestation) Copy() *Attestation {
+	if a == nil {
+		return nil
+	}
+
+	res := &Attestation{
+		Signature: a.Signature,
+	}
+	if a.AggregationBits != nil {
+		res.AggregationBits = make(bitfield.Bitlist, len(a.AggregationBits))
+		copy(res.AggregationBits, a.AggregationBits)
+	}
+	res.Data = a.Data.Copy()
+	if a.CommitteeBits != nil {
+		res.CommitteeBits = make(bitfield.Bitvector64, len(a.CommitteeBits))
+		copy(res.CommitteeBits, a.CommitteeBits)
+	}
+
+	return res
+}
+
+// Equal returns true if the attestation is equal to other.
+func (a *Attestation) Equal(other *Attestation) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if !bytes.Equal(a.AggregationBits, other.AggregationBits) {
+		return false
+	}
+	if !a.Data.Equal(other.Data) {
+		return false
+	}
+	if a.Signature != other.Signature {
+		return false
+	}
+	if !bytes.Equal(a.CommitteeBits, other.CommitteeBits) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the attester slashing.
+func (a *AttesterSlashing) Copy() *AttesterSlashing {
+	if a == nil {
+		return nil
+	}
+
+	res := &AttesterSlashing{}
+	res.Attestation1 = a.Attestation1.Copy()
+	res.Attestation2 = a.Attestation2.Copy()
+
+	return res
+}
+
+// Equal returns true if the attester slashing is equal to other.
+func (a *AttesterSlashing) Equal(other *AttesterSlashing) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if !a.Attestation1.Equal(other.Attestation1) {
+		return false
+	}
+	if !a.Attestation2.Equal(other.Attestation2) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the beacon block.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+	}
+	res.Body = b.Body.Copy()
+
+	return res
+}
+
+// Equal returns true if the beacon block is equal to other.
+func (b *BeaconBlock) Equal(other *BeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the beacon block body.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlockBody{
+		RANDAOReveal: b.RANDAOReveal,
+		Graffiti:     b.Graffiti,
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = make([]*phase0.ProposerSlashing, len(b.ProposerSlashings))
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = make([]*Attestation, len(b.Attestations))
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = make([]*phase0.Deposit, len(b.Deposits))
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = make([]*phase0.SignedVoluntaryExit, len(b.VoluntaryExits))
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+	res.SyncAggregate = b.SyncAggregate.Copy()
+	res.ExecutionPayload = b.ExecutionPayload.Copy()
+	if b.BLSToExecutionChanges != nil {
+		res.BLSToExecutionChanges = make([]*capella.SignedBLSToExecutionChange, len(b.BLSToExecutionChanges))
+		for i := range b.BLSToExecutionChanges {
+			res.BLSToExecutionChanges[i] = b.BLSToExecutionChanges[i].Copy()
+		}
+	}
+	if b.BlobKZGCommitments != nil {
+		res.BlobKZGCommitments = make([]deneb.KZGCommitment, len(b.BlobKZGCommitments))
+		copy(res.BlobKZGCommitments, b.BlobKZGCommitments)
+	}
+	res.ExecutionRequests = b.ExecutionRequests.Copy()
+
+	return res
+}
+
+// Equal returns true if the beacon block body is equal to other.
+func (b *BeaconBlockBody) Equal(other *BeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.RANDAOReveal != other.RANDAOReveal {
+		return false
+	}
+	if !b.ETH1Data.Equal(other.ETH1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for i := range b.ProposerSlashings {
+		if !b.ProposerSlashings[i].Equal(other.ProposerSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for i := range b.AttesterSlashings {
+		if !b.AttesterSlashings[i].Equal(other.AttesterSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for i := range b.Attestations {
+		if !b.Attestations[i].Equal(other.Attestations[i]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for i := range b.Deposits {
+		if !b.Deposits[i].Equal(other.Deposits[i]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for i := range b.VoluntaryExits {
+		if !b.VoluntaryExits[i].Equal(other.VoluntaryExits[i]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	if len(b.BLSToExecutionChanges) != len(other.BLSToExecutionChanges) {
+		return false
+	}
+	for i := range b.BLSToExecutionChanges {
+		if !b.BLSToExecutionChanges[i].Equal(other.BLSToExecutionChanges[i]) {
+			return false
+		}
+	}
+	if len(b.BlobKZGCommitments) != len(other.BlobKZGCommitments) {
+		return false
+	}
+	for i := range b.BlobKZGCommitments {
+		if b.BlobKZGCommitments[i] != other.BlobKZGCommitments[i] {
+			return false
+		}
+	}
+	if !b.ExecutionRequests.Equal(other.ExecutionRequests) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the consolidation request.
+func (e *ConsolidationRequest) Copy() *ConsolidationRequest {
+	if e == nil {
+		return nil
+	}
+
+	res := &ConsolidationRequest{
+		SourceAddress: e.SourceAddress,
+		SourcePubkey:  e.SourcePubkey,
+		TargetPubkey:  e.TargetPubkey,
+	}
+
+	return res
+}
+
+// Equal returns true if the consolidation request is equal to other.
+func (e *ConsolidationRequest) Equal(other *ConsolidationRequest) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if e.SourceAddress != other.SourceAddress {
+		return false
+	}
+	if e.SourcePubkey != other.SourcePubkey {
+		return false
+	}
+	if e.TargetPubkey != other.TargetPubkey {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the deposit request.
+func (d *DepositRequest) Copy() *DepositRequest {
+	if d == nil {
+		return nil
+	}
+
+	res := &DepositRequest{
+		Pubkey:    d.Pubkey,
+		Amount:    d.Amount,
+		Signature: d.Signature,
+		Index:     d.Index,
+	}
+	if d.WithdrawalCredentials != nil {
+		res.WithdrawalCredentials = make([]byte, len(d.WithdrawalCredentials))
+		copy(res.WithdrawalCredentials, d.WithdrawalCredentials)
+	}
+
+	return res
+}
+
+// Equal returns true if the deposit request is equal to other.
+func (d *DepositRequest) Equal(other *DepositRequest) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if d.Pubkey != other.Pubkey {
+		return false
+	}
+	if !bytes.Equal(d.WithdrawalCredentials, other.WithdrawalCredentials) {
+		return false
+	}
+	if d.Amount != other.Amount {
+		return false
+	}
+	if d.Signature != other.Signature {
+		return false
+	}
+	if d.Index != other.Index {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the execution requests.
+func (e *ExecutionRequests) Copy() *ExecutionRequests {
+	if e == nil {
+		return nil
+	}
+
+	res := &ExecutionRequests{}
+	if e.Deposits != nil {
+		res.Deposits = make([]*DepositRequest, len(e.Deposits))
+		for i := range e.Deposits {
+			res.Deposits[i] = e.Deposits[i].Copy()
+		}
+	}
+	if e.Withdrawals != nil {
+		res.Withdrawals = make([]*WithdrawalRequest, len(e.Withdrawals))
+		for i := range e.Withdrawals {
+			res.Withdrawals[i] = e.Withdrawals[i].Copy()
+		}
+	}
+	if e.Consolidations != nil {
+		res.Consolidations = make([]*ConsolidationRequest, len(e.Consolidations))
+		for i := range e.Consolidations {
+			res.Consolidations[i] = e.Consolidations[i].Copy()
+		}
+	}
+
+	return res
+}
+
+// Equal returns true if the execution requests is equal to other.
+func (e *ExecutionRequests) Equal(other *ExecutionRequests) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if len(e.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for i := range e.Deposits {
+		if !e.Deposits[i].Equal(other.Deposits[i]) {
+			return false
+		}
+	}
+	if len(e.Withdrawals) != len(other.Withdrawals) {
+		return false
+	}
+	for i := range e.Withdrawals {
+		if !e.Withdrawals[i].Equal(other.Withdrawals[i]) {
+			return false
+		}
+	}
+	if len(e.Consolidations) != len(other.Consolidations) {
+		return false
+	}
+	for i := range e.Consolidations {
+		if !e.Consolidations[i].Equal(other.Consolidations[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the indexed attestation.
+func (i *IndexedAttestation) Copy() *IndexedAttestation {
+	if i == nil {
+		return nil
+	}
+
+	res := &IndexedAttestation{
+		Signature: i.Signature,
+	}
+	if i.AttestingIndices != nil {
+		res.AttestingIndices = make([]uint64, len(i.AttestingIndices))
+		copy(res.AttestingIndices, i.AttestingIndices)
+	}
+	res.Data = i.Data.Copy()
+
+	return res
+}
+
+// Equal returns true if the indexed attestation is equal to other.
+func (i *IndexedAttestation) Equal(other *IndexedAttestation) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+	if len(i.AttestingIndices) != len(other.AttestingIndices) {
+		return false
+	}
+	for j := range i.AttestingIndices {
+		if i.AttestingIndices[j] != other.AttestingIndices[j] {
+			return false
+		}
+	}
+	if !i.Data.Equal(other.Data) {
+		return false
+	}
+	if i.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the signed beacon block.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := &SignedBeaconBlock{
+		Signature: s.Signature,
+	}
+	res.Message = s.Message.Copy()
+
+	return res
+}
+
+// Equal returns true if the signed beacon block is equal to other.
+func (s *SignedBeaconBlock) Equal(other *SignedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the single attestation.
+func (a *SingleAttestation) Copy() *SingleAttestation {
+	if a == nil {
+		return nil
+	}
+
+	res := &SingleAttestation{
+		CommitteeIndex: a.CommitteeIndex,
+		AttesterIndex:  a.AttesterIndex,
+		Signature:      a.Signature,
+	}
+	res.Data = a.Data.Copy()
+
+	return res
+}
+
+// Equal returns true if the single attestation is equal to other.
+func (a *SingleAttestation) Equal(other *SingleAttestation) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.CommitteeIndex != other.CommitteeIndex {
+		return false
+	}
+	if a.AttesterIndex != other.AttesterIndex {
+		return false
+	}
+	if !a.Data.Equal(other.Data) {
+		return false
+	}
+	if a.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the withdrawal request.
+func (e *WithdrawalRequest) Copy() *WithdrawalRequest {
+	if e == nil {
+		return nil
+	}
+
+	res := &WithdrawalRequest{
+		SourceAddress:   e.SourceAddress,
+		ValidatorPubkey: e.ValidatorPubkey,
+		Amount:          e.Amount,
+	}
+
+	return res
+}
+
+// Equal returns true if the withdrawal request is equal to other.
+func (e *WithdrawalRequest) Equal(other *WithdrawalRequest) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if e.SourceAddress != other.SourceAddress {
+		return false
+	}
+	if e.ValidatorPubkey != other.ValidatorPubkey {
+		return false
+	}
+	if e.Amount != other.Amount {
+		return false
+	}
+
+	return true
+}
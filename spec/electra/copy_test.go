@@ -0,0 +1,138 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+// populatedBlock creates a block with every list that a copy must duplicate
+// populated.
+func populatedBlock(t *testing.T) *electra.SignedBeaconBlock {
+	t.Helper()
+
+	attestation, err := testfixtures.Attestation(spec.DataVersionElectra, testfixtures.WithSlot(99))
+	require.NoError(t, err)
+	versionedBlock, err := testfixtures.Block(spec.DataVersionElectra,
+		testfixtures.WithSlot(100),
+		testfixtures.WithAttestations([]*spec.VersionedAttestation{attestation}),
+	)
+	require.NoError(t, err)
+
+	block := versionedBlock.Electra
+	body := block.Message.Body
+	body.Deposits = []*phase0.Deposit{
+		{
+			Proof: [][]byte{{0x01}, {0x02}},
+			Data:  &phase0.DepositData{Amount: 32000000000},
+		},
+	}
+	body.ExecutionPayload.ExtraData = []byte{0xab}
+	body.ExecutionPayload.Transactions = []bellatrix.Transaction{{0x01, 0x02}}
+	body.ExecutionPayload.Withdrawals = []*capella.Withdrawal{
+		{Index: 1, Amount: 10},
+	}
+	body.BlobKZGCommitments = []deneb.KZGCommitment{{0x01}}
+	body.ExecutionRequests.Withdrawals = []*electra.WithdrawalRequest{
+		{Amount: 5},
+	}
+
+	return block
+}
+
+func TestCopy(t *testing.T) {
+	block := populatedBlock(t)
+	cp := block.Copy()
+	require.True(t, block.Equal(cp))
+	require.Equal(t, block, cp)
+
+	// Changes to the copy must not affect the original.
+	cp.Message.Body.Graffiti[0] = 'x'
+	cp.Message.Body.ETH1Data.BlockHash[0] = 0xff
+	cp.Message.Body.Attestations[0].Data.Source.Epoch = 10
+	cp.Message.Body.Deposits[0].Proof[0][0] = 0xff
+	cp.Message.Body.ExecutionPayload.FeeRecipient[0] = 0xff
+	cp.Message.Body.ExecutionPayload.BaseFeePerGas.SetUint64(8)
+	cp.Message.Body.ExecutionPayload.Transactions[0][0] = 0xff
+	cp.Message.Body.ExecutionPayload.Withdrawals[0].Amount = 20
+	cp.Message.Body.BlobKZGCommitments[0][0] = 0xff
+	cp.Message.Body.ExecutionRequests.Withdrawals[0].Amount = 6
+	require.Equal(t, populatedBlock(t), block)
+	require.False(t, block.Equal(cp))
+
+	var nilBlock *electra.SignedBeaconBlock
+	require.Nil(t, nilBlock.Copy())
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*electra.SignedBeaconBlock)
+		equal  bool
+	}{
+		{
+			name:   "Same",
+			modify: func(*electra.SignedBeaconBlock) {},
+			equal:  true,
+		},
+		{
+			name:   "Slot",
+			modify: func(b *electra.SignedBeaconBlock) { b.Message.Slot++ },
+		},
+		{
+			name:   "Graffiti",
+			modify: func(b *electra.SignedBeaconBlock) { b.Message.Body.Graffiti[31] = 0x01 },
+		},
+		{
+			name:   "BaseFeePerGasNil",
+			modify: func(b *electra.SignedBeaconBlock) { b.Message.Body.ExecutionPayload.BaseFeePerGas = nil },
+		},
+		{
+			name: "TransactionAdded",
+			modify: func(b *electra.SignedBeaconBlock) {
+				b.Message.Body.ExecutionPayload.Transactions = append(b.Message.Body.ExecutionPayload.Transactions, bellatrix.Transaction{})
+			},
+		},
+		{
+			name:   "AttestationMissing",
+			modify: func(b *electra.SignedBeaconBlock) { b.Message.Body.Attestations[0] = nil },
+		},
+		{
+			name:   "ExecutionRequestsMissing",
+			modify: func(b *electra.SignedBeaconBlock) { b.Message.Body.ExecutionRequests = nil },
+		},
+		{
+			name:   "ExtraDataEmpty",
+			modify: func(b *electra.SignedBeaconBlock) { b.Message.Body.ExecutionPayload.ExtraData = []byte{} },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := populatedBlock(t)
+			test.modify(block)
+			require.Equal(t, test.equal, populatedBlock(t).Equal(block))
+			require.Equal(t, test.equal, block.Equal(populatedBlock(t)))
+		})
+	}
+}
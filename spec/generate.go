@@ -0,0 +1,26 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+// Versioned types listed in versioned.json are generated; edit the table rather than the generated files.
+// Only wrappers whose accessors read the same field in every fork are listed.  Types with fork-specific
+// behaviour, such as VersionedSignedBeaconBlock, VersionedAttestation and VersionedProposal, are hand-written.
+//go:generate go run ../internal/versionedgen -table versioned.json
+
+// Copy and Equal methods are generated for the containers listed in internal/copygen/roots.go.
+//go:generate rm -f phase0/copy.go altair/copy.go bellatrix/copy.go capella/copy.go deneb/copy.go electra/copy.go ../api/v1/bellatrix/copy.go ../api/v1/capella/copy.go ../api/v1/deneb/copy.go ../api/v1/electra/copy.go
+//go:generate go run ../internal/copygen -root ..
+
+// The containers checked by the round-trip tests are generated from the types with SSZ encodings.
+//go:generate go run ../internal/roundtripgen -root ..
@@ -0,0 +1,217 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update JSON golden files")
+
+// goldenVersions are the versions with golden files.
+var goldenVersions = []spec.DataVersion{
+	spec.DataVersionPhase0,
+	spec.DataVersionAltair,
+	spec.DataVersionBellatrix,
+	spec.DataVersionCapella,
+	spec.DataVersionDeneb,
+	spec.DataVersionElectra,
+}
+
+// goldenItems returns the items for the given version that are checked
+// against golden files, keyed by name.  Containers that are unchanged from a
+// previous fork, such as withdrawals, are checked in the fork that
+// introduced them.
+func goldenItems(t *testing.T, version spec.DataVersion) map[string]any {
+	t.Helper()
+
+	block, err := testfixtures.Block(version)
+	require.NoError(t, err)
+	attestation, err := testfixtures.Attestation(version)
+	require.NoError(t, err)
+	state, err := testfixtures.State(version)
+	require.NoError(t, err)
+
+	switch version {
+	case spec.DataVersionPhase0:
+		return map[string]any{
+			"block":       block.Phase0,
+			"body":        block.Phase0.Message.Body,
+			"attestation": attestation.Phase0,
+			"state":       state.Phase0,
+		}
+	case spec.DataVersionAltair:
+		return map[string]any{
+			"block":          block.Altair,
+			"body":           block.Altair.Message.Body,
+			"attestation":    attestation.Altair,
+			"state":          state.Altair,
+			"sync_aggregate": block.Altair.Message.Body.SyncAggregate,
+		}
+	case spec.DataVersionBellatrix:
+		return map[string]any{
+			"block":                    block.Bellatrix,
+			"body":                     block.Bellatrix.Message.Body,
+			"attestation":              attestation.Bellatrix,
+			"state":                    state.Bellatrix,
+			"execution_payload":        block.Bellatrix.Message.Body.ExecutionPayload,
+			"execution_payload_header": state.Bellatrix.LatestExecutionPayloadHeader,
+		}
+	case spec.DataVersionCapella:
+		return map[string]any{
+			"block":                    block.Capella,
+			"body":                     block.Capella.Message.Body,
+			"attestation":              attestation.Capella,
+			"state":                    state.Capella,
+			"execution_payload":        block.Capella.Message.Body.ExecutionPayload,
+			"execution_payload_header": state.Capella.LatestExecutionPayloadHeader,
+			"withdrawal": &capella.Withdrawal{
+				Index:          1,
+				ValidatorIndex: 2,
+				Address:        bellatrix.ExecutionAddress{0x01},
+				Amount:         3,
+			},
+		}
+	case spec.DataVersionDeneb:
+		return map[string]any{
+			"block":                    block.Deneb,
+			"body":                     block.Deneb.Message.Body,
+			"attestation":              attestation.Deneb,
+			"state":                    state.Deneb,
+			"execution_payload":        block.Deneb.Message.Body.ExecutionPayload,
+			"execution_payload_header": state.Deneb.LatestExecutionPayloadHeader,
+		}
+	case spec.DataVersionElectra:
+		return map[string]any{
+			"block":       block.Electra,
+			"body":        block.Electra.Message.Body,
+			"attestation": attestation.Electra,
+			"state":       state.Electra,
+			"execution_requests": &electra.ExecutionRequests{
+				Deposits: []*electra.DepositRequest{{
+					Pubkey:                phase0.BLSPubKey{0x01},
+					WithdrawalCredentials: make([]byte, 32),
+					Amount:                32_000_000_000,
+					Signature:             phase0.BLSSignature{0x02},
+					Index:                 4,
+				}},
+				Withdrawals: []*electra.WithdrawalRequest{{
+					SourceAddress:   bellatrix.ExecutionAddress{0x03},
+					ValidatorPubkey: phase0.BLSPubKey{0x04},
+					Amount:          5,
+				}},
+				Consolidations: []*electra.ConsolidationRequest{{
+					SourceAddress: bellatrix.ExecutionAddress{0x05},
+					SourcePubkey:  phase0.BLSPubKey{0x06},
+					TargetPubkey:  phase0.BLSPubKey{0x07},
+				}},
+			},
+		}
+	default:
+		require.FailNow(t, "unhandled version")
+
+		return nil
+	}
+}
+
+// goldenDigests are the items whose output is too large to hold in full, so
+// whose golden files hold the SHA-256 digest of the output instead.
+var goldenDigests = map[string]bool{
+	"state": true,
+}
+
+// TestJSONGolden ensures that JSON output for each fork matches the golden
+// files byte for byte.  Run with -update-golden to regenerate the files after
+// an intentional change.
+func TestJSONGolden(t *testing.T) {
+	for _, version := range goldenVersions {
+		for name, item := range goldenItems(t, version) {
+			t.Run(fmt.Sprintf("%s/%s", version, name), func(t *testing.T) {
+				data, err := json.Marshal(item)
+				require.NoError(t, err)
+
+				// Output must be repeatable, compact and canonical.
+				again, err := json.Marshal(item)
+				require.NoError(t, err)
+				require.Equal(t, data, again)
+				compact := bytes.NewBuffer(nil)
+				require.NoError(t, json.Compact(compact, data))
+				require.Equal(t, compact.Bytes(), data)
+				require.NoError(t, codecs.CheckCanonicalJSON(data))
+
+				path := filepath.Join("testdata", "golden", fmt.Sprintf("%s_%s.json", version, name))
+				if goldenDigests[name] {
+					path += ".sha256"
+					digest := sha256.Sum256(data)
+					data = []byte(hex.EncodeToString(digest[:]))
+				}
+				if *updateGolden {
+					require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+					require.NoError(t, os.WriteFile(path, data, 0o644))
+				}
+				expected, err := os.ReadFile(path)
+				require.NoError(t, err)
+				require.Equal(t, string(expected), string(data))
+			})
+		}
+	}
+}
+
+// TestJSONFieldOrderAcrossForks ensures that each fork only appends fields
+// to the containers of the previous fork, so fields common to both forks are
+// always in the same position.
+func TestJSONFieldOrderAcrossForks(t *testing.T) {
+	for _, name := range []string{"body", "attestation", "execution_payload", "execution_payload_header"} {
+		for i := 1; i < len(goldenVersions); i++ {
+			previous, current := goldenVersions[i-1], goldenVersions[i]
+			if _, exists := goldenItems(t, previous)[name]; !exists {
+				continue
+			}
+			if _, exists := goldenItems(t, current)[name]; !exists {
+				continue
+			}
+			t.Run(fmt.Sprintf("%s/%s", current, name), func(t *testing.T) {
+				previousData, err := json.Marshal(goldenItems(t, previous)[name])
+				require.NoError(t, err)
+				previousKeys, err := codecs.ObjectKeys(previousData)
+				require.NoError(t, err)
+
+				currentData, err := json.Marshal(goldenItems(t, current)[name])
+				require.NoError(t, err)
+				currentKeys, err := codecs.ObjectKeys(currentData)
+				require.NoError(t, err)
+
+				require.GreaterOrEqual(t, len(currentKeys), len(previousKeys))
+				require.Equal(t, previousKeys, currentKeys[:len(previousKeys)])
+			})
+		}
+	}
+}
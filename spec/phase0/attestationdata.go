@@ -149,3 +149,45 @@ func (a *AttestationData) String() string {
 
 	return string(data)
 }
+
+// Surrounds returns true if the attestation data surrounds other, that is it
+// has an earlier source epoch and a later target epoch.
+func (a *AttestationData) Surrounds(other *AttestationData) bool {
+	if !a.hasCheckpoints() || !other.hasCheckpoints() {
+		return false
+	}
+
+	return a.Source.IsBefore(other.Source) && other.Target.IsBefore(a.Target)
+}
+
+// IsConflicting returns true if a validator signing both the attestation
+// data and other would be slashable, either because they are different but
+// have the same target epoch or because one surrounds the other.
+func (a *AttestationData) IsConflicting(other *AttestationData) bool {
+	if !a.hasCheckpoints() || !other.hasCheckpoints() {
+		return false
+	}
+
+	if a.Target.Epoch == other.Target.Epoch {
+		return !a.sameVote(other)
+	}
+
+	return a.Surrounds(other) || other.Surrounds(a)
+}
+
+// sameVote returns true if the attestation data and other are identical.
+// This is written out rather than using the generated Equal method so that
+// the package builds while copy.go is being regenerated.
+func (a *AttestationData) sameVote(other *AttestationData) bool {
+	return a.Slot == other.Slot &&
+		a.Index == other.Index &&
+		a.BeaconBlockRoot == other.BeaconBlockRoot &&
+		*a.Source == *other.Source &&
+		*a.Target == *other.Target
+}
+
+// hasCheckpoints returns true if the attestation data has source and target
+// checkpoints.
+func (a *AttestationData) hasCheckpoints() bool {
+	return a != nil && a.Source != nil && a.Target != nil
+}
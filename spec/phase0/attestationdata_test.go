@@ -196,3 +196,72 @@ func TestAttestationDataYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestAttestationDataConflicts(t *testing.T) {
+	data := func(slot phase0.Slot, source phase0.Epoch, target phase0.Epoch, root byte) *phase0.AttestationData {
+		return &phase0.AttestationData{
+			Slot:            slot,
+			BeaconBlockRoot: phase0.Root{root},
+			Source:          &phase0.Checkpoint{Epoch: source},
+			Target:          &phase0.Checkpoint{Epoch: target},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		data1       *phase0.AttestationData
+		data2       *phase0.AttestationData
+		equal       bool
+		surrounds   bool
+		conflicting bool
+	}{
+		{
+			name:  "Nil",
+			data2: data(1, 1, 2, 0x01),
+		},
+		{
+			name:  "MissingSource",
+			data1: &phase0.AttestationData{Target: &phase0.Checkpoint{Epoch: 2}},
+			data2: data(1, 1, 2, 0x01),
+		},
+		{
+			name:  "Equal",
+			data1: data(1, 1, 2, 0x01),
+			data2: data(1, 1, 2, 0x01),
+			equal: true,
+		},
+		{
+			name:        "DoubleVote",
+			data1:       data(1, 1, 2, 0x01),
+			data2:       data(1, 1, 2, 0x02),
+			conflicting: true,
+		},
+		{
+			name:        "Surrounding",
+			data1:       data(1, 1, 5, 0x01),
+			data2:       data(1, 2, 4, 0x01),
+			surrounds:   true,
+			conflicting: true,
+		},
+		{
+			name:        "Surrounded",
+			data1:       data(1, 2, 4, 0x01),
+			data2:       data(1, 1, 5, 0x01),
+			conflicting: true,
+		},
+		{
+			name:  "Sequential",
+			data1: data(1, 1, 2, 0x01),
+			data2: data(2, 2, 3, 0x01),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.equal, test.data1.Equal(test.data2))
+			require.Equal(t, test.surrounds, test.data1.Surrounds(test.data2))
+			require.Equal(t, test.conflicting, test.data1.IsConflicting(test.data2))
+			require.Equal(t, test.conflicting, test.data2.IsConflicting(test.data1))
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -129,3 +130,19 @@ func (p *BLSPubKey) UnmarshalYAML(input []byte) error {
 func (p BLSPubKey) MarshalYAML() ([]byte, error) {
 	return []byte(fmt.Sprintf(`'%#x'`, p)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (p BLSPubKey) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(p[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *BLSPubKey) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), PublicKeyLength)
+	if err != nil {
+		return err
+	}
+	copy(p[:], data)
+
+	return nil
+}
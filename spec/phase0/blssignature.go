@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -135,3 +136,19 @@ func (s *BLSSignature) UnmarshalYAML(input []byte) error {
 func (s BLSSignature) MarshalYAML() ([]byte, error) {
 	return []byte(fmt.Sprintf(`'%#x'`, s)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BLSSignature) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(s[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BLSSignature) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), SignatureLength)
+	if err != nil {
+		return err
+	}
+	copy(s[:], data)
+
+	return nil
+}
@@ -119,3 +119,29 @@ func (c *Checkpoint) String() string {
 
 	return string(data)
 }
+
+// Compare orders checkpoints by epoch and then by root, returning -1 if the
+// checkpoint is before other, 0 if they are equal and 1 if it is after.  A
+// nil checkpoint is before any other checkpoint.  Compare can be used with
+// slices.SortFunc.
+func (c *Checkpoint) Compare(other *Checkpoint) int {
+	switch {
+	case c == nil && other == nil:
+		return 0
+	case c == nil:
+		return -1
+	case other == nil:
+		return 1
+	case c.Epoch < other.Epoch:
+		return -1
+	case c.Epoch > other.Epoch:
+		return 1
+	default:
+		return bytes.Compare(c.Root[:], other.Root[:])
+	}
+}
+
+// IsBefore returns true if the checkpoint is at an earlier epoch than other.
+func (c *Checkpoint) IsBefore(other *Checkpoint) bool {
+	return c != nil && other != nil && c.Epoch < other.Epoch
+}
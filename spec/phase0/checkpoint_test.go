@@ -131,3 +131,61 @@ func TestCheckpointYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckpointCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		c1       *phase0.Checkpoint
+		c2       *phase0.Checkpoint
+		compare  int
+		isBefore bool
+	}{
+		{
+			name:    "BothNil",
+			compare: 0,
+		},
+		{
+			name:    "FirstNil",
+			c2:      &phase0.Checkpoint{Epoch: 1},
+			compare: -1,
+		},
+		{
+			name:    "SecondNil",
+			c1:      &phase0.Checkpoint{Epoch: 1},
+			compare: 1,
+		},
+		{
+			name:     "EarlierEpoch",
+			c1:       &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x02}},
+			c2:       &phase0.Checkpoint{Epoch: 2, Root: phase0.Root{0x01}},
+			compare:  -1,
+			isBefore: true,
+		},
+		{
+			name:    "LaterEpoch",
+			c1:      &phase0.Checkpoint{Epoch: 2},
+			c2:      &phase0.Checkpoint{Epoch: 1},
+			compare: 1,
+		},
+		{
+			name:    "SameEpochLowerRoot",
+			c1:      &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x01}},
+			c2:      &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x02}},
+			compare: -1,
+		},
+		{
+			name:    "Equal",
+			c1:      &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x01}},
+			c2:      &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x01}},
+			compare: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.compare, test.c1.Compare(test.c2))
+			require.Equal(t, -test.compare, test.c2.Compare(test.c1))
+			require.Equal(t, test.isBefore, test.c1.IsBefore(test.c2))
+		})
+	}
+}
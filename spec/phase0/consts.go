@@ -13,6 +13,8 @@
 
 package phase0
 
+import "math"
+
 // ForkVersionLength is the number of bytes in a fork version.
 const ForkVersionLength = 4
 
@@ -36,3 +38,6 @@ const DomainLength = 32
 
 // Hash32Length is the number of bytes in a 32-byte hash.
 const Hash32Length = 32
+
+// FarFutureEpoch is the epoch used for events that have not been scheduled.
+const FarFutureEpoch = Epoch(math.MaxUint64)
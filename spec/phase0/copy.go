@@ -0,0 +1,629 @@
+// Code generated by copygen. DO NOT EDIT.
+
+package phase0
+
+import (
+	"bytes"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// Copy returns a deep copy of the attestation.
+func (a *Attestation) Copy() *Attestation {
+	if a == nil {
+		return nil
+	}
+
+	res := &Attestation{
+		Signature: a.Signature,
+	}
+	if a.AggregationBits != nil {
+		res.AggregationBits = make(bitfield.Bitlist, len(a.AggregationBits))
+		copy(res.AggregationBits, a.AggregationBits)
+	}
+	res.Data = a.Data.Copy()
+
+	return res
+}
+
+// Equal returns true if the attestation is equal to other.
+func (a *Attestation) Equal(other *Attestation) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if !bytes.Equal(a.AggregationBits, other.AggregationBits) {
+		return false
+	}
+	if !a.Data.Equal(other.Data) {
+		return false
+	}
+	if a.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the attestation data.
+func (a *AttestationData) Copy() *AttestationData {
+	if a == nil {
+		return nil
+	}
+
+	res := &AttestationData{
+		Slot:            a.Slot,
+		Index:           a.Index,
+		BeaconBlockRoot: a.BeaconBlockRoot,
+	}
+	res.Source = a.Source.Copy()
+	res.Target = a.Target.Copy()
+
+	return res
+}
+
+// Equal returns true if the attestation data is equal to other.
+func (a *AttestationData) Equal(other *AttestationData) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Slot != other.Slot {
+		return false
+	}
+	if a.Index != other.Index {
+		return false
+	}
+	if a.BeaconBlockRoot != other.BeaconBlockRoot {
+		return false
+	}
+	if !a.Source.Equal(other.Source) {
+		return false
+	}
+	if !a.Target.Equal(other.Target) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the attester slashing.
+func (a *AttesterSlashing) Copy() *AttesterSlashing {
+	if a == nil {
+		return nil
+	}
+
+	res := &AttesterSlashing{}
+	res.Attestation1 = a.Attestation1.Copy()
+	res.Attestation2 = a.Attestation2.Copy()
+
+	return res
+}
+
+// Equal returns true if the attester slashing is equal to other.
+func (a *AttesterSlashing) Equal(other *AttesterSlashing) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if !a.Attestation1.Equal(other.Attestation1) {
+		return false
+	}
+	if !a.Attestation2.Equal(other.Attestation2) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the beacon block.
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+	}
+	res.Body = b.Body.Copy()
+
+	return res
+}
+
+// Equal returns true if the beacon block is equal to other.
+func (b *BeaconBlock) Equal(other *BeaconBlock) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the beacon block body.
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlockBody{
+		RANDAOReveal: b.RANDAOReveal,
+		Graffiti:     b.Graffiti,
+	}
+	res.ETH1Data = b.ETH1Data.Copy()
+	if b.ProposerSlashings != nil {
+		res.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for i := range b.ProposerSlashings {
+			res.ProposerSlashings[i] = b.ProposerSlashings[i].Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		res.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for i := range b.AttesterSlashings {
+			res.AttesterSlashings[i] = b.AttesterSlashings[i].Copy()
+		}
+	}
+	if b.Attestations != nil {
+		res.Attestations = make([]*Attestation, len(b.Attestations))
+		for i := range b.Attestations {
+			res.Attestations[i] = b.Attestations[i].Copy()
+		}
+	}
+	if b.Deposits != nil {
+		res.Deposits = make([]*Deposit, len(b.Deposits))
+		for i := range b.Deposits {
+			res.Deposits[i] = b.Deposits[i].Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		res.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for i := range b.VoluntaryExits {
+			res.VoluntaryExits[i] = b.VoluntaryExits[i].Copy()
+		}
+	}
+
+	return res
+}
+
+// Equal returns true if the beacon block body is equal to other.
+func (b *BeaconBlockBody) Equal(other *BeaconBlockBody) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.RANDAOReveal != other.RANDAOReveal {
+		return false
+	}
+	if !b.ETH1Data.Equal(other.ETH1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for i := range b.ProposerSlashings {
+		if !b.ProposerSlashings[i].Equal(other.ProposerSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for i := range b.AttesterSlashings {
+		if !b.AttesterSlashings[i].Equal(other.AttesterSlashings[i]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for i := range b.Attestations {
+		if !b.Attestations[i].Equal(other.Attestations[i]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for i := range b.Deposits {
+		if !b.Deposits[i].Equal(other.Deposits[i]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for i := range b.VoluntaryExits {
+		if !b.VoluntaryExits[i].Equal(other.VoluntaryExits[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the beacon block header.
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+
+	res := &BeaconBlockHeader{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		BodyRoot:      b.BodyRoot,
+	}
+
+	return res
+}
+
+// Equal returns true if the beacon block header is equal to other.
+func (b *BeaconBlockHeader) Equal(other *BeaconBlockHeader) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if b.BodyRoot != other.BodyRoot {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the checkpoint.
+func (c *Checkpoint) Copy() *Checkpoint {
+	if c == nil {
+		return nil
+	}
+
+	res := &Checkpoint{
+		Epoch: c.Epoch,
+		Root:  c.Root,
+	}
+
+	return res
+}
+
+// Equal returns true if the checkpoint is equal to other.
+func (c *Checkpoint) Equal(other *Checkpoint) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if c.Epoch != other.Epoch {
+		return false
+	}
+	if c.Root != other.Root {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the deposit.
+func (d *Deposit) Copy() *Deposit {
+	if d == nil {
+		return nil
+	}
+
+	res := &Deposit{}
+	if d.Proof != nil {
+		res.Proof = make([][]byte, len(d.Proof))
+		for i := range d.Proof {
+			if d.Proof[i] != nil {
+				res.Proof[i] = make([]byte, len(d.Proof[i]))
+				copy(res.Proof[i], d.Proof[i])
+			}
+		}
+	}
+	res.Data = d.Data.Copy()
+
+	return res
+}
+
+// Equal returns true if the deposit is equal to other.
+func (d *Deposit) Equal(other *Deposit) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if len(d.Proof) != len(other.Proof) {
+		return false
+	}
+	for i := range d.Proof {
+		if !bytes.Equal(d.Proof[i], other.Proof[i]) {
+			return false
+		}
+	}
+	if !d.Data.Equal(other.Data) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the deposit data.
+func (d *DepositData) Copy() *DepositData {
+	if d == nil {
+		return nil
+	}
+
+	res := &DepositData{
+		PublicKey: d.PublicKey,
+		Amount:    d.Amount,
+		Signature: d.Signature,
+	}
+	if d.WithdrawalCredentials != nil {
+		res.WithdrawalCredentials = make([]byte, len(d.WithdrawalCredentials))
+		copy(res.WithdrawalCredentials, d.WithdrawalCredentials)
+	}
+
+	return res
+}
+
+// Equal returns true if the deposit data is equal to other.
+func (d *DepositData) Equal(other *DepositData) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if d.PublicKey != other.PublicKey {
+		return false
+	}
+	if !bytes.Equal(d.WithdrawalCredentials, other.WithdrawalCredentials) {
+		return false
+	}
+	if d.Amount != other.Amount {
+		return false
+	}
+	if d.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the ETH1 data.
+func (e *ETH1Data) Copy() *ETH1Data {
+	if e == nil {
+		return nil
+	}
+
+	res := &ETH1Data{
+		DepositRoot:  e.DepositRoot,
+		DepositCount: e.DepositCount,
+	}
+	if e.BlockHash != nil {
+		res.BlockHash = make([]byte, len(e.BlockHash))
+		copy(res.BlockHash, e.BlockHash)
+	}
+
+	return res
+}
+
+// Equal returns true if the ETH1 data is equal to other.
+func (e *ETH1Data) Equal(other *ETH1Data) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if e.DepositRoot != other.DepositRoot {
+		return false
+	}
+	if e.DepositCount != other.DepositCount {
+		return false
+	}
+	if !bytes.Equal(e.BlockHash, other.BlockHash) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the indexed attestation.
+func (i *IndexedAttestation) Copy() *IndexedAttestation {
+	if i == nil {
+		return nil
+	}
+
+	res := &IndexedAttestation{
+		Signature: i.Signature,
+	}
+	if i.AttestingIndices != nil {
+		res.AttestingIndices = make([]uint64, len(i.AttestingIndices))
+		copy(res.AttestingIndices, i.AttestingIndices)
+	}
+	res.Data = i.Data.Copy()
+
+	return res
+}
+
+// Equal returns true if the indexed attestation is equal to other.
+func (i *IndexedAttestation) Equal(other *IndexedAttestation) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+	if len(i.AttestingIndices) != len(other.AttestingIndices) {
+		return false
+	}
+	for j := range i.AttestingIndices {
+		if i.AttestingIndices[j] != other.AttestingIndices[j] {
+			return false
+		}
+	}
+	if !i.Data.Equal(other.Data) {
+		return false
+	}
+	if i.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the proposer slashing.
+func (p *ProposerSlashing) Copy() *ProposerSlashing {
+	if p == nil {
+		return nil
+	}
+
+	res := &ProposerSlashing{}
+	res.SignedHeader1 = p.SignedHeader1.Copy()
+	res.SignedHeader2 = p.SignedHeader2.Copy()
+
+	return res
+}
+
+// Equal returns true if the proposer slashing is equal to other.
+func (p *ProposerSlashing) Equal(other *ProposerSlashing) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if !p.SignedHeader1.Equal(other.SignedHeader1) {
+		return false
+	}
+	if !p.SignedHeader2.Equal(other.SignedHeader2) {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the signed beacon block.
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+
+	res := &SignedBeaconBlock{
+		Signature: s.Signature,
+	}
+	res.Message = s.Message.Copy()
+
+	return res
+}
+
+// Equal returns true if the signed beacon block is equal to other.
+func (s *SignedBeaconBlock) Equal(other *SignedBeaconBlock) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the signed beacon block header.
+func (s *SignedBeaconBlockHeader) Copy() *SignedBeaconBlockHeader {
+	if s == nil {
+		return nil
+	}
+
+	res := &SignedBeaconBlockHeader{
+		Signature: s.Signature,
+	}
+	res.Message = s.Message.Copy()
+
+	return res
+}
+
+// Equal returns true if the signed beacon block header is equal to other.
+func (s *SignedBeaconBlockHeader) Equal(other *SignedBeaconBlockHeader) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the signed voluntary exit.
+func (s *SignedVoluntaryExit) Copy() *SignedVoluntaryExit {
+	if s == nil {
+		return nil
+	}
+
+	res := &SignedVoluntaryExit{
+		Signature: s.Signature,
+	}
+	res.Message = s.Message.Copy()
+
+	return res
+}
+
+// Equal returns true if the signed voluntary exit is equal to other.
+func (s *SignedVoluntaryExit) Equal(other *SignedVoluntaryExit) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+
+	return true
+}
+
+// Copy returns a deep copy of the voluntary exit.
+func (v *VoluntaryExit) Copy() *VoluntaryExit {
+	if v == nil {
+		return nil
+	}
+
+	res := &VoluntaryExit{
+		Epoch:          v.Epoch,
+		ValidatorIndex: v.ValidatorIndex,
+	}
+
+	return res
+}
+
+// Equal returns true if the voluntary exit is equal to other.
+func (v *VoluntaryExit) Equal(other *VoluntaryExit) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if v.Epoch != other.Epoch {
+		return false
+	}
+	if v.ValidatorIndex != other.ValidatorIndex {
+		return false
+	}
+
+	return true
+}
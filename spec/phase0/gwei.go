@@ -16,14 +16,110 @@ package phase0
 import (
 	"bytes"
 	"fmt"
+	"math/big"
+	"math/bits"
 	"strconv"
+	"strings"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
 // Gwei is an amount in Gwei.
 type Gwei uint64
 
+var (
+	// ErrGweiOverflow is returned when a Gwei calculation overflows.
+	ErrGweiOverflow = errors.New("gwei overflow")
+	// ErrGweiUnderflow is returned when a Gwei calculation underflows.
+	ErrGweiUnderflow = errors.New("gwei underflow")
+)
+
+// weiPerGwei is the number of Wei in a Gwei.
+var weiPerGwei = big.NewInt(1e9)
+
+// weiPerEther is the number of Wei in an Ether.
+var weiPerEther = big.NewInt(1e18)
+
+// Add returns the sum of g and other.
+func (g Gwei) Add(other Gwei) (Gwei, error) {
+	sum, carry := bits.Add64(uint64(g), uint64(other), 0)
+	if carry != 0 {
+		return 0, ErrGweiOverflow
+	}
+
+	return Gwei(sum), nil
+}
+
+// Sub returns the result of subtracting other from g.
+func (g Gwei) Sub(other Gwei) (Gwei, error) {
+	if other > g {
+		return 0, ErrGweiUnderflow
+	}
+
+	return g - other, nil
+}
+
+// Mul returns the result of multiplying g by multiplier.
+func (g Gwei) Mul(multiplier uint64) (Gwei, error) {
+	hi, lo := bits.Mul64(uint64(g), multiplier)
+	if hi != 0 {
+		return 0, ErrGweiOverflow
+	}
+
+	return Gwei(lo), nil
+}
+
+// Wei returns the value in Wei.
+func (g Gwei) Wei() *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(uint64(g)), weiPerGwei)
+}
+
+// EtherString returns the value as a decimal string denominated in Ether,
+// for example "32" or "31.999999999".
+func (g Gwei) EtherString() string {
+	return WeiToEtherString(g.Wei())
+}
+
+// GweiFromWei converts a value in Wei to Gwei, truncating any fractional Gwei.
+func GweiFromWei(wei *big.Int) (Gwei, error) {
+	if wei == nil {
+		return 0, errors.New("value missing")
+	}
+	if wei.Sign() < 0 {
+		return 0, errors.New("value is negative")
+	}
+	gwei := new(big.Int).Quo(wei, weiPerGwei)
+	if !gwei.IsUint64() {
+		return 0, errors.New("value too large for Gwei")
+	}
+
+	return Gwei(gwei.Uint64()), nil
+}
+
+// WeiToEtherString returns a value in Wei as a decimal string denominated in
+// Ether, for example "1.5" or "-0.000000000000000001".  Trailing zeros are
+// removed from the fractional part.
+func WeiToEtherString(wei *big.Int) string {
+	if wei == nil {
+		return "0"
+	}
+
+	sign := ""
+	if wei.Sign() < 0 {
+		sign = "-"
+	}
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(wei), weiPerEther, new(big.Int))
+	if frac.Sign() == 0 {
+		return sign + whole.String()
+	}
+
+	fracStr := frac.String()
+	fracStr = strings.TrimRight(strings.Repeat("0", 18-len(fracStr))+fracStr, "0")
+
+	return fmt.Sprintf("%s%s.%s", sign, whole.String(), fracStr)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (g *Gwei) UnmarshalJSON(input []byte) error {
 	if len(input) == 0 {
@@ -72,3 +168,19 @@ func (g *Gwei) UnmarshalYAML(input []byte) error {
 func (g Gwei) MarshalYAML() ([]byte, error) {
 	return []byte(fmt.Sprintf(`%d`, g)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (g Gwei) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeUint64(uint64(g))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (g *Gwei) UnmarshalText(input []byte) error {
+	val, err := codecs.DecodeUint64(string(input))
+	if err != nil {
+		return err
+	}
+	*g = Gwei(val)
+
+	return nil
+}
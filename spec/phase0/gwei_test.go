@@ -15,9 +15,13 @@ package phase0_test
 
 // Create a test to verify gwei.unmarshalJSON
 import (
+	"encoding/json"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGweiUnmarshalJSON(t *testing.T) {
@@ -71,3 +75,97 @@ func TestGweiUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGweiArithmetic(t *testing.T) {
+	sum, err := phase0.Gwei(1).Add(2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(3), sum)
+	_, err = phase0.Gwei(math.MaxUint64).Add(1)
+	require.ErrorIs(t, err, phase0.ErrGweiOverflow)
+
+	diff, err := phase0.Gwei(3).Sub(2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(1), diff)
+	_, err = phase0.Gwei(2).Sub(3)
+	require.ErrorIs(t, err, phase0.ErrGweiUnderflow)
+
+	product, err := phase0.Gwei(32000000000).Mul(1000)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(32000000000000), product)
+	_, err = phase0.Gwei(math.MaxUint64 / 2).Mul(3)
+	require.ErrorIs(t, err, phase0.ErrGweiOverflow)
+}
+
+func TestGweiWei(t *testing.T) {
+	require.Equal(t, "32000000000000000000", phase0.Gwei(32000000000).Wei().String())
+	require.Equal(t, "18446744073709551615000000000", phase0.Gwei(math.MaxUint64).Wei().String())
+
+	gwei, err := phase0.GweiFromWei(big.NewInt(1999999999))
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(1), gwei)
+
+	gwei, err = phase0.GweiFromWei(phase0.Gwei(math.MaxUint64).Wei())
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(math.MaxUint64), gwei)
+
+	_, err = phase0.GweiFromWei(nil)
+	require.EqualError(t, err, "value missing")
+	_, err = phase0.GweiFromWei(big.NewInt(-1))
+	require.EqualError(t, err, "value is negative")
+	_, err = phase0.GweiFromWei(new(big.Int).Add(phase0.Gwei(math.MaxUint64).Wei(), big.NewInt(1e9)))
+	require.EqualError(t, err, "value too large for Gwei")
+}
+
+func TestGweiEtherString(t *testing.T) {
+	tests := []struct {
+		name     string
+		gwei     phase0.Gwei
+		expected string
+	}{
+		{
+			name:     "Zero",
+			expected: "0",
+		},
+		{
+			name:     "Whole",
+			gwei:     32000000000,
+			expected: "32",
+		},
+		{
+			name:     "Fractional",
+			gwei:     31999999999,
+			expected: "31.999999999",
+		},
+		{
+			name:     "Small",
+			gwei:     1,
+			expected: "0.000000001",
+		},
+		{
+			name:     "TrailingZeros",
+			gwei:     1500000000,
+			expected: "1.5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.gwei.EtherString())
+		})
+	}
+
+	require.Equal(t, "0", phase0.WeiToEtherString(nil))
+	require.Equal(t, "-0.000000000000000001", phase0.WeiToEtherString(big.NewInt(-1)))
+	require.Equal(t, "1.000000000000000001", phase0.WeiToEtherString(new(big.Int).SetUint64(1000000000000000001)))
+}
+
+func TestGweiJSONRoundTrip(t *testing.T) {
+	for _, gwei := range []phase0.Gwei{0, 1, 32000000000, math.MaxUint64} {
+		data, err := json.Marshal(gwei)
+		require.NoError(t, err)
+
+		var res phase0.Gwei
+		require.NoError(t, json.Unmarshal(data, &res))
+		require.Equal(t, gwei, res)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -110,3 +111,19 @@ func (h *Hash32) UnmarshalYAML(input []byte) error {
 func (h Hash32) MarshalYAML() ([]byte, error) {
 	return []byte(fmt.Sprintf(`'%#x'`, h)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (h Hash32) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(h[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *Hash32) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), Hash32Length)
+	if err != nil {
+		return err
+	}
+	copy(h[:], data)
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -117,3 +118,19 @@ func (r *Root) UnmarshalYAML(input []byte) error {
 func (r Root) MarshalYAML() ([]byte, error) {
 	return []byte(fmt.Sprintf(`'%#x'`, r)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (r Root) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(r[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *Root) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), RootLength)
+	if err != nil {
+		return err
+	}
+	copy(r[:], data)
+
+	return nil
+}
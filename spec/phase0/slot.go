@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -52,3 +53,19 @@ func (s *Slot) UnmarshalJSON(input []byte) error {
 func (s Slot) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%d"`, s)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Slot) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeUint64(uint64(s))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Slot) UnmarshalText(input []byte) error {
+	val, err := codecs.DecodeUint64(string(input))
+	if err != nil {
+		return err
+	}
+	*s = Slot(val)
+
+	return nil
+}
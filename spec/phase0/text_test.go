@@ -0,0 +1,215 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+type textType interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		res   func() textType
+		err   string
+	}{
+		{
+			name:  "Root",
+			input: "0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+			res:   func() textType { return &phase0.Root{} },
+		},
+		{
+			name:  "RootShort",
+			input: "0x0102",
+			res:   func() textType { return &phase0.Root{} },
+			err:   "incorrect length",
+		},
+		{
+			name:  "BLSPubKey",
+			input: "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c",
+			res:   func() textType { return &phase0.BLSPubKey{} },
+		},
+		{
+			name:  "BLSSignature",
+			input: "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f",
+			res:   func() textType { return &phase0.BLSSignature{} },
+		},
+		{
+			name:  "Hash32",
+			input: "0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+			res:   func() textType { return &phase0.Hash32{} },
+		},
+		{
+			name:  "Version",
+			input: "0x01020304",
+			res:   func() textType { return &phase0.Version{} },
+		},
+		{
+			name:  "VersionNoPrefix",
+			input: "01020304",
+			res:   func() textType { return &phase0.Version{} },
+			err:   "incorrect length",
+		},
+		{
+			name:  "DomainType",
+			input: "0x03000000",
+			res:   func() textType { return &phase0.DomainType{} },
+		},
+		{
+			name:  "ForkDigest",
+			input: "0xabcdef01",
+			res:   func() textType { return &phase0.ForkDigest{} },
+		},
+		{
+			name:  "ForkDigestInvalid",
+			input: "0xabcdefgh",
+			res:   func() textType { return &phase0.ForkDigest{} },
+			err:   "invalid value abcdefgh: encoding/hex: invalid byte: U+0067 'g'",
+		},
+		{
+			name:  "Domain",
+			input: "0x0300000000000000000000000000000000000000000000000000000000000000",
+			res:   func() textType { return &phase0.Domain{} },
+		},
+		{
+			name:  "Slot",
+			input: "12345",
+			res:   func() textType { s := phase0.Slot(0); return &s },
+		},
+		{
+			name:  "SlotNegative",
+			input: "-1",
+			res:   func() textType { s := phase0.Slot(0); return &s },
+			err:   `invalid value -1: strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		{
+			name:  "Epoch",
+			input: "18446744073709551615",
+			res:   func() textType { e := phase0.Epoch(0); return &e },
+		},
+		{
+			name:  "CommitteeIndex",
+			input: "63",
+			res:   func() textType { c := phase0.CommitteeIndex(0); return &c },
+		},
+		{
+			name:  "ValidatorIndex",
+			input: "1000000",
+			res:   func() textType { v := phase0.ValidatorIndex(0); return &v },
+		},
+		{
+			name:  "Gwei",
+			input: "32000000000",
+			res:   func() textType { g := phase0.Gwei(0); return &g },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := test.res()
+			err := res.UnmarshalText([]byte(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := res.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, test.input, string(rt))
+			}
+		})
+	}
+}
+
+func TestTextMapKey(t *testing.T) {
+	input := map[phase0.Root]phase0.ValidatorIndex{
+		{0x01}: 1,
+		{0x02}: 2,
+	}
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+	require.Equal(t, `{"0x0100000000000000000000000000000000000000000000000000000000000000":"1","0x0200000000000000000000000000000000000000000000000000000000000000":"2"}`, string(data))
+
+	res := make(map[phase0.Root]phase0.ValidatorIndex)
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, input, res)
+}
+
+// TestTextJSONUnchanged ensures that adding text marshalling does not alter
+// the existing JSON encoding of types without their own JSON handling.
+func TestTextJSONUnchanged(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		res   func() any
+	}{
+		{
+			name:  "CommitteeIndex",
+			input: `63`,
+			res:   func() any { c := phase0.CommitteeIndex(0); return &c },
+		},
+		{
+			name:  "Version",
+			input: `[1,2,3,4]`,
+			res:   func() any { return &phase0.Version{} },
+		},
+		{
+			name:  "DomainType",
+			input: `[7,0,0,0]`,
+			res:   func() any { return &phase0.DomainType{} },
+		},
+		{
+			name:  "ForkDigest",
+			input: `[171,205,239,1]`,
+			res:   func() any { return &phase0.ForkDigest{} },
+		},
+		{
+			name:  "Domain",
+			input: `[1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,2]`,
+			res:   func() any { return &phase0.Domain{} },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := test.res()
+			require.NoError(t, json.Unmarshal([]byte(test.input), res))
+			rt, err := json.Marshal(res)
+			require.NoError(t, err)
+			require.Equal(t, test.input, string(rt))
+		})
+	}
+}
+
+func TestTextJSONMapKey(t *testing.T) {
+	input := map[phase0.ForkDigest]phase0.Version{
+		{0x01, 0x02, 0x03, 0x04}: {0x05, 0x06, 0x07, 0x08},
+	}
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+	require.Equal(t, `{"0x01020304":[5,6,7,8]}`, string(data))
+
+	res := make(map[phase0.ForkDigest]phase0.Version)
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, input, res)
+}
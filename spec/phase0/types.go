@@ -15,9 +15,11 @@ package phase0
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -54,17 +56,241 @@ func (e Epoch) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%d"`, e)), nil
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (e Epoch) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeUint64(uint64(e))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *Epoch) UnmarshalText(input []byte) error {
+	val, err := codecs.DecodeUint64(string(input))
+	if err != nil {
+		return err
+	}
+	*e = Epoch(val)
+
+	return nil
+}
+
 // CommitteeIndex is a committee index at a slot.
 type CommitteeIndex uint64
 
+// MarshalJSON implements json.Marshaler.
+// The index is encoded as a bare number rather than using its text form.
+func (c CommitteeIndex) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(c), 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CommitteeIndex) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		// Map keys, and values written in text form, arrive as strings.
+		var text string
+		if err := json.Unmarshal(input, &text); err != nil {
+			return err
+		}
+
+		return c.UnmarshalText([]byte(text))
+	}
+
+	var val uint64
+	if err := json.Unmarshal(input, &val); err != nil {
+		return errors.Wrap(err, "invalid committee index")
+	}
+	*c = CommitteeIndex(val)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CommitteeIndex) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeUint64(uint64(c))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CommitteeIndex) UnmarshalText(input []byte) error {
+	val, err := codecs.DecodeUint64(string(input))
+	if err != nil {
+		return err
+	}
+	*c = CommitteeIndex(val)
+
+	return nil
+}
+
 // Version is a fork version.
 type Version [4]byte
 
+// MarshalJSON implements json.Marshaler.
+// The fork version is encoded as an array of bytes rather than using its text form.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]byte(v))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Version) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		var text string
+		if err := json.Unmarshal(input, &text); err != nil {
+			return err
+		}
+
+		return v.UnmarshalText([]byte(text))
+	}
+
+	var val [4]byte
+	if err := json.Unmarshal(input, &val); err != nil {
+		return errors.Wrap(err, "invalid fork version")
+	}
+	*v = Version(val)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(v[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Version) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), ForkVersionLength)
+	if err != nil {
+		return err
+	}
+	copy(v[:], data)
+
+	return nil
+}
+
 // DomainType is a domain type.
 type DomainType [4]byte
 
+// MarshalJSON implements json.Marshaler.
+func (d DomainType) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]byte(d))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DomainType) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		var text string
+		if err := json.Unmarshal(input, &text); err != nil {
+			return err
+		}
+
+		return d.UnmarshalText([]byte(text))
+	}
+
+	var val [4]byte
+	if err := json.Unmarshal(input, &val); err != nil {
+		return errors.Wrap(err, "invalid domain type")
+	}
+	*d = DomainType(val)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DomainType) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(d[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DomainType) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), DomainTypeLength)
+	if err != nil {
+		return err
+	}
+	copy(d[:], data)
+
+	return nil
+}
+
 // ForkDigest is a digest of fork data.
 type ForkDigest [4]byte
 
+// MarshalJSON implements json.Marshaler.
+func (f ForkDigest) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]byte(f))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkDigest) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		var text string
+		if err := json.Unmarshal(input, &text); err != nil {
+			return err
+		}
+
+		return f.UnmarshalText([]byte(text))
+	}
+
+	var val [4]byte
+	if err := json.Unmarshal(input, &val); err != nil {
+		return errors.Wrap(err, "invalid fork digest")
+	}
+	*f = ForkDigest(val)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f ForkDigest) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(f[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *ForkDigest) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), len(f))
+	if err != nil {
+		return err
+	}
+	copy(f[:], data)
+
+	return nil
+}
+
 // Domain is a signature domain.
 type Domain [32]byte
+
+// MarshalJSON implements json.Marshaler.
+func (d Domain) MarshalJSON() ([]byte, error) {
+	return json.Marshal([32]byte(d))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Domain) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		var text string
+		if err := json.Unmarshal(input, &text); err != nil {
+			return err
+		}
+
+		return d.UnmarshalText([]byte(text))
+	}
+
+	var val [32]byte
+	if err := json.Unmarshal(input, &val); err != nil {
+		return errors.Wrap(err, "invalid domain")
+	}
+	*d = Domain(val)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Domain) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeHex(d[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Domain) UnmarshalText(input []byte) error {
+	data, err := codecs.DecodeHexFixed(string(input), DomainLength)
+	if err != nil {
+		return err
+	}
+	copy(d[:], data)
+
+	return nil
+}
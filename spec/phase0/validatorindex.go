@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/pkg/errors"
 )
 
@@ -52,3 +53,19 @@ func (v *ValidatorIndex) UnmarshalJSON(input []byte) error {
 func (v ValidatorIndex) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%d"`, v)), nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ValidatorIndex) MarshalText() ([]byte, error) {
+	return []byte(codecs.EncodeUint64(uint64(v))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ValidatorIndex) UnmarshalText(input []byte) error {
+	val, err := codecs.DecodeUint64(string(input))
+	if err != nil {
+		return err
+	}
+	*v = ValidatorIndex(val)
+
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// Wei is an amount in Wei.  It holds values up to 2^256-1, the range of
+// execution layer amounts such as builder bid values.
+type Wei uint256.Int
+
+var (
+	// ErrWeiOverflow is returned when a Wei value or calculation overflows.
+	ErrWeiOverflow = errors.New("wei overflow")
+	// ErrWeiUnderflow is returned when a Wei calculation underflows.
+	ErrWeiUnderflow = errors.New("wei underflow")
+)
+
+// RoundingMode is the mode used when converting Wei to Gwei.
+type RoundingMode int
+
+const (
+	// RoundDown discards any fractional Gwei.
+	RoundDown RoundingMode = iota
+	// RoundUp rounds any fractional Gwei up to the next Gwei.
+	RoundUp
+	// RoundNearest rounds to the nearest Gwei, with half a Gwei rounded up.
+	RoundNearest
+)
+
+// WeiFromUint64 creates a Wei value from a uint64.
+func WeiFromUint64(value uint64) Wei {
+	return Wei(*uint256.NewInt(value))
+}
+
+// WeiFromBig creates a Wei value from a big integer, returning an error if
+// the value is missing, negative or too large.
+func WeiFromBig(value *big.Int) (Wei, error) {
+	if value == nil {
+		return Wei{}, errors.New("value missing")
+	}
+	if value.Sign() < 0 {
+		return Wei{}, errors.New("value is negative")
+	}
+	res, overflow := uint256.FromBig(value)
+	if overflow {
+		return Wei{}, ErrWeiOverflow
+	}
+
+	return Wei(*res), nil
+}
+
+// WeiFromString creates a Wei value from a decimal string, or a hex string
+// with a 0x prefix.  Signs, whitespace and fractions are rejected.
+func WeiFromString(input string) (Wei, error) {
+	if input == "" {
+		return Wei{}, errors.New("value missing")
+	}
+
+	var res *uint256.Int
+	var err error
+	if strings.HasPrefix(input, "0x") {
+		res, err = uint256.FromHex(input)
+	} else {
+		for _, c := range input {
+			if c < '0' || c > '9' {
+				return Wei{}, fmt.Errorf("invalid value %s", input)
+			}
+		}
+		res, err = uint256.FromDecimal(input)
+	}
+	if err != nil {
+		if errors.Is(err, uint256.ErrBig256Range) {
+			return Wei{}, ErrWeiOverflow
+		}
+
+		return Wei{}, errors.Wrapf(err, "invalid value %s", input)
+	}
+
+	return Wei(*res), nil
+}
+
+// int returns the value as a uint256 integer.
+func (w *Wei) int() *uint256.Int {
+	return (*uint256.Int)(w)
+}
+
+// Big returns the value as a big integer.
+func (w Wei) Big() *big.Int {
+	return w.int().ToBig()
+}
+
+// String returns the value as a decimal string.
+func (w Wei) String() string {
+	return w.int().Dec()
+}
+
+// IsZero returns true if the value is zero.
+func (w Wei) IsZero() bool {
+	return w.int().IsZero()
+}
+
+// Cmp compares w and other, returning -1 if w is less than other, 0 if they
+// are equal and 1 if w is greater than other.
+func (w Wei) Cmp(other Wei) int {
+	return w.int().Cmp(other.int())
+}
+
+// LessThan returns true if w is less than other.
+func (w Wei) LessThan(other Wei) bool {
+	return w.int().Lt(other.int())
+}
+
+// GreaterThan returns true if w is greater than other.
+func (w Wei) GreaterThan(other Wei) bool {
+	return w.int().Gt(other.int())
+}
+
+// Add returns the sum of w and other.
+func (w Wei) Add(other Wei) (Wei, error) {
+	var res uint256.Int
+	if _, overflow := res.AddOverflow(w.int(), other.int()); overflow {
+		return Wei{}, ErrWeiOverflow
+	}
+
+	return Wei(res), nil
+}
+
+// Sub returns the result of subtracting other from w.
+func (w Wei) Sub(other Wei) (Wei, error) {
+	var res uint256.Int
+	if _, underflow := res.SubOverflow(w.int(), other.int()); underflow {
+		return Wei{}, ErrWeiUnderflow
+	}
+
+	return Wei(res), nil
+}
+
+// ToGwei returns the value in Gwei, rounding any fractional Gwei according to
+// the supplied mode.
+func (w Wei) ToGwei(mode RoundingMode) (Gwei, error) {
+	divisor := uint256.NewInt(1e9)
+	var quotient, remainder uint256.Int
+	quotient.DivMod(w.int(), divisor, &remainder)
+
+	roundUp := false
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		roundUp = !remainder.IsZero()
+	case RoundNearest:
+		roundUp = remainder.CmpUint64(5e8) >= 0
+	default:
+		return 0, fmt.Errorf("unknown rounding mode %d", mode)
+	}
+
+	if !quotient.IsUint64() {
+		return 0, ErrGweiOverflow
+	}
+	gwei := Gwei(quotient.Uint64())
+	if roundUp {
+		return gwei.Add(1)
+	}
+
+	return gwei, nil
+}
+
+// EtherString returns the value as a decimal string denominated in Ether,
+// for example "1.5".
+func (w Wei) EtherString() string {
+	return WeiToEtherString(w.Big())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.  Values are accepted as quoted
+// strings or bare numbers, and are parsed without loss of precision.
+func (w *Wei) UnmarshalJSON(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		if len(input) < 3 || !bytes.HasSuffix(input, []byte{'"'}) {
+			return errors.New("input malformed")
+		}
+		input = input[1 : len(input)-1]
+	}
+
+	val, err := WeiFromString(string(input))
+	if err != nil {
+		return err
+	}
+	*w = val
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (w Wei) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%s"`, w.String())), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (w Wei) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (w *Wei) UnmarshalText(input []byte) error {
+	val, err := WeiFromString(string(input))
+	if err != nil {
+		return err
+	}
+	*w = val
+
+	return nil
+}
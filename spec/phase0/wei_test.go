@@ -0,0 +1,307 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// maxWei is the maximum value held by Wei, 2^256-1.
+const maxWei = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+func TestWeiFromString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "value missing",
+		},
+		{
+			name:     "Zero",
+			input:    "0",
+			expected: "0",
+		},
+		{
+			name:     "Decimal",
+			input:    "1234567890123456789012345678901234567890",
+			expected: "1234567890123456789012345678901234567890",
+		},
+		{
+			name:     "Hex",
+			input:    "0xde0b6b3a7640000",
+			expected: "1000000000000000000",
+		},
+		{
+			name:     "Max",
+			input:    maxWei,
+			expected: maxWei,
+		},
+		{
+			name:  "Overflow",
+			input: "115792089237316195423570985008687907853269984665640564039457584007913129639936",
+			err:   "wei overflow",
+		},
+		{
+			name:  "Negative",
+			input: "-1",
+			err:   "invalid value -1",
+		},
+		{
+			name:  "Plus",
+			input: "+1",
+			err:   "invalid value +1",
+		},
+		{
+			name:  "Fraction",
+			input: "1.5",
+			err:   "invalid value 1.5",
+		},
+		{
+			name:  "Exponent",
+			input: "1e18",
+			err:   "invalid value 1e18",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wei, err := phase0.WeiFromString(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, wei.String())
+			}
+		})
+	}
+}
+
+func TestWeiFromBig(t *testing.T) {
+	wei, err := phase0.WeiFromBig(big.NewInt(12345))
+	require.NoError(t, err)
+	require.Equal(t, phase0.WeiFromUint64(12345), wei)
+	require.Equal(t, big.NewInt(12345), wei.Big())
+
+	_, err = phase0.WeiFromBig(nil)
+	require.EqualError(t, err, "value missing")
+
+	_, err = phase0.WeiFromBig(big.NewInt(-1))
+	require.EqualError(t, err, "value is negative")
+
+	_, err = phase0.WeiFromBig(new(big.Int).Lsh(big.NewInt(1), 256))
+	require.ErrorIs(t, err, phase0.ErrWeiOverflow)
+}
+
+func TestWeiComparison(t *testing.T) {
+	one := phase0.WeiFromUint64(1)
+	two := phase0.WeiFromUint64(2)
+
+	require.Equal(t, -1, one.Cmp(two))
+	require.Equal(t, 0, one.Cmp(one))
+	require.Equal(t, 1, two.Cmp(one))
+	require.True(t, one.LessThan(two))
+	require.False(t, two.LessThan(one))
+	require.True(t, two.GreaterThan(one))
+	require.False(t, one.GreaterThan(one))
+	require.True(t, phase0.Wei{}.IsZero())
+	require.False(t, one.IsZero())
+}
+
+func TestWeiArithmetic(t *testing.T) {
+	sum, err := phase0.WeiFromUint64(math.MaxUint64).Add(phase0.WeiFromUint64(1))
+	require.NoError(t, err)
+	require.Equal(t, "18446744073709551616", sum.String())
+
+	maxValue, err := phase0.WeiFromString(maxWei)
+	require.NoError(t, err)
+	_, err = maxValue.Add(phase0.WeiFromUint64(1))
+	require.ErrorIs(t, err, phase0.ErrWeiOverflow)
+
+	diff, err := sum.Sub(phase0.WeiFromUint64(1))
+	require.NoError(t, err)
+	require.Equal(t, phase0.WeiFromUint64(math.MaxUint64), diff)
+
+	_, err = phase0.WeiFromUint64(1).Sub(phase0.WeiFromUint64(2))
+	require.ErrorIs(t, err, phase0.ErrWeiUnderflow)
+}
+
+func TestWeiToGwei(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mode     phase0.RoundingMode
+		expected phase0.Gwei
+		err      string
+	}{
+		{
+			name:     "ExactDown",
+			input:    "2000000000",
+			mode:     phase0.RoundDown,
+			expected: 2,
+		},
+		{
+			name:     "ExactUp",
+			input:    "2000000000",
+			mode:     phase0.RoundUp,
+			expected: 2,
+		},
+		{
+			name:     "Down",
+			input:    "2999999999",
+			mode:     phase0.RoundDown,
+			expected: 2,
+		},
+		{
+			name:     "Up",
+			input:    "2000000001",
+			mode:     phase0.RoundUp,
+			expected: 3,
+		},
+		{
+			name:     "NearestBelowHalf",
+			input:    "2499999999",
+			mode:     phase0.RoundNearest,
+			expected: 2,
+		},
+		{
+			name:     "NearestHalf",
+			input:    "2500000000",
+			mode:     phase0.RoundNearest,
+			expected: 3,
+		},
+		{
+			name:     "MaxGwei",
+			input:    "18446744073709551615000000000",
+			mode:     phase0.RoundUp,
+			expected: math.MaxUint64,
+		},
+		{
+			name:  "RoundUpOverflow",
+			input: "18446744073709551615000000001",
+			mode:  phase0.RoundUp,
+			err:   "gwei overflow",
+		},
+		{
+			name:  "Overflow",
+			input: maxWei,
+			mode:  phase0.RoundDown,
+			err:   "gwei overflow",
+		},
+		{
+			name:  "UnknownMode",
+			input: "1",
+			mode:  phase0.RoundingMode(99),
+			err:   "unknown rounding mode 99",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wei, err := phase0.WeiFromString(test.input)
+			require.NoError(t, err)
+			gwei, err := wei.ToGwei(test.mode)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, gwei)
+			}
+		})
+	}
+}
+
+func TestWeiJSON(t *testing.T) {
+	type bid struct {
+		Value phase0.Wei `json:"value"`
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      string
+	}{
+		{
+			name:     "Quoted",
+			input:    `{"value":"1000000000000000000"}`,
+			expected: "1000000000000000000",
+		},
+		{
+			name:     "Bare",
+			input:    `{"value":` + maxWei + `}`,
+			expected: maxWei,
+		},
+		{
+			name:  "Overflow",
+			input: `{"value":"115792089237316195423570985008687907853269984665640564039457584007913129639936"}`,
+			err:   "wei overflow",
+		},
+		{
+			name:  "Negative",
+			input: `{"value":"-1"}`,
+			err:   "invalid value -1",
+		},
+		{
+			name:  "Float",
+			input: `{"value":1.5e18}`,
+			err:   "invalid value 1.5e18",
+		},
+		{
+			name:  "EmptyString",
+			input: `{"value":""}`,
+			err:   "input malformed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res bid
+			err := json.Unmarshal([]byte(test.input), &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.Value.String())
+
+			data, err := json.Marshal(res)
+			require.NoError(t, err)
+			require.Equal(t, `{"value":"`+test.expected+`"}`, string(data))
+		})
+	}
+}
+
+func TestWeiText(t *testing.T) {
+	values := map[string]phase0.Wei{}
+	require.NoError(t, json.Unmarshal([]byte(`{"1000":"2000"}`), &values))
+	require.Equal(t, phase0.WeiFromUint64(2000), values["1000"])
+
+	var wei phase0.Wei
+	require.NoError(t, wei.UnmarshalText([]byte("42")))
+	text, err := wei.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "42", string(text))
+	require.Equal(t, "0.000000000000000042", wei.EtherString())
+}
@@ -0,0 +1,246 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Preset is a named set of consensus constants.
+type Preset uint64
+
+const (
+	// PresetUnknown is an unknown preset.
+	PresetUnknown Preset = iota
+	// PresetMainnet is the preset used by mainnet and public testnets.
+	PresetMainnet
+	// PresetMinimal is the preset used by small devnets and tests.
+	PresetMinimal
+)
+
+var presetStrings = [...]string{
+	"unknown",
+	"mainnet",
+	"minimal",
+}
+
+// String returns a string representation of the preset.
+func (p Preset) String() string {
+	if int(p) >= len(presetStrings) {
+		return "unknown"
+	}
+
+	return presetStrings[p]
+}
+
+// PresetFromSpec returns the preset on which the supplied spec is based.
+// PRESET_BASE is used if present, otherwise the preset is inferred from
+// SLOTS_PER_EPOCH.
+func PresetFromSpec(data map[string]any) Preset {
+	if base, isString := data["PRESET_BASE"].(string); isString {
+		switch strings.ToLower(base) {
+		case "mainnet":
+			return PresetMainnet
+		case "minimal":
+			return PresetMinimal
+		default:
+			return PresetUnknown
+		}
+	}
+
+	if slotsPerEpoch, isUint64 := data["SLOTS_PER_EPOCH"].(uint64); isUint64 {
+		switch slotsPerEpoch {
+		case MainnetLimits().SlotsPerEpoch:
+			return PresetMainnet
+		case MinimalLimits().SlotsPerEpoch:
+			return PresetMinimal
+		}
+	}
+
+	return PresetUnknown
+}
+
+// Limits are the preset values that define the lengths of lists and
+// vectors in containers, and hence their SSZ encoding, along with the
+// configured maximum number of blobs in a block.
+type Limits struct {
+	SlotsPerEpoch                      uint64
+	EpochsPerETH1VotingPeriod          uint64
+	SlotsPerHistoricalRoot             uint64
+	EpochsPerHistoricalVector          uint64
+	EpochsPerSlashingsVector           uint64
+	HistoricalRootsLimit               uint64
+	ValidatorRegistryLimit             uint64
+	MaxValidatorsPerCommittee          uint64
+	MaxCommitteesPerSlot               uint64
+	MaxProposerSlashings               uint64
+	MaxAttesterSlashings               uint64
+	MaxAttestations                    uint64
+	MaxDeposits                        uint64
+	MaxVoluntaryExits                  uint64
+	SyncCommitteeSize                  uint64
+	MaxBLSToExecutionChanges           uint64
+	MaxWithdrawalsPerPayload           uint64
+	MaxBlobCommitmentsPerBlock         uint64
+	MaxAttesterSlashingsElectra        uint64
+	MaxAttestationsElectra             uint64
+	MaxDepositRequestsPerPayload       uint64
+	MaxWithdrawalRequestsPerPayload    uint64
+	MaxConsolidationRequestsPerPayload uint64
+	PendingDepositsLimit               uint64
+	PendingPartialWithdrawalsLimit     uint64
+	PendingConsolidationsLimit         uint64
+	// MaxBlobsPerBlock and MaxBlobsPerBlockElectra are configuration values
+	// rather than preset values, and do not affect SSZ encoding.
+	MaxBlobsPerBlock        uint64
+	MaxBlobsPerBlockElectra uint64
+}
+
+// limitKeys maps spec keys to their limits.
+var limitKeys = map[string]func(*Limits) *uint64{
+	"SLOTS_PER_EPOCH":                        func(l *Limits) *uint64 { return &l.SlotsPerEpoch },
+	"EPOCHS_PER_ETH1_VOTING_PERIOD":          func(l *Limits) *uint64 { return &l.EpochsPerETH1VotingPeriod },
+	"SLOTS_PER_HISTORICAL_ROOT":              func(l *Limits) *uint64 { return &l.SlotsPerHistoricalRoot },
+	"EPOCHS_PER_HISTORICAL_VECTOR":           func(l *Limits) *uint64 { return &l.EpochsPerHistoricalVector },
+	"EPOCHS_PER_SLASHINGS_VECTOR":            func(l *Limits) *uint64 { return &l.EpochsPerSlashingsVector },
+	"HISTORICAL_ROOTS_LIMIT":                 func(l *Limits) *uint64 { return &l.HistoricalRootsLimit },
+	"VALIDATOR_REGISTRY_LIMIT":               func(l *Limits) *uint64 { return &l.ValidatorRegistryLimit },
+	"MAX_VALIDATORS_PER_COMMITTEE":           func(l *Limits) *uint64 { return &l.MaxValidatorsPerCommittee },
+	"MAX_COMMITTEES_PER_SLOT":                func(l *Limits) *uint64 { return &l.MaxCommitteesPerSlot },
+	"MAX_PROPOSER_SLASHINGS":                 func(l *Limits) *uint64 { return &l.MaxProposerSlashings },
+	"MAX_ATTESTER_SLASHINGS":                 func(l *Limits) *uint64 { return &l.MaxAttesterSlashings },
+	"MAX_ATTESTATIONS":                       func(l *Limits) *uint64 { return &l.MaxAttestations },
+	"MAX_DEPOSITS":                           func(l *Limits) *uint64 { return &l.MaxDeposits },
+	"MAX_VOLUNTARY_EXITS":                    func(l *Limits) *uint64 { return &l.MaxVoluntaryExits },
+	"SYNC_COMMITTEE_SIZE":                    func(l *Limits) *uint64 { return &l.SyncCommitteeSize },
+	"MAX_BLS_TO_EXECUTION_CHANGES":           func(l *Limits) *uint64 { return &l.MaxBLSToExecutionChanges },
+	"MAX_WITHDRAWALS_PER_PAYLOAD":            func(l *Limits) *uint64 { return &l.MaxWithdrawalsPerPayload },
+	"MAX_BLOB_COMMITMENTS_PER_BLOCK":         func(l *Limits) *uint64 { return &l.MaxBlobCommitmentsPerBlock },
+	"MAX_ATTESTER_SLASHINGS_ELECTRA":         func(l *Limits) *uint64 { return &l.MaxAttesterSlashingsElectra },
+	"MAX_ATTESTATIONS_ELECTRA":               func(l *Limits) *uint64 { return &l.MaxAttestationsElectra },
+	"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD":       func(l *Limits) *uint64 { return &l.MaxDepositRequestsPerPayload },
+	"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD":    func(l *Limits) *uint64 { return &l.MaxWithdrawalRequestsPerPayload },
+	"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD": func(l *Limits) *uint64 { return &l.MaxConsolidationRequestsPerPayload },
+	"PENDING_DEPOSITS_LIMIT":                 func(l *Limits) *uint64 { return &l.PendingDepositsLimit },
+	"PENDING_PARTIAL_WITHDRAWALS_LIMIT":      func(l *Limits) *uint64 { return &l.PendingPartialWithdrawalsLimit },
+	"PENDING_CONSOLIDATIONS_LIMIT":           func(l *Limits) *uint64 { return &l.PendingConsolidationsLimit },
+	"MAX_BLOBS_PER_BLOCK":                    func(l *Limits) *uint64 { return &l.MaxBlobsPerBlock },
+	"MAX_BLOBS_PER_BLOCK_ELECTRA":            func(l *Limits) *uint64 { return &l.MaxBlobsPerBlockElectra },
+}
+
+// MainnetLimits returns the limits of the mainnet preset.  These are the
+// limits assumed by the static SSZ encoding of containers.
+func MainnetLimits() *Limits {
+	return &Limits{
+		SlotsPerEpoch:                      32,
+		EpochsPerETH1VotingPeriod:          64,
+		SlotsPerHistoricalRoot:             8192,
+		EpochsPerHistoricalVector:          65536,
+		EpochsPerSlashingsVector:           8192,
+		HistoricalRootsLimit:               16777216,
+		ValidatorRegistryLimit:             1099511627776,
+		MaxValidatorsPerCommittee:          2048,
+		MaxCommitteesPerSlot:               64,
+		MaxProposerSlashings:               16,
+		MaxAttesterSlashings:               2,
+		MaxAttestations:                    128,
+		MaxDeposits:                        16,
+		MaxVoluntaryExits:                  16,
+		SyncCommitteeSize:                  512,
+		MaxBLSToExecutionChanges:           16,
+		MaxWithdrawalsPerPayload:           16,
+		MaxBlobCommitmentsPerBlock:         4096,
+		MaxAttesterSlashingsElectra:        1,
+		MaxAttestationsElectra:             8,
+		MaxDepositRequestsPerPayload:       8192,
+		MaxWithdrawalRequestsPerPayload:    16,
+		MaxConsolidationRequestsPerPayload: 2,
+		PendingDepositsLimit:               134217728,
+		PendingPartialWithdrawalsLimit:     134217728,
+		PendingConsolidationsLimit:         262144,
+		MaxBlobsPerBlock:                   6,
+		MaxBlobsPerBlockElectra:            9,
+	}
+}
+
+// MinimalLimits returns the limits of the minimal preset.
+func MinimalLimits() *Limits {
+	return &Limits{
+		SlotsPerEpoch:                      8,
+		EpochsPerETH1VotingPeriod:          4,
+		SlotsPerHistoricalRoot:             64,
+		EpochsPerHistoricalVector:          64,
+		EpochsPerSlashingsVector:           64,
+		HistoricalRootsLimit:               16777216,
+		ValidatorRegistryLimit:             1099511627776,
+		MaxValidatorsPerCommittee:          2048,
+		MaxCommitteesPerSlot:               4,
+		MaxProposerSlashings:               16,
+		MaxAttesterSlashings:               2,
+		MaxAttestations:                    128,
+		MaxDeposits:                        16,
+		MaxVoluntaryExits:                  16,
+		SyncCommitteeSize:                  32,
+		MaxBLSToExecutionChanges:           16,
+		MaxWithdrawalsPerPayload:           4,
+		MaxBlobCommitmentsPerBlock:         32,
+		MaxAttesterSlashingsElectra:        1,
+		MaxAttestationsElectra:             8,
+		MaxDepositRequestsPerPayload:       4,
+		MaxWithdrawalRequestsPerPayload:    2,
+		MaxConsolidationRequestsPerPayload: 2,
+		PendingDepositsLimit:               134217728,
+		PendingPartialWithdrawalsLimit:     64,
+		PendingConsolidationsLimit:         64,
+		MaxBlobsPerBlock:                   6,
+		MaxBlobsPerBlockElectra:            9,
+	}
+}
+
+// LimitsFromSpec returns the limits for the supplied spec.  The limits of
+// the spec's preset are used as a base, falling back to mainnet if the
+// preset is unknown, and are overridden by any values in the spec.
+func LimitsFromSpec(data map[string]any) (*Limits, error) {
+	limits := MainnetLimits()
+	if PresetFromSpec(data) == PresetMinimal {
+		limits = MinimalLimits()
+	}
+
+	for key, field := range limitKeys {
+		val, exists := data[key]
+		if !exists {
+			continue
+		}
+		limit, isUint64 := val.(uint64)
+		if !isUint64 {
+			return nil, fmt.Errorf("%s of unexpected type %T", key, val)
+		}
+		*field(limits) = limit
+	}
+
+	return limits, nil
+}
+
+// StaticSSZCompatible returns true if the limits match those assumed by the
+// static SSZ encoding of containers.  If not, containers must be encoded
+// dynamically using the limits.
+func (l *Limits) StaticSSZCompatible() bool {
+	sszLimits := *l
+	mainnet := MainnetLimits()
+	sszLimits.MaxBlobsPerBlock = mainnet.MaxBlobsPerBlock
+	sszLimits.MaxBlobsPerBlockElectra = mainnet.MaxBlobsPerBlockElectra
+
+	return sszLimits == *mainnet
+}
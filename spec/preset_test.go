@@ -0,0 +1,150 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresetFromSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   map[string]any
+		preset spec.Preset
+	}{
+		{
+			name:   "Empty",
+			data:   map[string]any{},
+			preset: spec.PresetUnknown,
+		},
+		{
+			name:   "PresetBaseMainnet",
+			data:   map[string]any{"PRESET_BASE": "mainnet"},
+			preset: spec.PresetMainnet,
+		},
+		{
+			name:   "PresetBaseMinimal",
+			data:   map[string]any{"PRESET_BASE": "minimal", "SLOTS_PER_EPOCH": uint64(32)},
+			preset: spec.PresetMinimal,
+		},
+		{
+			name:   "PresetBaseUnknown",
+			data:   map[string]any{"PRESET_BASE": "gnosis"},
+			preset: spec.PresetUnknown,
+		},
+		{
+			name:   "SlotsPerEpochMainnet",
+			data:   map[string]any{"SLOTS_PER_EPOCH": uint64(32)},
+			preset: spec.PresetMainnet,
+		},
+		{
+			name:   "SlotsPerEpochMinimal",
+			data:   map[string]any{"SLOTS_PER_EPOCH": uint64(8)},
+			preset: spec.PresetMinimal,
+		},
+		{
+			name:   "SlotsPerEpochUnknown",
+			data:   map[string]any{"SLOTS_PER_EPOCH": uint64(16)},
+			preset: spec.PresetUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.preset, spec.PresetFromSpec(test.data))
+		})
+	}
+}
+
+func TestPresetString(t *testing.T) {
+	require.Equal(t, "mainnet", spec.PresetMainnet.String())
+	require.Equal(t, "minimal", spec.PresetMinimal.String())
+	require.Equal(t, "unknown", spec.PresetUnknown.String())
+	require.Equal(t, "unknown", spec.Preset(99).String())
+}
+
+func TestLimitsFromSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   map[string]any
+		limits *spec.Limits
+		static bool
+		err    string
+	}{
+		{
+			name:   "Empty",
+			data:   map[string]any{},
+			limits: spec.MainnetLimits(),
+			static: true,
+		},
+		{
+			name:   "Mainnet",
+			data:   map[string]any{"PRESET_BASE": "mainnet", "MAX_ATTESTATIONS": uint64(128)},
+			limits: spec.MainnetLimits(),
+			static: true,
+		},
+		{
+			name:   "Minimal",
+			data:   map[string]any{"PRESET_BASE": "minimal"},
+			limits: spec.MinimalLimits(),
+			static: false,
+		},
+		{
+			name: "Override",
+			data: map[string]any{"PRESET_BASE": "mainnet", "MAX_BLOB_COMMITMENTS_PER_BLOCK": uint64(32)},
+			limits: func() *spec.Limits {
+				limits := spec.MainnetLimits()
+				limits.MaxBlobCommitmentsPerBlock = 32
+
+				return limits
+			}(),
+			static: false,
+		},
+		{
+			name: "BlobsOverride",
+			data: map[string]any{"PRESET_BASE": "mainnet", "MAX_BLOBS_PER_BLOCK": uint64(2), "MAX_BLOBS_PER_BLOCK_ELECTRA": uint64(3)},
+			limits: func() *spec.Limits {
+				limits := spec.MainnetLimits()
+				limits.MaxBlobsPerBlock = 2
+				limits.MaxBlobsPerBlockElectra = 3
+
+				return limits
+			}(),
+			// Blob counts do not affect the SSZ encoding.
+			static: true,
+		},
+		{
+			name: "InvalidType",
+			data: map[string]any{"MAX_ATTESTATIONS": "128"},
+			err:  "MAX_ATTESTATIONS of unexpected type string",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limits, err := spec.LimitsFromSpec(test.data)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.limits, limits)
+			require.Equal(t, test.static, limits.StaticSSZCompatible())
+		})
+	}
+}
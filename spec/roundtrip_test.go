@@ -0,0 +1,183 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFiller fills containers with deterministic data.
+type roundTripFiller struct {
+	// length is the number of elements in each variable-length list.
+	length int
+	// counter provides the value for each field.
+	counter uint64
+}
+
+// next returns the next value.
+func (f *roundTripFiller) next() uint64 {
+	f.counter++
+
+	return f.counter
+}
+
+// fill fills the value, using the dimensions in its SSZ size and max tags
+// to size vectors and lists.
+func (f *roundTripFiller) fill(val reflect.Value, sizes []string, maxes []string) {
+	switch val.Kind() {
+	case reflect.Pointer:
+		if val.Type() == reflect.TypeOf(&uint256.Int{}) {
+			val.Set(reflect.ValueOf(uint256.NewInt(f.next())))
+
+			return
+		}
+		val.Set(reflect.New(val.Type().Elem()))
+		f.fill(val.Elem(), sizes, maxes)
+	case reflect.Struct:
+		if val.Type() == reflect.TypeOf(time.Time{}) {
+			val.Set(reflect.ValueOf(time.Unix(int64(f.next()), 0)))
+
+			return
+		}
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			f.fill(val.Field(i), tagDimensions(field.Tag.Get("ssz-size")), tagDimensions(field.Tag.Get("ssz-max")))
+		}
+	case reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			f.fill(val.Index(i), nil, nil)
+		}
+	case reflect.Slice:
+		if val.Type() == reflect.TypeOf(bitfield.Bitlist{}) {
+			bits := bitfield.NewBitlist(uint64(8 * f.length))
+			for i := 0; i < f.length; i++ {
+				bits.SetBitAt(uint64(i*3), true)
+			}
+			val.Set(reflect.ValueOf(bits))
+
+			return
+		}
+		if val.Type() == reflect.TypeOf(bitfield.Bitvector4{}) {
+			// Only the low bits of the byte are valid.
+			val.Set(reflect.ValueOf(bitfield.Bitvector4{byte(f.next() & 0x0f)}))
+
+			return
+		}
+		length := f.length
+		if len(sizes) > 0 && sizes[0] != "?" {
+			length, _ = strconv.Atoi(sizes[0])
+		} else if len(maxes) > 0 {
+			if limit, err := strconv.Atoi(maxes[0]); err == nil && limit < length {
+				length = limit
+			}
+		}
+		val.Set(reflect.MakeSlice(val.Type(), length, length))
+		for i := 0; i < length; i++ {
+			f.fill(val.Index(i), tail(sizes), tail(maxes))
+		}
+	case reflect.Uint8:
+		val.SetUint(f.next() & 0xff)
+	case reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val.SetUint(f.next())
+	case reflect.Bool:
+		val.SetBool(true)
+	}
+}
+
+// tagDimensions returns the dimensions in an SSZ size or max tag.
+func tagDimensions(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+
+	return strings.Split(tag, ",")
+}
+
+// tail returns all but the first dimension.
+func tail(dimensions []string) []string {
+	if len(dimensions) < 2 {
+		return nil
+	}
+
+	return dimensions[1:]
+}
+
+// TestRoundTrip ensures that for every container JSON→struct→SSZ→struct→JSON,
+// and YAML→struct→JSON, are lossless and byte-stable.
+func TestRoundTrip(t *testing.T) {
+	for _, container := range roundTripContainers {
+		for _, length := range []int{0, 2} {
+			container := container
+			length := length
+			t.Run(container.name+"/"+strconv.Itoa(length), func(t *testing.T) {
+				if testing.Short() && strings.HasSuffix(container.name, ".BeaconState") {
+					t.Skip("skipping beacon state in short mode")
+				}
+				t.Parallel()
+
+				obj := container.obj()
+				filler := &roundTripFiller{length: length}
+				filler.fill(reflect.ValueOf(obj).Elem(), nil, nil)
+
+				original, err := json.Marshal(obj)
+				require.NoError(t, err)
+
+				// JSON→struct.
+				fromJSON := container.obj()
+				require.NoError(t, json.Unmarshal(original, fromJSON))
+
+				// struct→SSZ→struct.
+				expectedSSZ, err := obj.(ssz.Marshaler).MarshalSSZ()
+				require.NoError(t, err)
+				encoded, err := fromJSON.(ssz.Marshaler).MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, expectedSSZ, encoded)
+				fromSSZ := container.obj()
+				require.NoError(t, fromSSZ.(ssz.Unmarshaler).UnmarshalSSZ(encoded))
+
+				// struct→JSON.
+				roundTripped, err := json.Marshal(fromSSZ)
+				require.NoError(t, err)
+				require.Equal(t, string(original), string(roundTripped))
+
+				// YAML.  Beacon states are too large to encode as YAML in
+				// reasonable time, and their YAML is only used for display.
+				if _, isYAML := obj.(yaml.BytesMarshaler); !isYAML || strings.HasSuffix(container.name, ".BeaconState") {
+					return
+				}
+				encodedYAML, err := yaml.Marshal(obj)
+				require.NoError(t, err)
+				fromYAML := container.obj()
+				require.NoError(t, yaml.Unmarshal(encodedYAML, fromYAML))
+				roundTripped, err = json.Marshal(fromYAML)
+				require.NoError(t, err)
+				require.Equal(t, string(original), string(roundTripped))
+			})
+		}
+	}
+}
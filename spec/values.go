@@ -0,0 +1,50 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import "fmt"
+
+// Uint64Value is a uint64 value to be read from the data returned by a spec
+// provider.
+type Uint64Value struct {
+	// Key is the name of the value in the spec.
+	Key string
+	// Optional is true if the spec need not contain the value.
+	Optional bool
+	// Apply is called with the value if it is present.
+	Apply func(uint64)
+}
+
+// ReadUint64Values reads the supplied values from the data returned by a
+// spec provider, returning an error if a required value is missing or any
+// value is not a uint64.
+func ReadUint64Values(data map[string]any, values []Uint64Value) error {
+	for _, value := range values {
+		tmp, exists := data[value.Key]
+		if !exists {
+			if value.Optional {
+				continue
+			}
+
+			return fmt.Errorf("%s not found in spec", value.Key)
+		}
+		v, isUint64 := tmp.(uint64)
+		if !isUint64 {
+			return fmt.Errorf("%s of unexpected type", value.Key)
+		}
+		value.Apply(v)
+	}
+
+	return nil
+}
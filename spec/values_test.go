@@ -0,0 +1,72 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadUint64Values(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		required uint64
+		optional uint64
+		err      string
+	}{
+		{
+			name:     "Good",
+			data:     map[string]any{"REQUIRED": uint64(1), "OPTIONAL": uint64(2)},
+			required: 1,
+			optional: 2,
+		},
+		{
+			name:     "OptionalMissing",
+			data:     map[string]any{"REQUIRED": uint64(1)},
+			required: 1,
+			optional: 10,
+		},
+		{
+			name: "RequiredMissing",
+			data: map[string]any{"OPTIONAL": uint64(2)},
+			err:  "REQUIRED not found in spec",
+		},
+		{
+			name: "WrongType",
+			data: map[string]any{"REQUIRED": uint64(1), "OPTIONAL": "2"},
+			err:  "OPTIONAL of unexpected type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			required := uint64(0)
+			optional := uint64(10)
+			err := spec.ReadUint64Values(test.data, []spec.Uint64Value{
+				{Key: "REQUIRED", Apply: func(v uint64) { required = v }},
+				{Key: "OPTIONAL", Optional: true, Apply: func(v uint64) { optional = v }},
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.required, required)
+				require.Equal(t, test.optional, optional)
+			}
+		})
+	}
+}
@@ -1,15 +1,5 @@
-// Copyright © 2025 Attestant Limited.
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-//     http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
+// Code generated by versionedgen. DO NOT EDIT.
+// Source: versioned.json
 
 package spec
 
@@ -31,6 +21,11 @@ type VersionedAggregateAndProof struct {
 	Electra   *electra.AggregateAndProof
 }
 
+// IsEmpty returns true if there is no aggregate and proof.
+func (v *VersionedAggregateAndProof) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
 // AggregatorIndex returns the aggregator index of the aggregate.
 func (v *VersionedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIndex, error) {
 	switch v.Version {
@@ -75,139 +70,134 @@ func (v *VersionedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIndex, e
 	}
 }
 
-// HashTreeRoot returns the hash tree root of the aggregate and proof.
-func (v *VersionedAggregateAndProof) HashTreeRoot() ([32]byte, error) {
+// SelectionProof returns the selection proof of the aggregate.
+func (v *VersionedAggregateAndProof) SelectionProof() (phase0.BLSSignature, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return [32]byte{}, errors.New("no phase0 aggregate and proof")
+			return phase0.BLSSignature{}, errors.New("no phase0 aggregate and proof")
 		}
 
-		return v.Phase0.HashTreeRoot()
+		return v.Phase0.SelectionProof, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return [32]byte{}, errors.New("no altair aggregate and proof")
+			return phase0.BLSSignature{}, errors.New("no altair aggregate and proof")
 		}
 
-		return v.Altair.HashTreeRoot()
+		return v.Altair.SelectionProof, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return [32]byte{}, errors.New("no bellatrix aggregate and proof")
+			return phase0.BLSSignature{}, errors.New("no bellatrix aggregate and proof")
 		}
 
-		return v.Bellatrix.HashTreeRoot()
+		return v.Bellatrix.SelectionProof, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return [32]byte{}, errors.New("no capella aggregate and proof")
+			return phase0.BLSSignature{}, errors.New("no capella aggregate and proof")
 		}
 
-		return v.Capella.HashTreeRoot()
+		return v.Capella.SelectionProof, nil
 	case DataVersionDeneb:
 		if v.Deneb == nil {
-			return [32]byte{}, errors.New("no deneb aggregate and proof")
+			return phase0.BLSSignature{}, errors.New("no deneb aggregate and proof")
 		}
 
-		return v.Deneb.HashTreeRoot()
+		return v.Deneb.SelectionProof, nil
 	case DataVersionElectra:
 		if v.Electra == nil {
-			return [32]byte{}, errors.New("no electra aggregate and proof")
+			return phase0.BLSSignature{}, errors.New("no electra aggregate and proof")
 		}
 
-		return v.Electra.HashTreeRoot()
+		return v.Electra.SelectionProof, nil
 	default:
-		return [32]byte{}, errors.New("unknown version")
+		return phase0.BLSSignature{}, errors.New("unknown version for aggregate and proof")
 	}
 }
 
-// IsEmpty returns true if there is no aggregate and proof.
-func (v *VersionedAggregateAndProof) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
-}
-
-// String returns a string version of the structure.
-func (v *VersionedAggregateAndProof) String() string {
+// HashTreeRoot returns the hash tree root of the aggregate and proof.
+func (v *VersionedAggregateAndProof) HashTreeRoot() ([32]byte, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return ""
+			return [32]byte{}, errors.New("no phase0 aggregate and proof")
 		}
 
-		return v.Phase0.String()
+		return v.Phase0.HashTreeRoot()
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return ""
+			return [32]byte{}, errors.New("no altair aggregate and proof")
 		}
 
-		return v.Altair.String()
+		return v.Altair.HashTreeRoot()
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return ""
+			return [32]byte{}, errors.New("no bellatrix aggregate and proof")
 		}
 
-		return v.Bellatrix.String()
+		return v.Bellatrix.HashTreeRoot()
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return ""
+			return [32]byte{}, errors.New("no capella aggregate and proof")
 		}
 
-		return v.Capella.String()
+		return v.Capella.HashTreeRoot()
 	case DataVersionDeneb:
 		if v.Deneb == nil {
-			return ""
+			return [32]byte{}, errors.New("no deneb aggregate and proof")
 		}
 
-		return v.Deneb.String()
+		return v.Deneb.HashTreeRoot()
 	case DataVersionElectra:
 		if v.Electra == nil {
-			return ""
+			return [32]byte{}, errors.New("no electra aggregate and proof")
 		}
 
-		return v.Electra.String()
+		return v.Electra.HashTreeRoot()
 	default:
-		return "unknown version"
+		return [32]byte{}, errors.New("unknown version for aggregate and proof")
 	}
 }
 
-// SelectionProof returns the selection proof of the aggregate.
-func (v *VersionedAggregateAndProof) SelectionProof() (phase0.BLSSignature, error) {
+// String returns a string version of the structure.
+func (v *VersionedAggregateAndProof) String() string {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
-			return phase0.BLSSignature{}, errors.New("no phase0 aggregate and proof")
+			return ""
 		}
 
-		return v.Phase0.SelectionProof, nil
+		return v.Phase0.String()
 	case DataVersionAltair:
 		if v.Altair == nil {
-			return phase0.BLSSignature{}, errors.New("no altair aggregate and proof")
+			return ""
 		}
 
-		return v.Altair.SelectionProof, nil
+		return v.Altair.String()
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
-			return phase0.BLSSignature{}, errors.New("no bellatrix aggregate and proof")
+			return ""
 		}
 
-		return v.Bellatrix.SelectionProof, nil
+		return v.Bellatrix.String()
 	case DataVersionCapella:
 		if v.Capella == nil {
-			return phase0.BLSSignature{}, errors.New("no capella aggregate and proof")
+			return ""
 		}
 
-		return v.Capella.SelectionProof, nil
+		return v.Capella.String()
 	case DataVersionDeneb:
 		if v.Deneb == nil {
-			return phase0.BLSSignature{}, errors.New("no deneb aggregate and proof")
+			return ""
 		}
 
-		return v.Deneb.SelectionProof, nil
+		return v.Deneb.String()
 	case DataVersionElectra:
 		if v.Electra == nil {
-			return phase0.BLSSignature{}, errors.New("no electra aggregate and proof")
+			return ""
 		}
 
-		return v.Electra.SelectionProof, nil
+		return v.Electra.String()
 	default:
-		return phase0.BLSSignature{}, errors.New("unknown version")
+		return "unknown version"
 	}
 }
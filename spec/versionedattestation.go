@@ -188,6 +188,50 @@ func (v *VersionedAttestation) CommitteeIndex() (phase0.CommitteeIndex, error) {
 	}
 }
 
+// AttestingIndices returns the indices of the validators that took part in
+// the attestation, given the beacon committees at the attestation's slot.
+// From Electra an attestation can cover multiple committees, in which case
+// its aggregation bits are concatenated in committee order.
+func (v *VersionedAttestation) AttestingIndices(committees map[phase0.CommitteeIndex][]phase0.ValidatorIndex) ([]phase0.ValidatorIndex, error) {
+	data, err := v.Data()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain attestation data"), err)
+	}
+	aggregationBits, err := v.AggregationBits()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain aggregation bits"), err)
+	}
+
+	committeeIndices := []phase0.CommitteeIndex{data.Index}
+	if v.Version >= DataVersionElectra {
+		committeeBits, err := v.CommitteeBits()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain committee bits"), err)
+		}
+		committeeIndices = make([]phase0.CommitteeIndex, 0, committeeBits.Count())
+		for _, index := range committeeBits.BitIndices() {
+			committeeIndices = append(committeeIndices, phase0.CommitteeIndex(index))
+		}
+	}
+
+	indices := make([]phase0.ValidatorIndex, 0)
+	offset := uint64(0)
+	for _, committeeIndex := range committeeIndices {
+		committee, exists := committees[committeeIndex]
+		if !exists {
+			return nil, fmt.Errorf("no committee %d at slot %d", committeeIndex, data.Slot)
+		}
+		for i, validatorIndex := range committee {
+			if aggregationBits.BitAt(offset + uint64(i)) {
+				indices = append(indices, validatorIndex)
+			}
+		}
+		offset += uint64(len(committee))
+	}
+
+	return indices, nil
+}
+
 func (v *VersionedAttestation) HashTreeRoot() ([32]byte, error) {
 	switch v.Version {
 	case DataVersionPhase0:
@@ -318,3 +362,33 @@ func (v *VersionedAttestation) String() string {
 		return "unknown version"
 	}
 }
+
+// Short returns a short string version of the attestation suitable for
+// logging, containing its slot, committee index, a prefix of its beacon
+// block root, its source and target epochs, and the number of aggregated
+// attesters.
+func (v *VersionedAttestation) Short() string {
+	data, err := v.Data()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	committeeIndex, err := v.CommitteeIndex()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	aggregationBits, err := v.AggregationBits()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return fmt.Sprintf("%s attestation slot %d committee %d root %#x source %d target %d bits %d/%d",
+		v.Version,
+		data.Slot,
+		committeeIndex,
+		data.BeaconBlockRoot[:4],
+		data.Source.Epoch,
+		data.Target.Epoch,
+		aggregationBits.Count(),
+		aggregationBits.Len(),
+	)
+}
@@ -85,3 +85,110 @@ func TestVersionedAttestation_CommitteeIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionedAttestationShort(t *testing.T) {
+	aggregationBits := bitfield.NewBitlist(8)
+	aggregationBits.SetBitAt(1, true)
+	aggregationBits.SetBitAt(5, true)
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(3, true)
+	data := &phase0.AttestationData{
+		Slot:            100,
+		BeaconBlockRoot: phase0.Root{0x01, 0x02, 0x03, 0x04, 0x05},
+		Source:          &phase0.Checkpoint{Epoch: 2},
+		Target:          &phase0.Checkpoint{Epoch: 3},
+	}
+
+	attestation := &spec.VersionedAttestation{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.Attestation{
+			AggregationBits: aggregationBits,
+			Data:            data,
+			CommitteeBits:   committeeBits,
+		},
+	}
+	require.Equal(t, "electra attestation slot 100 committee 3 root 0x01020304 source 2 target 3 bits 2/8", attestation.Short())
+
+	require.Equal(t, "ERR: no Electra attestation", (&spec.VersionedAttestation{Version: spec.DataVersionElectra}).Short())
+}
+
+func TestVersionedAttestationAttestingIndices(t *testing.T) {
+	committees := map[phase0.CommitteeIndex][]phase0.ValidatorIndex{
+		0: {10, 11, 12},
+		2: {20, 21},
+	}
+
+	phase0Bits := bitfield.NewBitlist(2)
+	phase0Bits.SetBitAt(1, true)
+
+	// Electra aggregation bits cover committees 0 and 2 in order.
+	electraBits := bitfield.NewBitlist(5)
+	electraBits.SetBitAt(0, true)
+	electraBits.SetBitAt(2, true)
+	electraBits.SetBitAt(4, true)
+	committeeBits := bitfield.NewBitvector64()
+	committeeBits.SetBitAt(0, true)
+	committeeBits.SetBitAt(2, true)
+	missingCommitteeBits := bitfield.NewBitvector64()
+	missingCommitteeBits.SetBitAt(1, true)
+
+	tests := []struct {
+		name        string
+		attestation *spec.VersionedAttestation
+		indices     []phase0.ValidatorIndex
+		err         string
+	}{
+		{
+			name: "Phase0",
+			attestation: &spec.VersionedAttestation{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.Attestation{
+					AggregationBits: phase0Bits,
+					Data:            &phase0.AttestationData{Slot: 5, Index: 2},
+				},
+			},
+			indices: []phase0.ValidatorIndex{21},
+		},
+		{
+			name: "ElectraMultipleCommittees",
+			attestation: &spec.VersionedAttestation{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.Attestation{
+					AggregationBits: electraBits,
+					Data:            &phase0.AttestationData{Slot: 5},
+					CommitteeBits:   committeeBits,
+				},
+			},
+			indices: []phase0.ValidatorIndex{10, 12, 21},
+		},
+		{
+			name: "ElectraMissingCommittee",
+			attestation: &spec.VersionedAttestation{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.Attestation{
+					AggregationBits: electraBits,
+					Data:            &phase0.AttestationData{Slot: 5},
+					CommitteeBits:   missingCommitteeBits,
+				},
+			},
+			err: "no committee 1 at slot 5",
+		},
+		{
+			name:        "Empty",
+			attestation: &spec.VersionedAttestation{Version: spec.DataVersionElectra},
+			err:         "failed to obtain attestation data\nno Electra attestation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indices, err := test.attestation.AttestingIndices(committees)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.indices, indices)
+			}
+		})
+	}
+}
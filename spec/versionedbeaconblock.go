@@ -15,6 +15,7 @@ package spec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/electra"
 
@@ -718,3 +719,18 @@ func (v *VersionedBeaconBlock) String() string {
 		return "unknown version"
 	}
 }
+
+// Short returns a short string version of the block suitable for logging,
+// containing its version, slot and a prefix of its root.
+func (v *VersionedBeaconBlock) Short() string {
+	slot, err := v.Slot()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	root, err := v.Root()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return fmt.Sprintf("%s block slot %d root %#x", v.Version, slot, root[:4])
+}
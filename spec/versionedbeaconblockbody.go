@@ -1,15 +1,5 @@
-// Copyright © 2021 - 2024 Attestant Limited.
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-//     http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
+// Code generated by versionedgen. DO NOT EDIT.
+// Source: versioned.json
 
 package spec
 
@@ -33,6 +23,11 @@ type VersionedBeaconBlockBody struct {
 	Electra   *electra.BeaconBlockBody
 }
 
+// IsEmpty returns true if there is no beacon block body.
+func (v *VersionedBeaconBlockBody) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconBlockBody) String() string {
 	switch v.Version {
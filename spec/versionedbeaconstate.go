@@ -15,6 +15,7 @@ package spec
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -84,11 +85,201 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 	}
 }
 
+// LatestBlockHeader returns the latest block header of the state.
+func (v *VersionedBeaconState) LatestBlockHeader() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.LatestBlockHeader == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.LatestBlockHeader, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.LatestBlockHeader == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.LatestBlockHeader, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.LatestBlockHeader == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.LatestBlockHeader, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.LatestBlockHeader == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.LatestBlockHeader, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.LatestBlockHeader == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.LatestBlockHeader, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.LatestBlockHeader == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.LatestBlockHeader, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// Root returns the root of the state.
+func (v *VersionedBeaconState) Root() (phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return phase0.Root{}, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.HashTreeRoot()
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return phase0.Root{}, errors.New("no Altair state")
+		}
+
+		return v.Altair.HashTreeRoot()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Root{}, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.HashTreeRoot()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Root{}, errors.New("no Capella state")
+		}
+
+		return v.Capella.HashTreeRoot()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Root{}, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no Electra state")
+		}
+
+		return v.Electra.HashTreeRoot()
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+}
+
+// NextWithdrawalIndex returns the next withdrawal index of the state.
+func (v *VersionedBeaconState) NextWithdrawalIndex() (capella.WithdrawalIndex, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix:
+		return 0, errors.Join(errors.New("state does not provide next withdrawal index"), ErrNotAvailableInFork)
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no Capella state")
+		}
+
+		return v.Capella.NextWithdrawalIndex, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.NextWithdrawalIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+
+		return v.Electra.NextWithdrawalIndex, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// HistoricalSummaries returns the historical summaries of the state.
+func (v *VersionedBeaconState) HistoricalSummaries() ([]*capella.HistoricalSummary, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix:
+		return nil, errors.Join(errors.New("state does not provide historical summaries"), ErrNotAvailableInFork)
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.HistoricalSummaries, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.HistoricalSummaries, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.HistoricalSummaries, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// LatestExecutionPayloadHeader returns the latest execution payload header of the state.
+func (v *VersionedBeaconState) LatestExecutionPayloadHeader() (*VersionedExecutionPayloadHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair:
+		return nil, errors.Join(errors.New("state does not provide latest execution payload header"), ErrNotAvailableInFork)
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Capella: v.Capella.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Deneb:   v.Deneb.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Electra: v.Electra.LatestExecutionPayloadHeader,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // NextWithdrawalValidatorIndex returns the next withdrawal validator index of the state.
 func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorIndex, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix:
-		return 0, errors.New("state does not provide next withdrawal validator index")
+		return 0, errors.Join(errors.New("state does not provide next withdrawal validator index"), ErrNotAvailableInFork)
 	case DataVersionCapella:
 		if v.Capella == nil {
 			return 0, errors.New("no Capella state")
@@ -200,11 +391,135 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 	}
 }
 
-// DepositReceiptsStartIndex returns the deposit requests start index of the state.
+// RANDAOMixes returns the RANDAO mixes of the state.
+func (v *VersionedBeaconState) RANDAOMixes() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.RANDAOMixes, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.RANDAOMixes, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.RANDAOMixes, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.RANDAOMixes, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.RANDAOMixes, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.RANDAOMixes, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentSyncCommittee returns the current sync committee of the state.
+func (v *VersionedBeaconState) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.Join(errors.New("state does not provide current sync committee"), ErrNotAvailableInFork)
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.CurrentSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.CurrentSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.CurrentSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// NextSyncCommittee returns the next sync committee of the state.
+func (v *VersionedBeaconState) NextSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.Join(errors.New("state does not provide next sync committee"), ErrNotAvailableInFork)
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.NextSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.NextSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.NextSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.NextSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.NextSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// DepositRequestsStartIndex returns the deposit requests start index of the state.
 func (v *VersionedBeaconState) DepositRequestsStartIndex() (uint64, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return 0, errors.New("state does not provide deposit requests start index")
+		return 0, errors.Join(errors.New("state does not provide deposit requests start index"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return 0, errors.New("no Electra state")
@@ -220,7 +535,7 @@ func (v *VersionedBeaconState) DepositRequestsStartIndex() (uint64, error) {
 func (v *VersionedBeaconState) DepositBalanceToConsume() (phase0.Gwei, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return 0, errors.New("state does not provide deposit balance to consume")
+		return 0, errors.Join(errors.New("state does not provide deposit balance to consume"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return 0, errors.New("no Electra state")
@@ -236,7 +551,7 @@ func (v *VersionedBeaconState) DepositBalanceToConsume() (phase0.Gwei, error) {
 func (v *VersionedBeaconState) ExitBalanceToConsume() (phase0.Gwei, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return 0, errors.New("state does not provide exit balance to consume")
+		return 0, errors.Join(errors.New("state does not provide exit balance to consume"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return 0, errors.New("no Electra state")
@@ -252,7 +567,7 @@ func (v *VersionedBeaconState) ExitBalanceToConsume() (phase0.Gwei, error) {
 func (v *VersionedBeaconState) EarliestExitEpoch() (phase0.Epoch, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return 0, errors.New("state does not provide earliest exit epoch")
+		return 0, errors.Join(errors.New("state does not provide earliest exit epoch"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return 0, errors.New("no Electra state")
@@ -268,7 +583,7 @@ func (v *VersionedBeaconState) EarliestExitEpoch() (phase0.Epoch, error) {
 func (v *VersionedBeaconState) ConsolidationBalanceToConsume() (phase0.Gwei, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return 0, errors.New("state does not provide consolidation balance to consume")
+		return 0, errors.Join(errors.New("state does not provide consolidation balance to consume"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return 0, errors.New("no Electra state")
@@ -284,7 +599,7 @@ func (v *VersionedBeaconState) ConsolidationBalanceToConsume() (phase0.Gwei, err
 func (v *VersionedBeaconState) EarliestConsolidationEpoch() (phase0.Epoch, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return 0, errors.New("state does not provide earliest consolidation epoch")
+		return 0, errors.Join(errors.New("state does not provide earliest consolidation epoch"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return 0, errors.New("no Electra state")
@@ -300,7 +615,7 @@ func (v *VersionedBeaconState) EarliestConsolidationEpoch() (phase0.Epoch, error
 func (v *VersionedBeaconState) PendingDeposits() ([]*electra.PendingDeposit, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return nil, errors.New("state does not provide pending deposits")
+		return nil, errors.Join(errors.New("state does not provide pending deposits"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return nil, errors.New("no Electra state")
@@ -316,7 +631,7 @@ func (v *VersionedBeaconState) PendingDeposits() ([]*electra.PendingDeposit, err
 func (v *VersionedBeaconState) PendingPartialWithdrawals() ([]*electra.PendingPartialWithdrawal, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return nil, errors.New("state does not provide pending partial withdrawals")
+		return nil, errors.Join(errors.New("state does not provide pending partial withdrawals"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return nil, errors.New("no Electra state")
@@ -332,7 +647,7 @@ func (v *VersionedBeaconState) PendingPartialWithdrawals() ([]*electra.PendingPa
 func (v *VersionedBeaconState) PendingConsolidations() ([]*electra.PendingConsolidation, error) {
 	switch v.Version {
 	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
-		return nil, errors.New("state does not provide pending consolidations")
+		return nil, errors.Join(errors.New("state does not provide pending consolidations"), ErrNotAvailableInFork)
 	case DataVersionElectra:
 		if v.Electra == nil {
 			return nil, errors.New("no Electra state")
@@ -387,3 +702,19 @@ func (v *VersionedBeaconState) String() string {
 		return "unknown version"
 	}
 }
+
+// Short returns a short string version of the state suitable for logging,
+// containing its version, slot and number of validators.  It does not
+// serialize or hash the state.
+func (v *VersionedBeaconState) Short() string {
+	slot, err := v.Slot()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	validators, err := v.Validators()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return fmt.Sprintf("%s state slot %d validators %d", v.Version, slot, len(validators))
+}
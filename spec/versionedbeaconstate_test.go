@@ -0,0 +1,208 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconStateAccessors(t *testing.T) {
+	summaries := []*capella.HistoricalSummary{
+		{BlockSummaryRoot: phase0.Root{0x01}, StateSummaryRoot: phase0.Root{0x02}},
+	}
+	blockHash := phase0.Hash32{0x03}
+
+	tests := []struct {
+		name                string
+		state               *spec.VersionedBeaconState
+		withdrawalIndex     capella.WithdrawalIndex
+		summaries           []*capella.HistoricalSummary
+		blockHash           phase0.Hash32
+		depositRequestIndex uint64
+		notAvailable        []string
+	}{
+		{
+			name: "Phase0",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionPhase0,
+				Phase0:  &phase0.BeaconState{},
+			},
+			notAvailable: []string{"withdrawals", "summaries", "header", "deposits"},
+		},
+		{
+			name: "Altair",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionAltair,
+				Altair:  &altair.BeaconState{},
+			},
+			notAvailable: []string{"withdrawals", "summaries", "header", "deposits"},
+		},
+		{
+			name: "Bellatrix",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionBellatrix,
+				Bellatrix: &bellatrix.BeaconState{
+					LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{BlockHash: blockHash},
+				},
+			},
+			blockHash:    blockHash,
+			notAvailable: []string{"withdrawals", "summaries", "deposits"},
+		},
+		{
+			name: "Capella",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.BeaconState{
+					LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{BlockHash: blockHash},
+					NextWithdrawalIndex:          5,
+					HistoricalSummaries:          summaries,
+				},
+			},
+			withdrawalIndex: 5,
+			summaries:       summaries,
+			blockHash:       blockHash,
+			notAvailable:    []string{"deposits"},
+		},
+		{
+			name: "Deneb",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionDeneb,
+				Deneb: &deneb.BeaconState{
+					LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{BlockHash: blockHash},
+					NextWithdrawalIndex:          6,
+					HistoricalSummaries:          summaries,
+				},
+			},
+			withdrawalIndex: 6,
+			summaries:       summaries,
+			blockHash:       blockHash,
+			notAvailable:    []string{"deposits"},
+		},
+		{
+			name: "Electra",
+			state: &spec.VersionedBeaconState{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.BeaconState{
+					LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{BlockHash: blockHash},
+					NextWithdrawalIndex:          7,
+					HistoricalSummaries:          summaries,
+					DepositRequestsStartIndex:    8,
+				},
+			},
+			withdrawalIndex:     7,
+			summaries:           summaries,
+			blockHash:           blockHash,
+			depositRequestIndex: 8,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			notAvailable := make(map[string]bool)
+			for _, item := range test.notAvailable {
+				notAvailable[item] = true
+			}
+
+			withdrawalIndex, err := test.state.NextWithdrawalIndex()
+			if notAvailable["withdrawals"] {
+				require.ErrorIs(t, err, spec.ErrNotAvailableInFork)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.withdrawalIndex, withdrawalIndex)
+			}
+
+			summaries, err := test.state.HistoricalSummaries()
+			if notAvailable["summaries"] {
+				require.ErrorIs(t, err, spec.ErrNotAvailableInFork)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.summaries, summaries)
+			}
+
+			header, err := test.state.LatestExecutionPayloadHeader()
+			if notAvailable["header"] {
+				require.ErrorIs(t, err, spec.ErrNotAvailableInFork)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.state.Version, header.Version)
+				blockHash, err := header.BlockHash()
+				require.NoError(t, err)
+				require.Equal(t, test.blockHash, blockHash)
+			}
+
+			_, err = test.state.RANDAOMixes()
+			require.NoError(t, err)
+
+			_, err = test.state.CurrentSyncCommittee()
+			if test.state.Version == spec.DataVersionPhase0 {
+				require.ErrorIs(t, err, spec.ErrNotAvailableInFork)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = test.state.NextSyncCommittee()
+			if test.state.Version == spec.DataVersionPhase0 {
+				require.ErrorIs(t, err, spec.ErrNotAvailableInFork)
+			} else {
+				require.NoError(t, err)
+			}
+
+			depositRequestIndex, err := test.state.DepositRequestsStartIndex()
+			if notAvailable["deposits"] {
+				require.ErrorIs(t, err, spec.ErrNotAvailableInFork)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.depositRequestIndex, depositRequestIndex)
+			}
+		})
+	}
+}
+
+func TestVersionedBeaconStateMissing(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+	}
+
+	_, err := state.HistoricalSummaries()
+	require.EqualError(t, err, "no Deneb state")
+
+	_, err = state.LatestExecutionPayloadHeader()
+	require.EqualError(t, err, "no Deneb state")
+
+	state = &spec.VersionedBeaconState{}
+	_, err = state.NextWithdrawalIndex()
+	require.EqualError(t, err, "unknown version")
+}
+
+func TestVersionedBeaconStateShort(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.BeaconState{
+			Slot:       64,
+			Validators: make([]*phase0.Validator, 3),
+		},
+	}
+	require.Equal(t, "deneb state slot 64 validators 3", state.Short())
+
+	require.Equal(t, "ERR: unknown version", (&spec.VersionedBeaconState{}).Short())
+}
@@ -1,15 +1,5 @@
-// Copyright © 2025 Attestant Limited.
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-//     http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
+// Code generated by versionedgen. DO NOT EDIT.
+// Source: versioned.json
 
 package spec
 
@@ -31,6 +21,11 @@ type VersionedSignedAggregateAndProof struct {
 	Electra   *electra.SignedAggregateAndProof
 }
 
+// IsEmpty returns true if there is no signed aggregate and proof.
+func (v *VersionedSignedAggregateAndProof) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
 // AggregatorIndex returns the aggregator index of the aggregate.
 func (v *VersionedSignedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIndex, error) {
 	switch v.Version {
@@ -75,11 +70,6 @@ func (v *VersionedSignedAggregateAndProof) AggregatorIndex() (phase0.ValidatorIn
 	}
 }
 
-// IsEmpty returns true if there is no aggregate and proof.
-func (v *VersionedSignedAggregateAndProof) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
-}
-
 // SelectionProof returns the selection proof of the signed aggregate.
 func (v *VersionedSignedAggregateAndProof) SelectionProof() (phase0.BLSSignature, error) {
 	switch v.Version {
@@ -120,7 +110,7 @@ func (v *VersionedSignedAggregateAndProof) SelectionProof() (phase0.BLSSignature
 
 		return v.Electra.Message.SelectionProof, nil
 	default:
-		return phase0.BLSSignature{}, errors.New("unknown version")
+		return phase0.BLSSignature{}, errors.New("unknown version for signed aggregate and proof")
 	}
 }
 
@@ -164,7 +154,7 @@ func (v *VersionedSignedAggregateAndProof) Signature() (phase0.BLSSignature, err
 
 		return v.Electra.Signature, nil
 	default:
-		return phase0.BLSSignature{}, errors.New("unknown version")
+		return phase0.BLSSignature{}, errors.New("unknown version for signed aggregate and proof")
 	}
 }
 
@@ -208,7 +198,7 @@ func (v *VersionedSignedAggregateAndProof) Slot() (phase0.Slot, error) {
 
 		return v.Electra.Message.Aggregate.Data.Slot, nil
 	default:
-		return 0, errors.New("unknown version")
+		return 0, errors.New("unknown version for signed aggregate and proof")
 	}
 }
 
@@ -15,6 +15,8 @@ package spec
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/attestantio/go-eth2-client/spec/electra"
 
@@ -206,6 +208,85 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockNumber() (uint64, error) {
 	}
 }
 
+// BlobGasUsed returns the blob gas used by the execution payload of the beacon block.
+func (v *VersionedSignedBeaconBlock) BlobGasUsed() (uint64, error) {
+	payload, err := v.blobExecutionPayload()
+	if err != nil {
+		return 0, err
+	}
+
+	return payload.BlobGasUsed, nil
+}
+
+// ExcessBlobGas returns the excess blob gas of the execution payload of the beacon block.
+func (v *VersionedSignedBeaconBlock) ExcessBlobGas() (uint64, error) {
+	payload, err := v.blobExecutionPayload()
+	if err != nil {
+		return 0, err
+	}
+
+	return payload.ExcessBlobGas, nil
+}
+
+// BlobBaseFee returns the base fee per unit of blob gas, in wei, of the beacon block.
+func (v *VersionedSignedBeaconBlock) BlobBaseFee() (*big.Int, error) {
+	payload, err := v.blobExecutionPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.BlobBaseFee(v.blobBaseFeeUpdateFraction()), nil
+}
+
+// BlobFee returns the total blob fee, in wei, paid by the beacon block.
+func (v *VersionedSignedBeaconBlock) BlobFee() (*big.Int, error) {
+	payload, err := v.blobExecutionPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.BlobFee(v.blobBaseFeeUpdateFraction()), nil
+}
+
+// blobExecutionPayload returns the execution payload of the beacon block for
+// versions that support blobs.
+func (v *VersionedSignedBeaconBlock) blobExecutionPayload() (*deneb.ExecutionPayload, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella:
+		return nil, fmt.Errorf("%s block does not have blob gas", v.Version)
+	case DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Body == nil ||
+			v.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return v.Deneb.Message.Body.ExecutionPayload, nil
+	case DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil ||
+			v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// blobBaseFeeUpdateFraction returns the blob base fee update fraction in force
+// for the version of the beacon block.
+func (v *VersionedSignedBeaconBlock) blobBaseFeeUpdateFraction() uint64 {
+	if v.Version >= DataVersionElectra {
+		return electra.BlobBaseFeeUpdateFraction
+	}
+
+	return deneb.BlobBaseFeeUpdateFraction
+}
+
 // ExecutionTransactions returns the execution payload transactions for the block.
 func (v *VersionedSignedBeaconBlock) ExecutionTransactions() ([]bellatrix.Transaction, error) {
 	switch v.Version {
@@ -1086,3 +1167,18 @@ func (v *VersionedSignedBeaconBlock) String() string {
 		return "unknown version"
 	}
 }
+
+// Short returns a short string version of the block suitable for logging,
+// containing its version, slot and a prefix of its root.
+func (v *VersionedSignedBeaconBlock) Short() string {
+	slot, err := v.Slot()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	root, err := v.Root()
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return fmt.Sprintf("%s block slot %d root %#x", v.Version, slot, root[:4])
+}
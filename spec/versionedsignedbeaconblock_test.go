@@ -0,0 +1,129 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedSignedBeaconBlockBlobGas(t *testing.T) {
+	payload := &deneb.ExecutionPayload{
+		BlobGasUsed:   131072,
+		ExcessBlobGas: 10 * deneb.BlobBaseFeeUpdateFraction,
+	}
+
+	tests := []struct {
+		name     string
+		block    *spec.VersionedSignedBeaconBlock
+		baseFee  *big.Int
+		errorStr string
+	}{
+		{
+			name: "Capella",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.SignedBeaconBlock{},
+			},
+			errorStr: "capella block does not have blob gas",
+		},
+		{
+			name: "DenebMissing",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionDeneb,
+				Deneb:   &deneb.SignedBeaconBlock{},
+			},
+			errorStr: "no deneb block",
+		},
+		{
+			name: "Deneb",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionDeneb,
+				Deneb: &deneb.SignedBeaconBlock{
+					Message: &deneb.BeaconBlock{
+						Body: &deneb.BeaconBlockBody{ExecutionPayload: payload},
+					},
+				},
+			},
+			baseFee: big.NewInt(22026),
+		},
+		{
+			name: "Electra",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionElectra,
+				Electra: &electra.SignedBeaconBlock{
+					Message: &electra.BeaconBlock{
+						Body: &electra.BeaconBlockBody{ExecutionPayload: payload},
+					},
+				},
+			},
+			baseFee: big.NewInt(785),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			baseFee, err := test.block.BlobBaseFee()
+			if test.errorStr != "" {
+				require.EqualError(t, err, test.errorStr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, test.baseFee.Cmp(baseFee))
+
+			blobGasUsed, err := test.block.BlobGasUsed()
+			require.NoError(t, err)
+			require.Equal(t, payload.BlobGasUsed, blobGasUsed)
+
+			excessBlobGas, err := test.block.ExcessBlobGas()
+			require.NoError(t, err)
+			require.Equal(t, payload.ExcessBlobGas, excessBlobGas)
+
+			fee, err := test.block.BlobFee()
+			require.NoError(t, err)
+			require.Equal(t, 0, new(big.Int).Mul(test.baseFee, big.NewInt(131072)).Cmp(fee))
+		})
+	}
+}
+
+func TestVersionedSignedBeaconBlockShort(t *testing.T) {
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:          12345,
+				ProposerIndex: 1,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+				},
+			},
+		},
+	}
+	root, err := block.Root()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("phase0 block slot 12345 root %#x", root[:4]), block.Short())
+
+	require.Equal(t, "ERR: no phase0 block", (&spec.VersionedSignedBeaconBlock{Version: spec.DataVersionPhase0}).Short())
+}
@@ -0,0 +1,110 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package subnets calculates the gossip subnets for attestations and sync
+// committee messages.
+package subnets
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Calculator calculates subnets.
+type Calculator struct {
+	config *Config
+}
+
+// New creates a new subnet calculator.
+func New(config *Config) (*Calculator, error) {
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return &Calculator{
+		config: config,
+	}, nil
+}
+
+// AttestationSubnet returns the subnet for an attestation by the given
+// committee at the given slot, where committeesPerSlot is the number of
+// committees at the slot.
+func (c *Calculator) AttestationSubnet(committeesPerSlot uint64,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	uint64,
+	error,
+) {
+	if uint64(committeeIndex) >= committeesPerSlot {
+		return 0, fmt.Errorf("committee index %d not below committees per slot %d", committeeIndex, committeesPerSlot)
+	}
+
+	slotsSinceEpochStart := uint64(slot) % c.config.SlotsPerEpoch
+	committeesSinceEpochStart := committeesPerSlot * slotsSinceEpochStart
+
+	return (committeesSinceEpochStart + uint64(committeeIndex)) % c.config.AttestationSubnetCount, nil
+}
+
+// AttesterDutySubnet returns the subnet for attestations made for the given
+// duty.
+func (c *Calculator) AttesterDutySubnet(duty *apiv1.AttesterDuty) (uint64, error) {
+	if duty == nil {
+		return 0, errors.New("no duty supplied")
+	}
+
+	return c.AttestationSubnet(duty.CommitteesAtSlot, duty.Slot, duty.CommitteeIndex)
+}
+
+// SyncCommitteeSubnet returns the subnet for messages by the member of the
+// sync committee at the given index.
+func (c *Calculator) SyncCommitteeSubnet(syncCommitteeIndex phase0.CommitteeIndex) (uint64, error) {
+	if uint64(syncCommitteeIndex) >= c.config.SyncCommitteeSize {
+		return 0, fmt.Errorf("sync committee index %d not below sync committee size %d", syncCommitteeIndex, c.config.SyncCommitteeSize)
+	}
+
+	return uint64(syncCommitteeIndex) / (c.config.SyncCommitteeSize / c.config.SyncCommitteeSubnetCount), nil
+}
+
+// SyncCommitteeDutySubnets returns the subnets for messages made for the
+// given duty, in increasing order.  A validator can appear in the sync
+// committee more than once, so can have more than one subnet.
+func (c *Calculator) SyncCommitteeDutySubnets(duty *apiv1.SyncCommitteeDuty) ([]uint64, error) {
+	if duty == nil {
+		return nil, errors.New("no duty supplied")
+	}
+
+	subnets := make(map[uint64]struct{}, len(duty.ValidatorSyncCommitteeIndices))
+	for _, index := range duty.ValidatorSyncCommitteeIndices {
+		subnet, err := c.SyncCommitteeSubnet(index)
+		if err != nil {
+			return nil, err
+		}
+		subnets[subnet] = struct{}{}
+	}
+
+	res := make([]uint64, 0, len(subnets))
+	for subnet := range subnets {
+		res = append(res, subnet)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res, nil
+}
@@ -0,0 +1,240 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subnets_test
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/subnets"
+	"github.com/stretchr/testify/require"
+)
+
+func mainnetCalculator(t *testing.T) *subnets.Calculator {
+	t.Helper()
+
+	config, err := subnets.ConfigFromSpec(map[string]any{
+		"SLOTS_PER_EPOCH":     uint64(32),
+		"SYNC_COMMITTEE_SIZE": uint64(512),
+	})
+	require.NoError(t, err)
+	calculator, err := subnets.New(config)
+	require.NoError(t, err)
+
+	return calculator
+}
+
+func TestConfigFromSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     map[string]any
+		expected *subnets.Config
+		err      string
+	}{
+		{
+			name: "Defaults",
+			spec: map[string]any{
+				"SLOTS_PER_EPOCH":     uint64(32),
+				"SYNC_COMMITTEE_SIZE": uint64(512),
+			},
+			expected: &subnets.Config{
+				SlotsPerEpoch:            32,
+				SyncCommitteeSize:        512,
+				AttestationSubnetCount:   64,
+				SyncCommitteeSubnetCount: 4,
+			},
+		},
+		{
+			name: "Supplied",
+			spec: map[string]any{
+				"SLOTS_PER_EPOCH":             uint64(8),
+				"SYNC_COMMITTEE_SIZE":         uint64(32),
+				"ATTESTATION_SUBNET_COUNT":    uint64(16),
+				"SYNC_COMMITTEE_SUBNET_COUNT": uint64(2),
+			},
+			expected: &subnets.Config{
+				SlotsPerEpoch:            8,
+				SyncCommitteeSize:        32,
+				AttestationSubnetCount:   16,
+				SyncCommitteeSubnetCount: 2,
+			},
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			spec: map[string]any{
+				"SYNC_COMMITTEE_SIZE": uint64(512),
+			},
+			err: "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "SyncCommitteeSizeWrongType",
+			spec: map[string]any{
+				"SLOTS_PER_EPOCH":     uint64(32),
+				"SYNC_COMMITTEE_SIZE": "512",
+			},
+			err: "SYNC_COMMITTEE_SIZE of unexpected type",
+		},
+		{
+			name: "SyncCommitteeTooSmall",
+			spec: map[string]any{
+				"SLOTS_PER_EPOCH":     uint64(32),
+				"SYNC_COMMITTEE_SIZE": uint64(2),
+			},
+			err: "SYNC_COMMITTEE_SIZE cannot be less than SYNC_COMMITTEE_SUBNET_COUNT",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := subnets.ConfigFromSpec(test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, config)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := subnets.New(nil)
+	require.EqualError(t, err, "no config supplied")
+
+	_, err = subnets.New(&subnets.Config{SlotsPerEpoch: 32, SyncCommitteeSize: 512})
+	require.EqualError(t, err, "ATTESTATION_SUBNET_COUNT cannot be 0")
+}
+
+func TestAttestationSubnet(t *testing.T) {
+	calculator := mainnetCalculator(t)
+
+	tests := []struct {
+		name              string
+		committeesPerSlot uint64
+		slot              phase0.Slot
+		committeeIndex    phase0.CommitteeIndex
+		expected          uint64
+		err               string
+	}{
+		{
+			name:              "First",
+			committeesPerSlot: 64,
+			slot:              0,
+			committeeIndex:    0,
+			expected:          0,
+		},
+		{
+			name:              "FullCommittees",
+			committeesPerSlot: 64,
+			slot:              32 + 1,
+			committeeIndex:    3,
+			expected:          3,
+		},
+		{
+			name:              "FewCommittees",
+			committeesPerSlot: 10,
+			slot:              2,
+			committeeIndex:    5,
+			expected:          25,
+		},
+		{
+			name:              "Wrap",
+			committeesPerSlot: 4,
+			slot:              31,
+			committeeIndex:    3,
+			expected:          63,
+		},
+		{
+			name:              "IndexTooHigh",
+			committeesPerSlot: 4,
+			slot:              31,
+			committeeIndex:    4,
+			err:               "committee index 4 not below committees per slot 4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subnet, err := calculator.AttestationSubnet(test.committeesPerSlot, test.slot, test.committeeIndex)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, subnet)
+			}
+		})
+	}
+}
+
+func TestAttesterDutySubnet(t *testing.T) {
+	calculator := mainnetCalculator(t)
+
+	subnet, err := calculator.AttesterDutySubnet(&apiv1.AttesterDuty{
+		Slot:             2,
+		CommitteeIndex:   5,
+		CommitteesAtSlot: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), subnet)
+
+	_, err = calculator.AttesterDutySubnet(nil)
+	require.EqualError(t, err, "no duty supplied")
+}
+
+func TestSyncCommitteeSubnets(t *testing.T) {
+	calculator := mainnetCalculator(t)
+
+	tests := []struct {
+		name     string
+		indices  []phase0.CommitteeIndex
+		expected []uint64
+		err      string
+	}{
+		{
+			name:     "None",
+			indices:  []phase0.CommitteeIndex{},
+			expected: []uint64{},
+		},
+		{
+			name:     "Single",
+			indices:  []phase0.CommitteeIndex{128},
+			expected: []uint64{1},
+		},
+		{
+			name:     "Boundaries",
+			indices:  []phase0.CommitteeIndex{511, 0, 127, 384},
+			expected: []uint64{0, 3},
+		},
+		{
+			name:    "OutOfRange",
+			indices: []phase0.CommitteeIndex{512},
+			err:     "sync committee index 512 not below sync committee size 512",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subnets, err := calculator.SyncCommitteeDutySubnets(&apiv1.SyncCommitteeDuty{
+				ValidatorSyncCommitteeIndices: test.indices,
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, subnets)
+			}
+		})
+	}
+}
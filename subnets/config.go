@@ -0,0 +1,79 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subnets
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+const (
+	// defaultAttestationSubnetCount is the number of attestation subnets
+	// defined by the networking specification.
+	defaultAttestationSubnetCount = 64
+	// defaultSyncCommitteeSubnetCount is the number of sync committee
+	// subnets defined by the networking specification.
+	defaultSyncCommitteeSubnetCount = 4
+)
+
+// Config contains the chain configuration values used for calculation.
+type Config struct {
+	SlotsPerEpoch            uint64
+	SyncCommitteeSize        uint64
+	AttestationSubnetCount   uint64
+	SyncCommitteeSubnetCount uint64
+}
+
+// ConfigFromSpec creates a configuration from the values returned by a spec
+// provider.  The subnet counts are not returned by all beacon nodes, so the
+// values from the networking specification are used if they are absent.
+func ConfigFromSpec(data map[string]any) (*Config, error) {
+	config := &Config{
+		AttestationSubnetCount:   defaultAttestationSubnetCount,
+		SyncCommitteeSubnetCount: defaultSyncCommitteeSubnetCount,
+	}
+
+	values := []spec.Uint64Value{
+		{Key: "SLOTS_PER_EPOCH", Apply: func(v uint64) { config.SlotsPerEpoch = v }},
+		{Key: "SYNC_COMMITTEE_SIZE", Apply: func(v uint64) { config.SyncCommitteeSize = v }},
+		{Key: "ATTESTATION_SUBNET_COUNT", Optional: true, Apply: func(v uint64) { config.AttestationSubnetCount = v }},
+		{Key: "SYNC_COMMITTEE_SUBNET_COUNT", Optional: true, Apply: func(v uint64) { config.SyncCommitteeSubnetCount = v }},
+	}
+	if err := spec.ReadUint64Values(data, values); err != nil {
+		return nil, err
+	}
+
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check ensures that values used as divisors are present.
+func (c *Config) check() error {
+	switch {
+	case c.SlotsPerEpoch == 0:
+		return errors.New("SLOTS_PER_EPOCH cannot be 0")
+	case c.AttestationSubnetCount == 0:
+		return errors.New("ATTESTATION_SUBNET_COUNT cannot be 0")
+	case c.SyncCommitteeSubnetCount == 0:
+		return errors.New("SYNC_COMMITTEE_SUBNET_COUNT cannot be 0")
+	case c.SyncCommitteeSize < c.SyncCommitteeSubnetCount:
+		return errors.New("SYNC_COMMITTEE_SIZE cannot be less than SYNC_COMMITTEE_SUBNET_COUNT")
+	}
+
+	return nil
+}
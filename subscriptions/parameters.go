@@ -0,0 +1,182 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                              zerolog.Level
+	genesisProvider                       consensusclient.GenesisProvider
+	specProvider                          consensusclient.SpecProvider
+	attesterDutiesProvider                consensusclient.AttesterDutiesProvider
+	beaconCommitteeSubscriptionsSubmitter consensusclient.BeaconCommitteeSubscriptionsSubmitter
+	syncCommitteeDutiesProvider           consensusclient.SyncCommitteeDutiesProvider
+	syncCommitteeSubscriptionsSubmitter   consensusclient.SyncCommitteeSubscriptionsSubmitter
+	aggregatorFunc                        AggregatorFunc
+	validatorIndices                      []phase0.ValidatorIndex
+	retries                               int
+	retryInterval                         time.Duration
+	clock                                 clock.Clock
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithGenesisProvider sets the genesis provider.
+func WithGenesisProvider(provider consensusclient.GenesisProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisProvider = provider
+	})
+}
+
+// WithSpecProvider sets the spec provider.
+func WithSpecProvider(provider consensusclient.SpecProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specProvider = provider
+	})
+}
+
+// WithAttesterDutiesProvider sets the attester duties provider.
+func WithAttesterDutiesProvider(provider consensusclient.AttesterDutiesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.attesterDutiesProvider = provider
+	})
+}
+
+// WithBeaconCommitteeSubscriptionsSubmitter sets the beacon committee subscriptions submitter.
+func WithBeaconCommitteeSubscriptionsSubmitter(submitter consensusclient.BeaconCommitteeSubscriptionsSubmitter) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteeSubscriptionsSubmitter = submitter
+	})
+}
+
+// WithSyncCommitteeDutiesProvider sets the sync committee duties provider.
+// If this and a sync committee subscriptions submitter are supplied then
+// sync committee subscriptions are also managed.
+func WithSyncCommitteeDutiesProvider(provider consensusclient.SyncCommitteeDutiesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncCommitteeDutiesProvider = provider
+	})
+}
+
+// WithSyncCommitteeSubscriptionsSubmitter sets the sync committee subscriptions submitter.
+func WithSyncCommitteeSubscriptionsSubmitter(submitter consensusclient.SyncCommitteeSubscriptionsSubmitter) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncCommitteeSubscriptionsSubmitter = submitter
+	})
+}
+
+// WithAggregatorFunc sets the function used to decide if a validator is an
+// aggregator for an attester duty.  If not supplied, no validator is
+// considered to be an aggregator.
+func WithAggregatorFunc(aggregatorFunc AggregatorFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.aggregatorFunc = aggregatorFunc
+	})
+}
+
+// WithValidatorIndices sets the initial indices of the validators for which
+// subscriptions are managed.
+func WithValidatorIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorIndices = indices
+	})
+}
+
+// WithRetries sets the number of times a failed subscription is retried.
+func WithRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retries = retries
+	})
+}
+
+// WithRetryInterval sets the interval between retries of a failed subscription.
+func WithRetryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryInterval = interval
+	})
+}
+
+// WithClock sets the clock used to schedule renewals and wait between retries.  Defaults to the system clock.
+func WithClock(clock clock.Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		clock:         clock.System(),
+		logLevel:      zerolog.GlobalLevel(),
+		retries:       3,
+		retryInterval: time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.genesisProvider == nil {
+		return nil, errors.New("no genesis provider specified")
+	}
+	if parameters.specProvider == nil {
+		return nil, errors.New("no spec provider specified")
+	}
+	if parameters.attesterDutiesProvider == nil {
+		return nil, errors.New("no attester duties provider specified")
+	}
+	if parameters.beaconCommitteeSubscriptionsSubmitter == nil {
+		return nil, errors.New("no beacon committee subscriptions submitter specified")
+	}
+	if (parameters.syncCommitteeDutiesProvider == nil) != (parameters.syncCommitteeSubscriptionsSubmitter == nil) {
+		return nil, errors.New("sync committee duties provider and subscriptions submitter must be supplied together")
+	}
+	if parameters.retries < 0 {
+		return nil, errors.New("retries cannot be negative")
+	}
+	if parameters.retryInterval <= 0 {
+		return nil, errors.New("no retry interval specified")
+	}
+
+	if parameters.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,328 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package subscriptions manages beacon committee and sync committee
+// subscriptions for a set of validators, renewing them each epoch and
+// sync committee period respectively.
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/clock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// AggregatorFunc is the definition for a function that decides if a
+// validator is an aggregator for an attester duty.  This generally requires
+// a selection proof signed by the validator.
+type AggregatorFunc func(ctx context.Context, duty *apiv1.AttesterDuty) (bool, error)
+
+// Service manages subscriptions.
+type Service struct {
+	log                                   zerolog.Logger
+	attesterDutiesProvider                consensusclient.AttesterDutiesProvider
+	beaconCommitteeSubscriptionsSubmitter consensusclient.BeaconCommitteeSubscriptionsSubmitter
+	syncCommitteeDutiesProvider           consensusclient.SyncCommitteeDutiesProvider
+	syncCommitteeSubscriptionsSubmitter   consensusclient.SyncCommitteeSubscriptionsSubmitter
+	aggregatorFunc                        AggregatorFunc
+	retries                               int
+	retryInterval                         time.Duration
+	clock                                 clock.Clock
+
+	genesisTime     time.Time
+	slotDuration    time.Duration
+	slotsPerEpoch   uint64
+	epochsPerPeriod uint64
+
+	validatorIndicesMu sync.RWMutex
+	validatorIndices   []phase0.ValidatorIndex
+}
+
+// New creates a new subscription manager.  The manager runs until the
+// supplied context is canceled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "subscriptions").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:                                   log,
+		attesterDutiesProvider:                parameters.attesterDutiesProvider,
+		beaconCommitteeSubscriptionsSubmitter: parameters.beaconCommitteeSubscriptionsSubmitter,
+		syncCommitteeDutiesProvider:           parameters.syncCommitteeDutiesProvider,
+		syncCommitteeSubscriptionsSubmitter:   parameters.syncCommitteeSubscriptionsSubmitter,
+		aggregatorFunc:                        parameters.aggregatorFunc,
+		retries:                               parameters.retries,
+		retryInterval:                         parameters.retryInterval,
+		clock:                                 parameters.clock,
+		validatorIndices:                      parameters.validatorIndices,
+	}
+
+	if err := s.obtainChainInfo(ctx, parameters.genesisProvider, parameters.specProvider); err != nil {
+		return nil, err
+	}
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// SetValidatorIndices sets the indices of the validators for which
+// subscriptions are managed.  The new set takes effect from the next renewal.
+func (s *Service) SetValidatorIndices(indices []phase0.ValidatorIndex) {
+	s.validatorIndicesMu.Lock()
+	s.validatorIndices = indices
+	s.validatorIndicesMu.Unlock()
+}
+
+// SubscribeBeaconCommittees subscribes the validators to the beacon
+// committees for their attester duties in the given epoch.
+func (s *Service) SubscribeBeaconCommittees(ctx context.Context, epoch phase0.Epoch) error {
+	indices := s.currentValidatorIndices()
+	if len(indices) == 0 {
+		return nil
+	}
+
+	return s.withRetries(ctx, func(ctx context.Context) error {
+		dutiesResponse, err := s.attesterDutiesProvider.AttesterDuties(ctx, &api.AttesterDutiesOpts{
+			Epoch:   epoch,
+			Indices: indices,
+		})
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain attester duties"), err)
+		}
+		if len(dutiesResponse.Data) == 0 {
+			return nil
+		}
+
+		subscriptions := make([]*apiv1.BeaconCommitteeSubscription, 0, len(dutiesResponse.Data))
+		for _, duty := range dutiesResponse.Data {
+			subscriptions = append(subscriptions, &apiv1.BeaconCommitteeSubscription{
+				ValidatorIndex:   duty.ValidatorIndex,
+				Slot:             duty.Slot,
+				CommitteeIndex:   duty.CommitteeIndex,
+				CommitteesAtSlot: duty.CommitteesAtSlot,
+				IsAggregator:     s.isAggregator(ctx, duty),
+			})
+		}
+
+		if err := s.beaconCommitteeSubscriptionsSubmitter.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions); err != nil {
+			return errors.Join(errors.New("failed to submit beacon committee subscriptions"), err)
+		}
+		s.log.Trace().Uint64("epoch", uint64(epoch)).Int("subscriptions", len(subscriptions)).Msg("Subscribed to beacon committees")
+
+		return nil
+	})
+}
+
+// SubscribeSyncCommittees subscribes the validators to the sync committee
+// for the sync committee period containing the given epoch.
+func (s *Service) SubscribeSyncCommittees(ctx context.Context, epoch phase0.Epoch) error {
+	if s.syncCommitteeDutiesProvider == nil {
+		return errors.New("sync committee subscriptions not configured")
+	}
+	indices := s.currentValidatorIndices()
+	if len(indices) == 0 {
+		return nil
+	}
+
+	untilEpoch := s.periodStartEpoch(epoch) + phase0.Epoch(s.epochsPerPeriod)
+
+	return s.withRetries(ctx, func(ctx context.Context) error {
+		dutiesResponse, err := s.syncCommitteeDutiesProvider.SyncCommitteeDuties(ctx, &api.SyncCommitteeDutiesOpts{
+			Epoch:   epoch,
+			Indices: indices,
+		})
+		if err != nil {
+			return errors.Join(errors.New("failed to obtain sync committee duties"), err)
+		}
+		if len(dutiesResponse.Data) == 0 {
+			return nil
+		}
+
+		subscriptions := make([]*apiv1.SyncCommitteeSubscription, 0, len(dutiesResponse.Data))
+		for _, duty := range dutiesResponse.Data {
+			subscriptions = append(subscriptions, &apiv1.SyncCommitteeSubscription{
+				ValidatorIndex:       duty.ValidatorIndex,
+				SyncCommitteeIndices: duty.ValidatorSyncCommitteeIndices,
+				UntilEpoch:           untilEpoch,
+			})
+		}
+
+		if err := s.syncCommitteeSubscriptionsSubmitter.SubmitSyncCommitteeSubscriptions(ctx, subscriptions); err != nil {
+			return errors.Join(errors.New("failed to submit sync committee subscriptions"), err)
+		}
+		s.log.Trace().Uint64("until_epoch", uint64(untilEpoch)).Int("subscriptions", len(subscriptions)).Msg("Subscribed to sync committees")
+
+		return nil
+	})
+}
+
+// run renews subscriptions at the start of each epoch.
+func (s *Service) run(ctx context.Context) {
+	epoch := s.currentEpoch()
+	s.renew(ctx, epoch, true)
+	for {
+		epoch++
+		select {
+		case <-ctx.Done():
+			s.log.Trace().Msg("Context done; stopping")
+
+			return
+		case <-s.clock.After(s.epochStart(epoch).Sub(s.clock.Now())):
+			s.renew(ctx, epoch, false)
+		}
+	}
+}
+
+// renew renews subscriptions at the start of an epoch.  Beacon committee
+// subscriptions are made an epoch ahead, and sync committee subscriptions
+// are made in the last epoch of the preceding period.
+func (s *Service) renew(ctx context.Context, epoch phase0.Epoch, first bool) {
+	if first {
+		if err := s.SubscribeBeaconCommittees(ctx, epoch); err != nil {
+			s.log.Warn().Err(err).Uint64("epoch", uint64(epoch)).Msg("Failed to subscribe to beacon committees")
+		}
+	}
+	if err := s.SubscribeBeaconCommittees(ctx, epoch+1); err != nil {
+		s.log.Warn().Err(err).Uint64("epoch", uint64(epoch+1)).Msg("Failed to subscribe to beacon committees")
+	}
+
+	if s.syncCommitteeDutiesProvider == nil {
+		return
+	}
+	if first {
+		if err := s.SubscribeSyncCommittees(ctx, epoch); err != nil {
+			s.log.Warn().Err(err).Uint64("epoch", uint64(epoch)).Msg("Failed to subscribe to sync committees")
+		}
+	}
+	if s.periodStartEpoch(epoch+1) == epoch+1 {
+		if err := s.SubscribeSyncCommittees(ctx, epoch+1); err != nil {
+			s.log.Warn().Err(err).Uint64("epoch", uint64(epoch+1)).Msg("Failed to subscribe to sync committees")
+		}
+	}
+}
+
+// withRetries calls the function, retrying on failure.
+func (s *Service) withRetries(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt <= s.retries; attempt++ {
+		if attempt > 0 {
+			s.log.Debug().Err(err).Int("attempt", attempt).Msg("Retrying")
+			select {
+			case <-ctx.Done():
+				return errors.Join(ctx.Err(), err)
+			case <-s.clock.After(s.retryInterval):
+			}
+		}
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// isAggregator returns true if the validator is an aggregator for the duty.
+func (s *Service) isAggregator(ctx context.Context, duty *apiv1.AttesterDuty) bool {
+	if s.aggregatorFunc == nil {
+		return false
+	}
+
+	isAggregator, err := s.aggregatorFunc(ctx, duty)
+	if err != nil {
+		s.log.Debug().Err(err).Uint64("validator_index", uint64(duty.ValidatorIndex)).Msg("Failed to calculate aggregator status")
+
+		return false
+	}
+
+	return isAggregator
+}
+
+func (s *Service) currentValidatorIndices() []phase0.ValidatorIndex {
+	s.validatorIndicesMu.RLock()
+	defer s.validatorIndicesMu.RUnlock()
+
+	return s.validatorIndices
+}
+
+// obtainChainInfo obtains the information about the chain required for timing.
+func (s *Service) obtainChainInfo(ctx context.Context,
+	genesisProvider consensusclient.GenesisProvider,
+	specProvider consensusclient.SpecProvider,
+) error {
+	genesisResponse, err := genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain genesis"), err)
+	}
+	s.genesisTime = genesisResponse.Data.GenesisTime
+
+	specResponse, err := specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain spec"), err)
+	}
+
+	var isCorrectType bool
+	s.slotDuration, isCorrectType = specResponse.Data["SECONDS_PER_SLOT"].(time.Duration)
+	if !isCorrectType || s.slotDuration == 0 {
+		return errors.New("SECONDS_PER_SLOT not found in spec")
+	}
+	s.slotsPerEpoch, isCorrectType = specResponse.Data["SLOTS_PER_EPOCH"].(uint64)
+	if !isCorrectType || s.slotsPerEpoch == 0 {
+		return errors.New("SLOTS_PER_EPOCH not found in spec")
+	}
+	if s.syncCommitteeDutiesProvider != nil {
+		s.epochsPerPeriod, isCorrectType = specResponse.Data["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"].(uint64)
+		if !isCorrectType || s.epochsPerPeriod == 0 {
+			return errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD not found in spec")
+		}
+	}
+
+	return nil
+}
+
+// currentEpoch returns the current epoch.
+func (s *Service) currentEpoch() phase0.Epoch {
+	now := s.clock.Now()
+	if now.Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Epoch(uint64(now.Sub(s.genesisTime)/s.slotDuration) / s.slotsPerEpoch)
+}
+
+// epochStart returns the start time of the given epoch.
+func (s *Service) epochStart(epoch phase0.Epoch) time.Time {
+	return s.genesisTime.Add(time.Duration(uint64(epoch)*s.slotsPerEpoch) * s.slotDuration)
+}
+
+// periodStartEpoch returns the first epoch of the sync committee period containing the given epoch.
+func (s *Service) periodStartEpoch(epoch phase0.Epoch) phase0.Epoch {
+	return epoch - epoch%phase0.Epoch(s.epochsPerPeriod)
+}
@@ -0,0 +1,351 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscriptions_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/subscriptions"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testSubmitter records submitted subscriptions, failing the first failures submissions.
+type testSubmitter struct {
+	mu                           sync.Mutex
+	failures                     int
+	beaconCommitteeSubscriptions [][]*apiv1.BeaconCommitteeSubscription
+	syncCommitteeSubscriptions   [][]*apiv1.SyncCommitteeSubscription
+}
+
+func (s *testSubmitter) SubmitBeaconCommitteeSubscriptions(_ context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+
+		return errors.New("submission failed")
+	}
+	s.beaconCommitteeSubscriptions = append(s.beaconCommitteeSubscriptions, subscriptions)
+
+	return nil
+}
+
+func (s *testSubmitter) SubmitSyncCommitteeSubscriptions(_ context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+
+		return errors.New("submission failed")
+	}
+	s.syncCommitteeSubscriptions = append(s.syncCommitteeSubscriptions, subscriptions)
+
+	return nil
+}
+
+func (s *testSubmitter) submissions() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.beaconCommitteeSubscriptions), len(s.syncCommitteeSubscriptions)
+}
+
+func testClient(t *testing.T) *mock.Service {
+	t.Helper()
+
+	client, err := mock.New(context.Background(), mock.WithGenesisTime(time.Now().Add(-time.Hour)))
+	require.NoError(t, err)
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"SECONDS_PER_SLOT":                 12 * time.Second,
+				"SLOTS_PER_EPOCH":                  uint64(32),
+				"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(256),
+			},
+		}, nil
+	}
+	client.AttesterDutiesFunc = func(_ context.Context, opts *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error) {
+		duties := make([]*apiv1.AttesterDuty, 0, len(opts.Indices))
+		for _, index := range opts.Indices {
+			duties = append(duties, &apiv1.AttesterDuty{
+				ValidatorIndex:   index,
+				Slot:             phase0.Slot(uint64(opts.Epoch) * 32),
+				CommitteeIndex:   phase0.CommitteeIndex(index),
+				CommitteesAtSlot: 4,
+			})
+		}
+
+		return &api.Response[[]*apiv1.AttesterDuty]{Data: duties}, nil
+	}
+	client.SyncCommitteeDutiesFunc = func(_ context.Context, opts *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error) {
+		return &api.Response[[]*apiv1.SyncCommitteeDuty]{
+			Data: []*apiv1.SyncCommitteeDuty{
+				{
+					ValidatorIndex:                opts.Indices[0],
+					ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{5},
+				},
+			},
+		}, nil
+	}
+
+	return client
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := testClient(t)
+	submitter := &testSubmitter{}
+
+	tests := []struct {
+		name   string
+		params []subscriptions.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisProviderMissing",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithSpecProvider(client),
+				subscriptions.WithAttesterDutiesProvider(client),
+				subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+			},
+			err: "problem with parameters\nno genesis provider specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithGenesisProvider(client),
+				subscriptions.WithAttesterDutiesProvider(client),
+				subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+			},
+			err: "problem with parameters\nno spec provider specified",
+		},
+		{
+			name: "AttesterDutiesProviderMissing",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithGenesisProvider(client),
+				subscriptions.WithSpecProvider(client),
+				subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+			},
+			err: "problem with parameters\nno attester duties provider specified",
+		},
+		{
+			name: "BeaconCommitteeSubscriptionsSubmitterMissing",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithGenesisProvider(client),
+				subscriptions.WithSpecProvider(client),
+				subscriptions.WithAttesterDutiesProvider(client),
+			},
+			err: "problem with parameters\nno beacon committee subscriptions submitter specified",
+		},
+		{
+			name: "SyncCommitteeSubmitterMissing",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithGenesisProvider(client),
+				subscriptions.WithSpecProvider(client),
+				subscriptions.WithAttesterDutiesProvider(client),
+				subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+				subscriptions.WithSyncCommitteeDutiesProvider(client),
+			},
+			err: "problem with parameters\nsync committee duties provider and subscriptions submitter must be supplied together",
+		},
+		{
+			name: "RetriesNegative",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithGenesisProvider(client),
+				subscriptions.WithSpecProvider(client),
+				subscriptions.WithAttesterDutiesProvider(client),
+				subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+				subscriptions.WithRetries(-1),
+			},
+			err: "problem with parameters\nretries cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []subscriptions.Parameter{
+				subscriptions.WithLogLevel(zerolog.Disabled),
+				subscriptions.WithGenesisProvider(client),
+				subscriptions.WithSpecProvider(client),
+				subscriptions.WithAttesterDutiesProvider(client),
+				subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+				subscriptions.WithSyncCommitteeDutiesProvider(client),
+				subscriptions.WithSyncCommitteeSubscriptionsSubmitter(submitter),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := subscriptions.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInitialSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := testClient(t)
+	submitter := &testSubmitter{}
+
+	_, err := subscriptions.New(ctx,
+		subscriptions.WithLogLevel(zerolog.Disabled),
+		subscriptions.WithGenesisProvider(client),
+		subscriptions.WithSpecProvider(client),
+		subscriptions.WithAttesterDutiesProvider(client),
+		subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+		subscriptions.WithSyncCommitteeDutiesProvider(client),
+		subscriptions.WithSyncCommitteeSubscriptionsSubmitter(submitter),
+		subscriptions.WithValidatorIndices([]phase0.ValidatorIndex{1, 2}),
+	)
+	require.NoError(t, err)
+
+	// Expect subscriptions for the current and next epoch, and the current sync committee period.
+	require.Eventually(t, func() bool {
+		beaconCommitteeSubmissions, syncCommitteeSubmissions := submitter.submissions()
+
+		return beaconCommitteeSubmissions == 2 && syncCommitteeSubmissions == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestSubscribeBeaconCommittees(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := testClient(t)
+
+	// No validators, so no subscriptions.
+	submitter := &testSubmitter{}
+	s, err := subscriptions.New(ctx,
+		subscriptions.WithLogLevel(zerolog.Disabled),
+		subscriptions.WithGenesisProvider(client),
+		subscriptions.WithSpecProvider(client),
+		subscriptions.WithAttesterDutiesProvider(client),
+		subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.SubscribeBeaconCommittees(ctx, 10))
+	beaconCommitteeSubmissions, _ := submitter.submissions()
+	require.Equal(t, 0, beaconCommitteeSubmissions)
+
+	submitter = &testSubmitter{}
+	s, err = subscriptions.New(ctx,
+		subscriptions.WithLogLevel(zerolog.Disabled),
+		subscriptions.WithGenesisProvider(client),
+		subscriptions.WithSpecProvider(client),
+		subscriptions.WithAttesterDutiesProvider(client),
+		subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+		subscriptions.WithAggregatorFunc(func(_ context.Context, duty *apiv1.AttesterDuty) (bool, error) {
+			if duty.ValidatorIndex == 3 {
+				return false, errors.New("no selection proof")
+			}
+
+			return duty.ValidatorIndex == 2, nil
+		}),
+		subscriptions.WithValidatorIndices([]phase0.ValidatorIndex{1, 2, 3}),
+		subscriptions.WithRetries(1),
+		subscriptions.WithRetryInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// Wait for the initial subscriptions to complete.
+	require.Eventually(t, func() bool {
+		beaconCommitteeSubmissions, _ := submitter.submissions()
+
+		return beaconCommitteeSubmissions == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// Single failure is retried.
+	submitter.mu.Lock()
+	submitter.failures = 1
+	submitter.mu.Unlock()
+	require.NoError(t, s.SubscribeBeaconCommittees(ctx, 10))
+	submitter.mu.Lock()
+	require.Len(t, submitter.beaconCommitteeSubscriptions, 3)
+	require.Equal(t, []*apiv1.BeaconCommitteeSubscription{
+		{ValidatorIndex: 1, Slot: 320, CommitteeIndex: 1, CommitteesAtSlot: 4},
+		{ValidatorIndex: 2, Slot: 320, CommitteeIndex: 2, CommitteesAtSlot: 4, IsAggregator: true},
+		{ValidatorIndex: 3, Slot: 320, CommitteeIndex: 3, CommitteesAtSlot: 4},
+	}, submitter.beaconCommitteeSubscriptions[2])
+	submitter.mu.Unlock()
+
+	// Repeated failures exhaust retries.
+	submitter.mu.Lock()
+	submitter.failures = 2
+	submitter.mu.Unlock()
+	require.EqualError(t, s.SubscribeBeaconCommittees(ctx, 10), "failed to submit beacon committee subscriptions\nsubmission failed")
+
+	// Sync committees not configured.
+	require.EqualError(t, s.SubscribeSyncCommittees(ctx, 10), "sync committee subscriptions not configured")
+}
+
+func TestSubscribeSyncCommittees(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := testClient(t)
+	submitter := &testSubmitter{}
+
+	s, err := subscriptions.New(ctx,
+		subscriptions.WithLogLevel(zerolog.Disabled),
+		subscriptions.WithGenesisProvider(client),
+		subscriptions.WithSpecProvider(client),
+		subscriptions.WithAttesterDutiesProvider(client),
+		subscriptions.WithBeaconCommitteeSubscriptionsSubmitter(submitter),
+		subscriptions.WithSyncCommitteeDutiesProvider(client),
+		subscriptions.WithSyncCommitteeSubscriptionsSubmitter(submitter),
+		subscriptions.WithValidatorIndices([]phase0.ValidatorIndex{7}),
+	)
+	require.NoError(t, err)
+
+	// Wait for the initial subscriptions to complete.
+	require.Eventually(t, func() bool {
+		_, syncCommitteeSubmissions := submitter.submissions()
+
+		return syncCommitteeSubmissions == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, s.SubscribeSyncCommittees(ctx, 300))
+
+	submitter.mu.Lock()
+	defer submitter.mu.Unlock()
+	require.Len(t, submitter.syncCommitteeSubscriptions, 2)
+	require.Equal(t, []*apiv1.SyncCommitteeSubscription{
+		{ValidatorIndex: 7, SyncCommitteeIndices: []phase0.CommitteeIndex{5}, UntilEpoch: 256},
+	}, submitter.syncCommitteeSubscriptions[0])
+	require.Equal(t, []*apiv1.SyncCommitteeSubscription{
+		{ValidatorIndex: 7, SyncCommitteeIndices: []phase0.CommitteeIndex{5}, UntilEpoch: 512},
+	}, submitter.syncCommitteeSubscriptions[1])
+}
@@ -0,0 +1,126 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synccommitteetracker
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                  zerolog.Level
+	chainTime                 *chaintime.Service
+	specProvider              consensusclient.SpecProvider
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	syncCommitteesProvider    consensusclient.SyncCommitteesProvider
+	validatorIndices          []phase0.ValidatorIndex
+	periodHandler             PeriodHandlerFunc
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithSpecProvider sets the provider from which the sync committee period length is obtained.
+func WithSpecProvider(provider consensusclient.SpecProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specProvider = provider
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the provider from which blocks are scanned for sync aggregates.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithSyncCommitteesProvider sets the provider from which sync committee membership is obtained.
+func WithSyncCommitteesProvider(provider consensusclient.SyncCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncCommitteesProvider = provider
+	})
+}
+
+// WithValidatorIndices sets the validators whose participation is tracked.
+func WithValidatorIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorIndices = indices
+	})
+}
+
+// WithPeriodHandler sets a function to be called with the participation of
+// the tracked validators at the end of each sync committee period.  The
+// handler is called synchronously from ProcessSlot, and must not call back
+// in to the service.
+func WithPeriodHandler(handler PeriodHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.periodHandler = handler
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.specProvider == nil {
+		return nil, errors.New("no spec provider specified")
+	}
+	if parameters.signedBeaconBlockProvider == nil {
+		return nil, errors.New("no signed beacon block provider specified")
+	}
+	if parameters.syncCommitteesProvider == nil {
+		return nil, errors.New("no sync committees provider specified")
+	}
+	if len(parameters.validatorIndices) == 0 {
+		return nil, errors.New("no validator indices specified")
+	}
+
+	return &parameters, nil
+}
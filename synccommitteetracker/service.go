@@ -0,0 +1,228 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package synccommitteetracker tracks the inclusion of sync committee
+// messages from a set of validators in the sync aggregates of blocks,
+// reporting their participation for each sync committee period.
+package synccommitteetracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Participation is the participation of a validator in a sync committee period.
+type Participation struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex phase0.ValidatorIndex
+	// Expected is the number of messages expected from the validator.  This
+	// is the number of blocks processed multiplied by the number of
+	// positions the validator holds in the sync committee.
+	Expected uint64
+	// Included is the number of messages from the validator included in blocks.
+	Included uint64
+}
+
+// Rate returns the proportion of expected messages that were included.
+func (p *Participation) Rate() float64 {
+	if p.Expected == 0 {
+		return 0
+	}
+
+	return float64(p.Included) / float64(p.Expected)
+}
+
+// PeriodReport is the participation of tracked validators in a sync committee period.
+type PeriodReport struct {
+	// Period is the sync committee period.
+	Period uint64
+	// Participation is the participation of tracked validators that are in
+	// the sync committee for the period.
+	Participation map[phase0.ValidatorIndex]*Participation
+}
+
+// PeriodHandlerFunc is the handler for period reports.
+type PeriodHandlerFunc func(ctx context.Context, report *PeriodReport)
+
+// Service tracks sync committee participation.
+type Service struct {
+	log                       zerolog.Logger
+	chainTime                 *chaintime.Service
+	signedBeaconBlockProvider consensusclient.SignedBeaconBlockProvider
+	syncCommitteesProvider    consensusclient.SyncCommitteesProvider
+	validatorIndices          map[phase0.ValidatorIndex]struct{}
+	periodHandler             PeriodHandlerFunc
+	epochsPerPeriod           uint64
+
+	mu sync.Mutex
+	// positions are the positions of tracked validators in the sync committee for the current period.
+	positions map[phase0.ValidatorIndex][]int
+	report    *PeriodReport
+}
+
+// New creates a new sync committee tracker.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "synccommitteetracker").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	specResponse, err := parameters.specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain spec"), err)
+	}
+	epochsPerPeriod, isCorrectType := specResponse.Data["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"].(uint64)
+	if !isCorrectType || epochsPerPeriod == 0 {
+		return nil, errors.New("EPOCHS_PER_SYNC_COMMITTEE_PERIOD not found in spec")
+	}
+
+	validatorIndices := make(map[phase0.ValidatorIndex]struct{}, len(parameters.validatorIndices))
+	for _, index := range parameters.validatorIndices {
+		validatorIndices[index] = struct{}{}
+	}
+
+	return &Service{
+		log:                       log,
+		chainTime:                 parameters.chainTime,
+		signedBeaconBlockProvider: parameters.signedBeaconBlockProvider,
+		syncCommitteesProvider:    parameters.syncCommitteesProvider,
+		validatorIndices:          validatorIndices,
+		periodHandler:             parameters.periodHandler,
+		epochsPerPeriod:           epochsPerPeriod,
+	}, nil
+}
+
+// ProcessSlot updates participation with the sync aggregate in the block at
+// the given slot.  Slots should be processed in order once the block for the
+// slot, if any, is available.  Slots without a block do not count towards
+// participation.  When the first slot of a new period is processed the
+// period handler, if any, is called with the report for the previous period.
+func (s *Service) ProcessSlot(ctx context.Context, slot phase0.Slot) error {
+	period := uint64(s.chainTime.SlotToEpoch(slot)) / s.epochsPerPeriod
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.report == nil || s.report.Period != period {
+		if err := s.startPeriod(ctx, period); err != nil {
+			return err
+		}
+	}
+
+	if len(s.positions) == 0 {
+		// No tracked validators in this period.
+		return nil
+	}
+
+	block, err := consensusclient.SlotBlock(ctx, s.signedBeaconBlockProvider, slot)
+	if err != nil {
+		return err
+	}
+	if block == nil || block.Version == spec.DataVersionPhase0 {
+		return nil
+	}
+
+	syncAggregate, err := block.SyncAggregate()
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to obtain sync aggregate for slot %d", slot), err)
+	}
+
+	for index, positions := range s.positions {
+		participation := s.report.Participation[index]
+		for _, position := range positions {
+			participation.Expected++
+			if syncAggregate.SyncCommitteeBits.BitAt(uint64(position)) {
+				participation.Included++
+			}
+		}
+	}
+
+	return nil
+}
+
+// Participation returns the participation of tracked validators in the current period.
+func (s *Service) Participation() *PeriodReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.report == nil {
+		return nil
+	}
+
+	return copyReport(s.report)
+}
+
+// startPeriod finishes the current period and starts tracking the given period.
+func (s *Service) startPeriod(ctx context.Context, period uint64) error {
+	epoch := phase0.Epoch(period * s.epochsPerPeriod)
+	response, err := s.syncCommitteesProvider.SyncCommittee(ctx, &api.SyncCommitteeOpts{
+		State: "head",
+		Epoch: &epoch,
+	})
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to obtain sync committee for period %d", period), err)
+	}
+
+	if s.report != nil && s.periodHandler != nil {
+		s.periodHandler(ctx, copyReport(s.report))
+	}
+
+	s.positions = make(map[phase0.ValidatorIndex][]int)
+	s.report = &PeriodReport{
+		Period:        period,
+		Participation: make(map[phase0.ValidatorIndex]*Participation),
+	}
+	for position, index := range response.Data.Validators {
+		if _, tracked := s.validatorIndices[index]; !tracked {
+			continue
+		}
+		s.positions[index] = append(s.positions[index], position)
+		if _, exists := s.report.Participation[index]; !exists {
+			s.report.Participation[index] = &Participation{
+				ValidatorIndex: index,
+			}
+		}
+	}
+	s.log.Trace().Uint64("period", period).Int("validators", len(s.positions)).Msg("Started period")
+
+	return nil
+}
+
+func copyReport(report *PeriodReport) *PeriodReport {
+	res := &PeriodReport{
+		Period:        report.Period,
+		Participation: make(map[phase0.ValidatorIndex]*Participation, len(report.Participation)),
+	}
+	for index, participation := range report.Participation {
+		tmp := *participation
+		res.Participation[index] = &tmp
+	}
+
+	return res
+}
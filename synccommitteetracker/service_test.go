@@ -0,0 +1,237 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synccommitteetracker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/synccommitteetracker"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func altairBlock(slot phase0.Slot, bits ...uint64) *spec.VersionedSignedBeaconBlock {
+	syncCommitteeBits := bitfield.NewBitvector512()
+	for _, bit := range bits {
+		syncCommitteeBits.SetBitAt(bit, true)
+	}
+
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.SignedBeaconBlock{
+			Message: &altair.BeaconBlock{
+				Slot: slot,
+				Body: &altair.BeaconBlockBody{
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: syncCommitteeBits,
+					},
+				},
+			},
+		},
+	}
+}
+
+// syncCommitteesClient creates a test client with 1 epoch per sync committee
+// period, the supplied sync committees and the supplied blocks.
+func syncCommitteesClient(t *testing.T,
+	committees map[phase0.Epoch][]phase0.ValidatorIndex,
+	blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock,
+) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now(), map[string]any{
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(1),
+	})
+	require.NoError(t, err)
+	testfixtures.SetBlocks(client, blocks)
+	client.SyncCommitteeFunc = func(_ context.Context, opts *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error) {
+		return &api.Response[*apiv1.SyncCommittee]{
+			Data: &apiv1.SyncCommittee{
+				Validators: committees[*opts.Epoch],
+			},
+		}, nil
+	}
+
+	return client
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	client := syncCommitteesClient(t, nil, nil)
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []synccommitteetracker.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []synccommitteetracker.Parameter{
+				synccommitteetracker.WithLogLevel(zerolog.Disabled),
+				synccommitteetracker.WithSpecProvider(client),
+				synccommitteetracker.WithSignedBeaconBlockProvider(client),
+				synccommitteetracker.WithSyncCommitteesProvider(client),
+				synccommitteetracker.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []synccommitteetracker.Parameter{
+				synccommitteetracker.WithLogLevel(zerolog.Disabled),
+				synccommitteetracker.WithChainTime(chainTime),
+				synccommitteetracker.WithSignedBeaconBlockProvider(client),
+				synccommitteetracker.WithSyncCommitteesProvider(client),
+				synccommitteetracker.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+			err: "problem with parameters\nno spec provider specified",
+		},
+		{
+			name: "SignedBeaconBlockProviderMissing",
+			params: []synccommitteetracker.Parameter{
+				synccommitteetracker.WithLogLevel(zerolog.Disabled),
+				synccommitteetracker.WithChainTime(chainTime),
+				synccommitteetracker.WithSpecProvider(client),
+				synccommitteetracker.WithSyncCommitteesProvider(client),
+				synccommitteetracker.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+			err: "problem with parameters\nno signed beacon block provider specified",
+		},
+		{
+			name: "SyncCommitteesProviderMissing",
+			params: []synccommitteetracker.Parameter{
+				synccommitteetracker.WithLogLevel(zerolog.Disabled),
+				synccommitteetracker.WithChainTime(chainTime),
+				synccommitteetracker.WithSpecProvider(client),
+				synccommitteetracker.WithSignedBeaconBlockProvider(client),
+				synccommitteetracker.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+			err: "problem with parameters\nno sync committees provider specified",
+		},
+		{
+			name: "ValidatorIndicesMissing",
+			params: []synccommitteetracker.Parameter{
+				synccommitteetracker.WithLogLevel(zerolog.Disabled),
+				synccommitteetracker.WithChainTime(chainTime),
+				synccommitteetracker.WithSpecProvider(client),
+				synccommitteetracker.WithSignedBeaconBlockProvider(client),
+				synccommitteetracker.WithSyncCommitteesProvider(client),
+			},
+			err: "problem with parameters\nno validator indices specified",
+		},
+		{
+			name: "Good",
+			params: []synccommitteetracker.Parameter{
+				synccommitteetracker.WithLogLevel(zerolog.Disabled),
+				synccommitteetracker.WithChainTime(chainTime),
+				synccommitteetracker.WithSpecProvider(client),
+				synccommitteetracker.WithSignedBeaconBlockProvider(client),
+				synccommitteetracker.WithSyncCommitteesProvider(client),
+				synccommitteetracker.WithValidatorIndices([]phase0.ValidatorIndex{1}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := synccommitteetracker.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProcessSlot(t *testing.T) {
+	ctx := context.Background()
+
+	committees := map[phase0.Epoch][]phase0.ValidatorIndex{
+		// Validator 1 holds two positions.
+		0: {1, 2, 1, 3},
+		1: {4, 2},
+		2: {4, 5},
+	}
+	blocks := map[phase0.Slot]*spec.VersionedSignedBeaconBlock{
+		0: {
+			Version: spec.DataVersionPhase0,
+			Phase0:  &phase0.SignedBeaconBlock{},
+		},
+		1: altairBlock(1, 0, 1),
+		2: altairBlock(2, 2),
+		4: altairBlock(4, 1),
+		8: altairBlock(8, 0, 1),
+	}
+	client := syncCommitteesClient(t, committees, blocks)
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	reports := make([]*synccommitteetracker.PeriodReport, 0)
+	s, err := synccommitteetracker.New(ctx,
+		synccommitteetracker.WithLogLevel(zerolog.Disabled),
+		synccommitteetracker.WithChainTime(chainTime),
+		synccommitteetracker.WithSpecProvider(client),
+		synccommitteetracker.WithSignedBeaconBlockProvider(client),
+		synccommitteetracker.WithSyncCommitteesProvider(client),
+		synccommitteetracker.WithValidatorIndices([]phase0.ValidatorIndex{1, 2}),
+		synccommitteetracker.WithPeriodHandler(func(_ context.Context, report *synccommitteetracker.PeriodReport) {
+			reports = append(reports, report)
+		}),
+	)
+	require.NoError(t, err)
+	require.Nil(t, s.Participation())
+
+	// Slot 3 has no block.
+	for slot := phase0.Slot(0); slot < 4; slot++ {
+		require.NoError(t, s.ProcessSlot(ctx, slot))
+	}
+	require.Empty(t, reports)
+	current := s.Participation()
+	require.Equal(t, uint64(0), current.Period)
+	require.Equal(t, &synccommitteetracker.Participation{ValidatorIndex: 1, Expected: 4, Included: 2}, current.Participation[1])
+	require.Equal(t, &synccommitteetracker.Participation{ValidatorIndex: 2, Expected: 2, Included: 1}, current.Participation[2])
+	require.InDelta(t, 0.5, current.Participation[1].Rate(), 0.0001)
+
+	for slot := phase0.Slot(4); slot < 9; slot++ {
+		require.NoError(t, s.ProcessSlot(ctx, slot))
+	}
+	require.Len(t, reports, 2)
+	require.Equal(t, current, reports[0])
+	require.Equal(t, &synccommitteetracker.PeriodReport{
+		Period: 1,
+		Participation: map[phase0.ValidatorIndex]*synccommitteetracker.Participation{
+			2: {ValidatorIndex: 2, Expected: 1, Included: 1},
+		},
+	}, reports[1])
+
+	// No tracked validators in period 2.
+	require.Equal(t, &synccommitteetracker.PeriodReport{
+		Period:        2,
+		Participation: map[phase0.ValidatorIndex]*synccommitteetracker.Participation{},
+	}, s.Participation())
+}
@@ -374,6 +374,24 @@ func (s *Erroring) BeaconBlockRoot(ctx context.Context,
 	return next.BeaconBlockRoot(ctx, opts)
 }
 
+// BeaconBlockRoots fetches the roots of multiple blocks given a set of options.
+func (s *Erroring) BeaconBlockRoots(ctx context.Context,
+	opts *api.BeaconBlockRootsOpts,
+) (
+	*api.Response[map[string]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockRootsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconBlockRoots(ctx, opts)
+}
+
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
 func (s *Erroring) BeaconCommittees(ctx context.Context,
 	opts *api.BeaconCommitteesOpts,
@@ -588,6 +606,24 @@ func (s *Erroring) Genesis(ctx context.Context,
 	return next.Genesis(ctx, opts)
 }
 
+// NodeHealth provides the health of the node.
+func (s *Erroring) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeHealth(ctx, opts)
+}
+
 // NodeSyncing provides the state of the node's synchronization with the chain.
 func (s *Erroring) NodeSyncing(ctx context.Context,
 	opts *api.NodeSyncingOpts,
@@ -606,6 +642,24 @@ func (s *Erroring) NodeSyncing(ctx context.Context,
 	return next.NodeSyncing(ctx, opts)
 }
 
+// NodeIdentity provides the network identity of the node.
+func (s *Erroring) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
 // NodePeers provides the peers of the node.
 func (s *Erroring) NodePeers(ctx context.Context,
 	opts *api.NodePeersOpts,
@@ -795,6 +849,25 @@ func (s *Erroring) Domain(ctx context.Context, domainType phase0.DomainType, epo
 	return next.Domain(ctx, domainType, epoch)
 }
 
+// DomainsForEpoch provides the domains for the given domain types at the given epoch.
+func (s *Erroring) DomainsForEpoch(ctx context.Context,
+	epoch phase0.Epoch,
+	domainTypes ...phase0.DomainType,
+) (
+	map[phase0.DomainType]phase0.Domain,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DomainsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DomainsForEpoch(ctx, epoch, domainTypes...)
+}
+
 // GenesisDomain provides a domain for a given domain type.
 func (s *Erroring) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -859,6 +932,24 @@ func (s *Erroring) SignedBeaconBlock(ctx context.Context,
 	return next.SignedBeaconBlock(ctx, opts)
 }
 
+// BlindedBlock fetches a signed blinded beacon block given a block ID.
+func (s *Erroring) BlindedBlock(ctx context.Context,
+	opts *api.BlindedBlockOpts,
+) (
+	*api.Response[*api.VersionedSignedBlindedBeaconBlock],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlindedBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BlindedBlock(ctx, opts)
+}
+
 // BlobSidecars fetches the blobs given a block ID.
 func (s *Erroring) BlobSidecars(ctx context.Context,
 	opts *api.BlobSidecarsOpts,
@@ -1002,3 +1093,88 @@ func (s *Erroring) PendingDeposits(ctx context.Context,
 
 	return next.PendingDeposits(ctx, opts)
 }
+
+// PendingPartialWithdrawals provides the pending partial withdrawals for a given state.
+func (s *Erroring) PendingPartialWithdrawals(ctx context.Context,
+	opts *api.PendingPartialWithdrawalsOpts,
+) (
+	*api.Response[[]*electra.PendingPartialWithdrawal],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PendingPartialWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.PendingPartialWithdrawals(ctx, opts)
+}
+
+// PendingConsolidations provides the pending consolidations for a given state.
+func (s *Erroring) PendingConsolidations(ctx context.Context,
+	opts *api.PendingConsolidationsOpts,
+) (
+	*api.Response[[]*electra.PendingConsolidation],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PendingConsolidationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.PendingConsolidations(ctx, opts)
+}
+
+// ValidatorIdentities provides the identities of validators for the given options.
+func (s *Erroring) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorIdentitiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorIdentities(ctx, opts)
+}
+
+// BeaconHeads fetches all heads of the beacon chain known to the node.
+func (s *Erroring) BeaconHeads(ctx context.Context,
+	opts *api.BeaconHeadsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconHead],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconHeadsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconHeads(ctx, opts)
+}
+
+// SupportsFork returns true if the beacon node supports the given fork.
+func (s *Erroring) SupportsFork(ctx context.Context, version spec.DataVersion) (bool, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return false, err
+	}
+	next, isNext := s.next.(consensusclient.ForkSupporter)
+	if !isNext {
+		return false, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SupportsFork(ctx, version)
+}
@@ -453,6 +453,22 @@ func (s *Sleepy) Genesis(ctx context.Context,
 	return next.Genesis(ctx, opts)
 }
 
+// NodeHealth provides the health of the node.
+func (s *Sleepy) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodeHealth(ctx, opts)
+}
+
 // NodeSyncing provides the state of the node's synchronization with the chain.
 func (s *Sleepy) NodeSyncing(ctx context.Context,
 	opts *api.NodeSyncingOpts,
@@ -469,6 +485,22 @@ func (s *Sleepy) NodeSyncing(ctx context.Context,
 	return next.NodeSyncing(ctx, opts)
 }
 
+// NodeIdentity provides the network identity of the node.
+func (s *Sleepy) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
 // NodePeers provides the peers of the node.
 func (s *Sleepy) NodePeers(ctx context.Context,
 	opts *api.NodePeersOpts,
@@ -722,3 +754,78 @@ func (s *Sleepy) PendingDeposits(ctx context.Context,
 
 	return next.PendingDeposits(ctx, opts)
 }
+
+// PendingPartialWithdrawals provides the pending partial withdrawals for a given state.
+func (s *Sleepy) PendingPartialWithdrawals(ctx context.Context,
+	opts *api.PendingPartialWithdrawalsOpts,
+) (
+	*api.Response[[]*electra.PendingPartialWithdrawal],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.PendingPartialWithdrawalsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.PendingPartialWithdrawals(ctx, opts)
+}
+
+// PendingConsolidations provides the pending consolidations for a given state.
+func (s *Sleepy) PendingConsolidations(ctx context.Context,
+	opts *api.PendingConsolidationsOpts,
+) (
+	*api.Response[[]*electra.PendingConsolidation],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.PendingConsolidationsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.PendingConsolidations(ctx, opts)
+}
+
+// ValidatorIdentities provides the identities of validators for the given options.
+func (s *Sleepy) ValidatorIdentities(ctx context.Context,
+	opts *api.ValidatorIdentitiesOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorIdentity],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorIdentitiesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ValidatorIdentities(ctx, opts)
+}
+
+// BeaconHeads fetches all heads of the beacon chain known to the node.
+func (s *Sleepy) BeaconHeads(ctx context.Context,
+	opts *api.BeaconHeadsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconHead],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconHeadsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BeaconHeads(ctx, opts)
+}
+
+// SupportsFork returns true if the beacon node supports the given fork.
+func (s *Sleepy) SupportsFork(ctx context.Context, version spec.DataVersion) (bool, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ForkSupporter)
+	if !isNext {
+		return false, errors.New("next does not support this call")
+	}
+
+	return next.SupportsFork(ctx, version)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testfixtures
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// Attestation builds an attestation for the given version.
+func Attestation(version spec.DataVersion, params ...Parameter) (*spec.VersionedAttestation, error) {
+	parameters := parseParameters(params...)
+
+	beaconBlockRoot := hash("block", uint64(parameters.slot))
+	if parameters.beaconBlockRoot != nil {
+		beaconBlockRoot = *parameters.beaconBlockRoot
+	}
+	data := &phase0.AttestationData{
+		Slot:            parameters.slot,
+		Index:           parameters.committeeIndex,
+		BeaconBlockRoot: beaconBlockRoot,
+		Source:          checkpoint(epochBefore(parameters.slot, 1)),
+		Target:          checkpoint(epochBefore(parameters.slot, 0)),
+	}
+	aggregationBits := bitfield.Bitlist(append([]byte{}, parameters.aggregationBits...))
+	sig := signature("attestation", uint64(parameters.slot))
+
+	res := &spec.VersionedAttestation{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair, spec.DataVersionBellatrix, spec.DataVersionCapella, spec.DataVersionDeneb:
+		attestation := &phase0.Attestation{
+			AggregationBits: aggregationBits,
+			Data:            data,
+			Signature:       sig,
+		}
+		switch version {
+		case spec.DataVersionPhase0:
+			res.Phase0 = attestation
+		case spec.DataVersionAltair:
+			res.Altair = attestation
+		case spec.DataVersionBellatrix:
+			res.Bellatrix = attestation
+		case spec.DataVersionCapella:
+			res.Capella = attestation
+		default:
+			res.Deneb = attestation
+		}
+	case spec.DataVersionElectra:
+		committeeBits := bitfield.NewBitvector64()
+		committeeBits.SetBitAt(uint64(parameters.committeeIndex), true)
+		data.Index = 0
+		res.Electra = &electra.Attestation{
+			AggregationBits: aggregationBits,
+			Data:            data,
+			Signature:       sig,
+			CommitteeBits:   committeeBits,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported version %v", version)
+	}
+
+	return res, nil
+}
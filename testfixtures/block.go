@@ -0,0 +1,435 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testfixtures builds deterministic, internally consistent blocks,
+// states and attestations for each fork, for use in tests.
+//
+// All values are derived from the supplied parameters, so building the same
+// item twice gives identical results.  Roots that link items, such as block
+// parent roots and state roots, are calculated from the items themselves.
+// Signatures are deterministic but not valid.
+package testfixtures
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	utilcapella "github.com/attestantio/go-eth2-client/util/capella"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// Block builds a signed beacon block for the given version.  Signatures
+// are deterministic but not valid.
+func Block(version spec.DataVersion, params ...Parameter) (*spec.VersionedSignedBeaconBlock, error) {
+	return block(version, parseParameters(params...))
+}
+
+// Chain builds the given number of consecutive signed beacon blocks for the
+// given version, starting at the slot supplied with WithSlot.  The parent
+// root of each block after the first is the root of the previous block.
+func Chain(version spec.DataVersion, count int, params ...Parameter) ([]*spec.VersionedSignedBeaconBlock, error) {
+	parameters := parseParameters(params...)
+
+	res := make([]*spec.VersionedSignedBeaconBlock, 0, count)
+	for i := 0; i < count; i++ {
+		signedBlock, err := block(version, parameters)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, signedBlock)
+
+		root, err := signedBlock.Root()
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to obtain block root"), err)
+		}
+		parameters.slot++
+		parameters.parentRoot = &root
+	}
+
+	return res, nil
+}
+
+func block(version spec.DataVersion, p *parameters) (*spec.VersionedSignedBeaconBlock, error) {
+	epoch := uint64(p.slot) / SlotsPerEpoch
+	randaoReveal := signature("randao", epoch)
+	syncCommitteeBits := bitfield.NewBitvector512()
+	for i := uint64(0); i < syncCommitteeBits.Len(); i++ {
+		syncCommitteeBits.SetBitAt(i, true)
+	}
+	syncAggregate := &altair.SyncAggregate{
+		SyncCommitteeBits:      syncCommitteeBits,
+		SyncCommitteeSignature: signature("syncaggregate", uint64(p.slot)),
+	}
+	payload := executionPayload(p.slot)
+	sig := signature("block", uint64(p.slot))
+
+	phase0Attestations, electraAttestations, err := blockAttestations(version, p.attestations)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &spec.VersionedSignedBeaconBlock{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		res.Phase0 = &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot:          p.slot,
+				ProposerIndex: p.proposerIndex(),
+				ParentRoot:    *p.parentRoot,
+				StateRoot:     p.stateRoot,
+				Body: &phase0.BeaconBlockBody{
+					RANDAOReveal:      randaoReveal,
+					ETH1Data:          eth1Data(p.slot),
+					Graffiti:          [32]byte(p.graffiti),
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      phase0Attestations,
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+				},
+			},
+			Signature: sig,
+		}
+	case spec.DataVersionAltair:
+		res.Altair = &altair.SignedBeaconBlock{
+			Message: &altair.BeaconBlock{
+				Slot:          p.slot,
+				ProposerIndex: p.proposerIndex(),
+				ParentRoot:    *p.parentRoot,
+				StateRoot:     p.stateRoot,
+				Body: &altair.BeaconBlockBody{
+					RANDAOReveal:      randaoReveal,
+					ETH1Data:          eth1Data(p.slot),
+					Graffiti:          [32]byte(p.graffiti),
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      phase0Attestations,
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:     syncAggregate,
+				},
+			},
+			Signature: sig,
+		}
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.SignedBeaconBlock{
+			Message: &bellatrix.BeaconBlock{
+				Slot:          p.slot,
+				ProposerIndex: p.proposerIndex(),
+				ParentRoot:    *p.parentRoot,
+				StateRoot:     p.stateRoot,
+				Body: &bellatrix.BeaconBlockBody{
+					RANDAOReveal:      randaoReveal,
+					ETH1Data:          eth1Data(p.slot),
+					Graffiti:          [32]byte(p.graffiti),
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      phase0Attestations,
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:     syncAggregate,
+					ExecutionPayload:  bellatrixExecutionPayload(payload),
+				},
+			},
+			Signature: sig,
+		}
+	case spec.DataVersionCapella:
+		res.Capella = &capella.SignedBeaconBlock{
+			Message: &capella.BeaconBlock{
+				Slot:          p.slot,
+				ProposerIndex: p.proposerIndex(),
+				ParentRoot:    *p.parentRoot,
+				StateRoot:     p.stateRoot,
+				Body: &capella.BeaconBlockBody{
+					RANDAOReveal:          randaoReveal,
+					ETH1Data:              eth1Data(p.slot),
+					Graffiti:              [32]byte(p.graffiti),
+					ProposerSlashings:     []*phase0.ProposerSlashing{},
+					AttesterSlashings:     []*phase0.AttesterSlashing{},
+					Attestations:          phase0Attestations,
+					Deposits:              []*phase0.Deposit{},
+					VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:         syncAggregate,
+					ExecutionPayload:      capellaExecutionPayload(payload),
+					BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+				},
+			},
+			Signature: sig,
+		}
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.SignedBeaconBlock{
+			Message: &deneb.BeaconBlock{
+				Slot:          p.slot,
+				ProposerIndex: p.proposerIndex(),
+				ParentRoot:    *p.parentRoot,
+				StateRoot:     p.stateRoot,
+				Body: &deneb.BeaconBlockBody{
+					RANDAOReveal:          randaoReveal,
+					ETH1Data:              eth1Data(p.slot),
+					Graffiti:              [32]byte(p.graffiti),
+					ProposerSlashings:     []*phase0.ProposerSlashing{},
+					AttesterSlashings:     []*phase0.AttesterSlashing{},
+					Attestations:          phase0Attestations,
+					Deposits:              []*phase0.Deposit{},
+					VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:         syncAggregate,
+					ExecutionPayload:      payload,
+					BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+					BlobKZGCommitments:    []deneb.KZGCommitment{},
+				},
+			},
+			Signature: sig,
+		}
+	case spec.DataVersionElectra:
+		res.Electra = &electra.SignedBeaconBlock{
+			Message: &electra.BeaconBlock{
+				Slot:          p.slot,
+				ProposerIndex: p.proposerIndex(),
+				ParentRoot:    *p.parentRoot,
+				StateRoot:     p.stateRoot,
+				Body: &electra.BeaconBlockBody{
+					RANDAOReveal:          randaoReveal,
+					ETH1Data:              eth1Data(p.slot),
+					Graffiti:              [32]byte(p.graffiti),
+					ProposerSlashings:     []*phase0.ProposerSlashing{},
+					AttesterSlashings:     []*electra.AttesterSlashing{},
+					Attestations:          electraAttestations,
+					Deposits:              []*phase0.Deposit{},
+					VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:         syncAggregate,
+					ExecutionPayload:      payload,
+					BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+					BlobKZGCommitments:    []deneb.KZGCommitment{},
+					ExecutionRequests: &electra.ExecutionRequests{
+						Deposits:       []*electra.DepositRequest{},
+						Withdrawals:    []*electra.WithdrawalRequest{},
+						Consolidations: []*electra.ConsolidationRequest{},
+					},
+				},
+			},
+			Signature: sig,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported version %v", version)
+	}
+
+	return res, nil
+}
+
+// blockAttestations returns the attestations in the form required by a
+// block of the given version.
+func blockAttestations(version spec.DataVersion,
+	attestations []*spec.VersionedAttestation,
+) (
+	[]*phase0.Attestation,
+	[]*electra.Attestation,
+	error,
+) {
+	phase0Attestations := make([]*phase0.Attestation, 0)
+	electraAttestations := make([]*electra.Attestation, 0)
+	for i, attestation := range attestations {
+		if attestation == nil || attestation.Version != version {
+			return nil, nil, fmt.Errorf("attestation %d is not of version %v", i, version)
+		}
+		switch version {
+		case spec.DataVersionPhase0:
+			phase0Attestations = append(phase0Attestations, attestation.Phase0)
+		case spec.DataVersionAltair:
+			phase0Attestations = append(phase0Attestations, attestation.Altair)
+		case spec.DataVersionBellatrix:
+			phase0Attestations = append(phase0Attestations, attestation.Bellatrix)
+		case spec.DataVersionCapella:
+			phase0Attestations = append(phase0Attestations, attestation.Capella)
+		case spec.DataVersionDeneb:
+			phase0Attestations = append(phase0Attestations, attestation.Deneb)
+		default:
+			electraAttestations = append(electraAttestations, attestation.Electra)
+		}
+	}
+
+	return phase0Attestations, electraAttestations, nil
+}
+
+// executionPayload returns the execution payload for the given slot.
+func executionPayload(slot phase0.Slot) *deneb.ExecutionPayload {
+	var parentHash phase0.Hash32
+	if slot > 0 {
+		parentHash = ExecutionBlockHash(slot - 1)
+	}
+
+	return &deneb.ExecutionPayload{
+		ParentHash:    parentHash,
+		FeeRecipient:  bellatrix.ExecutionAddress{0x01},
+		StateRoot:     hash("executionstate", uint64(slot)),
+		ReceiptsRoot:  hash("receipts", uint64(slot)),
+		PrevRandao:    hash("prevrandao", uint64(slot)),
+		BlockNumber:   uint64(slot),
+		GasLimit:      30_000_000,
+		Timestamp:     GenesisTime + uint64(slot)*SecondsPerSlot,
+		ExtraData:     []byte{},
+		BaseFeePerGas: uint256.NewInt(7),
+		BlockHash:     ExecutionBlockHash(slot),
+		Transactions:  []bellatrix.Transaction{},
+		Withdrawals:   []*capella.Withdrawal{},
+	}
+}
+
+// baseFeePerGas returns the base fee in the little-endian form used prior to Deneb.
+func baseFeePerGas(baseFee *uint256.Int) [32]byte {
+	var res [32]byte
+	bigEndian := baseFee.Bytes32()
+	for i := range bigEndian {
+		res[i] = bigEndian[len(bigEndian)-1-i]
+	}
+
+	return res
+}
+
+func bellatrixExecutionPayload(payload *deneb.ExecutionPayload) *bellatrix.ExecutionPayload {
+	return &bellatrix.ExecutionPayload{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: baseFeePerGas(payload.BaseFeePerGas),
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+	}
+}
+
+func capellaExecutionPayload(payload *deneb.ExecutionPayload) *capella.ExecutionPayload {
+	return &capella.ExecutionPayload{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		PrevRandao:    payload.PrevRandao,
+		BlockNumber:   payload.BlockNumber,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: baseFeePerGas(payload.BaseFeePerGas),
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		Withdrawals:   payload.Withdrawals,
+	}
+}
+
+// executionPayloadHeader returns the header for the execution payload.
+func executionPayloadHeader(payload *deneb.ExecutionPayload) (*deneb.ExecutionPayloadHeader, error) {
+	transactionsRoot, err := (&utilbellatrix.ExecutionPayloadTransactions{Transactions: payload.Transactions}).HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate transactions root"), err)
+	}
+	withdrawalsRoot, err := (&utilcapella.ExecutionPayloadWithdrawals{Withdrawals: payload.Withdrawals}).HashTreeRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate withdrawals root"), err)
+	}
+
+	return &deneb.ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+		BlobGasUsed:      payload.BlobGasUsed,
+		ExcessBlobGas:    payload.ExcessBlobGas,
+	}, nil
+}
+
+func bellatrixExecutionPayloadHeader(header *deneb.ExecutionPayloadHeader) *bellatrix.ExecutionPayloadHeader {
+	return &bellatrix.ExecutionPayloadHeader{
+		ParentHash:       header.ParentHash,
+		FeeRecipient:     header.FeeRecipient,
+		StateRoot:        header.StateRoot,
+		ReceiptsRoot:     header.ReceiptsRoot,
+		LogsBloom:        header.LogsBloom,
+		PrevRandao:       header.PrevRandao,
+		BlockNumber:      header.BlockNumber,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Timestamp:        header.Timestamp,
+		ExtraData:        header.ExtraData,
+		BaseFeePerGas:    baseFeePerGas(header.BaseFeePerGas),
+		BlockHash:        header.BlockHash,
+		TransactionsRoot: header.TransactionsRoot,
+	}
+}
+
+func capellaExecutionPayloadHeader(header *deneb.ExecutionPayloadHeader) *capella.ExecutionPayloadHeader {
+	return &capella.ExecutionPayloadHeader{
+		ParentHash:       header.ParentHash,
+		FeeRecipient:     header.FeeRecipient,
+		StateRoot:        header.StateRoot,
+		ReceiptsRoot:     header.ReceiptsRoot,
+		LogsBloom:        header.LogsBloom,
+		PrevRandao:       header.PrevRandao,
+		BlockNumber:      header.BlockNumber,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Timestamp:        header.Timestamp,
+		ExtraData:        header.ExtraData,
+		BaseFeePerGas:    baseFeePerGas(header.BaseFeePerGas),
+		BlockHash:        header.BlockHash,
+		TransactionsRoot: header.TransactionsRoot,
+		WithdrawalsRoot:  header.WithdrawalsRoot,
+	}
+}
+
+// setStateRoot sets the state root of a block.
+func setStateRoot(block *spec.VersionedSignedBeaconBlock, root phase0.Root) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		block.Phase0.Message.StateRoot = root
+	case spec.DataVersionAltair:
+		block.Altair.Message.StateRoot = root
+	case spec.DataVersionBellatrix:
+		block.Bellatrix.Message.StateRoot = root
+	case spec.DataVersionCapella:
+		block.Capella.Message.StateRoot = root
+	case spec.DataVersionDeneb:
+		block.Deneb.Message.StateRoot = root
+	case spec.DataVersionElectra:
+		block.Electra.Message.StateRoot = root
+	}
+}
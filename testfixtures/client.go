@@ -0,0 +1,136 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testfixtures
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// ClientSlotsPerEpoch is the number of slots per epoch of the client's
+	// chain.  This is lower than that used by the builders, to keep tests
+	// that walk the chain short.
+	ClientSlotsPerEpoch = 4
+	// ClientCommittees is the number of committees per slot returned by the
+	// client.
+	ClientCommittees = 2
+	// ClientCommitteeSize is the number of validators in each committee
+	// returned by the client.
+	ClientCommitteeSize = 4
+)
+
+// ErrNotFound is the error returned by the client for items that it does not
+// have.
+var ErrNotFound = &api.Error{
+	Method:     http.MethodGet,
+	StatusCode: http.StatusNotFound,
+}
+
+// Client returns a mock client for a chain with the given genesis time, 12
+// second slots and ClientSlotsPerEpoch slots per epoch.  The supplied spec
+// values are added to, or replace, these.
+func Client(genesisTime time.Time, specValues map[string]any) (*mock.Service, error) {
+	client, err := mock.New(context.Background(), mock.WithGenesisTime(genesisTime))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]any{
+		"SECONDS_PER_SLOT": time.Duration(SecondsPerSlot) * time.Second,
+		"SLOTS_PER_EPOCH":  uint64(ClientSlotsPerEpoch),
+	}
+	for k, v := range specValues {
+		data[k] = v
+	}
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: data,
+		}, nil
+	}
+
+	return client, nil
+}
+
+// SetBeaconCommittees sets the client to return ClientCommittees committees
+// of ClientCommitteeSize validators for each slot of the requested epoch.
+// The indices of the validators are given by CommitteeMember.
+func SetBeaconCommittees(client *mock.Service) {
+	client.BeaconCommitteesFunc = func(_ context.Context, opts *api.BeaconCommitteesOpts) (*api.Response[[]*apiv1.BeaconCommittee], error) {
+		epoch := phase0.Epoch(0)
+		if opts.Epoch != nil {
+			epoch = *opts.Epoch
+		}
+		committees := make([]*apiv1.BeaconCommittee, 0, ClientSlotsPerEpoch*ClientCommittees)
+		startSlot := phase0.Slot(uint64(epoch) * ClientSlotsPerEpoch)
+		for slot := startSlot; slot < startSlot+ClientSlotsPerEpoch; slot++ {
+			for index := phase0.CommitteeIndex(0); index < ClientCommittees; index++ {
+				committee := &apiv1.BeaconCommittee{
+					Slot:       slot,
+					Index:      index,
+					Validators: make([]phase0.ValidatorIndex, 0, ClientCommitteeSize),
+				}
+				for i := 0; i < ClientCommitteeSize; i++ {
+					committee.Validators = append(committee.Validators, CommitteeMember(slot, index, i))
+				}
+				committees = append(committees, committee)
+			}
+		}
+
+		return &api.Response[[]*apiv1.BeaconCommittee]{Data: committees}, nil
+	}
+}
+
+// CommitteeMember returns the index of the validator at the given position
+// in a committee returned by a client set up with SetBeaconCommittees.
+func CommitteeMember(slot phase0.Slot, index phase0.CommitteeIndex, position int) phase0.ValidatorIndex {
+	return phase0.ValidatorIndex(uint64(slot)*10 + uint64(index)*ClientCommitteeSize + uint64(position))
+}
+
+// SetBlocks sets the client to return the supplied blocks, keyed by slot, and
+// ErrNotFound for slots without a block.
+func SetBlocks(client *mock.Service, blocks map[phase0.Slot]*spec.VersionedSignedBeaconBlock) {
+	client.SignedBeaconBlockFunc = func(_ context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+		slot, err := strconv.ParseUint(opts.Block, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		block, exists := blocks[phase0.Slot(slot)]
+		if !exists {
+			return nil, ErrNotFound
+		}
+
+		return &api.Response[*spec.VersionedSignedBeaconBlock]{Data: block}, nil
+	}
+}
+
+// ChainTime returns a chain time service that obtains its genesis and spec
+// from the client.  The supplied parameters are added to these.
+func ChainTime(client *mock.Service, params ...chaintime.Parameter) (*chaintime.Service, error) {
+	return chaintime.New(context.Background(), append([]chaintime.Parameter{
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(client),
+		chaintime.WithSpecProvider(client),
+	}, params...)...)
+}
@@ -0,0 +1,159 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testfixtures
+
+import (
+	"github.com/attestantio/go-eth2-client/graffiti"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+type parameters struct {
+	slot            phase0.Slot
+	proposer        *phase0.ValidatorIndex
+	parentRoot      *phase0.Root
+	stateRoot       phase0.Root
+	graffiti        graffiti.Graffiti
+	attestations    []*spec.VersionedAttestation
+	validators      uint64
+	committeeIndex  phase0.CommitteeIndex
+	beaconBlockRoot *phase0.Root
+	aggregationBits bitfield.Bitlist
+}
+
+// Parameter is the interface for builder parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithSlot sets the slot of the block, state or attestation.  Defaults to 1.
+func WithSlot(slot phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slot = slot
+	})
+}
+
+// WithProposer sets the proposer of the block.  Defaults to the slot modulo
+// the number of validators.
+func WithProposer(index phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proposer = &index
+	})
+}
+
+// WithParentRoot sets the parent root of the block.  Defaults to a value
+// derived from the slot, matching the root of the block built by Chain for
+// the previous slot only if that block was itself built by Chain.
+func WithParentRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parentRoot = &root
+	})
+}
+
+// WithStateRoot sets the state root of the block.  It is ignored by
+// BlockAndState, which calculates the state root.
+func WithStateRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stateRoot = root
+	})
+}
+
+// WithGraffiti sets the graffiti of the block.
+func WithGraffiti(graffiti graffiti.Graffiti) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.graffiti = graffiti
+	})
+}
+
+// WithAttestations sets the attestations included in the block.  The
+// attestations must be of the same version as the block.
+func WithAttestations(attestations []*spec.VersionedAttestation) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.attestations = attestations
+	})
+}
+
+// WithValidators sets the number of validators in the state.  Defaults to 64.
+func WithValidators(validators uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validators = validators
+	})
+}
+
+// WithCommitteeIndex sets the committee index of the attestation.
+func WithCommitteeIndex(index phase0.CommitteeIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.committeeIndex = index
+	})
+}
+
+// WithBeaconBlockRoot sets the beacon block root of the attestation.
+// Defaults to a value derived from the slot.
+func WithBeaconBlockRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconBlockRoot = &root
+	})
+}
+
+// WithAggregationBits sets the aggregation bits of the attestation.
+// Defaults to the first member of a committee of 4.
+func WithAggregationBits(bits bitfield.Bitlist) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.aggregationBits = bits
+	})
+}
+
+// parseParameters parses parameters, applying defaults.
+func parseParameters(params ...Parameter) *parameters {
+	parameters := parameters{
+		slot:       1,
+		validators: 64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.validators == 0 {
+		parameters.validators = 1
+	}
+	if parameters.parentRoot == nil {
+		parentRoot := parentRoot(parameters.slot)
+		parameters.parentRoot = &parentRoot
+	}
+	if parameters.aggregationBits == nil {
+		parameters.aggregationBits = bitfield.NewBitlist(4)
+		parameters.aggregationBits.SetBitAt(0, true)
+	}
+
+	return &parameters
+}
+
+// proposerIndex returns the proposer, defaulting to the slot modulo the
+// number of validators.
+func (p *parameters) proposerIndex() phase0.ValidatorIndex {
+	if p.proposer != nil {
+		return *p.proposer
+	}
+
+	return phase0.ValidatorIndex(uint64(p.slot) % p.validators)
+}
@@ -0,0 +1,342 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testfixtures
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+const (
+	slotsPerHistoricalRoot    = 8192
+	epochsPerHistoricalVector = 65536
+	epochsPerSlashingsVector  = 8192
+	syncCommitteeSize         = 512
+	maxEffectiveBalance       = phase0.Gwei(32_000_000_000)
+)
+
+// State builds a beacon state for the given version.  The latest block
+// header is for a block at the slot of the state, built with the same
+// parameters.
+func State(version spec.DataVersion, params ...Parameter) (*spec.VersionedBeaconState, error) {
+	parameters := parseParameters(params...)
+	signedBlock, err := block(version, parameters)
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := signedBlock.BodyRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block body root"), err)
+	}
+
+	return state(version, parameters, bodyRoot)
+}
+
+// BlockAndState builds a signed beacon block and the state after the block
+// has been applied.  The state root of the block is the root of the state,
+// and the latest block header of the state is that of the block, with its
+// state root unset as per the specification.
+func BlockAndState(version spec.DataVersion,
+	params ...Parameter,
+) (
+	*spec.VersionedSignedBeaconBlock,
+	*spec.VersionedBeaconState,
+	error,
+) {
+	parameters := parseParameters(params...)
+	parameters.stateRoot = phase0.Root{}
+	signedBlock, err := block(version, parameters)
+	if err != nil {
+		return nil, nil, err
+	}
+	bodyRoot, err := signedBlock.BodyRoot()
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("failed to obtain block body root"), err)
+	}
+	beaconState, err := state(version, parameters, bodyRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	stateRoot, err := beaconState.Root()
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("failed to obtain state root"), err)
+	}
+	setStateRoot(signedBlock, stateRoot)
+
+	return signedBlock, beaconState, nil
+}
+
+func state(version spec.DataVersion, p *parameters, bodyRoot phase0.Root) (*spec.VersionedBeaconState, error) {
+	epoch := phase0.Epoch(uint64(p.slot) / SlotsPerEpoch)
+
+	fork := &phase0.Fork{
+		PreviousVersion: forkVersion(version),
+		CurrentVersion:  forkVersion(version),
+		Epoch:           0,
+	}
+	if version > spec.DataVersionPhase0 {
+		fork.PreviousVersion = forkVersion(version - 1)
+	}
+	header := &phase0.BeaconBlockHeader{
+		Slot:          p.slot,
+		ProposerIndex: p.proposerIndex(),
+		ParentRoot:    *p.parentRoot,
+		BodyRoot:      bodyRoot,
+	}
+
+	blockRoots := make([]phase0.Root, slotsPerHistoricalRoot)
+	if p.slot > 0 {
+		blockRoots[(uint64(p.slot)-1)%slotsPerHistoricalRoot] = *p.parentRoot
+	}
+	stateRoots := make([]phase0.Root, slotsPerHistoricalRoot)
+	randaoMixes := make([]phase0.Root, epochsPerHistoricalVector)
+	for i := range randaoMixes {
+		randaoMixes[i] = hash("mix", uint64(i))
+	}
+	slashings := make([]phase0.Gwei, epochsPerSlashingsVector)
+
+	validators := make([]*phase0.Validator, p.validators)
+	balances := make([]phase0.Gwei, p.validators)
+	for i := range validators {
+		withdrawalCredentials := hash("withdrawal", uint64(i))
+		withdrawalCredentials[0] = 0x01
+		validators[i] = &phase0.Validator{
+			PublicKey:                  PubKey(phase0.ValidatorIndex(i)),
+			WithdrawalCredentials:      withdrawalCredentials[:],
+			EffectiveBalance:           maxEffectiveBalance,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  phase0.FarFutureEpoch,
+			WithdrawableEpoch:          phase0.FarFutureEpoch,
+		}
+		balances[i] = maxEffectiveBalance
+	}
+
+	justificationBits := bitfield.NewBitvector4()
+	previousJustified := checkpoint(epochBefore(p.slot, 2))
+	currentJustified := checkpoint(epochBefore(p.slot, 1))
+	finalized := checkpoint(epochBefore(p.slot, 2))
+
+	res := &spec.VersionedBeaconState{
+		Version: version,
+	}
+	if version == spec.DataVersionPhase0 {
+		res.Phase0 = &phase0.BeaconState{
+			GenesisTime:                 GenesisTime,
+			GenesisValidatorsRoot:       GenesisValidatorsRoot(),
+			Slot:                        p.slot,
+			Fork:                        fork,
+			LatestBlockHeader:           header,
+			BlockRoots:                  blockRoots,
+			StateRoots:                  stateRoots,
+			HistoricalRoots:             []phase0.Root{},
+			ETH1Data:                    eth1Data(p.slot),
+			ETH1DataVotes:               []*phase0.ETH1Data{},
+			Validators:                  validators,
+			Balances:                    balances,
+			RANDAOMixes:                 randaoMixes,
+			Slashings:                   slashings,
+			PreviousEpochAttestations:   []*phase0.PendingAttestation{},
+			CurrentEpochAttestations:    []*phase0.PendingAttestation{},
+			JustificationBits:           justificationBits,
+			PreviousJustifiedCheckpoint: previousJustified,
+			CurrentJustifiedCheckpoint:  currentJustified,
+			FinalizedCheckpoint:         finalized,
+		}
+
+		return res, nil
+	}
+
+	previousParticipation := make([]altair.ParticipationFlags, p.validators)
+	currentParticipation := make([]altair.ParticipationFlags, p.validators)
+	inactivityScores := make([]uint64, p.validators)
+	currentSyncCommittee := syncCommittee(p.validators, uint64(epoch))
+	nextSyncCommittee := syncCommittee(p.validators, uint64(epoch)+1)
+	payloadHeader, err := executionPayloadHeader(executionPayload(p.slot))
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &altair.BeaconState{
+			GenesisTime:                 GenesisTime,
+			GenesisValidatorsRoot:       GenesisValidatorsRoot(),
+			Slot:                        p.slot,
+			Fork:                        fork,
+			LatestBlockHeader:           header,
+			BlockRoots:                  blockRoots,
+			StateRoots:                  stateRoots,
+			HistoricalRoots:             []phase0.Root{},
+			ETH1Data:                    eth1Data(p.slot),
+			ETH1DataVotes:               []*phase0.ETH1Data{},
+			Validators:                  validators,
+			Balances:                    balances,
+			RANDAOMixes:                 randaoMixes,
+			Slashings:                   slashings,
+			PreviousEpochParticipation:  previousParticipation,
+			CurrentEpochParticipation:   currentParticipation,
+			JustificationBits:           justificationBits,
+			PreviousJustifiedCheckpoint: previousJustified,
+			CurrentJustifiedCheckpoint:  currentJustified,
+			FinalizedCheckpoint:         finalized,
+			InactivityScores:            inactivityScores,
+			CurrentSyncCommittee:        currentSyncCommittee,
+			NextSyncCommittee:           nextSyncCommittee,
+		}
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{
+			GenesisTime:                  GenesisTime,
+			GenesisValidatorsRoot:        GenesisValidatorsRoot(),
+			Slot:                         p.slot,
+			Fork:                         fork,
+			LatestBlockHeader:            header,
+			BlockRoots:                   blockRoots,
+			StateRoots:                   stateRoots,
+			HistoricalRoots:              []phase0.Root{},
+			ETH1Data:                     eth1Data(p.slot),
+			ETH1DataVotes:                []*phase0.ETH1Data{},
+			Validators:                   validators,
+			Balances:                     balances,
+			RANDAOMixes:                  randaoMixes,
+			Slashings:                    slashings,
+			PreviousEpochParticipation:   previousParticipation,
+			CurrentEpochParticipation:    currentParticipation,
+			JustificationBits:            justificationBits,
+			PreviousJustifiedCheckpoint:  previousJustified,
+			CurrentJustifiedCheckpoint:   currentJustified,
+			FinalizedCheckpoint:          finalized,
+			InactivityScores:             inactivityScores,
+			CurrentSyncCommittee:         currentSyncCommittee,
+			NextSyncCommittee:            nextSyncCommittee,
+			LatestExecutionPayloadHeader: bellatrixExecutionPayloadHeader(payloadHeader),
+		}
+	case spec.DataVersionCapella:
+		res.Capella = &capella.BeaconState{
+			GenesisTime:                  GenesisTime,
+			GenesisValidatorsRoot:        GenesisValidatorsRoot(),
+			Slot:                         p.slot,
+			Fork:                         fork,
+			LatestBlockHeader:            header,
+			BlockRoots:                   blockRoots,
+			StateRoots:                   stateRoots,
+			HistoricalRoots:              []phase0.Root{},
+			ETH1Data:                     eth1Data(p.slot),
+			ETH1DataVotes:                []*phase0.ETH1Data{},
+			Validators:                   validators,
+			Balances:                     balances,
+			RANDAOMixes:                  randaoMixes,
+			Slashings:                    slashings,
+			PreviousEpochParticipation:   previousParticipation,
+			CurrentEpochParticipation:    currentParticipation,
+			JustificationBits:            justificationBits,
+			PreviousJustifiedCheckpoint:  previousJustified,
+			CurrentJustifiedCheckpoint:   currentJustified,
+			FinalizedCheckpoint:          finalized,
+			InactivityScores:             inactivityScores,
+			CurrentSyncCommittee:         currentSyncCommittee,
+			NextSyncCommittee:            nextSyncCommittee,
+			LatestExecutionPayloadHeader: capellaExecutionPayloadHeader(payloadHeader),
+			HistoricalSummaries:          []*capella.HistoricalSummary{},
+		}
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.BeaconState{
+			GenesisTime:                  GenesisTime,
+			GenesisValidatorsRoot:        GenesisValidatorsRoot(),
+			Slot:                         p.slot,
+			Fork:                         fork,
+			LatestBlockHeader:            header,
+			BlockRoots:                   blockRoots,
+			StateRoots:                   stateRoots,
+			HistoricalRoots:              []phase0.Root{},
+			ETH1Data:                     eth1Data(p.slot),
+			ETH1DataVotes:                []*phase0.ETH1Data{},
+			Validators:                   validators,
+			Balances:                     balances,
+			RANDAOMixes:                  randaoMixes,
+			Slashings:                    slashings,
+			PreviousEpochParticipation:   previousParticipation,
+			CurrentEpochParticipation:    currentParticipation,
+			JustificationBits:            justificationBits,
+			PreviousJustifiedCheckpoint:  previousJustified,
+			CurrentJustifiedCheckpoint:   currentJustified,
+			FinalizedCheckpoint:          finalized,
+			InactivityScores:             inactivityScores,
+			CurrentSyncCommittee:         currentSyncCommittee,
+			NextSyncCommittee:            nextSyncCommittee,
+			LatestExecutionPayloadHeader: payloadHeader,
+			HistoricalSummaries:          []*capella.HistoricalSummary{},
+		}
+	case spec.DataVersionElectra:
+		res.Electra = &electra.BeaconState{
+			GenesisTime:                  GenesisTime,
+			GenesisValidatorsRoot:        GenesisValidatorsRoot(),
+			Slot:                         p.slot,
+			Fork:                         fork,
+			LatestBlockHeader:            header,
+			BlockRoots:                   blockRoots,
+			StateRoots:                   stateRoots,
+			HistoricalRoots:              []phase0.Root{},
+			ETH1Data:                     eth1Data(p.slot),
+			ETH1DataVotes:                []*phase0.ETH1Data{},
+			Validators:                   validators,
+			Balances:                     balances,
+			RANDAOMixes:                  randaoMixes,
+			Slashings:                    slashings,
+			PreviousEpochParticipation:   previousParticipation,
+			CurrentEpochParticipation:    currentParticipation,
+			JustificationBits:            justificationBits,
+			PreviousJustifiedCheckpoint:  previousJustified,
+			CurrentJustifiedCheckpoint:   currentJustified,
+			FinalizedCheckpoint:          finalized,
+			InactivityScores:             inactivityScores,
+			CurrentSyncCommittee:         currentSyncCommittee,
+			NextSyncCommittee:            nextSyncCommittee,
+			LatestExecutionPayloadHeader: payloadHeader,
+			HistoricalSummaries:          []*capella.HistoricalSummary{},
+			DepositRequestsStartIndex:    math.MaxUint64,
+			PendingDeposits:              []*electra.PendingDeposit{},
+			PendingPartialWithdrawals:    []*electra.PendingPartialWithdrawal{},
+			PendingConsolidations:        []*electra.PendingConsolidation{},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported version %v", version)
+	}
+
+	return res, nil
+}
+
+// syncCommittee returns a deterministic sync committee made up of the
+// validators in turn.
+func syncCommittee(validators uint64, seed uint64) *altair.SyncCommittee {
+	pubKeys := make([]phase0.BLSPubKey, syncCommitteeSize)
+	for i := range pubKeys {
+		pubKeys[i] = PubKey(phase0.ValidatorIndex((seed + uint64(i)) % validators))
+	}
+
+	return &altair.SyncCommittee{
+		Pubkeys:         pubKeys,
+		AggregatePubkey: PubKey(phase0.ValidatorIndex(math.MaxUint32 + seed)),
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testfixtures_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/graffiti"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+var versions = []spec.DataVersion{
+	spec.DataVersionPhase0,
+	spec.DataVersionAltair,
+	spec.DataVersionBellatrix,
+	spec.DataVersionCapella,
+	spec.DataVersionDeneb,
+	spec.DataVersionElectra,
+}
+
+func TestBlock(t *testing.T) {
+	for _, version := range versions {
+		t.Run(version.String(), func(t *testing.T) {
+			attestation, err := testfixtures.Attestation(version, testfixtures.WithSlot(99), testfixtures.WithCommitteeIndex(2))
+			require.NoError(t, err)
+			committeeIndex, err := attestation.CommitteeIndex()
+			require.NoError(t, err)
+			require.Equal(t, phase0.CommitteeIndex(2), committeeIndex)
+
+			block, err := testfixtures.Block(version,
+				testfixtures.WithSlot(100),
+				testfixtures.WithProposer(5),
+				testfixtures.WithGraffiti(graffiti.New("test")),
+				testfixtures.WithAttestations([]*spec.VersionedAttestation{attestation}),
+			)
+			require.NoError(t, err)
+			require.Equal(t, version, block.Version)
+
+			slot, err := block.Slot()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(100), slot)
+			proposer, err := block.ProposerIndex()
+			require.NoError(t, err)
+			require.Equal(t, phase0.ValidatorIndex(5), proposer)
+			blockGraffiti, err := block.Graffiti()
+			require.NoError(t, err)
+			require.Equal(t, "test", graffiti.Graffiti(blockGraffiti).String())
+			attestations, err := block.Attestations()
+			require.NoError(t, err)
+			require.Len(t, attestations, 1)
+
+			// Deterministic.
+			root, err := block.Root()
+			require.NoError(t, err)
+			again, err := testfixtures.Block(version,
+				testfixtures.WithSlot(100),
+				testfixtures.WithProposer(5),
+				testfixtures.WithGraffiti(graffiti.New("test")),
+				testfixtures.WithAttestations([]*spec.VersionedAttestation{attestation}),
+			)
+			require.NoError(t, err)
+			againRoot, err := again.Root()
+			require.NoError(t, err)
+			require.Equal(t, root, againRoot)
+
+			if version >= spec.DataVersionBellatrix {
+				blockHash, err := block.ExecutionBlockHash()
+				require.NoError(t, err)
+				require.Equal(t, testfixtures.ExecutionBlockHash(100), blockHash)
+			}
+		})
+	}
+}
+
+func TestBlockErrors(t *testing.T) {
+	_, err := testfixtures.Block(spec.DataVersionUnknown)
+	require.EqualError(t, err, "unsupported version unknown")
+
+	attestation, err := testfixtures.Attestation(spec.DataVersionDeneb)
+	require.NoError(t, err)
+	_, err = testfixtures.Block(spec.DataVersionElectra, testfixtures.WithAttestations([]*spec.VersionedAttestation{attestation}))
+	require.EqualError(t, err, "attestation 0 is not of version electra")
+
+	_, err = testfixtures.Attestation(spec.DataVersionUnknown)
+	require.EqualError(t, err, "unsupported version unknown")
+}
+
+func TestChain(t *testing.T) {
+	for _, version := range versions {
+		t.Run(version.String(), func(t *testing.T) {
+			blocks, err := testfixtures.Chain(version, 3, testfixtures.WithSlot(10))
+			require.NoError(t, err)
+			require.Len(t, blocks, 3)
+
+			for i := 1; i < len(blocks); i++ {
+				slot, err := blocks[i].Slot()
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(10+i), slot)
+				proposer, err := blocks[i].ProposerIndex()
+				require.NoError(t, err)
+				require.Equal(t, phase0.ValidatorIndex(10+i), proposer)
+
+				parentRoot, err := blocks[i].ParentRoot()
+				require.NoError(t, err)
+				root, err := blocks[i-1].Root()
+				require.NoError(t, err)
+				require.Equal(t, root, parentRoot)
+			}
+		})
+	}
+}
+
+func TestBlockAndState(t *testing.T) {
+	for _, version := range versions {
+		t.Run(version.String(), func(t *testing.T) {
+			block, state, err := testfixtures.BlockAndState(version,
+				testfixtures.WithSlot(65),
+				testfixtures.WithValidators(16),
+				testfixtures.WithParentRoot(phase0.Root{0x01}),
+			)
+			require.NoError(t, err)
+			require.Equal(t, version, state.Version)
+
+			stateRoot, err := state.Root()
+			require.NoError(t, err)
+			blockStateRoot, err := block.StateRoot()
+			require.NoError(t, err)
+			require.Equal(t, stateRoot, blockStateRoot)
+
+			// Filling in the state root of the latest block header, as
+			// happens when the next slot is processed, gives the block root.
+			header, err := state.LatestBlockHeader()
+			require.NoError(t, err)
+			header.StateRoot = stateRoot
+			headerRoot, err := header.HashTreeRoot()
+			require.NoError(t, err)
+			blockRoot, err := block.Root()
+			require.NoError(t, err)
+			require.Equal(t, blockRoot, phase0.Root(headerRoot))
+
+			validators, err := state.Validators()
+			require.NoError(t, err)
+			require.Len(t, validators, 16)
+			require.Equal(t, testfixtures.PubKey(3), validators[3].PublicKey)
+
+			if version >= spec.DataVersionBellatrix {
+				payloadHeader, err := state.LatestExecutionPayloadHeader()
+				require.NoError(t, err)
+				blockHash, err := payloadHeader.BlockHash()
+				require.NoError(t, err)
+				require.Equal(t, testfixtures.ExecutionBlockHash(65), blockHash)
+			}
+		})
+	}
+}
+
+func TestState(t *testing.T) {
+	state, err := testfixtures.State(spec.DataVersionDeneb, testfixtures.WithSlot(5))
+	require.NoError(t, err)
+	slot, err := state.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), slot)
+	validators, err := state.Validators()
+	require.NoError(t, err)
+	require.Len(t, validators, 64)
+
+	_, err = testfixtures.State(spec.DataVersionUnknown)
+	require.EqualError(t, err, "unsupported version unknown")
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Now().Add(-10 * 12 * time.Second)
+	client, err := testfixtures.Client(genesisTime, map[string]any{
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(1),
+	})
+	require.NoError(t, err)
+	block, err := testfixtures.Block(spec.DataVersionPhase0, testfixtures.WithSlot(5))
+	require.NoError(t, err)
+	testfixtures.SetBeaconCommittees(client)
+	testfixtures.SetBlocks(client, map[phase0.Slot]*spec.VersionedSignedBeaconBlock{5: block})
+
+	specResponse, err := client.Spec(ctx, &api.SpecOpts{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(testfixtures.ClientSlotsPerEpoch), specResponse.Data["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(1), specResponse.Data["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"])
+
+	epoch := phase0.Epoch(2)
+	committeesResponse, err := client.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{State: "head", Epoch: &epoch})
+	require.NoError(t, err)
+	require.Len(t, committeesResponse.Data, testfixtures.ClientSlotsPerEpoch*testfixtures.ClientCommittees)
+	committee := committeesResponse.Data[3]
+	require.Equal(t, phase0.Slot(9), committee.Slot)
+	require.Equal(t, phase0.CommitteeIndex(1), committee.Index)
+	require.Len(t, committee.Validators, testfixtures.ClientCommitteeSize)
+	require.Equal(t, testfixtures.CommitteeMember(9, 1, 2), committee.Validators[2])
+
+	blockResponse, err := client.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "5"})
+	require.NoError(t, err)
+	require.Equal(t, block, blockResponse.Data)
+	_, err = client.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "6"})
+	require.ErrorIs(t, err, testfixtures.ErrNotFound)
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+	require.Equal(t, genesisTime.Unix(), chainTime.GenesisTime().Unix())
+	require.Equal(t, phase0.Slot(10), chainTime.CurrentSlot())
+	require.Equal(t, phase0.Epoch(2), chainTime.CurrentEpoch())
+}
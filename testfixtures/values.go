@@ -0,0 +1,117 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testfixtures
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+const (
+	// SlotsPerEpoch is the number of slots per epoch used by the builders.
+	SlotsPerEpoch = 32
+	// GenesisTime is the genesis time used by the builders.
+	GenesisTime = uint64(1606824023)
+	// SecondsPerSlot is the slot duration used by the builders.
+	SecondsPerSlot = uint64(12)
+)
+
+// hash returns a deterministic hash of a label and a number.
+func hash(label string, number uint64) [32]byte {
+	input := make([]byte, len(label)+8)
+	copy(input, label)
+	binary.LittleEndian.PutUint64(input[len(label):], number)
+
+	return sha256.Sum256(input)
+}
+
+// signature returns a deterministic, but not valid, signature.
+func signature(label string, number uint64) phase0.BLSSignature {
+	var res phase0.BLSSignature
+	for i := 0; i < 3; i++ {
+		part := hash(label, number*3+uint64(i))
+		copy(res[i*32:], part[:])
+	}
+
+	return res
+}
+
+// PubKey returns the deterministic public key of the validator with the
+// given index.
+func PubKey(index phase0.ValidatorIndex) phase0.BLSPubKey {
+	var res phase0.BLSPubKey
+	first := hash("pubkey", uint64(index)*2)
+	second := hash("pubkey", uint64(index)*2+1)
+	copy(res[:], first[:])
+	copy(res[32:], second[:16])
+
+	return res
+}
+
+// GenesisValidatorsRoot is the genesis validators root used by the builders.
+func GenesisValidatorsRoot() phase0.Root {
+	return hash("genesis", 0)
+}
+
+// ExecutionBlockHash returns the execution block hash of the payload in the
+// block built for the given slot.
+func ExecutionBlockHash(slot phase0.Slot) phase0.Hash32 {
+	return hash("execution", uint64(slot))
+}
+
+// forkVersion returns the fork version for the given data version.
+func forkVersion(version spec.DataVersion) phase0.Version {
+	if version <= spec.DataVersionPhase0 {
+		return phase0.Version{}
+	}
+
+	return phase0.Version{byte(version - spec.DataVersionPhase0)}
+}
+
+// parentRoot returns the default parent root for a block at the given slot.
+func parentRoot(slot phase0.Slot) phase0.Root {
+	return hash("parent", uint64(slot))
+}
+
+// checkpoint returns a deterministic checkpoint for the given epoch.
+func checkpoint(epoch phase0.Epoch) *phase0.Checkpoint {
+	return &phase0.Checkpoint{
+		Epoch: epoch,
+		Root:  hash("checkpoint", uint64(epoch)),
+	}
+}
+
+// epochBefore returns the epoch the given number of epochs before the epoch
+// of the slot, or 0.
+func epochBefore(slot phase0.Slot, epochs uint64) phase0.Epoch {
+	epoch := uint64(slot) / SlotsPerEpoch
+	if epoch < epochs {
+		return 0
+	}
+
+	return phase0.Epoch(epoch - epochs)
+}
+
+// eth1Data returns the deterministic ETH1 data for the given slot.
+func eth1Data(slot phase0.Slot) *phase0.ETH1Data {
+	blockHash := hash("eth1", uint64(slot)/SlotsPerEpoch)
+
+	return &phase0.ETH1Data{
+		DepositRoot: hash("deposits", 0),
+		BlockHash:   blockHash[:],
+	}
+}
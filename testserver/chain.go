@@ -0,0 +1,204 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testserver
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// The chain served by the test server is deterministic: every slot up to the
+// current slot contains a block, every validator is active from genesis and
+// attests once per epoch, and all roots and keys are derived from hashes of
+// the slot or validator index.  This allows tests to calculate the values
+// they expect without reference to the server.
+
+// GenesisValidatorsRoot is the genesis validators root of the chain.
+var GenesisValidatorsRoot = hashRoot("genesis_validators_root", 0)
+
+// GenesisForkVersion is the genesis fork version of the chain.
+var GenesisForkVersion = phase0.Version{0x10, 0x00, 0x00, 0x38}
+
+// PubKey returns the public key of the validator with the given index.
+// Public keys are unique but are not valid BLS public keys.
+func PubKey(index phase0.ValidatorIndex) phase0.BLSPubKey {
+	root1 := hashRoot("pubkey", uint64(index))
+	root2 := hashRoot("pubkey2", uint64(index))
+
+	pubKey := phase0.BLSPubKey{}
+	copy(pubKey[:32], root1[:])
+	copy(pubKey[32:], root2[:16])
+
+	return pubKey
+}
+
+// BlockRoot returns the root of the block at the given slot.
+func BlockRoot(slot phase0.Slot) phase0.Root {
+	return hashRoot("block", uint64(slot))
+}
+
+// StateRoot returns the root of the state at the given slot.
+func StateRoot(slot phase0.Slot) phase0.Root {
+	return hashRoot("state", uint64(slot))
+}
+
+// hashRoot returns a root derived from the given label and value.
+func hashRoot(label string, value uint64) phase0.Root {
+	data := make([]byte, len(label)+8)
+	copy(data, label)
+	binary.LittleEndian.PutUint64(data[len(label):], value)
+
+	return sha256.Sum256(data)
+}
+
+// currentSlot returns the current slot of the chain.
+func (s *Server) currentSlot() phase0.Slot {
+	elapsed := time.Since(s.genesisTime)
+	if elapsed < 0 {
+		return 0
+	}
+
+	return phase0.Slot(elapsed / s.slotDuration)
+}
+
+// epoch returns the epoch of the given slot.
+func (s *Server) epoch(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// epochStartSlot returns the first slot of the given epoch.
+func (s *Server) epochStartSlot(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// checkpoint returns the checkpoint for the given epoch.
+func (s *Server) checkpoint(epoch phase0.Epoch) *phase0.Checkpoint {
+	return &phase0.Checkpoint{
+		Epoch: epoch,
+		Root:  BlockRoot(s.epochStartSlot(epoch)),
+	}
+}
+
+// justifiedEpoch returns the justified epoch as of the given slot.
+func (s *Server) justifiedEpoch(slot phase0.Slot) phase0.Epoch {
+	epoch := s.epoch(slot)
+	if epoch == 0 {
+		return 0
+	}
+
+	return epoch - 1
+}
+
+// finalizedEpoch returns the finalized epoch as of the given slot.
+func (s *Server) finalizedEpoch(slot phase0.Slot) phase0.Epoch {
+	epoch := s.epoch(slot)
+	if epoch < 2 {
+		return 0
+	}
+
+	return epoch - 2
+}
+
+// dependentRoot returns the root of the block on which duties for the given
+// epoch depend, which is the last block of the previous epoch.
+func (s *Server) dependentRoot(epoch phase0.Epoch) phase0.Root {
+	if epoch == 0 {
+		return BlockRoot(0)
+	}
+
+	return BlockRoot(s.epochStartSlot(epoch) - 1)
+}
+
+// proposerIndex returns the index of the proposer for the given slot.
+func (s *Server) proposerIndex(slot phase0.Slot) phase0.ValidatorIndex {
+	return phase0.ValidatorIndex(uint64(slot) % s.validators)
+}
+
+// attesterDuty returns the attester duty for the given validator in the given epoch.
+// Each slot has a single committee, made up of the validators whose index
+// modulo the number of slots per epoch is the slot's position in the epoch.
+func (s *Server) attesterDuty(epoch phase0.Epoch, index phase0.ValidatorIndex) *apiv1.AttesterDuty {
+	position := uint64(index) % s.slotsPerEpoch
+	committeeLength := s.validators / s.slotsPerEpoch
+	if position < s.validators%s.slotsPerEpoch {
+		committeeLength++
+	}
+
+	return &apiv1.AttesterDuty{
+		PubKey:                  PubKey(index),
+		Slot:                    s.epochStartSlot(epoch) + phase0.Slot(position),
+		ValidatorIndex:          index,
+		CommitteeIndex:          0,
+		CommitteeLength:         committeeLength,
+		CommitteesAtSlot:        1,
+		ValidatorCommitteeIndex: uint64(index) / s.slotsPerEpoch,
+	}
+}
+
+// validator returns the validator with the given index.
+func (*Server) validator(index phase0.ValidatorIndex) *apiv1.Validator {
+	withdrawalCredentials := hashRoot("withdrawal_credentials", uint64(index))
+	withdrawalCredentials[0] = 0x00
+
+	return &apiv1.Validator{
+		Index:   index,
+		Balance: 32000000000,
+		Status:  apiv1.ValidatorStateActiveOngoing,
+		Validator: &phase0.Validator{
+			PublicKey:                  PubKey(index),
+			WithdrawalCredentials:      withdrawalCredentials[:],
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  phase0.FarFutureEpoch,
+			WithdrawableEpoch:          phase0.FarFutureEpoch,
+		},
+	}
+}
+
+// block returns the unsigned block for the given slot.
+func (s *Server) block(slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti [32]byte,
+) *phase0.BeaconBlock {
+	parentRoot := phase0.Root{}
+	if slot > 0 {
+		parentRoot = BlockRoot(slot - 1)
+	}
+	blockHash := hashRoot("eth1_block_hash", uint64(slot))
+
+	return &phase0.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: s.proposerIndex(slot),
+		ParentRoot:    parentRoot,
+		StateRoot:     StateRoot(slot),
+		Body: &phase0.BeaconBlockBody{
+			RANDAOReveal: randaoReveal,
+			ETH1Data: &phase0.ETH1Data{
+				DepositRoot: hashRoot("deposit_root", 0),
+				BlockHash:   blockHash[:],
+			},
+			Graffiti:          graffiti,
+			ProposerSlashings: make([]*phase0.ProposerSlashing, 0),
+			AttesterSlashings: make([]*phase0.AttesterSlashing, 0),
+			Attestations:      make([]*phase0.Attestation, 0),
+			Deposits:          make([]*phase0.Deposit, 0),
+			VoluntaryExits:    make([]*phase0.SignedVoluntaryExit, 0),
+		},
+	}
+}
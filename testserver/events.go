@@ -0,0 +1,121 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// handleEvents serves the event stream.  Head and block events are sent at
+// the start of each slot; other topics are accepted but no events are sent.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming not supported")
+
+		return
+	}
+
+	topics := make(map[string]struct{})
+	for _, topic := range r.URL.Query()["topics"] {
+		if _, exists := apiv1.SupportedEventTopics[topic]; !exists {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported event topic %s", topic))
+
+			return
+		}
+		topics[topic] = struct{}{}
+	}
+	if len(topics) == 0 {
+		writeError(w, http.StatusBadRequest, "no topics specified")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		slot := s.currentSlot() + 1
+		timer := time.NewTimer(time.Until(s.genesisTime.Add(time.Duration(slot) * s.slotDuration)))
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+
+			return
+		case <-s.done:
+			timer.Stop()
+
+			return
+		case <-timer.C:
+		}
+
+		if err := s.sendSlotEvents(w, topics, phase0.Slot(slot)); err != nil {
+			s.log.Debug().Err(err).Msg("Failed to send events")
+
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// sendSlotEvents sends the events for the start of the given slot.
+func (s *Server) sendSlotEvents(w http.ResponseWriter, topics map[string]struct{}, slot phase0.Slot) error {
+	if _, exists := topics["block"]; exists {
+		if err := writeEvent(w, "block", &apiv1.BlockEvent{
+			Slot:  slot,
+			Block: BlockRoot(slot),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if _, exists := topics["head"]; exists {
+		epoch := s.epoch(slot)
+		previousEpoch := phase0.Epoch(0)
+		if epoch > 0 {
+			previousEpoch = epoch - 1
+		}
+		if err := writeEvent(w, "head", &apiv1.HeadEvent{
+			Slot:                      slot,
+			Block:                     BlockRoot(slot),
+			State:                     StateRoot(slot),
+			EpochTransition:           uint64(slot)%s.slotsPerEpoch == 0,
+			CurrentDutyDependentRoot:  s.dependentRoot(epoch),
+			PreviousDutyDependentRoot: s.dependentRoot(previousEpoch),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeEvent writes a single server-sent event.
+func writeEvent(w http.ResponseWriter, topic string, event any) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, data)
+
+	return err
+}
@@ -0,0 +1,277 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// depositChainID is the chain ID of the execution chain.
+const depositChainID = 1337
+
+func (s *Server) handleNodeSyncing(w http.ResponseWriter) {
+	writeData(w, &apiv1.SyncState{
+		HeadSlot: s.currentSlot(),
+	}, nil)
+}
+
+func (s *Server) handleGenesis(w http.ResponseWriter) {
+	writeData(w, &apiv1.Genesis{
+		GenesisTime:           s.genesisTime,
+		GenesisValidatorsRoot: GenesisValidatorsRoot,
+		GenesisForkVersion:    GenesisForkVersion,
+	}, nil)
+}
+
+// handleSpec serves the chain specification.  All forks after phase 0 are
+// scheduled for the far future, so the chain remains in phase 0.
+func (s *Server) handleSpec(w http.ResponseWriter) {
+	writeData(w, map[string]string{
+		"CONFIG_NAME":                      "testserver",
+		"PRESET_BASE":                      "mainnet",
+		"GENESIS_FORK_VERSION":             fmt.Sprintf("%#x", GenesisForkVersion),
+		"MIN_GENESIS_TIME":                 strconv.FormatInt(s.genesisTime.Unix(), 10),
+		"SECONDS_PER_SLOT":                 strconv.FormatInt(int64(s.slotDuration.Seconds()), 10),
+		"SLOTS_PER_EPOCH":                  strconv.FormatUint(s.slotsPerEpoch, 10),
+		"MAX_COMMITTEES_PER_SLOT":          "1",
+		"TARGET_COMMITTEE_SIZE":            "128",
+		"TARGET_AGGREGATORS_PER_COMMITTEE": "16",
+		"MAX_EFFECTIVE_BALANCE":            "32000000000",
+		"EFFECTIVE_BALANCE_INCREMENT":      "1000000000",
+		"FAR_FUTURE_EPOCH":                 strconv.FormatUint(uint64(phase0.FarFutureEpoch), 10),
+		"DEPOSIT_CHAIN_ID":                 strconv.FormatUint(depositChainID, 10),
+		"DEPOSIT_NETWORK_ID":               strconv.FormatUint(depositChainID, 10),
+		"DEPOSIT_CONTRACT_ADDRESS":         fmt.Sprintf("%#x", depositContractAddress()),
+		"ALTAIR_FORK_EPOCH":                strconv.FormatUint(uint64(phase0.FarFutureEpoch), 10),
+		"BELLATRIX_FORK_EPOCH":             strconv.FormatUint(uint64(phase0.FarFutureEpoch), 10),
+		"CAPELLA_FORK_EPOCH":               strconv.FormatUint(uint64(phase0.FarFutureEpoch), 10),
+		"DENEB_FORK_EPOCH":                 strconv.FormatUint(uint64(phase0.FarFutureEpoch), 10),
+		"ELECTRA_FORK_EPOCH":               strconv.FormatUint(uint64(phase0.FarFutureEpoch), 10),
+		"DOMAIN_BEACON_PROPOSER":           "0x00000000",
+		"DOMAIN_BEACON_ATTESTER":           "0x01000000",
+		"DOMAIN_RANDAO":                    "0x02000000",
+		"DOMAIN_DEPOSIT":                   "0x03000000",
+		"DOMAIN_VOLUNTARY_EXIT":            "0x04000000",
+		"DOMAIN_SELECTION_PROOF":           "0x05000000",
+		"DOMAIN_AGGREGATE_AND_PROOF":       "0x06000000",
+		"DOMAIN_APPLICATION_BUILDER":       "0x00000001",
+	}, nil)
+}
+
+func (*Server) handleForkSchedule(w http.ResponseWriter) {
+	writeData(w, []*phase0.Fork{
+		{
+			PreviousVersion: GenesisForkVersion,
+			CurrentVersion:  GenesisForkVersion,
+			Epoch:           0,
+		},
+	}, nil)
+}
+
+func (*Server) handleDepositContract(w http.ResponseWriter) {
+	writeData(w, &apiv1.DepositContract{
+		ChainID: depositChainID,
+		Address: depositContractAddress(),
+	}, nil)
+}
+
+// depositContractAddress returns the address of the deposit contract.
+func depositContractAddress() []byte {
+	root := hashRoot("deposit_contract", 0)
+
+	return root[:20]
+}
+
+func (s *Server) handleProposerDuties(w http.ResponseWriter, epochStr string) {
+	epoch, err := strconv.ParseUint(epochStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid epoch")
+
+		return
+	}
+
+	startSlot := s.epochStartSlot(phase0.Epoch(epoch))
+	duties := make([]*apiv1.ProposerDuty, 0, s.slotsPerEpoch)
+	for i := uint64(0); i < s.slotsPerEpoch; i++ {
+		slot := startSlot + phase0.Slot(i)
+		index := s.proposerIndex(slot)
+		duties = append(duties, &apiv1.ProposerDuty{
+			PubKey:         PubKey(index),
+			Slot:           slot,
+			ValidatorIndex: index,
+		})
+	}
+
+	writeData(w, duties, map[string]any{
+		"dependent_root":       s.dependentRoot(phase0.Epoch(epoch)),
+		"execution_optimistic": false,
+	})
+}
+
+func (s *Server) handleAttesterDuties(w http.ResponseWriter, epochStr string, body []byte) {
+	epoch, err := strconv.ParseUint(epochStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid epoch")
+
+		return
+	}
+
+	indices := make([]string, 0)
+	if err := json.Unmarshal(body, &indices); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid body")
+
+		return
+	}
+
+	duties := make([]*apiv1.AttesterDuty, 0, len(indices))
+	for _, indexStr := range indices {
+		index, err := strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid validator index %s", indexStr))
+
+			return
+		}
+		if index >= s.validators {
+			// Unknown validators have no duties.
+			continue
+		}
+		duties = append(duties, s.attesterDuty(phase0.Epoch(epoch), phase0.ValidatorIndex(index)))
+	}
+
+	previousEpoch := phase0.Epoch(0)
+	if epoch > 0 {
+		previousEpoch = phase0.Epoch(epoch - 1)
+	}
+	writeData(w, duties, map[string]any{
+		"dependent_root":       s.dependentRoot(previousEpoch),
+		"execution_optimistic": false,
+	})
+}
+
+func (s *Server) handleProposal(w http.ResponseWriter, r *http.Request, slotStr string) {
+	slot, err := strconv.ParseUint(slotStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+
+		return
+	}
+
+	query := r.URL.Query()
+	randaoReveal := phase0.BLSSignature{}
+	data, err := codecs.DecodeHexFixed(query.Get("randao_reveal"), len(randaoReveal))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid randao reveal")
+
+		return
+	}
+	copy(randaoReveal[:], data)
+
+	graffiti := [32]byte{}
+	if query.Has("graffiti") {
+		data, err := codecs.DecodeHexFixed(query.Get("graffiti"), phase0.GraffitiLength)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid graffiti")
+
+			return
+		}
+		copy(graffiti[:], data)
+	}
+
+	w.Header().Set("Eth-Consensus-Version", "phase0")
+	w.Header().Set("Eth-Execution-Payload-Blinded", "false")
+	w.Header().Set("Eth-Execution-Payload-Value", "0")
+	w.Header().Set("Eth-Consensus-Block-Value", "0")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"version":                   "phase0",
+		"execution_payload_blinded": false,
+		"execution_payload_value":   "0",
+		"consensus_block_value":     "0",
+		"data":                      s.block(phase0.Slot(slot), randaoReveal, graffiti),
+	})
+}
+
+func (s *Server) handleAttestationData(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	slot, err := strconv.ParseUint(query.Get("slot"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot")
+
+		return
+	}
+	committeeIndex := uint64(0)
+	if query.Has("committee_index") {
+		committeeIndex, err = strconv.ParseUint(query.Get("committee_index"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid committee index")
+
+			return
+		}
+	}
+
+	writeData(w, &phase0.AttestationData{
+		Slot:            phase0.Slot(slot),
+		Index:           phase0.CommitteeIndex(committeeIndex),
+		BeaconBlockRoot: BlockRoot(phase0.Slot(slot)),
+		Source:          s.checkpoint(s.justifiedEpoch(phase0.Slot(slot))),
+		Target:          s.checkpoint(s.epoch(phase0.Slot(slot))),
+	}, nil)
+}
+
+func (s *Server) handleValidators(w http.ResponseWriter, ids []string) {
+	validators := make([]*apiv1.Validator, 0)
+	if len(ids) == 0 {
+		for i := uint64(0); i < s.validators; i++ {
+			validators = append(validators, s.validator(phase0.ValidatorIndex(i)))
+		}
+	}
+	for _, id := range ids {
+		if strings.HasPrefix(id, "0x") {
+			data, err := codecs.DecodeHexFixed(id, phase0.PublicKeyLength)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid public key %s", id))
+
+				return
+			}
+			index, exists := s.indices[phase0.BLSPubKey(data)]
+			if exists {
+				validators = append(validators, s.validator(index))
+			}
+
+			continue
+		}
+
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid validator index %s", id))
+
+			return
+		}
+		if index < s.validators {
+			validators = append(validators, s.validator(phase0.ValidatorIndex(index)))
+		}
+	}
+
+	writeData(w, validators, map[string]any{
+		"execution_optimistic": false,
+		"finalized":            false,
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testserver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel      zerolog.Level
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	validators    uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain.  Defaults to the time
+// at which the server is created.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithSlotDuration sets the duration of a slot.  It must be a whole number
+// of seconds, as that is how it is represented in the chain specification.
+func WithSlotDuration(slotDuration time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotDuration = slotDuration
+	})
+}
+
+// WithSlotsPerEpoch sets the number of slots in an epoch.
+func WithSlotsPerEpoch(slotsPerEpoch uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpoch = slotsPerEpoch
+	})
+}
+
+// WithValidators sets the number of validators on the chain.
+func WithValidators(validators uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validators = validators
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:      zerolog.GlobalLevel(),
+		genesisTime:   time.Now(),
+		slotDuration:  12 * time.Second,
+		slotsPerEpoch: 32,
+		validators:    64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.genesisTime.IsZero() {
+		return nil, errors.New("no genesis time specified")
+	}
+	if parameters.slotDuration < time.Second {
+		return nil, errors.New("slot duration must be at least one second")
+	}
+	if parameters.slotDuration%time.Second != 0 {
+		return nil, errors.New("slot duration must be a whole number of seconds")
+	}
+	if parameters.slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch must be greater than 0")
+	}
+	if parameters.validators == 0 {
+		return nil, errors.New("validators must be greater than 0")
+	}
+
+	return &parameters, nil
+}
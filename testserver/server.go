@@ -0,0 +1,259 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testserver provides a simulated beacon node serving a minimal,
+// in-memory beacon API backed by a deterministic chain.  It allows validator
+// clients built on this module to run end-to-end tests, covering genesis and
+// configuration, duties, block production, submissions and the event stream,
+// without the need for a real beacon node.
+package testserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Version is the version reported by the server.
+const Version = "testserver/v0.1.0"
+
+// submissionPaths are the paths to which data can be submitted.  Submitted
+// data is accepted without validation and recorded for later inspection.
+var submissionPaths = map[string]struct{}{
+	"/eth/v1/beacon/blocks":                            {},
+	"/eth/v2/beacon/blocks":                            {},
+	"/eth/v1/beacon/pool/attestations":                 {},
+	"/eth/v2/beacon/pool/attestations":                 {},
+	"/eth/v1/validator/aggregate_and_proofs":           {},
+	"/eth/v2/validator/aggregate_and_proofs":           {},
+	"/eth/v1/validator/beacon_committee_subscriptions": {},
+	"/eth/v1/validator/prepare_beacon_proposer":        {},
+}
+
+// Server is a simulated beacon node.
+type Server struct {
+	log           zerolog.Logger
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	validators    uint64
+	indices       map[phase0.BLSPubKey]phase0.ValidatorIndex
+
+	server    *httptest.Server
+	done      chan struct{}
+	closeOnce sync.Once
+
+	submissionsMu sync.Mutex
+	submissions   map[string][][]byte
+}
+
+// New creates and starts a new simulated beacon node.  The server is closed
+// when the context is done, or when Close is called.
+func New(ctx context.Context, params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "testserver").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Server{
+		log:           log,
+		genesisTime:   parameters.genesisTime.Truncate(time.Second),
+		slotDuration:  parameters.slotDuration,
+		slotsPerEpoch: parameters.slotsPerEpoch,
+		validators:    parameters.validators,
+		indices:       make(map[phase0.BLSPubKey]phase0.ValidatorIndex, parameters.validators),
+		done:          make(chan struct{}),
+		submissions:   make(map[string][][]byte),
+	}
+	for i := uint64(0); i < s.validators; i++ {
+		s.indices[PubKey(phase0.ValidatorIndex(i))] = phase0.ValidatorIndex(i)
+	}
+
+	s.server = httptest.NewServer(s)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.Close()
+		case <-s.done:
+		}
+	}()
+
+	return s, nil
+}
+
+// Address returns the address of the server.
+func (s *Server) Address() string {
+	return s.server.URL
+}
+
+// GenesisTime returns the genesis time of the chain.
+func (s *Server) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// Close closes the server.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.server.Close()
+	})
+}
+
+// Submissions returns the bodies of the requests submitted to the given path.
+func (s *Server) Submissions(path string) [][]byte {
+	s.submissionsMu.Lock()
+	defer s.submissionsMu.Unlock()
+
+	submissions := make([][]byte, len(s.submissions[path]))
+	copy(submissions, s.submissions[path])
+
+	return submissions
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.log.Trace().Str("method", r.Method).Str("path", r.URL.Path).Msg("Received request")
+
+	path := r.URL.Path
+	switch r.Method {
+	case http.MethodGet:
+		s.serveGet(w, r, path)
+	case http.MethodPost:
+		s.servePost(w, r, path)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", r.Method))
+	}
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, r *http.Request, path string) {
+	switch {
+	case path == "/eth/v1/node/version":
+		writeData(w, map[string]string{"version": Version}, nil)
+	case path == "/eth/v1/node/syncing":
+		s.handleNodeSyncing(w)
+	case path == "/eth/v1/node/health":
+		w.WriteHeader(http.StatusOK)
+	case path == "/eth/v1/beacon/genesis":
+		s.handleGenesis(w)
+	case path == "/eth/v1/config/spec":
+		s.handleSpec(w)
+	case path == "/eth/v1/config/fork_schedule":
+		s.handleForkSchedule(w)
+	case path == "/eth/v1/config/deposit_contract":
+		s.handleDepositContract(w)
+	case path == "/eth/v1/validator/attestation_data":
+		s.handleAttestationData(w, r)
+	case path == "/eth/v1/events":
+		s.handleEvents(w, r)
+	case strings.HasPrefix(path, "/eth/v1/validator/duties/proposer/"):
+		s.handleProposerDuties(w, strings.TrimPrefix(path, "/eth/v1/validator/duties/proposer/"))
+	case strings.HasPrefix(path, "/eth/v3/validator/blocks/"):
+		s.handleProposal(w, r, strings.TrimPrefix(path, "/eth/v3/validator/blocks/"))
+	case isValidatorsPath(path):
+		s.handleValidators(w, r.URL.Query()["id"])
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("endpoint %s not found", path))
+	}
+}
+
+func (s *Server) servePost(w http.ResponseWriter, r *http.Request, path string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read body")
+
+		return
+	}
+
+	if _, exists := submissionPaths[path]; exists {
+		s.submissionsMu.Lock()
+		s.submissions[path] = append(s.submissions[path], body)
+		s.submissionsMu.Unlock()
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(path, "/eth/v1/validator/duties/attester/"):
+		s.handleAttesterDuties(w, strings.TrimPrefix(path, "/eth/v1/validator/duties/attester/"), body)
+	case isValidatorsPath(path):
+		ids := struct {
+			IDs []string `json:"ids"`
+		}{}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &ids); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid body")
+
+				return
+			}
+		}
+		s.handleValidators(w, ids.IDs)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("endpoint %s not found", path))
+	}
+}
+
+// isValidatorsPath returns true if the path is that of the validators endpoint for any state.
+func isValidatorsPath(path string) bool {
+	return strings.HasPrefix(path, "/eth/v1/beacon/states/") && strings.HasSuffix(path, "/validators")
+}
+
+// writeData writes a successful response containing the given data and metadata.
+func writeData(w http.ResponseWriter, data any, metadata map[string]any) {
+	body := map[string]any{
+		"data": data,
+	}
+	for k, v := range metadata {
+		body[k] = v
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// writeError writes an error response in the format used by the beacon API.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]any{
+		"code":    statusCode,
+		"message": message,
+	})
+}
+
+// writeJSON writes the given value as JSON.
+func writeJSON(w http.ResponseWriter, statusCode int, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		data = []byte(fmt.Sprintf(`{"code":%d,"message":"failed to marshal response"}`, statusCode))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,190 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testserver_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	ethhttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testserver"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []testserver.Parameter
+		err    string
+	}{
+		{
+			name:   "GenesisTimeZero",
+			params: []testserver.Parameter{testserver.WithGenesisTime(time.Time{})},
+			err:    "problem with parameters\nno genesis time specified",
+		},
+		{
+			name:   "SlotDurationShort",
+			params: []testserver.Parameter{testserver.WithSlotDuration(time.Millisecond)},
+			err:    "problem with parameters\nslot duration must be at least one second",
+		},
+		{
+			name:   "SlotDurationFractional",
+			params: []testserver.Parameter{testserver.WithSlotDuration(1500 * time.Millisecond)},
+			err:    "problem with parameters\nslot duration must be a whole number of seconds",
+		},
+		{
+			name:   "SlotsPerEpochZero",
+			params: []testserver.Parameter{testserver.WithSlotsPerEpoch(0)},
+			err:    "problem with parameters\nslots per epoch must be greater than 0",
+		},
+		{
+			name:   "ValidatorsZero",
+			params: []testserver.Parameter{testserver.WithValidators(0)},
+			err:    "problem with parameters\nvalidators must be greater than 0",
+		},
+		{
+			name: "Good",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := testserver.New(context.Background(), append(test.params, testserver.WithLogLevel(zerolog.Disabled))...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				s.Close()
+			}
+		})
+	}
+}
+
+func TestEndToEnd(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, err := testserver.New(ctx,
+		testserver.WithLogLevel(zerolog.Disabled),
+		testserver.WithGenesisTime(time.Now().Add(-time.Minute)),
+		testserver.WithSlotDuration(time.Second),
+		testserver.WithSlotsPerEpoch(4),
+		testserver.WithValidators(10),
+	)
+	require.NoError(t, err)
+	defer server.Close()
+
+	service, err := ethhttp.New(ctx,
+		ethhttp.WithLogLevel(zerolog.Disabled),
+		ethhttp.WithAddress(server.Address()),
+	)
+	require.NoError(t, err)
+
+	// Genesis and configuration.
+	genesisResponse, err := service.(client.GenesisProvider).Genesis(ctx, &api.GenesisOpts{})
+	require.NoError(t, err)
+	require.Equal(t, server.GenesisTime(), genesisResponse.Data.GenesisTime)
+	require.Equal(t, testserver.GenesisValidatorsRoot, genesisResponse.Data.GenesisValidatorsRoot)
+	slotsPerEpoch, err := service.(client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), slotsPerEpoch)
+	slotDuration, err := service.(client.SlotDurationProvider).SlotDuration(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Second, slotDuration)
+
+	// Validators.
+	validatorsResponse, err := service.(client.ValidatorsProvider).Validators(ctx, &api.ValidatorsOpts{
+		State:   "head",
+		PubKeys: []phase0.BLSPubKey{testserver.PubKey(3), testserver.PubKey(7)},
+	})
+	require.NoError(t, err)
+	require.Len(t, validatorsResponse.Data, 2)
+	require.Equal(t, testserver.PubKey(7), validatorsResponse.Data[7].Validator.PublicKey)
+	require.Equal(t, apiv1.ValidatorStateActiveOngoing, validatorsResponse.Data[3].Status)
+
+	// Duties.
+	attesterDutiesResponse, err := service.(client.AttesterDutiesProvider).AttesterDuties(ctx, &api.AttesterDutiesOpts{
+		Epoch:   5,
+		Indices: []phase0.ValidatorIndex{1, 6},
+	})
+	require.NoError(t, err)
+	require.Len(t, attesterDutiesResponse.Data, 2)
+	require.Equal(t, phase0.Slot(21), attesterDutiesResponse.Data[0].Slot)
+	require.Equal(t, uint64(3), attesterDutiesResponse.Data[0].CommitteeLength)
+	require.Equal(t, uint64(0), attesterDutiesResponse.Data[0].ValidatorCommitteeIndex)
+	require.Equal(t, phase0.Slot(22), attesterDutiesResponse.Data[1].Slot)
+	require.Equal(t, uint64(1), attesterDutiesResponse.Data[1].ValidatorCommitteeIndex)
+	proposerDutiesResponse, err := service.(client.ProposerDutiesProvider).ProposerDuties(ctx, &api.ProposerDutiesOpts{
+		Epoch: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, proposerDutiesResponse.Data, 4)
+	require.Equal(t, phase0.ValidatorIndex(0), proposerDutiesResponse.Data[0].ValidatorIndex)
+	require.Equal(t, phase0.ValidatorIndex(3), proposerDutiesResponse.Data[3].ValidatorIndex)
+
+	// Attestation data.
+	attestationDataResponse, err := service.(client.AttestationDataProvider).AttestationData(ctx, &api.AttestationDataOpts{
+		Slot: 21,
+	})
+	require.NoError(t, err)
+	require.Equal(t, testserver.BlockRoot(21), attestationDataResponse.Data.BeaconBlockRoot)
+	require.Equal(t, phase0.Epoch(4), attestationDataResponse.Data.Source.Epoch)
+	require.Equal(t, phase0.Epoch(5), attestationDataResponse.Data.Target.Epoch)
+	require.Equal(t, testserver.BlockRoot(20), attestationDataResponse.Data.Target.Root)
+
+	// Block production.
+	randaoReveal := phase0.BLSSignature{0x01, 0x02}
+	proposalResponse, err := service.(client.ProposalProvider).Proposal(ctx, &api.ProposalOpts{
+		Slot:         22,
+		RandaoReveal: randaoReveal,
+		Graffiti:     [32]byte{0x03},
+	})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionPhase0, proposalResponse.Data.Version)
+	require.Equal(t, phase0.ValidatorIndex(2), proposalResponse.Data.Phase0.ProposerIndex)
+	require.Equal(t, testserver.BlockRoot(21), proposalResponse.Data.Phase0.ParentRoot)
+	require.Equal(t, [32]byte{0x03}, proposalResponse.Data.Phase0.Body.Graffiti)
+
+	// Submissions.
+	resp, err := http.Post(server.Address()+"/eth/v2/beacon/pool/attestations", "application/json", strings.NewReader(`[]`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, [][]byte{[]byte(`[]`)}, server.Submissions("/eth/v2/beacon/pool/attestations"))
+
+	// Events.
+	headEvents := make(chan *apiv1.HeadEvent, 16)
+	require.NoError(t, service.(client.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics: []string{"head"},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			headEvents <- event
+		},
+	}))
+	select {
+	case event := <-headEvents:
+		require.Equal(t, testserver.BlockRoot(event.Slot), event.Block)
+		require.Equal(t, testserver.StateRoot(event.Slot), event.State)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no head event received")
+	}
+}
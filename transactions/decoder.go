@@ -0,0 +1,105 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transactions provides an integration point to decode the execution
+// layer transactions carried in execution payloads, along with helpers that
+// use the decoded transactions to verify proposals.
+//
+// Decoding is carried out by an implementation of Decoder supplied by the
+// caller, so that this module does not depend on any particular execution
+// layer library.  For example, a thin wrapper around UnmarshalBinary and
+// types.Sender from github.com/ethereum/go-ethereum/core/types satisfies the
+// interface.
+package transactions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// Transaction is the information about an execution layer transaction
+// required by the helpers in this package.
+type Transaction struct {
+	// Type is the EIP-2718 type of the transaction.
+	Type uint8
+	// Hash is the hash of the transaction.
+	Hash phase0.Hash32
+	// From is the sender of the transaction.
+	From bellatrix.ExecutionAddress
+	// To is the recipient of the transaction.  It is nil for contract creation.
+	To *bellatrix.ExecutionAddress
+	// Value is the value transferred by the transaction, in wei.
+	Value *uint256.Int
+	// BlobVersionedHashes are the versioned hashes of the blobs referenced
+	// by the transaction.  Only present for blob transactions.
+	BlobVersionedHashes []deneb.VersionedHash
+}
+
+// Decoder is the interface for an execution layer transaction decoder.
+type Decoder interface {
+	// DecodeTransaction decodes a single transaction in its consensus
+	// layer, that is EIP-2718 encoded, form.
+	DecodeTransaction(tx bellatrix.Transaction) (*Transaction, error)
+}
+
+// DecoderFunc is an adapter to allow the use of an ordinary function as a
+// Decoder.
+type DecoderFunc func(tx bellatrix.Transaction) (*Transaction, error)
+
+// DecodeTransaction implements Decoder.
+func (f DecoderFunc) DecodeTransaction(tx bellatrix.Transaction) (*Transaction, error) {
+	return f(tx)
+}
+
+// Decode decodes the supplied transactions.
+func Decode(decoder Decoder, txs []bellatrix.Transaction) ([]*Transaction, error) {
+	if decoder == nil {
+		return nil, errors.New("no decoder specified")
+	}
+
+	res := make([]*Transaction, len(txs))
+	for i, tx := range txs {
+		decoded, err := decoder.DecodeTransaction(tx)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to decode transaction %d", i), err)
+		}
+		if decoded == nil {
+			return nil, fmt.Errorf("no data returned for transaction %d", i)
+		}
+		res[i] = decoded
+	}
+
+	return res, nil
+}
+
+// DecodeProposal decodes the transactions in the execution payload of the
+// supplied proposal.  Blinded proposals do not contain transactions, and
+// result in an error.
+func DecodeProposal(decoder Decoder, proposal *api.VersionedProposal) ([]*Transaction, error) {
+	if proposal == nil {
+		return nil, errors.New("no proposal specified")
+	}
+
+	txs, err := proposal.Transactions()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain transactions"), err)
+	}
+
+	return Decode(decoder, txs)
+}
@@ -0,0 +1,116 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transactions
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/holiman/uint256"
+)
+
+// versionedHashVersionKZG is the version byte of versioned hashes that
+// refer to KZG commitments.
+const versionedHashVersionKZG = 0x01
+
+// ErrBlobVersionedHashMismatch is returned when the blob versioned hashes in
+// transactions do not match the KZG commitments in the block.
+var ErrBlobVersionedHashMismatch = errors.New("blob versioned hash mismatch")
+
+// Payments returns the transactions that transfer value to the given
+// recipient, for example the payment from a block builder to a proposer's
+// fee recipient.
+func Payments(txs []*Transaction, recipient bellatrix.ExecutionAddress) []*Transaction {
+	res := make([]*Transaction, 0)
+	for _, tx := range txs {
+		if tx.To == nil || *tx.To != recipient {
+			continue
+		}
+		if tx.Value == nil || tx.Value.IsZero() {
+			continue
+		}
+		res = append(res, tx)
+	}
+
+	return res
+}
+
+// TotalValue returns the total value transferred by the given transactions.
+func TotalValue(txs []*Transaction) *uint256.Int {
+	total := uint256.NewInt(0)
+	for _, tx := range txs {
+		if tx.Value != nil {
+			total.Add(total, tx.Value)
+		}
+	}
+
+	return total
+}
+
+// BlobVersionedHashes returns the blob versioned hashes referenced by the
+// given transactions, in the order in which they appear.
+func BlobVersionedHashes(txs []*Transaction) []deneb.VersionedHash {
+	res := make([]deneb.VersionedHash, 0)
+	for _, tx := range txs {
+		res = append(res, tx.BlobVersionedHashes...)
+	}
+
+	return res
+}
+
+// VersionedHash returns the versioned hash of the given KZG commitment.
+func VersionedHash(commitment deneb.KZGCommitment) deneb.VersionedHash {
+	hash := deneb.VersionedHash(sha256.Sum256(commitment[:]))
+	hash[0] = versionedHashVersionKZG
+
+	return hash
+}
+
+// VerifyBlobVersionedHashes verifies that the blob versioned hashes
+// referenced by the given transactions match the given KZG commitments.
+func VerifyBlobVersionedHashes(txs []*Transaction, commitments []deneb.KZGCommitment) error {
+	hashes := BlobVersionedHashes(txs)
+	if len(hashes) != len(commitments) {
+		return errors.Join(ErrBlobVersionedHashMismatch,
+			fmt.Errorf("%d versioned hashes for %d commitments", len(hashes), len(commitments)),
+		)
+	}
+	for i := range commitments {
+		if hashes[i] != VersionedHash(commitments[i]) {
+			return errors.Join(ErrBlobVersionedHashMismatch, fmt.Errorf("versioned hash %d does not match commitment", i))
+		}
+	}
+
+	return nil
+}
+
+// VerifyProposalBlobVersionedHashes verifies that the blob versioned hashes
+// referenced by the transactions in the supplied proposal match the KZG
+// commitments in its block.
+func VerifyProposalBlobVersionedHashes(decoder Decoder, proposal *api.VersionedProposal) error {
+	txs, err := DecodeProposal(decoder, proposal)
+	if err != nil {
+		return err
+	}
+	commitments, err := proposal.BlobKZGCommitments()
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain blob KZG commitments"), err)
+	}
+
+	return VerifyBlobVersionedHashes(txs, commitments)
+}
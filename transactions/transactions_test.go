@@ -0,0 +1,184 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transactions_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/transactions"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// testDecoder decodes transactions in a simple test encoding: a type byte, a
+// 20-byte recipient, an 8-byte value and any number of 32-byte versioned hashes.
+var testDecoder = transactions.DecoderFunc(func(tx bellatrix.Transaction) (*transactions.Transaction, error) {
+	if len(tx) < 29 || (len(tx)-29)%32 != 0 {
+		return nil, errors.New("invalid transaction")
+	}
+
+	to := bellatrix.ExecutionAddress{}
+	copy(to[:], tx[1:21])
+	decoded := &transactions.Transaction{
+		Type:  tx[0],
+		To:    &to,
+		Value: uint256.NewInt(binary.BigEndian.Uint64(tx[21:29])),
+	}
+	for i := 29; i < len(tx); i += 32 {
+		decoded.BlobVersionedHashes = append(decoded.BlobVersionedHashes, deneb.VersionedHash(tx[i:i+32]))
+	}
+
+	return decoded, nil
+})
+
+func encode(txType uint8, to byte, value uint64, hashes ...deneb.VersionedHash) bellatrix.Transaction {
+	tx := make([]byte, 29)
+	tx[0] = txType
+	tx[1] = to
+	binary.BigEndian.PutUint64(tx[21:29], value)
+	for _, hash := range hashes {
+		tx = append(tx, hash[:]...)
+	}
+
+	return tx
+}
+
+func denebProposal(txs []bellatrix.Transaction, commitments []deneb.KZGCommitment) *api.VersionedProposal {
+	return &api.VersionedProposal{
+		Version: spec.DataVersionDeneb,
+		Deneb: &apiv1deneb.BlockContents{
+			Block: &deneb.BeaconBlock{
+				Body: &deneb.BeaconBlockBody{
+					ExecutionPayload: &deneb.ExecutionPayload{
+						Transactions: txs,
+					},
+					BlobKZGCommitments: commitments,
+				},
+			},
+		},
+	}
+}
+
+func TestDecode(t *testing.T) {
+	_, err := transactions.Decode(nil, nil)
+	require.EqualError(t, err, "no decoder specified")
+
+	_, err = transactions.Decode(testDecoder, []bellatrix.Transaction{encode(2, 1, 1), {0x01}})
+	require.EqualError(t, err, "failed to decode transaction 1\ninvalid transaction")
+
+	nilDecoder := transactions.DecoderFunc(func(bellatrix.Transaction) (*transactions.Transaction, error) {
+		return nil, nil
+	})
+	_, err = transactions.Decode(nilDecoder, []bellatrix.Transaction{encode(2, 1, 1)})
+	require.EqualError(t, err, "no data returned for transaction 0")
+
+	txs, err := transactions.Decode(testDecoder, []bellatrix.Transaction{encode(2, 1, 5), encode(3, 2, 0)})
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, uint8(3), txs[1].Type)
+	require.Equal(t, bellatrix.ExecutionAddress{0x02}, *txs[1].To)
+	require.Equal(t, uint256.NewInt(5), txs[0].Value)
+}
+
+func TestDecodeProposal(t *testing.T) {
+	_, err := transactions.DecodeProposal(testDecoder, nil)
+	require.EqualError(t, err, "no proposal specified")
+
+	_, err = transactions.DecodeProposal(testDecoder, &api.VersionedProposal{Version: spec.DataVersionDeneb, Blinded: true})
+	require.ErrorIs(t, err, api.ErrDataMissing)
+
+	txs, err := transactions.DecodeProposal(testDecoder, denebProposal([]bellatrix.Transaction{encode(2, 1, 5)}, nil))
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+}
+
+func TestPayments(t *testing.T) {
+	txs, err := transactions.Decode(testDecoder, []bellatrix.Transaction{
+		encode(2, 1, 5),
+		encode(2, 2, 7),
+		encode(2, 1, 0),
+		encode(2, 1, 11),
+	})
+	require.NoError(t, err)
+	txs = append(txs, &transactions.Transaction{Type: 2})
+
+	payments := transactions.Payments(txs, bellatrix.ExecutionAddress{0x01})
+	require.Len(t, payments, 2)
+	require.Equal(t, uint256.NewInt(16), transactions.TotalValue(payments))
+	require.Empty(t, transactions.Payments(txs, bellatrix.ExecutionAddress{0x03}))
+	require.Equal(t, uint256.NewInt(0), transactions.TotalValue(nil))
+}
+
+func TestVersionedHash(t *testing.T) {
+	// The commitment to the empty blob is the compressed point at infinity.
+	hash := transactions.VersionedHash(deneb.KZGCommitment{0xc0})
+	require.Equal(t, byte(0x01), hash[0])
+	require.Equal(t, "0x010657f37554c781402a22917dee2f75def7ab966d7b770905398eba3c444014", hash.String())
+}
+
+func TestVerifyBlobVersionedHashes(t *testing.T) {
+	commitments := []deneb.KZGCommitment{{0x01}, {0x02}, {0x03}}
+	hashes := make([]deneb.VersionedHash, len(commitments))
+	for i := range commitments {
+		hashes[i] = transactions.VersionedHash(commitments[i])
+	}
+
+	tests := []struct {
+		name        string
+		txs         []bellatrix.Transaction
+		commitments []deneb.KZGCommitment
+		err         string
+	}{
+		{
+			name: "NoBlobs",
+			txs:  []bellatrix.Transaction{encode(2, 1, 1)},
+		},
+		{
+			name:        "Good",
+			txs:         []bellatrix.Transaction{encode(3, 1, 0, hashes[0], hashes[1]), encode(2, 1, 1), encode(3, 1, 0, hashes[2])},
+			commitments: commitments,
+		},
+		{
+			name:        "Missing",
+			txs:         []bellatrix.Transaction{encode(3, 1, 0, hashes[0], hashes[1])},
+			commitments: commitments,
+			err:         "blob versioned hash mismatch\n2 versioned hashes for 3 commitments",
+		},
+		{
+			name:        "OutOfOrder",
+			txs:         []bellatrix.Transaction{encode(3, 1, 0, hashes[0], hashes[2], hashes[1])},
+			commitments: commitments,
+			err:         "blob versioned hash mismatch\nversioned hash 1 does not match commitment",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := transactions.VerifyProposalBlobVersionedHashes(testDecoder, denebProposal(test.txs, test.commitments))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, transactions.ErrBlobVersionedHashMismatch)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
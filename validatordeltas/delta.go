@@ -0,0 +1,183 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatordeltas
+
+import (
+	"sort"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Delta is the set of changes to the validator registry between two epochs.
+// All entries are ordered by validator index.
+type Delta struct {
+	// FromEpoch is the epoch of the earlier registry.
+	FromEpoch phase0.Epoch
+	// Epoch is the epoch of the later registry.
+	Epoch phase0.Epoch
+	// New are the validators that were added to the registry.
+	New []*apiv1.Validator
+	// StatusChanges are the validators whose status changed.
+	StatusChanges []*StatusChange
+	// Slashings are the validators that were slashed.
+	Slashings []phase0.ValidatorIndex
+	// Exits are the validators whose exit was initiated.
+	Exits []*Exit
+	// EffectiveBalanceChanges are the validators whose effective balance changed.
+	EffectiveBalanceChanges []*EffectiveBalanceChange
+}
+
+// StatusChange is a change in the status of a validator.
+type StatusChange struct {
+	Index    phase0.ValidatorIndex
+	Previous apiv1.ValidatorState
+	Current  apiv1.ValidatorState
+}
+
+// Exit is the initiation of the exit of a validator.
+type Exit struct {
+	Index             phase0.ValidatorIndex
+	ExitEpoch         phase0.Epoch
+	WithdrawableEpoch phase0.Epoch
+}
+
+// EffectiveBalanceChange is a change in the effective balance of a validator.
+type EffectiveBalanceChange struct {
+	Index    phase0.ValidatorIndex
+	Previous phase0.Gwei
+	Current  phase0.Gwei
+}
+
+// IsEmpty returns true if the delta contains no changes.
+func (d *Delta) IsEmpty() bool {
+	return len(d.New) == 0 &&
+		len(d.StatusChanges) == 0 &&
+		len(d.Slashings) == 0 &&
+		len(d.Exits) == 0 &&
+		len(d.EffectiveBalanceChanges) == 0
+}
+
+// record is the information about a validator required to calculate deltas.
+// It is kept small as the registry contains millions of validators.
+type record struct {
+	status            apiv1.ValidatorState
+	effectiveBalance  phase0.Gwei
+	slashed           bool
+	exitEpoch         phase0.Epoch
+	withdrawableEpoch phase0.Epoch
+}
+
+// newRecord creates a record for a validator.
+func newRecord(validator *apiv1.Validator) record {
+	res := record{
+		status:            validator.Status,
+		exitEpoch:         phase0.FarFutureEpoch,
+		withdrawableEpoch: phase0.FarFutureEpoch,
+	}
+	if validator.Validator != nil {
+		res.effectiveBalance = validator.Validator.EffectiveBalance
+		res.slashed = validator.Validator.Slashed
+		res.exitEpoch = validator.Validator.ExitEpoch
+		res.withdrawableEpoch = validator.Validator.WithdrawableEpoch
+	}
+
+	return res
+}
+
+// Diff calculates the delta between two snapshots of the validator registry.
+func Diff(fromEpoch phase0.Epoch,
+	previous map[phase0.ValidatorIndex]*apiv1.Validator,
+	epoch phase0.Epoch,
+	current map[phase0.ValidatorIndex]*apiv1.Validator,
+) *Delta {
+	return diff(fromEpoch, records(previous), epoch, current)
+}
+
+// records creates records for a snapshot of the validator registry.
+func records(validators map[phase0.ValidatorIndex]*apiv1.Validator) map[phase0.ValidatorIndex]record {
+	res := make(map[phase0.ValidatorIndex]record, len(validators))
+	for index, validator := range validators {
+		if validator == nil {
+			continue
+		}
+		res[index] = newRecord(validator)
+	}
+
+	return res
+}
+
+func diff(fromEpoch phase0.Epoch,
+	previous map[phase0.ValidatorIndex]record,
+	epoch phase0.Epoch,
+	current map[phase0.ValidatorIndex]*apiv1.Validator,
+) *Delta {
+	delta := &Delta{
+		FromEpoch:               fromEpoch,
+		Epoch:                   epoch,
+		New:                     make([]*apiv1.Validator, 0),
+		StatusChanges:           make([]*StatusChange, 0),
+		Slashings:               make([]phase0.ValidatorIndex, 0),
+		Exits:                   make([]*Exit, 0),
+		EffectiveBalanceChanges: make([]*EffectiveBalanceChange, 0),
+	}
+
+	indices := make([]phase0.ValidatorIndex, 0, len(current))
+	for index, validator := range current {
+		if validator != nil {
+			indices = append(indices, index)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+
+	for _, index := range indices {
+		validator := current[index]
+		before, exists := previous[index]
+		if !exists {
+			delta.New = append(delta.New, validator)
+
+			continue
+		}
+		after := newRecord(validator)
+
+		if before.status != after.status {
+			delta.StatusChanges = append(delta.StatusChanges, &StatusChange{
+				Index:    index,
+				Previous: before.status,
+				Current:  after.status,
+			})
+		}
+		if !before.slashed && after.slashed {
+			delta.Slashings = append(delta.Slashings, index)
+		}
+		if before.exitEpoch == phase0.FarFutureEpoch && after.exitEpoch != phase0.FarFutureEpoch {
+			delta.Exits = append(delta.Exits, &Exit{
+				Index:             index,
+				ExitEpoch:         after.exitEpoch,
+				WithdrawableEpoch: after.withdrawableEpoch,
+			})
+		}
+		if before.effectiveBalance != after.effectiveBalance {
+			delta.EffectiveBalanceChanges = append(delta.EffectiveBalanceChanges, &EffectiveBalanceChange{
+				Index:    index,
+				Previous: before.effectiveBalance,
+				Current:  after.effectiveBalance,
+			})
+		}
+	}
+
+	return delta
+}
@@ -0,0 +1,101 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatordeltas
+
+import (
+	"errors"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	chainTime          *chaintime.Service
+	validatorsProvider consensusclient.ValidatorsProvider
+	validatorIndices   []phase0.ValidatorIndex
+	deltaHandler       DeltaHandlerFunc
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithValidatorsProvider sets the provider from which the validator registry is obtained.
+func WithValidatorsProvider(provider consensusclient.ValidatorsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsProvider = provider
+	})
+}
+
+// WithValidatorIndices restricts tracking to the given validators.  If not
+// supplied the entire registry is tracked.
+func WithValidatorIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorIndices = indices
+	})
+}
+
+// WithDeltaHandler sets a function to be called with the delta each time an
+// epoch is processed.  The handler is called synchronously from
+// ProcessEpoch, and must not call back in to the service.
+func WithDeltaHandler(handler DeltaHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.deltaHandler = handler
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.validatorsProvider == nil {
+		return nil, errors.New("no validators provider specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validatordeltas tracks the validator registry across epochs,
+// providing the changes between epochs rather than full snapshots.
+package validatordeltas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// DeltaHandlerFunc is the handler for deltas.
+type DeltaHandlerFunc func(ctx context.Context, delta *Delta)
+
+// Service tracks the validator registry.
+type Service struct {
+	log                zerolog.Logger
+	chainTime          *chaintime.Service
+	validatorsProvider consensusclient.ValidatorsProvider
+	validatorIndices   []phase0.ValidatorIndex
+	deltaHandler       DeltaHandlerFunc
+
+	mu sync.Mutex
+	// epoch is the epoch of the tracked registry.
+	epoch phase0.Epoch
+	// records is the tracked registry; nil until the first epoch is processed.
+	records map[phase0.ValidatorIndex]record
+}
+
+// New creates a new validator delta tracker.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "validatordeltas").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                log,
+		chainTime:          parameters.chainTime,
+		validatorsProvider: parameters.validatorsProvider,
+		validatorIndices:   parameters.validatorIndices,
+		deltaHandler:       parameters.deltaHandler,
+	}, nil
+}
+
+// ProcessEpoch obtains the validator registry at the start of the given
+// epoch and returns the changes since the previously processed epoch.
+// Epochs should be processed in increasing order, but need not be
+// consecutive.  The first epoch processed provides the baseline against
+// which later epochs are compared, so returns no delta.  If a delta is
+// returned the delta handler, if any, is called with it.
+func (s *Service) ProcessEpoch(ctx context.Context, epoch phase0.Epoch) (*Delta, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.records != nil && epoch <= s.epoch {
+		return nil, fmt.Errorf("epoch %d not after tracked epoch %d", epoch, s.epoch)
+	}
+
+	response, err := s.validatorsProvider.Validators(ctx, &api.ValidatorsOpts{
+		State:   strconv.FormatUint(uint64(s.chainTime.EpochStartSlot(epoch)), 10),
+		Indices: s.validatorIndices,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to obtain validators for epoch %d", epoch), err)
+	}
+	if response == nil || response.Data == nil {
+		return nil, fmt.Errorf("no validators returned for epoch %d", epoch)
+	}
+
+	var delta *Delta
+	if s.records != nil {
+		delta = diff(s.epoch, s.records, epoch, response.Data)
+	}
+	s.epoch = epoch
+	s.records = records(response.Data)
+	s.log.Trace().Uint64("epoch", uint64(epoch)).Int("validators", len(s.records)).Msg("Processed epoch")
+
+	if delta != nil && s.deltaHandler != nil {
+		s.deltaHandler(ctx, delta)
+	}
+
+	return delta, nil
+}
+
+// Epoch returns the epoch of the tracked registry, and false if no epoch
+// has been processed.
+func (s *Service) Epoch() (phase0.Epoch, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.epoch, s.records != nil
+}
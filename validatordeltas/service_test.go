@@ -0,0 +1,212 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validatordeltas_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testfixtures"
+	"github.com/attestantio/go-eth2-client/validatordeltas"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testValidator(index phase0.ValidatorIndex,
+	status apiv1.ValidatorState,
+	effectiveBalance phase0.Gwei,
+	slashed bool,
+	exitEpoch phase0.Epoch,
+) *apiv1.Validator {
+	withdrawableEpoch := phase0.FarFutureEpoch
+	if exitEpoch != phase0.FarFutureEpoch {
+		withdrawableEpoch = exitEpoch + 256
+	}
+
+	return &apiv1.Validator{
+		Index:  index,
+		Status: status,
+		Validator: &phase0.Validator{
+			EffectiveBalance:  effectiveBalance,
+			Slashed:           slashed,
+			ExitEpoch:         exitEpoch,
+			WithdrawableEpoch: withdrawableEpoch,
+		},
+	}
+}
+
+// registriesClient creates a test client returning the given registries by
+// slot.
+func registriesClient(t *testing.T,
+	registries map[phase0.Slot]map[phase0.ValidatorIndex]*apiv1.Validator,
+) *mock.Service {
+	t.Helper()
+
+	client, err := testfixtures.Client(time.Now(), nil)
+	require.NoError(t, err)
+	client.ValidatorsFunc = func(_ context.Context, opts *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+		slot, err := strconv.ParseUint(opts.State, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{
+			Data: registries[phase0.Slot(slot)],
+		}, nil
+	}
+
+	return client
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	client := registriesClient(t, nil)
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []validatordeltas.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []validatordeltas.Parameter{
+				validatordeltas.WithLogLevel(zerolog.Disabled),
+				validatordeltas.WithValidatorsProvider(client),
+			},
+			err: "problem with parameters\nno chain time specified",
+		},
+		{
+			name: "ValidatorsProviderMissing",
+			params: []validatordeltas.Parameter{
+				validatordeltas.WithLogLevel(zerolog.Disabled),
+				validatordeltas.WithChainTime(chainTime),
+			},
+			err: "problem with parameters\nno validators provider specified",
+		},
+		{
+			name: "Good",
+			params: []validatordeltas.Parameter{
+				validatordeltas.WithLogLevel(zerolog.Disabled),
+				validatordeltas.WithChainTime(chainTime),
+				validatordeltas.WithValidatorsProvider(client),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := validatordeltas.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProcessEpoch(t *testing.T) {
+	ctx := context.Background()
+	registries := map[phase0.Slot]map[phase0.ValidatorIndex]*apiv1.Validator{
+		4: {
+			0: testValidator(0, apiv1.ValidatorStateActiveOngoing, 32_000_000_000, false, phase0.FarFutureEpoch),
+			1: testValidator(1, apiv1.ValidatorStateActiveOngoing, 32_000_000_000, false, phase0.FarFutureEpoch),
+			2: testValidator(2, apiv1.ValidatorStatePendingQueued, 32_000_000_000, false, phase0.FarFutureEpoch),
+			3: testValidator(3, apiv1.ValidatorStateActiveOngoing, 32_000_000_000, false, phase0.FarFutureEpoch),
+		},
+		12: {
+			0: testValidator(0, apiv1.ValidatorStateActiveOngoing, 32_000_000_000, false, phase0.FarFutureEpoch),
+			1: testValidator(1, apiv1.ValidatorStateActiveSlashed, 31_000_000_000, true, 10),
+			2: testValidator(2, apiv1.ValidatorStateActiveOngoing, 32_000_000_000, false, phase0.FarFutureEpoch),
+			3: testValidator(3, apiv1.ValidatorStateActiveExiting, 32_000_000_000, false, 8),
+			4: testValidator(4, apiv1.ValidatorStatePendingInitialized, 0, false, phase0.FarFutureEpoch),
+		},
+	}
+	client := registriesClient(t, registries)
+
+	chainTime, err := testfixtures.ChainTime(client)
+	require.NoError(t, err)
+
+	handled := make([]*validatordeltas.Delta, 0)
+	s, err := validatordeltas.New(ctx,
+		validatordeltas.WithLogLevel(zerolog.Disabled),
+		validatordeltas.WithChainTime(chainTime),
+		validatordeltas.WithValidatorsProvider(client),
+		validatordeltas.WithDeltaHandler(func(_ context.Context, delta *validatordeltas.Delta) {
+			handled = append(handled, delta)
+		}),
+	)
+	require.NoError(t, err)
+
+	_, processed := s.Epoch()
+	require.False(t, processed)
+
+	// First epoch is the baseline.
+	delta, err := s.ProcessEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, delta)
+	require.Empty(t, handled)
+
+	delta, err = s.ProcessEpoch(ctx, 3)
+	require.NoError(t, err)
+	require.NotNil(t, delta)
+	require.False(t, delta.IsEmpty())
+	require.Equal(t, phase0.Epoch(1), delta.FromEpoch)
+	require.Equal(t, phase0.Epoch(3), delta.Epoch)
+	require.Len(t, delta.New, 1)
+	require.Equal(t, phase0.ValidatorIndex(4), delta.New[0].Index)
+	require.Equal(t, []*validatordeltas.StatusChange{
+		{Index: 1, Previous: apiv1.ValidatorStateActiveOngoing, Current: apiv1.ValidatorStateActiveSlashed},
+		{Index: 2, Previous: apiv1.ValidatorStatePendingQueued, Current: apiv1.ValidatorStateActiveOngoing},
+		{Index: 3, Previous: apiv1.ValidatorStateActiveOngoing, Current: apiv1.ValidatorStateActiveExiting},
+	}, delta.StatusChanges)
+	require.Equal(t, []phase0.ValidatorIndex{1}, delta.Slashings)
+	require.Equal(t, []*validatordeltas.Exit{
+		{Index: 1, ExitEpoch: 10, WithdrawableEpoch: 266},
+		{Index: 3, ExitEpoch: 8, WithdrawableEpoch: 264},
+	}, delta.Exits)
+	require.Equal(t, []*validatordeltas.EffectiveBalanceChange{
+		{Index: 1, Previous: 32_000_000_000, Current: 31_000_000_000},
+	}, delta.EffectiveBalanceChanges)
+	require.Equal(t, []*validatordeltas.Delta{delta}, handled)
+
+	epoch, processed := s.Epoch()
+	require.True(t, processed)
+	require.Equal(t, phase0.Epoch(3), epoch)
+
+	_, err = s.ProcessEpoch(ctx, 3)
+	require.EqualError(t, err, "epoch 3 not after tracked epoch 3")
+}
+
+func TestDiff(t *testing.T) {
+	registry := map[phase0.ValidatorIndex]*apiv1.Validator{
+		0: testValidator(0, apiv1.ValidatorStateActiveOngoing, 32_000_000_000, false, phase0.FarFutureEpoch),
+	}
+
+	delta := validatordeltas.Diff(1, registry, 2, registry)
+	require.True(t, delta.IsEmpty())
+	require.Equal(t, phase0.Epoch(1), delta.FromEpoch)
+	require.Equal(t, phase0.Epoch(2), delta.Epoch)
+
+	delta = validatordeltas.Diff(1, nil, 2, registry)
+	require.Len(t, delta.New, 1)
+}
@@ -0,0 +1,312 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package web3signer builds signing request bodies for remote signers that
+// implement the Web3Signer Ethereum consensus signing API.
+//
+// Requests are created with the New*Request functions, optionally have their
+// signing root set, and are then marshalled to JSON to form the body of a
+// request to the signer's /api/v1/eth2/sign/{identifier} endpoint.
+package web3signer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// RequestType is the type of a signing request.
+type RequestType string
+
+const (
+	// RequestTypeBlock is a request to sign a beacon block.
+	RequestTypeBlock RequestType = "BLOCK_V2"
+	// RequestTypeAttestation is a request to sign attestation data.
+	RequestTypeAttestation RequestType = "ATTESTATION"
+	// RequestTypeAggregateAndProof is a request to sign an aggregate and proof.
+	RequestTypeAggregateAndProof RequestType = "AGGREGATE_AND_PROOF_V2"
+	// RequestTypeSyncCommitteeMessage is a request to sign a sync committee message.
+	RequestTypeSyncCommitteeMessage RequestType = "SYNC_COMMITTEE_MESSAGE"
+	// RequestTypeValidatorRegistration is a request to sign a validator registration.
+	RequestTypeValidatorRegistration RequestType = "VALIDATOR_REGISTRATION"
+)
+
+// ForkInfo is the fork information that a signer uses to calculate the
+// signing domain.
+type ForkInfo struct {
+	Fork                  *phase0.Fork
+	GenesisValidatorsRoot phase0.Root
+}
+
+// SyncCommitteeMessage is the data signed for a sync committee message.
+type SyncCommitteeMessage struct {
+	Slot            phase0.Slot
+	BeaconBlockRoot phase0.Root
+}
+
+// Request is a signing request.  Exactly one of the data fields is set,
+// according to the type of the request.
+type Request struct {
+	Type RequestType
+	// ForkInfo is the fork information.  Not used for validator registrations.
+	ForkInfo *ForkInfo
+	// SigningRoot is the root to sign.  If supplied, signers check that it
+	// matches the root calculated from the data.
+	SigningRoot *phase0.Root
+
+	BeaconBlock           *spec.VersionedBeaconBlock
+	Attestation           *phase0.AttestationData
+	AggregateAndProof     *spec.VersionedAggregateAndProof
+	SyncCommitteeMessage  *SyncCommitteeMessage
+	ValidatorRegistration *apiv1.ValidatorRegistration
+}
+
+// requestJSON is the signer representation of the struct.
+type requestJSON struct {
+	Type                  RequestType                  `json:"type"`
+	ForkInfo              *forkInfoJSON                `json:"fork_info,omitempty"`
+	SigningRoot           string                       `json:"signingRoot,omitempty"`
+	BeaconBlock           *versionedJSON               `json:"beacon_block,omitempty"`
+	Attestation           *phase0.AttestationData      `json:"attestation,omitempty"`
+	AggregateAndProof     *versionedJSON               `json:"aggregate_and_proof,omitempty"`
+	SyncCommitteeMessage  *syncCommitteeMessageJSON    `json:"sync_committee_message,omitempty"`
+	ValidatorRegistration *apiv1.ValidatorRegistration `json:"validator_registration,omitempty"`
+}
+
+type forkInfoJSON struct {
+	Fork                  *phase0.Fork `json:"fork"`
+	GenesisValidatorsRoot string       `json:"genesis_validators_root"`
+}
+
+type versionedJSON struct {
+	Version     string                    `json:"version"`
+	Block       any                       `json:"block,omitempty"`
+	BlockHeader *phase0.BeaconBlockHeader `json:"block_header,omitempty"`
+	Data        any                       `json:"data,omitempty"`
+}
+
+type syncCommitteeMessageJSON struct {
+	BeaconBlockRoot string `json:"beacon_block_root"`
+	Slot            string `json:"slot"`
+}
+
+// NewBlockRequest creates a request to sign a beacon block.
+func NewBlockRequest(forkInfo *ForkInfo, block *spec.VersionedBeaconBlock) (*Request, error) {
+	if forkInfo == nil {
+		return nil, errors.New("no fork info specified")
+	}
+	if block == nil || block.IsEmpty() {
+		return nil, errors.New("no block specified")
+	}
+
+	return &Request{
+		Type:        RequestTypeBlock,
+		ForkInfo:    forkInfo,
+		BeaconBlock: block,
+	}, nil
+}
+
+// NewAttestationRequest creates a request to sign attestation data.
+func NewAttestationRequest(forkInfo *ForkInfo, data *phase0.AttestationData) (*Request, error) {
+	if forkInfo == nil {
+		return nil, errors.New("no fork info specified")
+	}
+	if data == nil || data.Source == nil || data.Target == nil {
+		return nil, errors.New("no attestation data specified")
+	}
+
+	return &Request{
+		Type:        RequestTypeAttestation,
+		ForkInfo:    forkInfo,
+		Attestation: data,
+	}, nil
+}
+
+// NewAggregateAndProofRequest creates a request to sign an aggregate and proof.
+func NewAggregateAndProofRequest(forkInfo *ForkInfo, aggregateAndProof *spec.VersionedAggregateAndProof) (*Request, error) {
+	if forkInfo == nil {
+		return nil, errors.New("no fork info specified")
+	}
+	if aggregateAndProof == nil || aggregateAndProof.IsEmpty() {
+		return nil, errors.New("no aggregate and proof specified")
+	}
+
+	return &Request{
+		Type:              RequestTypeAggregateAndProof,
+		ForkInfo:          forkInfo,
+		AggregateAndProof: aggregateAndProof,
+	}, nil
+}
+
+// NewSyncCommitteeMessageRequest creates a request to sign a sync committee
+// message for the given block root.
+func NewSyncCommitteeMessageRequest(forkInfo *ForkInfo, slot phase0.Slot, beaconBlockRoot phase0.Root) (*Request, error) {
+	if forkInfo == nil {
+		return nil, errors.New("no fork info specified")
+	}
+
+	return &Request{
+		Type:     RequestTypeSyncCommitteeMessage,
+		ForkInfo: forkInfo,
+		SyncCommitteeMessage: &SyncCommitteeMessage{
+			Slot:            slot,
+			BeaconBlockRoot: beaconBlockRoot,
+		},
+	}, nil
+}
+
+// NewValidatorRegistrationRequest creates a request to sign a validator
+// registration.  Validator registrations are signed outside of any fork, so
+// no fork information is required.
+func NewValidatorRegistrationRequest(registration *apiv1.ValidatorRegistration) (*Request, error) {
+	if registration == nil {
+		return nil, errors.New("no validator registration specified")
+	}
+
+	return &Request{
+		Type:                  RequestTypeValidatorRegistration,
+		ValidatorRegistration: registration,
+	}, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Request) MarshalJSON() ([]byte, error) {
+	data := &requestJSON{
+		Type:                  r.Type,
+		Attestation:           r.Attestation,
+		ValidatorRegistration: r.ValidatorRegistration,
+	}
+	if r.ForkInfo != nil {
+		data.ForkInfo = &forkInfoJSON{
+			Fork:                  r.ForkInfo.Fork,
+			GenesisValidatorsRoot: fmt.Sprintf("%#x", r.ForkInfo.GenesisValidatorsRoot),
+		}
+	}
+	if r.SigningRoot != nil {
+		data.SigningRoot = fmt.Sprintf("%#x", *r.SigningRoot)
+	}
+	if r.SyncCommitteeMessage != nil {
+		data.SyncCommitteeMessage = &syncCommitteeMessageJSON{
+			BeaconBlockRoot: fmt.Sprintf("%#x", r.SyncCommitteeMessage.BeaconBlockRoot),
+			Slot:            fmt.Sprintf("%d", r.SyncCommitteeMessage.Slot),
+		}
+	}
+
+	var err error
+	if r.BeaconBlock != nil {
+		data.BeaconBlock, err = blockJSON(r.BeaconBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.AggregateAndProof != nil {
+		data.AggregateAndProof, err = aggregateAndProofJSON(r.AggregateAndProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// blockJSON returns the signer representation of a block.  Blocks from
+// Bellatrix onwards are sent as headers, as signers do not need the body.
+func blockJSON(block *spec.VersionedBeaconBlock) (*versionedJSON, error) {
+	res := &versionedJSON{
+		Version: version(block.Version),
+	}
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		res.Block = block.Phase0
+	case spec.DataVersionAltair:
+		res.Block = block.Altair
+	default:
+		header, err := blockHeader(block)
+		if err != nil {
+			return nil, err
+		}
+		res.BlockHeader = header
+	}
+
+	return res, nil
+}
+
+// blockHeader returns the header of a block.
+func blockHeader(block *spec.VersionedBeaconBlock) (*phase0.BeaconBlockHeader, error) {
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block slot"), err)
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block proposer index"), err)
+	}
+	parentRoot, err := block.ParentRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block parent root"), err)
+	}
+	stateRoot, err := block.StateRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block state root"), err)
+	}
+	bodyRoot, err := block.BodyRoot()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain block body root"), err)
+	}
+
+	return &phase0.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		BodyRoot:      bodyRoot,
+	}, nil
+}
+
+// aggregateAndProofJSON returns the signer representation of an aggregate
+// and proof.
+func aggregateAndProofJSON(aggregateAndProof *spec.VersionedAggregateAndProof) (*versionedJSON, error) {
+	res := &versionedJSON{
+		Version: version(aggregateAndProof.Version),
+	}
+
+	switch aggregateAndProof.Version {
+	case spec.DataVersionPhase0:
+		res.Data = aggregateAndProof.Phase0
+	case spec.DataVersionAltair:
+		res.Data = aggregateAndProof.Altair
+	case spec.DataVersionBellatrix:
+		res.Data = aggregateAndProof.Bellatrix
+	case spec.DataVersionCapella:
+		res.Data = aggregateAndProof.Capella
+	case spec.DataVersionDeneb:
+		res.Data = aggregateAndProof.Deneb
+	case spec.DataVersionElectra:
+		res.Data = aggregateAndProof.Electra
+	default:
+		return nil, errors.New("unknown version")
+	}
+
+	return res, nil
+}
+
+// version returns the signer representation of a data version.
+func version(version spec.DataVersion) string {
+	return strings.ToUpper(version.String())
+}
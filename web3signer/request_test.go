@@ -0,0 +1,201 @@
+// Copyright © 2025 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web3signer_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/web3signer"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testForkInfo() *web3signer.ForkInfo {
+	return &web3signer.ForkInfo{
+		Fork: &phase0.Fork{
+			PreviousVersion: phase0.Version{0x03, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x04, 0x00, 0x00, 0x00},
+			Epoch:           269568,
+		},
+		GenesisValidatorsRoot: phase0.Root{0x01},
+	}
+}
+
+func testAttestationData() *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            10,
+		Index:           2,
+		BeaconBlockRoot: phase0.Root{0x02},
+		Source: &phase0.Checkpoint{
+			Epoch: 0,
+			Root:  phase0.Root{0x03},
+		},
+		Target: &phase0.Checkpoint{
+			Epoch: 1,
+			Root:  phase0.Root{0x04},
+		},
+	}
+}
+
+const (
+	forkInfoJSON = `"fork_info":{"fork":{"previous_version":"0x03000000","current_version":"0x04000000","epoch":"269568"},"genesis_validators_root":"0x0100000000000000000000000000000000000000000000000000000000000000"}`
+	dataJSON     = `{"slot":"10","index":"2","beacon_block_root":"0x0200000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0300000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"1","root":"0x0400000000000000000000000000000000000000000000000000000000000000"}}`
+)
+
+func TestAttestationRequest(t *testing.T) {
+	_, err := web3signer.NewAttestationRequest(nil, testAttestationData())
+	require.EqualError(t, err, "no fork info specified")
+	_, err = web3signer.NewAttestationRequest(testForkInfo(), nil)
+	require.EqualError(t, err, "no attestation data specified")
+
+	req, err := web3signer.NewAttestationRequest(testForkInfo(), testAttestationData())
+	require.NoError(t, err)
+	signingRoot := phase0.Root{0x05}
+	req.SigningRoot = &signingRoot
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"ATTESTATION",`+forkInfoJSON+`,"signingRoot":"0x0500000000000000000000000000000000000000000000000000000000000000","attestation":`+dataJSON+`}`, string(data))
+}
+
+func TestAggregateAndProofRequest(t *testing.T) {
+	_, err := web3signer.NewAggregateAndProofRequest(testForkInfo(), &spec.VersionedAggregateAndProof{Version: spec.DataVersionDeneb})
+	require.EqualError(t, err, "no aggregate and proof specified")
+
+	req, err := web3signer.NewAggregateAndProofRequest(testForkInfo(), &spec.VersionedAggregateAndProof{
+		Version: spec.DataVersionDeneb,
+		Deneb: &phase0.AggregateAndProof{
+			AggregatorIndex: 3,
+			Aggregate: &phase0.Attestation{
+				AggregationBits: bitfield.NewBitlist(8),
+				Data:            testAttestationData(),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"AGGREGATE_AND_PROOF_V2",`+forkInfoJSON+`,"aggregate_and_proof":{"version":"DENEB","data":{"aggregator_index":"3","aggregate":{"aggregation_bits":"0x0001","data":`+dataJSON+`,"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"selection_proof":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}}`, string(data))
+}
+
+func TestSyncCommitteeMessageRequest(t *testing.T) {
+	req, err := web3signer.NewSyncCommitteeMessageRequest(testForkInfo(), 12, phase0.Root{0x06})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"SYNC_COMMITTEE_MESSAGE",`+forkInfoJSON+`,"sync_committee_message":{"beacon_block_root":"0x0600000000000000000000000000000000000000000000000000000000000000","slot":"12"}}`, string(data))
+}
+
+func TestValidatorRegistrationRequest(t *testing.T) {
+	_, err := web3signer.NewValidatorRegistrationRequest(nil)
+	require.EqualError(t, err, "no validator registration specified")
+
+	req, err := web3signer.NewValidatorRegistrationRequest(&apiv1.ValidatorRegistration{
+		FeeRecipient: bellatrix.ExecutionAddress{0x07},
+		GasLimit:     30000000,
+		Timestamp:    time.Unix(1700000000, 0),
+		Pubkey:       phase0.BLSPubKey{0x08},
+	})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"VALIDATOR_REGISTRATION","validator_registration":{"fee_recipient":"0x0700000000000000000000000000000000000000","gas_limit":"30000000","timestamp":"1700000000","pubkey":"0x080000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}`, string(data))
+}
+
+func TestBlockRequest(t *testing.T) {
+	_, err := web3signer.NewBlockRequest(testForkInfo(), nil)
+	require.EqualError(t, err, "no block specified")
+
+	// Phase 0 blocks are sent in full.
+	req, err := web3signer.NewBlockRequest(testForkInfo(), &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconBlock{
+			Slot:          1,
+			ProposerIndex: 2,
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{},
+			},
+		},
+	})
+	require.NoError(t, err)
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	var res map[string]any
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, "BLOCK_V2", res["type"])
+	block, isMap := res["beacon_block"].(map[string]any)
+	require.True(t, isMap)
+	require.Equal(t, "PHASE0", block["version"])
+	require.Contains(t, block, "block")
+	require.NotContains(t, block, "block_header")
+
+	// Later blocks are sent as headers.
+	denebBlock := &deneb.BeaconBlock{
+		Slot:          3,
+		ProposerIndex: 4,
+		ParentRoot:    phase0.Root{0x09},
+		StateRoot:     phase0.Root{0x0a},
+		Body: &deneb.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(0),
+				BlobGasUsed:   0,
+			},
+		},
+	}
+	bodyRoot, err := denebBlock.Body.HashTreeRoot()
+	require.NoError(t, err)
+	req, err = web3signer.NewBlockRequest(testForkInfo(), &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionDeneb,
+		Deneb:   denebBlock,
+	})
+	require.NoError(t, err)
+	data, err = json.Marshal(req)
+	require.NoError(t, err)
+	header, err := json.Marshal(&phase0.BeaconBlockHeader{
+		Slot:          3,
+		ProposerIndex: 4,
+		ParentRoot:    phase0.Root{0x09},
+		StateRoot:     phase0.Root{0x0a},
+		BodyRoot:      bodyRoot,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"BLOCK_V2",`+forkInfoJSON+`,"beacon_block":{"version":"DENEB","block_header":`+string(header)+`}}`, string(data))
+
+	// Blocks without bodies cannot be converted to headers.
+	req, err = web3signer.NewBlockRequest(testForkInfo(), &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionDeneb,
+		Deneb:   &deneb.BeaconBlock{Slot: 3},
+	})
+	require.NoError(t, err)
+	_, err = json.Marshal(req)
+	require.ErrorContains(t, err, "failed to obtain block body root")
+}